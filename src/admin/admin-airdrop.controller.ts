@@ -0,0 +1,39 @@
+import { Body, Controller, Post } from '@nestjs/common';
+import { ApiOperation, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { AdminProtected } from 'src/auth/admin';
+import { AirdropDrillsDto } from 'src/common/dto/shops/shop-purchase.dto';
+import { ShopPurchaseService } from 'src/shops/shop-purchase.service';
+
+@ApiTags('Admin Airdrops')
+@Controller('admin/airdrop')
+export class AdminAirdropController {
+  constructor(private readonly shopPurchaseService: ShopPurchaseService) {}
+
+  @ApiOperation({
+    summary: 'Airdrop drills',
+    description:
+      'Gives a drill from the shop to each of the given operators for free (e.g. for promotional campaigns) and notifies them. Returns how many airdrops succeeded and failed',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Airdrop completed',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Shop item is not a drill',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Shop item not found',
+  })
+  @AdminProtected()
+  @Post('drills')
+  async airdropDrills(@Body() body: AirdropDrillsDto) {
+    return this.shopPurchaseService.airdropShopDrill(
+      new Types.ObjectId(body.shopItemId),
+      body.operatorIds.map((id) => new Types.ObjectId(id)),
+      body.reason,
+    );
+  }
+}
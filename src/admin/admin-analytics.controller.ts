@@ -0,0 +1,63 @@
+import { Controller, Get, Query } from '@nestjs/common';
+import { ApiOperation, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { AdminProtected } from 'src/auth/admin';
+import {
+  GetPoolsCreatedQueryDto,
+  GetRevenueQueryDto,
+  PoolsCreatedPeriodDto,
+  RevenueResponseDto,
+} from 'src/common/dto/admin-analytics.dto';
+import { AdminService } from './admin.service';
+
+@ApiTags('Admin Analytics')
+@Controller('admin/analytics')
+export class AdminAnalyticsController {
+  constructor(private readonly adminService: AdminService) {}
+
+  @ApiOperation({
+    summary: 'Get pools created over time',
+    description:
+      'Counts the pools created per day or week within an optional date range, oldest period first',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Pool creation counts fetched',
+    type: [PoolsCreatedPeriodDto],
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid date range',
+  })
+  @AdminProtected()
+  @Get('pools-created')
+  async fetchPoolsCreated(@Query() query: GetPoolsCreatedQueryDto) {
+    return this.adminService.fetchPoolsCreated(
+      query.from ? new Date(query.from) : undefined,
+      query.to ? new Date(query.to) : undefined,
+      query.granularity,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get TON revenue',
+    description:
+      'Sums the TON received from shop purchases per category (purchases, upgrades, fuel, slots and bundles) within an optional date range. Includes a daily breakdown if the range is at most 30 days',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Revenue fetched',
+    type: RevenueResponseDto,
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid date range',
+  })
+  @AdminProtected()
+  @Get('revenue')
+  async fetchRevenue(@Query() query: GetRevenueQueryDto) {
+    return this.adminService.fetchRevenue(
+      query.from ? new Date(query.from) : undefined,
+      query.to ? new Date(query.to) : undefined,
+    );
+  }
+}
@@ -0,0 +1,123 @@
+import { Body, Controller, Delete, Get, Param, Post, Put } from '@nestjs/common';
+import { ApiOperation, ApiParam, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { AdminProtected } from 'src/auth/admin';
+import {
+  CreateAnnouncementDto,
+  UpdateAnnouncementDto,
+} from 'src/common/dto/announcement.dto';
+import { AnnouncementService } from 'src/platform/announcement.service';
+import { AnnouncementType } from 'src/platform/schemas/announcement.schema';
+
+@ApiTags('Admin Announcements')
+@Controller('admin/announcements')
+export class AdminAnnouncementController {
+  constructor(private readonly announcementService: AnnouncementService) {}
+
+  @ApiOperation({
+    summary: 'Get all announcements',
+    description:
+      'Fetches all announcements, including past and upcoming ones, newest first',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Announcements fetched',
+  })
+  @AdminProtected()
+  @Get()
+  async getAnnouncements() {
+    return this.announcementService.fetchAnnouncements();
+  }
+
+  @ApiOperation({
+    summary: 'Create an announcement',
+    description:
+      'Creates a platform-wide announcement that is shown to all operators between its start and end time',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Announcement created',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - The announcement ends before it starts',
+  })
+  @AdminProtected()
+  @Post()
+  async createAnnouncement(@Body() body: CreateAnnouncementDto) {
+    return this.announcementService.createAnnouncement(
+      body.title,
+      body.body,
+      body.type ?? AnnouncementType.INFO,
+      new Date(body.startsAt),
+      body.endsAt ? new Date(body.endsAt) : null,
+      body.createdByAdmin,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Update an announcement',
+    description: 'Updates the provided fields of an announcement',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the announcement',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Announcement updated',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - The announcement ends before it starts',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Announcement not found',
+  })
+  @AdminProtected()
+  @Put(':id')
+  async updateAnnouncement(
+    @Param('id') announcementId: string,
+    @Body() body: UpdateAnnouncementDto,
+  ) {
+    return this.announcementService.updateAnnouncement(
+      new Types.ObjectId(announcementId),
+      {
+        title: body.title,
+        body: body.body,
+        type: body.type,
+        startsAt: body.startsAt ? new Date(body.startsAt) : undefined,
+        endsAt:
+          body.endsAt === undefined
+            ? undefined
+            : body.endsAt && new Date(body.endsAt),
+      },
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Delete an announcement',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the announcement',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Announcement deleted',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Announcement not found',
+  })
+  @AdminProtected()
+  @Delete(':id')
+  async deleteAnnouncement(@Param('id') announcementId: string) {
+    return this.announcementService.deleteAnnouncement(
+      new Types.ObjectId(announcementId),
+    );
+  }
+}
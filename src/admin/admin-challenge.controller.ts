@@ -0,0 +1,61 @@
+import { Body, Controller, Get, Post, Query } from '@nestjs/common';
+import { ApiOperation, ApiQuery, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { AdminProtected } from 'src/auth/admin';
+import { CreatePoolChallengeDto } from 'src/common/dto/pools/pool-challenge.dto';
+import { PoolChallengeService } from 'src/pools/pool-challenge.service';
+import { PoolChallengeStatus } from 'src/pools/schemas/pool-challenge.schema';
+
+@ApiTags('Admin Pool Challenges')
+@Controller('admin/challenges')
+export class AdminChallengeController {
+  constructor(private readonly poolChallengeService: PoolChallengeService) {}
+
+  @ApiOperation({
+    summary: 'Create a pool challenge',
+    description:
+      'Pits two pools against each other for a number of cycles. The pool that extracts the most $HASH wins the reward from the other pool',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Challenge created',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Same pool on both sides or invalid start cycle',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @AdminProtected()
+  @Post()
+  async createChallenge(@Body() body: CreatePoolChallengeDto) {
+    return this.poolChallengeService.createChallenge(
+      new Types.ObjectId(body.challengingPoolId),
+      new Types.ObjectId(body.challengedPoolId),
+      body.cycleCount,
+      body.rewardHASH,
+      body.startsAtCycle,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get pool challenges',
+    description: 'Fetches all pool challenges, optionally filtered by status',
+  })
+  @ApiQuery({
+    name: 'status',
+    enum: PoolChallengeStatus,
+    required: false,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Challenges fetched',
+  })
+  @AdminProtected()
+  @Get()
+  async fetchChallenges(@Query('status') status?: PoolChallengeStatus) {
+    return this.poolChallengeService.fetchChallenges(status);
+  }
+}
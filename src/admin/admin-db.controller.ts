@@ -0,0 +1,55 @@
+import { Body, Controller, Get, HttpCode, Param, Post } from '@nestjs/common';
+import { ApiOperation, ApiParam, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { AdminProtected } from 'src/auth/admin';
+import { StartCompactionDto } from 'src/common/dto/admin-db.dto';
+import { AdminService } from './admin.service';
+
+@ApiTags('Admin Database')
+@Controller('admin/db')
+export class AdminDbController {
+  constructor(private readonly adminService: AdminService) {}
+
+  @ApiOperation({
+    summary: 'Compact a collection',
+    description:
+      'Queues compacting a long-running collection and returns a job ID to poll its status and result',
+  })
+  @ApiResponse({
+    status: 202,
+    description: 'Compaction job queued',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Collection cannot be compacted',
+  })
+  @AdminProtected()
+  @HttpCode(202)
+  @Post('compact')
+  async startCompaction(@Body() body: StartCompactionDto) {
+    return this.adminService.startCompaction(body.collection, body.validate);
+  }
+
+  @ApiOperation({
+    summary: 'Get compaction job status',
+    description:
+      'Fetches the status of a compaction job, including what the compact and validate commands reported once it completed',
+  })
+  @ApiParam({
+    name: 'jobId',
+    description: 'The ID of the compaction job',
+    example: '1',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Compaction job fetched',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Compaction job not found',
+  })
+  @AdminProtected()
+  @Get('compact/:jobId')
+  async fetchCompactionJob(@Param('jobId') jobId: string) {
+    return this.adminService.fetchCompactionJob(jobId);
+  }
+}
@@ -0,0 +1,33 @@
+import { Processor, Process, OnGlobalQueueFailed } from '@nestjs/bull';
+import { Job } from 'bull';
+import { Injectable, Logger } from '@nestjs/common';
+import { AdminService, CompactionJobData } from './admin.service';
+
+@Injectable()
+@Processor('admin-db-queue')
+export class AdminDbQueue {
+  private readonly logger = new Logger(AdminDbQueue.name);
+
+  constructor(private readonly adminService: AdminService) {}
+
+  /**
+   * Compacts a collection. The returned result is stored on the job, so admins can poll it.
+   */
+  @Process({
+    name: 'compact-collection',
+    concurrency: 1, // Compacting several collections at once would compete for the same disk
+  })
+  async handleCompactCollection(job: Job<CompactionJobData>) {
+    return this.adminService.compactCollection(job.data);
+  }
+
+  /**
+   * Handle failed jobs in the queue.
+   */
+  @OnGlobalQueueFailed()
+  onFailed(jobId: number, err: Error) {
+    this.logger.error(
+      `❌ Admin DB Queue job ${jobId} has failed: ${err.message}`,
+    );
+  }
+}
@@ -0,0 +1,35 @@
+import { Body, Controller, Post } from '@nestjs/common';
+import { ApiOperation, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { AdminProtected } from 'src/auth/admin';
+import { CreateDrillSkinDto } from 'src/common/dto/drill-skin.dto';
+import { DrillSkinService } from 'src/drills/drill-skin.service';
+
+@ApiTags('Admin Drill Skins')
+@Controller('admin/drill-skins')
+export class AdminDrillSkinController {
+  constructor(private readonly drillSkinService: DrillSkinService) {}
+
+  @ApiOperation({
+    summary: 'Create a drill skin',
+    description:
+      'Creates a cosmetic drill skin that operators can apply to their drills by paying its TON price',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Drill skin created',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - A skin with the same name already exists',
+  })
+  @AdminProtected()
+  @Post()
+  async createDrillSkin(@Body() body: CreateDrillSkinDto) {
+    return this.drillSkinService.createDrillSkin(
+      body.name,
+      body.description ?? '',
+      body.imageURL,
+      body.priceTON,
+    );
+  }
+}
@@ -0,0 +1,68 @@
+import { Controller, Get, Query } from '@nestjs/common';
+import { ApiOperation, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { AdminProtected } from 'src/auth/admin';
+import { Types } from 'mongoose';
+import {
+  AdminListOperatorsQueryDto,
+  GetOperatorsByTrustQueryDto,
+} from 'src/common/dto/admin-operator.dto';
+import { AdminService } from './admin.service';
+
+@ApiTags('Admin Operators')
+@Controller('admin/operators')
+export class AdminOperatorController {
+  constructor(private readonly adminService: AdminService) {}
+
+  @ApiOperation({
+    summary: 'List operators',
+    description:
+      'Lists operators with cursor-based pagination, including whether they are drilling, their pool, their drill count and their ban status. Pass the returned `nextCursor` as `cursor` to fetch the next page',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Operators fetched',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid cursor',
+  })
+  @AdminProtected()
+  @Get()
+  async listOperators(@Query() query: AdminListOperatorsQueryDto) {
+    return this.adminService.listOperators(
+      {
+        filterBanned: query.filterBanned,
+        filterHasPool: query.filterHasPool,
+      },
+      query.sort,
+      query.limit,
+      query.cursor ? new Types.ObjectId(query.cursor) : undefined,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get operators by trust score',
+    description:
+      'Fetches operators whose trust score is within the given range, highest first',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Operators fetched',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid trust score range',
+  })
+  @AdminProtected()
+  @Get('trust')
+  async fetchOperatorsByTrustScore(
+    @Query() query: GetOperatorsByTrustQueryDto,
+  ) {
+    return this.adminService.fetchOperatorsByTrustScore(
+      query.minTrust,
+      query.maxTrust,
+      query.page,
+      query.limit,
+    );
+  }
+}
@@ -0,0 +1,39 @@
+import { Body, Controller, Post, Query } from '@nestjs/common';
+import { ApiOperation, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { AdminProtected } from 'src/auth/admin';
+import {
+  ResetSeasonDto,
+  ResetSeasonQueryDto,
+} from 'src/common/dto/admin-season.dto';
+import { AdminService } from './admin.service';
+
+@ApiTags('Admin Season')
+@Controller('admin/season')
+export class AdminSeasonController {
+  constructor(private readonly adminService: AdminService) {}
+
+  @ApiOperation({
+    summary: 'Reset the season',
+    description:
+      "Zeroes every operator's HASH balance and current streak, archives closed pool membership records and stores a season summary. With `dryRun=true`, nothing is written; the number of affected records per collection and the season summary are returned along with a confirmation token, which must be passed to execute the reset",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Season reset previewed or completed',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid or expired confirmation token',
+  })
+  @AdminProtected()
+  @Post('reset')
+  async resetSeason(
+    @Query() query: ResetSeasonQueryDto,
+    @Body() body: ResetSeasonDto,
+  ) {
+    return this.adminService.resetSeason(
+      query.dryRun ?? false,
+      body?.confirmationToken,
+    );
+  }
+}
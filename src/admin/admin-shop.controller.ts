@@ -0,0 +1,150 @@
+import { Body, Controller, Delete, Param, Post } from '@nestjs/common';
+import { ApiOperation, ApiParam, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { AdminProtected } from 'src/auth/admin';
+import {
+  RestockShopDrillDto,
+  StartFlashSaleDto,
+} from 'src/common/dto/shops/shop-purchase.dto';
+import { AddShopDrillDto } from 'src/common/dto/shops/shop-item.dto';
+import { ShopPurchaseService } from 'src/shops/shop-purchase.service';
+import { ShopItemService } from 'src/shops/shop-item.service';
+
+@ApiTags('Admin Shop')
+@Controller('admin/shop')
+export class AdminShopController {
+  constructor(
+    private readonly shopPurchaseService: ShopPurchaseService,
+    private readonly shopItemService: ShopItemService,
+  ) {}
+
+  @ApiOperation({
+    summary: 'Add a drill to the shop',
+    description:
+      'Lists a new drill in the shop. `upgradeCosts` must contain exactly `maxLevel - 1` entries for levels 2 to `maxLevel`, in order',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Shop drill created',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - The configuration is not sold in the shop or the upgrade costs do not match the max level',
+  })
+  @AdminProtected()
+  @Post('drills')
+  async addShopDrill(@Body() body: AddShopDrillDto) {
+    return this.shopItemService.addShopDrill(
+      body.config,
+      body.version,
+      { ton: body.ton, bera: body.bera },
+      body.baseEff,
+      body.maxLevel,
+      body.upgradeCosts,
+      body.description,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Remove a drill from the shop',
+    description:
+      'Deactivates a drill shop item so it can no longer be bought. The item is kept so drills bought from it retain their lineage',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the drill shop item',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Shop drill removed',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Shop item is not a drill or has already been removed',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Shop item not found',
+  })
+  @AdminProtected()
+  @Delete('drills/:id')
+  async removeShopDrill(@Param('id') shopItemId: string) {
+    return this.shopItemService.removeShopDrill(
+      new Types.ObjectId(shopItemId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Restock a drill',
+    description:
+      'Adds units to the remaining supply of a limited-edition drill in the shop, then notifies connected operators and announces the restock on Telegram. Returns the new remaining supply',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the drill shop item',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Drill restocked',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Shop item is not a drill or has an unlimited supply',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Shop item not found',
+  })
+  @AdminProtected()
+  @Post('drills/:id/restock')
+  async restockDrill(
+    @Param('id') shopItemId: string,
+    @Body() body: RestockShopDrillDto,
+  ) {
+    return this.shopPurchaseService.restockShopDrill(
+      new Types.ObjectId(shopItemId),
+      body.quantity,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Start a flash sale',
+    description:
+      "Lowers a shop item's price until the given end time. The original price is restored automatically once the sale ends",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the shop item',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Flash sale started',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - End time in the past, sale price above the current price or the item already has an active flash sale',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Shop item not found',
+  })
+  @AdminProtected()
+  @Post('items/:id/flash-sale')
+  async startFlashSale(
+    @Param('id') shopItemId: string,
+    @Body() body: StartFlashSaleDto,
+  ) {
+    return this.shopPurchaseService.startFlashSale(
+      new Types.ObjectId(shopItemId),
+      { ton: body.ton, bera: body.bera },
+      new Date(body.endTime),
+    );
+  }
+}
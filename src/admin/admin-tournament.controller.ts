@@ -0,0 +1,35 @@
+import { Body, Controller, Post } from '@nestjs/common';
+import { ApiOperation, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { AdminProtected } from 'src/auth/admin';
+import { CreateTournamentDto } from 'src/common/dto/tournament.dto';
+import { TournamentService } from 'src/tournaments/tournament.service';
+
+@ApiTags('Admin Tournaments')
+@Controller('admin/tournaments')
+export class AdminTournamentController {
+  constructor(private readonly tournamentService: TournamentService) {}
+
+  @ApiOperation({
+    summary: 'Create a tournament',
+    description:
+      "Creates a tournament between all pools. Pools score a point for every cycle extracted by their operators, and the highest scoring pool's members split the prize",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Tournament created',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid tournament period',
+  })
+  @AdminProtected()
+  @Post()
+  async createTournament(@Body() body: CreateTournamentDto) {
+    return this.tournamentService.createTournament(
+      body.name,
+      new Date(body.startsAt),
+      new Date(body.endsAt),
+      body.prizeHASH,
+    );
+  }
+}
@@ -1,5 +1,6 @@
 import { Module } from '@nestjs/common';
 import { ConfigModule } from '@nestjs/config';
+import { BullModule } from '@nestjs/bull';
 import { AdminService } from './admin.service';
 import { MongooseModule } from '@nestjs/mongoose';
 import {
@@ -28,6 +29,31 @@ import {
   DrillingSession,
   DrillingSessionSchema,
 } from 'src/drills/schemas/drilling-session.schema';
+import { AdminDbController } from './admin-db.controller';
+import { AdminChallengeController } from './admin-challenge.controller';
+import { PoolModule } from 'src/pools/pool.module';
+import { AdminOperatorController } from './admin-operator.controller';
+import { AdminAnalyticsController } from './admin-analytics.controller';
+import { AdminTournamentController } from './admin-tournament.controller';
+import { TournamentModule } from 'src/tournaments/tournament.module';
+import { AdminAirdropController } from './admin-airdrop.controller';
+import { ShopPurchaseModule } from 'src/shops/shop-purchase.module';
+import { ShopItemModule } from 'src/shops/shop-item.module';
+import { AdminDrillSkinController } from './admin-drill-skin.controller';
+import { DrillSkinModule } from 'src/drills/drill-skin.module';
+import { AdminShopController } from './admin-shop.controller';
+import { AdminAnnouncementController } from './admin-announcement.controller';
+import { PlatformModule } from 'src/platform/platform.module';
+import { AdminSeasonController } from './admin-season.controller';
+import {
+  PoolMembershipHistory,
+  PoolMembershipHistorySchema,
+} from 'src/pools/schemas/pool-membership-history.schema';
+import { AdminDbQueue } from './admin-db.queue';
+import {
+  HashTransaction,
+  HashTransactionSchema,
+} from 'src/operators/schemas/hash-transaction.schema';
 
 @Module({
   imports: [
@@ -43,10 +69,41 @@ import {
       { name: HASHReserve.name, schema: HashReserveSchema },
       { name: Pool.name, schema: PoolSchema },
       { name: PoolOperator.name, schema: PoolOperatorSchema },
+      {
+        name: PoolMembershipHistory.name,
+        schema: PoolMembershipHistorySchema,
+      },
+      { name: HashTransaction.name, schema: HashTransactionSchema },
     ]),
+    BullModule.registerQueue({
+      name: 'admin-db-queue',
+      defaultJobOptions: {
+        attempts: 1, // A failed compaction is reported instead of retried
+        removeOnComplete: { age: 86_400 }, // Keep results for 24 hours
+        removeOnFail: { age: 86_400 },
+      },
+    }),
     RedisModule,
+    PoolModule,
+    TournamentModule,
+    ShopPurchaseModule,
+    ShopItemModule,
+    DrillSkinModule,
+    PlatformModule,
+  ],
+  controllers: [
+    AdminDbController,
+    AdminChallengeController,
+    AdminOperatorController,
+    AdminAnalyticsController,
+    AdminTournamentController,
+    AdminAirdropController,
+    AdminDrillSkinController,
+    AdminShopController,
+    AdminAnnouncementController,
+    AdminSeasonController,
   ],
-  providers: [AdminService],
+  providers: [AdminService, AdminDbQueue],
   exports: [AdminService],
 })
 export class AdminModule {}
@@ -3,8 +3,12 @@ import {
   InternalServerErrorException,
   Logger,
 } from '@nestjs/common';
-import { InjectModel } from '@nestjs/mongoose';
-import { Model } from 'mongoose';
+import { InjectConnection, InjectModel } from '@nestjs/mongoose';
+import { Connection, Model, Types } from 'mongoose';
+import { randomBytes } from 'crypto';
+import { InjectQueue } from '@nestjs/bull';
+import { Queue } from 'bull';
+import { ApiResponse } from 'src/common/dto/response.dto';
 import { RedisService } from 'src/common/redis.service';
 import { DrillingCycleRewardShare } from 'src/drills/schemas/drilling-crs.schema';
 import { DrillingCycle } from 'src/drills/schemas/drilling-cycle.schema';
@@ -13,6 +17,133 @@ import { HASHReserve } from 'src/hash-reserve/schemas/hash-reserve.schema';
 import { Operator } from 'src/operators/schemas/operator.schema';
 import { PoolOperator } from 'src/pools/schemas/pool-operator.schema';
 import { Pool } from 'src/pools/schemas/pool.schema';
+import {
+  PoolsCreatedPeriodDto,
+  RevenueDayDto,
+  RevenueResponseDto,
+  RevenueTotalsDto,
+} from 'src/common/dto/admin-analytics.dto';
+import { ShopPurchase } from 'src/shops/schemas/shop-purchase.schema';
+import { ShopPurchaseCategory } from 'src/common/enums/shop.enum';
+import { SHOP_ITEM_CATEGORIES } from 'src/common/utils/shop';
+import {
+  AdminOperatorSort,
+  OperatorAdminStatusDto,
+} from 'src/common/dto/admin-operator.dto';
+import { PoolMembershipHistory } from 'src/pools/schemas/pool-membership-history.schema';
+import {
+  SeasonResetDiffDto,
+  SeasonSummaryDto,
+} from 'src/common/dto/admin-season.dto';
+import {
+  HashTransaction,
+  HashTransactionCategory,
+  HashTransactionStatus,
+  HashTransactionType,
+} from 'src/operators/schemas/hash-transaction.schema';
+
+/**
+ * The collections that grow the most over time and can be compacted by admins.
+ */
+const COMPACTABLE_COLLECTIONS = ['DrillingSessions', 'HashTransactions'];
+
+/**
+ * The operator field and direction each admin operator sort order sorts by.
+ */
+const ADMIN_OPERATOR_SORTS: Record<
+  AdminOperatorSort,
+  { field: keyof Operator; direction: 1 | -1 }
+> = {
+  [AdminOperatorSort.HASH_BALANCE_DESC]: {
+    field: 'currentHASH',
+    direction: -1,
+  },
+  [AdminOperatorSort.HASH_BALANCE_ASC]: { field: 'currentHASH', direction: 1 },
+  [AdminOperatorSort.TOTAL_EARNED_HASH_DESC]: {
+    field: 'totalEarnedHASH',
+    direction: -1,
+  },
+  [AdminOperatorSort.TRUST_SCORE_DESC]: { field: 'trustScore', direction: -1 },
+  [AdminOperatorSort.CREATED_AT_DESC]: { field: 'createdAt', direction: -1 },
+  [AdminOperatorSort.CREATED_AT_ASC]: { field: 'createdAt', direction: 1 },
+};
+
+/**
+ * The revenue totals field each shop purchase category is counted in.
+ */
+const REVENUE_CATEGORY_FIELDS: Record<
+  ShopPurchaseCategory,
+  Exclude<keyof RevenueTotalsDto, 'grandTotalTON'>
+> = {
+  [ShopPurchaseCategory.PURCHASE]: 'totalTONReceivedPurchases',
+  [ShopPurchaseCategory.UPGRADE]: 'totalTONReceivedUpgrades',
+  [ShopPurchaseCategory.FUEL]: 'totalTONReceivedFuel',
+  [ShopPurchaseCategory.SLOT]: 'totalTONReceivedSlots',
+  [ShopPurchaseCategory.BUNDLE]: 'totalTONReceivedBundles',
+};
+
+/**
+ * The maximum date range (in days) for which revenue is also broken down per day.
+ */
+const REVENUE_DAILY_BREAKDOWN_MAX_DAYS = 30;
+
+/**
+ * The data of a queued collection compaction job.
+ */
+export interface CompactionJobData {
+  collection: string;
+  /** Whether to also validate the collection afterwards to refresh its statistics */
+  validate: boolean;
+}
+
+/**
+ * What the `compact` and `validate` commands reported for a collection.
+ */
+export interface CompactionJobResult {
+  bytesFreed: number;
+  /** `null` if the collection wasn't validated */
+  valid: boolean | null;
+  validationErrors: string[];
+}
+
+/**
+ * The status of a collection compaction job.
+ */
+export interface CompactionJobStatus {
+  jobId: string;
+  collection: string;
+  validate: boolean;
+  status: 'pending' | 'running' | 'completed' | 'failed';
+  queuedAt: string;
+  finishedAt: string | null;
+  result: CompactionJobResult | null;
+  error: string | null;
+}
+
+/**
+ * The Redis key of the confirmation token issued by the latest season reset dry run.
+ */
+const SEASON_RESET_TOKEN_KEY = 'admin:season-reset:token';
+
+/**
+ * How long (in seconds) a season reset confirmation token stays valid.
+ */
+const SEASON_RESET_TOKEN_TTL = 600; // 10 minutes
+
+/**
+ * The collection closed pool membership records are moved to on a season reset.
+ */
+const POOL_MEMBERSHIP_ARCHIVE_COLLECTION = 'PoolMembershipHistoryArchives';
+
+/**
+ * The collection season summary reports are stored in.
+ */
+const SEASON_SUMMARY_COLLECTION = 'SeasonSummaries';
+
+/**
+ * The number of top operators (by HASH balance) included in a season summary.
+ */
+const SEASON_SUMMARY_TOP_OPERATORS = 10;
 
 @Injectable()
 export class AdminService {
@@ -30,7 +161,16 @@ export class AdminService {
     @InjectModel(Pool.name) private poolModel: Model<Pool>,
     @InjectModel(PoolOperator.name)
     private poolOperatorModel: Model<PoolOperator>,
+    @InjectModel(ShopPurchase.name)
+    private shopPurchaseModel: Model<ShopPurchase>,
+    @InjectModel(PoolMembershipHistory.name)
+    private poolMembershipHistoryModel: Model<PoolMembershipHistory>,
+    @InjectModel(HashTransaction.name)
+    private hashTransactionModel: Model<HashTransaction>,
     private readonly redisService: RedisService,
+    @InjectConnection() private readonly connection: Connection,
+    @InjectQueue('admin-db-queue')
+    private readonly adminDbQueue: Queue<CompactionJobData>,
   ) {}
 
   /**
@@ -107,4 +247,653 @@ export class AdminService {
       );
     }
   }
+
+  /**
+   * Fetches operators whose trust score is within `[minTrust, maxTrust]`, highest first.
+   */
+  async fetchOperatorsByTrustScore(
+    minTrust: number = 0,
+    maxTrust: number = 1,
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<ApiResponse<{ operators: Partial<Operator>[] } | null>> {
+    if (minTrust > maxTrust) {
+      return new ApiResponse(
+        400,
+        `(fetchOperatorsByTrustScore) minTrust cannot be greater than maxTrust.`,
+      );
+    }
+
+    try {
+      const operators = await this.operatorModel
+        .find(
+          { trustScore: { $gte: minTrust, $lte: maxTrust } },
+          {
+            'usernameData.username': 1,
+            trustScore: 1,
+            totalEarnedHASH: 1,
+            createdAt: 1,
+          },
+        )
+        .sort({ trustScore: -1, _id: 1 })
+        .skip((page - 1) * limit)
+        .limit(limit)
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(fetchOperatorsByTrustScore) Operators fetched.`,
+        { operators },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchOperatorsByTrustScore) Error fetching operators: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Lists operators for admins with cursor-based pagination, sorted by `sort` (newest first by default).
+   *
+   * Each operator is returned with whether they're currently drilling, their pool, their drill count and their ban status.
+   * `filterBanned` and `filterHasPool` are ignored when undefined. `cursor` is the ID of the last operator of the previous page.
+   */
+  async listOperators(
+    filters: { filterBanned?: boolean; filterHasPool?: boolean },
+    sort: AdminOperatorSort = AdminOperatorSort.CREATED_AT_DESC,
+    limit: number = 50,
+    cursor?: Types.ObjectId,
+  ): Promise<
+    ApiResponse<{
+      operators: (Operator & OperatorAdminStatusDto)[];
+      nextCursor: string | null;
+    }>
+  > {
+    try {
+      const { field, direction } = ADMIN_OPERATOR_SORTS[sort];
+
+      const match: Record<string, any> = {};
+      if (filters.filterBanned !== undefined) {
+        match.bannedAt = filters.filterBanned ? { $ne: null } : null;
+      }
+
+      if (cursor) {
+        const cursorOperator = await this.operatorModel
+          .findById(cursor, { [field]: 1 })
+          .lean();
+
+        if (!cursorOperator) {
+          return new ApiResponse(400, `(listOperators) Invalid cursor.`);
+        }
+
+        // Continue after the cursor operator in (field, _id) order
+        const cursorValue = cursorOperator[field];
+        match.$or = [
+          { [field]: { [direction === -1 ? '$lt' : '$gt']: cursorValue } },
+          { [field]: cursorValue, _id: { $gt: cursor } },
+        ];
+      }
+
+      const operators = await this.operatorModel.aggregate<
+        Operator & OperatorAdminStatusDto
+      >([
+        { $match: match },
+        { $sort: { [field]: direction, _id: 1 } },
+        {
+          $lookup: {
+            from: 'PoolOperators',
+            localField: '_id',
+            foreignField: 'operator',
+            pipeline: [{ $project: { pool: 1 } }],
+            as: 'poolMembership',
+          },
+        },
+        ...(filters.filterHasPool !== undefined
+          ? [
+              {
+                $match: {
+                  'poolMembership.0': { $exists: filters.filterHasPool },
+                },
+              },
+            ]
+          : []),
+        { $limit: limit },
+        {
+          $lookup: {
+            from: 'Drills',
+            localField: '_id',
+            foreignField: 'operatorId',
+            pipeline: [{ $project: { _id: 1 } }],
+            as: 'drills',
+          },
+        },
+        {
+          $lookup: {
+            from: 'DrillingSessions',
+            localField: '_id',
+            foreignField: 'operatorId',
+            pipeline: [
+              { $match: { endTime: null } },
+              { $limit: 1 },
+              { $project: { _id: 1 } },
+            ],
+            as: 'activeSessions',
+          },
+        },
+        {
+          $addFields: {
+            isDrilling: { $gt: [{ $size: '$activeSessions' }, 0] },
+            poolId: {
+              $ifNull: [{ $first: '$poolMembership.pool' }, null],
+            },
+            drillCount: { $size: '$drills' },
+            banStatus: {
+              $cond: [{ $ne: ['$bannedAt', null] }, 'banned', 'active'],
+            },
+          },
+        },
+        { $project: { poolMembership: 0, drills: 0, activeSessions: 0 } },
+      ]);
+
+      const nextCursor =
+        operators.length === limit
+          ? operators[operators.length - 1]._id.toString()
+          : null;
+
+      return new ApiResponse(200, `(listOperators) Operators fetched.`, {
+        operators,
+        nextCursor,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(listOperators) Error listing operators: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Counts the pools created per `granularity` (day or week) within `[from, to)`, oldest period first.
+   *
+   * Not cached, as it's only called occasionally by admins.
+   */
+  async fetchPoolsCreated(
+    from?: Date,
+    to?: Date,
+    granularity: 'day' | 'week' = 'day',
+  ): Promise<ApiResponse<{ periods: PoolsCreatedPeriodDto[] } | null>> {
+    if (from && to && from >= to) {
+      return new ApiResponse(
+        400,
+        `(fetchPoolsCreated) from must be earlier than to.`,
+      );
+    }
+
+    try {
+      const createdAt: Record<string, Date> = {};
+      if (from) createdAt.$gte = from;
+      if (to) createdAt.$lt = to;
+
+      const periods = await this.poolModel.aggregate<PoolsCreatedPeriodDto>([
+        ...(from || to ? [{ $match: { createdAt } }] : []),
+        {
+          $group: {
+            _id: { $dateTrunc: { date: '$createdAt', unit: granularity } },
+            count: { $sum: 1 },
+          },
+        },
+        { $sort: { _id: 1 } },
+        { $project: { _id: 0, period: '$_id', count: 1 } },
+      ]);
+
+      return new ApiResponse(
+        200,
+        `(fetchPoolsCreated) Pool creation counts fetched.`,
+        { periods },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchPoolsCreated) Error fetching pool creation counts: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Sums the TON received from shop purchases per revenue category within an optional date range.
+   *
+   * If both `from` and `to` are given and at most `REVENUE_DAILY_BREAKDOWN_MAX_DAYS` days apart, the revenue is also broken down per day.
+   * Purchases made before `priceTON` and `category` were stored fall back to their TON `totalCost` and their item's category.
+   */
+  async fetchRevenue(
+    from?: Date,
+    to?: Date,
+  ): Promise<ApiResponse<RevenueResponseDto | null>> {
+    if (from && to && from >= to) {
+      return new ApiResponse(
+        400,
+        `(fetchRevenue) from must be earlier than to.`,
+      );
+    }
+
+    try {
+      const createdAt: Record<string, Date> = {};
+      if (from) createdAt.$gte = from;
+      if (to) createdAt.$lt = to;
+
+      const withDailyBreakdown =
+        !!from &&
+        !!to &&
+        to.getTime() - from.getTime() <=
+          REVENUE_DAILY_BREAKDOWN_MAX_DAYS * 24 * 60 * 60 * 1000;
+
+      const revenue = await this.shopPurchaseModel.aggregate<{
+        _id: { category: ShopPurchaseCategory; day: Date | null };
+        total: number;
+      }>([
+        { $match: { currency: 'TON', ...(from || to ? { createdAt } : {}) } },
+        {
+          $group: {
+            _id: {
+              category: {
+                $ifNull: [
+                  '$category',
+                  {
+                    $switch: {
+                      branches: Object.entries(SHOP_ITEM_CATEGORIES).map(
+                        ([item, category]) => ({
+                          case: { $eq: ['$itemPurchased', item] },
+                          then: category,
+                        }),
+                      ),
+                      default: ShopPurchaseCategory.PURCHASE,
+                    },
+                  },
+                ],
+              },
+              day: withDailyBreakdown
+                ? { $dateTrunc: { date: '$createdAt', unit: 'day' } }
+                : null,
+            },
+            total: { $sum: { $ifNull: ['$priceTON', '$totalCost'] } },
+          },
+        },
+      ]);
+
+      const emptyTotals = (): RevenueTotalsDto => ({
+        totalTONReceivedPurchases: 0,
+        totalTONReceivedUpgrades: 0,
+        totalTONReceivedFuel: 0,
+        totalTONReceivedSlots: 0,
+        totalTONReceivedBundles: 0,
+        grandTotalTON: 0,
+      });
+
+      const totals = emptyTotals();
+      const dailyMap = new Map<number, RevenueDayDto>();
+
+      for (const { _id, total } of revenue) {
+        const field = REVENUE_CATEGORY_FIELDS[_id.category];
+        if (!field) continue;
+
+        totals[field] += total;
+        totals.grandTotalTON += total;
+
+        if (_id.day) {
+          const dayKey = new Date(_id.day).getTime();
+          const day = dailyMap.get(dayKey) ?? {
+            day: new Date(_id.day),
+            ...emptyTotals(),
+          };
+          day[field] += total;
+          day.grandTotalTON += total;
+          dailyMap.set(dayKey, day);
+        }
+      }
+
+      return new ApiResponse(200, `(fetchRevenue) Revenue fetched.`, {
+        totals,
+        daily: withDailyBreakdown
+          ? Array.from(dailyMap.values()).sort(
+              (a, b) => a.day.getTime() - b.day.getTime(),
+            )
+          : null,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchRevenue) Error fetching revenue: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Queues compacting a long-running collection, optionally validating it afterwards to refresh its statistics.
+   *
+   * Compaction can take a while, so it runs as a job on the `admin-db-queue`; its status can be polled via `fetchCompactionJob`.
+   */
+  async startCompaction(
+    collection: string,
+    validate: boolean = false,
+  ): Promise<ApiResponse<{ jobId: string } | null>> {
+    if (!COMPACTABLE_COLLECTIONS.includes(collection)) {
+      return new ApiResponse(
+        400,
+        `(startCompaction) Collection ${collection} cannot be compacted. Allowed: ${COMPACTABLE_COLLECTIONS.join(', ')}.`,
+      );
+    }
+
+    try {
+      const job = await this.adminDbQueue.add('compact-collection', {
+        collection,
+        validate,
+      });
+
+      return new ApiResponse(202, `(startCompaction) Compaction job queued.`, {
+        jobId: String(job.id),
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(startCompaction) Error queueing compaction job: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches the status (and once completed, the result) of a compaction job.
+   */
+  async fetchCompactionJob(
+    jobId: string,
+  ): Promise<ApiResponse<{ job: CompactionJobStatus } | null>> {
+    try {
+      const job = await this.adminDbQueue.getJob(jobId);
+
+      if (!job) {
+        return new ApiResponse(
+          404,
+          `(fetchCompactionJob) Compaction job not found.`,
+        );
+      }
+
+      const state = await job.getState();
+
+      return new ApiResponse(
+        200,
+        `(fetchCompactionJob) Compaction job fetched.`,
+        {
+          job: {
+            jobId: String(job.id),
+            collection: job.data.collection,
+            validate: job.data.validate,
+            status:
+              state === 'active'
+                ? 'running'
+                : state === 'completed' || state === 'failed'
+                  ? state
+                  : 'pending',
+            queuedAt: new Date(job.timestamp).toISOString(),
+            finishedAt: job.finishedOn
+              ? new Date(job.finishedOn).toISOString()
+              : null,
+            result: state === 'completed' ? job.returnvalue : null,
+            error: job.failedReason ?? null,
+          },
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchCompactionJob) Error fetching compaction job: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Runs the `compact` (and optionally `validate`) command for a compaction job and returns what they reported.
+   *
+   * Called by the `admin-db-queue`; throws on failure so that the job is marked as failed.
+   */
+  async compactCollection(
+    job: CompactionJobData,
+  ): Promise<CompactionJobResult> {
+    const compaction = await this.connection.db.command({
+      compact: job.collection,
+    });
+
+    const validation = job.validate
+      ? await this.connection.db.command({ validate: job.collection })
+      : null;
+
+    this.logger.log(
+      `🧹 (compactCollection) Compacted collection ${job.collection}, freeing ${compaction.bytesFreed ?? 0} bytes.`,
+    );
+
+    return {
+      bytesFreed: compaction.bytesFreed ?? 0,
+      valid: validation ? Boolean(validation.valid) : null,
+      validationErrors: validation?.errors ?? [],
+    };
+  }
+
+  /**
+   * Resets the season: zeroes every operator's HASH balance and current streak, and archives closed pool membership records.
+   *
+   * If `dryRun` is true, nothing is written. Instead, the number of affected records per collection and the season summary
+   * are returned along with a confirmation token. Executing the reset requires the token from the latest dry run,
+   * after which the reset and the summary are written in a single transaction.
+   *
+   * Every zeroed balance is recorded as a `SEASON_RESET` debit, so the operators' $HASH ledgers stay consistent with their balances.
+   * Held $HASH (`holdHASH`), active stakes and outstanding loans are NOT reset: they carry over into the new season
+   * and are settled into the balance through their own transactions (e.g. an unstake or a loan repayment).
+   */
+  async resetSeason(
+    dryRun: boolean,
+    confirmationToken?: string,
+  ): Promise<
+    ApiResponse<{
+      dryRun: boolean;
+      diff: SeasonResetDiffDto;
+      summary: SeasonSummaryDto;
+      confirmationToken?: string;
+    } | null>
+  > {
+    try {
+      if (dryRun) {
+        const [diff, summary] = await Promise.all([
+          this.fetchSeasonResetDiff(),
+          this.generateSeasonSummary(),
+        ]);
+
+        const token = randomBytes(16).toString('hex');
+        await this.redisService.set(
+          SEASON_RESET_TOKEN_KEY,
+          token,
+          SEASON_RESET_TOKEN_TTL,
+        );
+
+        return new ApiResponse(
+          200,
+          `(resetSeason) Season reset dry run completed.`,
+          { dryRun, diff, summary, confirmationToken: token },
+        );
+      }
+
+      const expectedToken = await this.redisService.get(
+        SEASON_RESET_TOKEN_KEY,
+      );
+
+      if (!confirmationToken || confirmationToken !== expectedToken) {
+        return new ApiResponse(
+          400,
+          `(resetSeason) Invalid or expired confirmation token. Run a dry run first.`,
+        );
+      }
+
+      // Tokens are single-use
+      await this.redisService.del(SEASON_RESET_TOKEN_KEY);
+
+      // The summary is taken before the balances and streaks are zeroed
+      const summary = await this.generateSeasonSummary();
+      const archivedAt = new Date();
+
+      const diff = await this.connection.transaction(async (session) => {
+        const hashBalances = await this.operatorModel
+          .find({ currentHASH: { $gt: 0 } }, { currentHASH: 1 }, { session })
+          .lean();
+
+        if (hashBalances.length > 0) {
+          await this.operatorModel.bulkWrite(
+            hashBalances.map(({ _id, currentHASH }) => ({
+              updateOne: {
+                filter: { _id },
+                update: { $inc: { currentHASH: -currentHASH } },
+              },
+            })),
+            { session },
+          );
+          await this.hashTransactionModel.insertMany(
+            hashBalances.map(({ _id, currentHASH }) => ({
+              operatorId: _id,
+              transactionType: HashTransactionType.DEBIT,
+              amount: currentHASH,
+              category: HashTransactionCategory.SEASON_RESET,
+              description: 'Season reset',
+              balanceBefore: currentHASH,
+              balanceAfter: 0,
+              status: HashTransactionStatus.COMPLETED,
+            })),
+            { session },
+          );
+        }
+        const streaks = await this.operatorModel.updateMany(
+          { currentStreak: { $ne: 0 } },
+          { $set: { currentStreak: 0 } },
+          { session },
+        );
+
+        // Open memberships are kept since they reflect the operators' current pools
+        const closedMemberships = await this.poolMembershipHistoryModel
+          .find({ memberTo: { $ne: null } }, null, { session })
+          .lean();
+
+        if (closedMemberships.length > 0) {
+          await this.connection
+            .collection(POOL_MEMBERSHIP_ARCHIVE_COLLECTION)
+            .insertMany(
+              closedMemberships.map((membership) => ({
+                ...membership,
+                archivedAt,
+              })),
+              { session },
+            );
+          await this.poolMembershipHistoryModel.deleteMany(
+            { _id: { $in: closedMemberships.map(({ _id }) => _id) } },
+            { session },
+          );
+        }
+
+        await this.connection
+          .collection(SEASON_SUMMARY_COLLECTION)
+          .insertOne({ ...summary }, { session });
+
+        return {
+          operatorsHASHBalanceReset: hashBalances.length,
+          operatorsStreakReset: streaks.modifiedCount,
+          poolMembershipHistoriesArchived: closedMemberships.length,
+        } as SeasonResetDiffDto;
+      });
+
+      this.logger.log(`🔄 (resetSeason) Season reset: ${JSON.stringify(diff)}`);
+
+      return new ApiResponse(200, `(resetSeason) Season reset completed.`, {
+        dryRun,
+        diff,
+        summary,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(resetSeason) Error resetting season: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Counts the records per collection a season reset would modify.
+   */
+  private async fetchSeasonResetDiff(): Promise<SeasonResetDiffDto> {
+    const [
+      operatorsHASHBalanceReset,
+      operatorsStreakReset,
+      poolMembershipHistoriesArchived,
+    ] = await Promise.all([
+      this.operatorModel.countDocuments({ currentHASH: { $gt: 0 } }),
+      this.operatorModel.countDocuments({ currentStreak: { $ne: 0 } }),
+      this.poolMembershipHistoryModel.countDocuments({
+        memberTo: { $ne: null },
+      }),
+    ]);
+
+    return {
+      operatorsHASHBalanceReset,
+      operatorsStreakReset,
+      poolMembershipHistoriesArchived,
+    };
+  }
+
+  /**
+   * Generates the summary of the current season from the operators' balances and streaks.
+   */
+  private async generateSeasonSummary(): Promise<SeasonSummaryDto> {
+    const [totals, topOperators] = await Promise.all([
+      this.operatorModel.aggregate([
+        {
+          $group: {
+            _id: null,
+            totalOperators: { $sum: 1 },
+            totalHASHBalance: { $sum: '$currentHASH' },
+            totalEarnedHASH: { $sum: '$totalEarnedHASH' },
+            longestCurrentStreak: { $max: '$currentStreak' },
+          },
+        },
+      ]),
+      this.operatorModel
+        .find(
+          { currentHASH: { $gt: 0 } },
+          { 'usernameData.username': 1, currentHASH: 1 },
+        )
+        .sort({ currentHASH: -1 })
+        .limit(SEASON_SUMMARY_TOP_OPERATORS)
+        .lean(),
+    ]);
+
+    return {
+      generatedAt: new Date(),
+      totalOperators: totals[0]?.totalOperators ?? 0,
+      totalHASHBalance: totals[0]?.totalHASHBalance ?? 0,
+      totalEarnedHASH: totals[0]?.totalEarnedHASH ?? 0,
+      longestCurrentStreak: totals[0]?.longestCurrentStreak ?? 0,
+      topOperators: topOperators.map((operator) => ({
+        operatorId: String(operator._id),
+        username: operator.usernameData?.username ?? null,
+        currentHASH: operator.currentHASH,
+      })),
+    };
+  }
 }
@@ -1,7 +1,14 @@
-import { Controller, Get } from '@nestjs/common';
+import { Controller, Get, Res } from '@nestjs/common';
+import { FastifyReply } from 'fastify';
 import { AppService } from './app.service';
 import { DatabaseService } from 'src/common/database.service';
 import { BullQueueService } from './common/bull-queue.service';
+import { RedisService } from './common/redis.service';
+
+/**
+ * How long (in ms) the readiness check waits for each dependency to respond.
+ */
+const READINESS_TIMEOUT_MS = 2000;
 
 @Controller()
 export class AppController {
@@ -9,6 +16,7 @@ export class AppController {
     private readonly appService: AppService,
     private readonly databaseService: DatabaseService,
     private readonly bullQueueService: BullQueueService,
+    private readonly redisService: RedisService,
   ) {}
 
   /**
@@ -20,9 +28,41 @@ export class AppController {
   }
 
   /**
-   * GET `/health` - Returns MongoDB connection pool status
+   * GET `/health` - Liveness check. Always returns 200 while the process is alive.
    */
   @Get('health')
+  checkHealth() {
+    return { status: 'ok' };
+  }
+
+  /**
+   * GET `/ready` - Readiness check. Returns 200 if both MongoDB and Redis respond, otherwise 503.
+   */
+  @Get('ready')
+  async checkReadiness(@Res({ passthrough: true }) reply: FastifyReply) {
+    const [mongodb, redis] = await Promise.all([
+      this.pingWithTimeout(() => this.databaseService.ping()),
+      this.pingWithTimeout(() => this.redisService.ping()),
+    ]);
+    const ready = mongodb && redis;
+
+    if (!ready) {
+      reply.status(503);
+    }
+
+    return {
+      status: ready ? 'ok' : 'unavailable',
+      dependencies: {
+        mongodb: mongodb ? 'up' : 'down',
+        redis: redis ? 'up' : 'down',
+      },
+    };
+  }
+
+  /**
+   * GET `/db-status` - Returns MongoDB connection pool status
+   */
+  @Get('db-status')
   checkDatabaseHealth() {
     return this.databaseService.getPoolStatus();
   }
@@ -34,4 +74,28 @@ export class AppController {
   async getQueueStatus() {
     return this.bullQueueService.getQueueStatus();
   }
+
+  /**
+   * Returns whether `ping` resolves within `READINESS_TIMEOUT_MS`.
+   */
+  private async pingWithTimeout(ping: () => Promise<unknown>) {
+    let timeout: NodeJS.Timeout;
+
+    try {
+      await Promise.race([
+        ping(),
+        new Promise((_, reject) => {
+          timeout = setTimeout(
+            () => reject(new Error('Timed out')),
+            READINESS_TIMEOUT_MS,
+          );
+        }),
+      ]);
+      return true;
+    } catch {
+      return false;
+    } finally {
+      clearTimeout(timeout);
+    }
+  }
 }
@@ -1,4 +1,4 @@
-import { Module } from '@nestjs/common';
+import { MiddlewareConsumer, Module, NestModule } from '@nestjs/common';
 import { AppController } from './app.controller';
 import { AppService } from './app.service';
 import { DatabaseModule } from 'src/common/database.module';
@@ -21,6 +21,27 @@ import { AdminModule } from './admin/admin.module';
 import { MixpanelModule } from './mixpanel/mixpanel.module';
 import { TelegramModule } from './telegram/telegram.module';
 import { AuctionModule } from './auction/auction.module';
+import { HashStakeModule } from './operators/hash-stake.module';
+import { DrillInsuranceModule } from './drills/drill-insurance.module';
+import { DrillUpgradeModule } from './drills/drill-upgrade.module';
+import { OperatorSkillModule } from './operators/operator-skill.module';
+import { OperatorSocialModule } from './operators/operator-social.module';
+import { GuildModule } from './guilds/guild.module';
+import { MissionRewardModule } from './missions/mission-reward.module';
+import { HASHLoanModule } from './loans/hash-loan.module';
+import { OperatorNotificationModule } from './notifications/operator-notification.module';
+import { TournamentModule } from './tournaments/tournament.module';
+import { OnboardingRewardModule } from './onboarding/onboarding-reward.module';
+import { DrillSkinModule } from './drills/drill-skin.module';
+import { PoolAnnouncementModule } from './pools/pool-announcement.module';
+import { PoolTreasuryModule } from './pools/pool-treasury.module';
+import { PoolJoinRequestModule } from './pools/pool-join-request.module';
+import { ScheduledDrillingSessionModule } from './drills/scheduled-drilling-session.module';
+import { DrillBattleModule } from './battles/drill-battle.module';
+import { PoolEfficiencyGoalModule } from './pools/pool-efficiency-goal.module';
+import { PlatformModule } from './platform/platform.module';
+import { PoolMergeModule } from './pools/pool-merge.module';
+import { RequestIdMiddleware } from './common/middlewares/request-id';
 
 @Module({
   imports: [
@@ -47,8 +68,33 @@ import { AuctionModule } from './auction/auction.module';
     MixpanelModule,
     TelegramModule,
     AuctionModule,
+    HashStakeModule,
+    DrillInsuranceModule,
+    DrillUpgradeModule,
+    OperatorSkillModule,
+    OperatorSocialModule,
+    GuildModule,
+    MissionRewardModule,
+    HASHLoanModule,
+    OperatorNotificationModule,
+    TournamentModule,
+    OnboardingRewardModule,
+    DrillSkinModule,
+    PoolAnnouncementModule,
+    PoolTreasuryModule,
+    PoolJoinRequestModule,
+    ScheduledDrillingSessionModule,
+    DrillBattleModule,
+    PoolEfficiencyGoalModule,
+    PlatformModule,
+    PoolMergeModule,
   ],
   controllers: [AppController],
   providers: [AppService],
 })
-export class AppModule {}
+export class AppModule implements NestModule {
+  configure(consumer: MiddlewareConsumer) {
+    // Assign every request a correlation ID
+    consumer.apply(RequestIdMiddleware).forRoutes('{*splat}');
+  }
+}
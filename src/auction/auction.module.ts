@@ -5,6 +5,7 @@ import { JwtModule } from '@nestjs/jwt';
 import { BullModule } from '@nestjs/bull';
 import { ScheduleModule } from '@nestjs/schedule';
 import { OperatorModule } from '../operators/operator.module';
+import { DrillModule } from '../drills/drill.module';
 
 // Queue configuration
 import { QUEUE_NAMES } from './config/queue.config';
@@ -21,6 +22,14 @@ import {
   AuctionHistory,
   AuctionHistorySchema,
 } from './schemas/auction-history.schema';
+import {
+  DrillAuction,
+  DrillAuctionSchema,
+} from './schemas/drill-auction.schema';
+import {
+  DrillAuctionBid,
+  DrillAuctionBidSchema,
+} from './schemas/drill-auction-bid.schema';
 
 // External schemas
 import {
@@ -31,6 +40,7 @@ import {
   HashTransaction,
   HashTransactionSchema,
 } from 'src/operators/schemas/hash-transaction.schema';
+import { ShopItem, ShopItemSchema } from 'src/shops/schemas/shop-item.schema';
 
 // Services
 import { AuctionService } from './services/auction.service';
@@ -45,6 +55,7 @@ import { AuctionController } from './controllers/auction.controller';
 import { NFTController } from './controllers/nft.controller';
 import { QueueController } from './controllers/queue.controller';
 import { LifecycleController } from './controllers/lifecycle.controller';
+import { DrillAuctionController } from './controllers/drill-auction.controller';
 
 // Gateways
 import { AuctionGateway } from './gateways/auction.gateway';
@@ -78,10 +89,13 @@ import { AuctionSeeder } from './seeders/auction.seeder';
       { name: AuctionWhitelist.name, schema: AuctionWhitelistSchema },
       { name: Bid.name, schema: BidSchema },
       { name: AuctionHistory.name, schema: AuctionHistorySchema },
+      { name: DrillAuction.name, schema: DrillAuctionSchema },
+      { name: DrillAuctionBid.name, schema: DrillAuctionBidSchema },
 
       // External schemas needed for operations
       { name: Operator.name, schema: OperatorSchema },
       { name: HashTransaction.name, schema: HashTransactionSchema },
+      { name: ShopItem.name, schema: ShopItemSchema },
     ]),
 
     // JWT module for WebSocket authentication
@@ -92,12 +106,16 @@ import { AuctionSeeder } from './seeders/auction.seeder';
 
     // Import operator module for currency operations
     OperatorModule,
+
+    // Import drill module for granting auctioned drills
+    DrillModule,
   ],
   controllers: [
     AuctionController,
     NFTController,
     QueueController,
     LifecycleController,
+    DrillAuctionController,
   ],
   providers: [
     AuctionService,
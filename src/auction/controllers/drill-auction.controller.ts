@@ -0,0 +1,159 @@
+import {
+  Controller,
+  Get,
+  Post,
+  Body,
+  Param,
+  HttpCode,
+  HttpStatus,
+  UsePipes,
+  ValidationPipe,
+  Query,
+  Request,
+} from '@nestjs/common';
+import {
+  ApiTags,
+  ApiOperation,
+  ApiResponse as SwaggerApiResponse,
+  ApiParam,
+  ApiBody,
+  ApiQuery,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { AuctionService } from '../services/auction.service';
+import { DrillAuction } from '../schemas/drill-auction.schema';
+import { DrillAuctionBid } from '../schemas/drill-auction-bid.schema';
+import { CreateDrillAuctionDto, PlaceDrillAuctionBidDto } from '../dto';
+import { ApiResponse } from '../../common/dto/response.dto';
+import { WonderverseProtected, CombinedAuth } from '../../common/auth';
+
+/**
+ * Controller for auctions of rare shop drills
+ */
+@ApiTags('Drill Auctions')
+@Controller('drill-auctions')
+@UsePipes(new ValidationPipe({ transform: true, whitelist: true }))
+export class DrillAuctionController {
+  constructor(private readonly auctionService: AuctionService) {}
+
+  /**
+   * Create a new drill auction
+   */
+  @Post()
+  @WonderverseProtected(3) // Admin level access
+  @HttpCode(HttpStatus.CREATED)
+  @ApiOperation({ summary: 'Create a new drill auction' })
+  @ApiBody({ type: CreateDrillAuctionDto })
+  @SwaggerApiResponse({
+    status: 201,
+    description: 'Drill auction created successfully',
+    type: ApiResponse.withType(DrillAuction),
+  })
+  @SwaggerApiResponse({
+    status: 400,
+    description: 'Bad request - validation failed or shop item is not a drill',
+    type: ApiResponse,
+  })
+  @SwaggerApiResponse({
+    status: 404,
+    description: 'Shop item not found',
+    type: ApiResponse,
+  })
+  async createDrillAuction(
+    @Body() createDrillAuctionDto: CreateDrillAuctionDto,
+  ): Promise<ApiResponse<DrillAuction>> {
+    const auction = await this.auctionService.createDrillAuction({
+      shopItemId: new Types.ObjectId(createDrillAuctionDto.shopItemId),
+      startingBid: createDrillAuctionDto.startingBid,
+      startsAt: new Date(createDrillAuctionDto.startsAt),
+      endsAt: new Date(createDrillAuctionDto.endsAt),
+    });
+
+    return new ApiResponse(
+      HttpStatus.CREATED,
+      'Drill auction created successfully',
+      auction,
+    );
+  }
+
+  /**
+   * Get all active drill auctions
+   */
+  @Get()
+  @CombinedAuth()
+  @ApiOperation({
+    summary: 'Get all active drill auctions',
+    description:
+      "Fetches all active drill auctions with their drill's rarity, ending soonest first. Pass `sort=rarity` to list the rarest drills first",
+  })
+  @ApiQuery({
+    name: 'sort',
+    description: 'Sort the auctions by drill rarity (rarest first)',
+    required: false,
+    enum: ['rarity'],
+  })
+  @SwaggerApiResponse({
+    status: 200,
+    description: 'Drill auctions retrieved successfully',
+    type: ApiResponse,
+  })
+  async getActiveDrillAuctions(
+    @Query('sort') sort?: string,
+  ): Promise<ApiResponse<DrillAuction[]>> {
+    const auctions = await this.auctionService.getActiveDrillAuctions(
+      sort === 'rarity' ? 'rarity' : undefined,
+    );
+
+    return new ApiResponse(
+      HttpStatus.OK,
+      'Drill auctions retrieved successfully',
+      auctions,
+    );
+  }
+
+  /**
+   * Place a bid on a drill auction
+   */
+  @Post(':id/bid')
+  @CombinedAuth()
+  @HttpCode(HttpStatus.CREATED)
+  @ApiOperation({
+    summary: 'Place a bid on a drill auction',
+    description: 'Places a bid on a drill auction as the authenticated operator',
+  })
+  @ApiParam({ name: 'id', description: 'Drill auction ID' })
+  @ApiBody({ type: PlaceDrillAuctionBidDto })
+  @SwaggerApiResponse({
+    status: 201,
+    description: 'Bid placed successfully',
+    type: ApiResponse.withType(DrillAuctionBid),
+  })
+  @SwaggerApiResponse({
+    status: 400,
+    description:
+      'Bad request - auction not active, bid too low or insufficient HASH',
+    type: ApiResponse,
+  })
+  @SwaggerApiResponse({
+    status: 404,
+    description: 'Drill auction not found',
+    type: ApiResponse,
+  })
+  async placeBid(
+    @Param('id') id: string,
+    @Body() placeBidDto: PlaceDrillAuctionBidDto,
+    @Request() req,
+  ): Promise<ApiResponse<DrillAuctionBid>> {
+    // Get operator ID based on authentication type
+    const bidderId =
+      req.authType === 'jwt' ? req.user.operatorId : req.wonderverseCreds.id;
+
+    const bid = await this.auctionService.placeDrillAuctionBid(
+      new Types.ObjectId(id),
+      new Types.ObjectId(bidderId),
+      placeBidDto.amount,
+    );
+
+    return new ApiResponse(HttpStatus.CREATED, 'Bid placed successfully', bid);
+  }
+}
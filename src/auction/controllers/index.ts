@@ -2,3 +2,5 @@ export { AuctionController } from './auction.controller';
 export { NFTController } from './nft.controller';
 export { LifecycleController } from './lifecycle.controller';
 export { QueueController } from './queue.controller';
+export { DrillAuctionController } from './drill-auction.controller';
+export { DrillAuctionController } from './drill-auction.controller';
@@ -0,0 +1,51 @@
+import { IsDateString, IsMongoId, IsNumber, Min } from 'class-validator';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * DTO for creating a drill auction
+ */
+export class CreateDrillAuctionDto {
+  @ApiProperty({
+    description: 'The ID of the shop item (drill) to auction',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @IsMongoId()
+  shopItemId: string;
+
+  @ApiProperty({
+    description: 'The starting bid in HASH currency',
+    example: 1000,
+    minimum: 0,
+  })
+  @IsNumber()
+  @Min(0)
+  startingBid: number;
+
+  @ApiProperty({
+    description: 'When bidding opens (ISO string)',
+    example: '2024-03-20T12:00:00.000Z',
+  })
+  @IsDateString()
+  startsAt: string;
+
+  @ApiProperty({
+    description: 'When bidding closes (ISO string)',
+    example: '2024-03-21T12:00:00.000Z',
+  })
+  @IsDateString()
+  endsAt: string;
+}
+
+/**
+ * DTO for placing a bid on a drill auction (the bidder is the authenticated operator)
+ */
+export class PlaceDrillAuctionBidDto {
+  @ApiProperty({
+    description: 'The bid amount in HASH currency',
+    example: 1500,
+    minimum: 0,
+  })
+  @IsNumber()
+  @Min(0)
+  amount: number;
+}
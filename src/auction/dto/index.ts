@@ -10,6 +10,9 @@ export * from './get-auction-history-query.dto';
 // Bid DTOs
 export * from './place-bid.dto';
 
+// Drill auction DTOs
+export * from './drill-auction.dto';
+
 // Whitelist DTOs
 export * from './join-whitelist.dto';
 
@@ -0,0 +1,68 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+import { DrillAuction } from './drill-auction.schema';
+import { Operator } from 'src/operators/schemas/operator.schema';
+
+/**
+ * Schema for bids placed on drill auctions
+ */
+@Schema({
+  timestamps: true,
+  collection: 'DrillAuctionBids',
+  versionKey: false,
+})
+export class DrillAuctionBid extends Document {
+  /**
+   * The database ID of the bid
+   */
+  @ApiProperty({
+    description: 'The database ID of the bid',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The drill auction this bid belongs to
+   */
+  @ApiProperty({
+    description: 'The drill auction this bid belongs to',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({
+    type: Types.ObjectId,
+    ref: DrillAuction.name,
+    required: true,
+    index: true,
+  })
+  auctionId: Types.ObjectId;
+
+  /**
+   * The operator who placed the bid
+   */
+  @ApiProperty({
+    description: 'The operator who placed the bid',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({
+    type: Types.ObjectId,
+    ref: Operator.name,
+    required: true,
+    index: true,
+  })
+  bidderId: Types.ObjectId;
+
+  /**
+   * The bid amount in HASH currency
+   */
+  @ApiProperty({
+    description: 'The bid amount in HASH currency',
+    example: 1500,
+  })
+  @Prop({ required: true, min: 0 })
+  amount: number;
+}
+
+export const DrillAuctionBidSchema =
+  SchemaFactory.createForClass(DrillAuctionBid);
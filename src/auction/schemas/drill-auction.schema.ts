@@ -0,0 +1,127 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+import { Operator } from 'src/operators/schemas/operator.schema';
+
+/**
+ * Enum defining the status of drill auctions
+ */
+export enum DrillAuctionStatus {
+  ACTIVE = 'active',
+  FINALIZED = 'finalized',
+  FAILED = 'failed',
+}
+
+/**
+ * Schema for auctions of rare shop drills.
+ *
+ * Unlike NFT auctions, drill auctions have no whitelist phase: any operator can bid
+ * between `startsAt` and `endsAt`, and the highest bidder receives the drill once the auction is finalized.
+ * If the winning bid can't be settled, the auction is marked as failed and no drill is granted.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'DrillAuctions',
+  versionKey: false,
+})
+export class DrillAuction extends Document {
+  /**
+   * The database ID of the drill auction
+   */
+  @ApiProperty({
+    description: 'The database ID of the drill auction',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The shop item (drill) being auctioned
+   */
+  @ApiProperty({
+    description: 'The shop item (drill) being auctioned',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, ref: 'ShopItems', required: true })
+  shopItemId: Types.ObjectId;
+
+  /**
+   * The starting bid of the auction in HASH currency
+   */
+  @ApiProperty({
+    description: 'The starting bid of the auction in HASH currency',
+    example: 1000,
+  })
+  @Prop({ required: true, min: 0 })
+  startingBid: number;
+
+  /**
+   * The current highest bid in HASH currency (0 if no bids yet)
+   */
+  @ApiProperty({
+    description: 'The current highest bid in HASH currency',
+    example: 1500,
+  })
+  @Prop({ required: true, default: 0, min: 0 })
+  currentBid: number;
+
+  /**
+   * The operator with the current highest bid
+   */
+  @ApiProperty({
+    description: 'The operator with the current highest bid',
+    required: false,
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, ref: Operator.name, default: null })
+  currentBidderId: Types.ObjectId | null;
+
+  /**
+   * When bidding opens
+   */
+  @ApiProperty({
+    description: 'When bidding opens',
+    example: '2024-03-20T12:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  startsAt: Date;
+
+  /**
+   * When bidding closes
+   */
+  @ApiProperty({
+    description: 'When bidding closes',
+    example: '2024-03-21T12:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true, index: true })
+  endsAt: Date;
+
+  /**
+   * The status of the drill auction
+   */
+  @ApiProperty({
+    description: 'The status of the drill auction',
+    example: DrillAuctionStatus.ACTIVE,
+    enum: DrillAuctionStatus,
+  })
+  @Prop({
+    type: String,
+    enum: DrillAuctionStatus,
+    default: DrillAuctionStatus.ACTIVE,
+    index: true,
+  })
+  status: DrillAuctionStatus;
+
+  /**
+   * The drill granted to the winner once the auction is finalized
+   */
+  @ApiProperty({
+    description: 'The drill granted to the winner once the auction is finalized',
+    required: false,
+    example: '507f1f77bcf86cd799439014',
+  })
+  @Prop({ type: Types.ObjectId, ref: 'Drills', default: null })
+  drillId: Types.ObjectId | null;
+}
+
+export const DrillAuctionSchema = SchemaFactory.createForClass(DrillAuction);
@@ -6,6 +6,10 @@ import { AuctionService } from './auction.service';
 import { AuctionNotificationService } from './auction-notification.service';
 import { Auction, AuctionStatus } from '../schemas/auction.schema';
 import { NFT, NFTStatus } from '../schemas/nft.schema';
+import {
+  DrillAuction,
+  DrillAuctionStatus,
+} from '../schemas/drill-auction.schema';
 import {
   AuctionHistory,
   AuctionAction,
@@ -23,6 +27,8 @@ export class AuctionLifecycleService {
     @InjectModel(NFT.name) private nftModel: Model<NFT>,
     @InjectModel(AuctionHistory.name)
     private historyModel: Model<AuctionHistory>,
+    @InjectModel(DrillAuction.name)
+    private drillAuctionModel: Model<DrillAuction>,
     private auctionService: AuctionService,
     private notificationService: AuctionNotificationService,
   ) {}
@@ -41,6 +47,7 @@ export class AuctionLifecycleService {
         this.startAuctions(),
         this.endAuctions(),
         this.sendEndingWarnings(),
+        this.finalizeDrillAuctions(),
       ]);
 
       this.logger.log('Auction lifecycle processing completed');
@@ -146,6 +153,35 @@ export class AuctionLifecycleService {
     }
   }
 
+  /**
+   * Finalize drill auctions that have finished
+   */
+  private async finalizeDrillAuctions(): Promise<void> {
+    try {
+      const auctionsToFinalize = await this.drillAuctionModel
+        .find(
+          {
+            status: DrillAuctionStatus.ACTIVE,
+            endsAt: { $lte: new Date() },
+          },
+          { _id: 1 },
+        )
+        .lean();
+
+      for (const auction of auctionsToFinalize) {
+        await this.auctionService.finalizeDrillAuction(auction._id);
+      }
+
+      if (auctionsToFinalize.length > 0) {
+        this.logger.log(
+          `Finalized ${auctionsToFinalize.length} drill auctions`,
+        );
+      }
+    } catch (error) {
+      this.logger.error(`Error finalizing drill auctions: ${error.message}`);
+    }
+  }
+
   /**
    * Send ending warnings for auctions ending soon
    */
@@ -20,10 +20,20 @@ import {
   AuctionAction,
 } from '../schemas/auction-history.schema';
 import { NFT, NFTStatus } from '../schemas/nft.schema';
+import {
+  DrillAuction,
+  DrillAuctionStatus,
+} from '../schemas/drill-auction.schema';
+import { DrillAuctionBid } from '../schemas/drill-auction-bid.schema';
+import { ShopItem } from 'src/shops/schemas/shop-item.schema';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { DrillRarity } from 'src/common/enums/drill.enum';
+import { drillRarity, drillRarityMultiplier } from 'src/common/utils/drill';
 import { HashTransactionCategory } from 'src/operators/schemas/hash-transaction.schema';
 
 // External services
 import { OperatorService } from 'src/operators/operator.service';
+import { DrillService } from 'src/drills/drill.service';
 
 // Queue service
 import { BidQueueService } from '../services/bid-queue.service';
@@ -46,8 +56,14 @@ export class AuctionService {
     @InjectModel(AuctionHistory.name)
     private historyModel: Model<AuctionHistory>,
     @InjectModel(NFT.name) private nftModel: Model<NFT>,
+    @InjectModel(DrillAuction.name)
+    private drillAuctionModel: Model<DrillAuction>,
+    @InjectModel(DrillAuctionBid.name)
+    private drillAuctionBidModel: Model<DrillAuctionBid>,
+    @InjectModel(ShopItem.name) private shopItemModel: Model<ShopItem>,
     private operatorService: OperatorService,
     private bidQueueService: BidQueueService,
+    private drillService: DrillService,
   ) {}
 
   /**
@@ -866,4 +882,359 @@ export class AuctionService {
       return false; // Default to direct processing
     }
   }
+
+  /**
+   * Create a new drill auction
+   */
+  async createDrillAuction(auctionData: {
+    shopItemId: Types.ObjectId;
+    startingBid: number;
+    startsAt: Date;
+    endsAt: Date;
+  }): Promise<DrillAuction> {
+    try {
+      const shopItem = await this.shopItemModel
+        .findById(auctionData.shopItemId, { itemEffects: 1 })
+        .lean();
+      if (!shopItem) {
+        throw new NotFoundException('Shop item not found');
+      }
+
+      if (!shopItem.itemEffects?.drillData) {
+        throw new BadRequestException('Shop item is not a drill');
+      }
+
+      // Rarer drills have a higher minimum starting bid
+      const minStartingBid =
+        GAME_CONSTANTS.DRILLS.AUCTION_MIN_STARTING_BID *
+        drillRarityMultiplier(shopItem.itemEffects.drillData.config);
+      if (auctionData.startingBid < minStartingBid) {
+        throw new BadRequestException(
+          `Starting bid must be at least ${minStartingBid} HASH for this drill`,
+        );
+      }
+
+      if (auctionData.endsAt <= auctionData.startsAt) {
+        throw new BadRequestException('Auction must end after it starts');
+      }
+
+      const auction = await this.drillAuctionModel.create({
+        shopItemId: auctionData.shopItemId,
+        startingBid: auctionData.startingBid,
+        startsAt: auctionData.startsAt,
+        endsAt: auctionData.endsAt,
+      });
+
+      this.logger.log(
+        `Drill auction created: ${auction._id} for shop item ${auctionData.shopItemId}`,
+      );
+      return auction;
+    } catch (error) {
+      if (
+        error instanceof NotFoundException ||
+        error instanceof BadRequestException
+      ) {
+        throw error;
+      }
+      this.logger.error(
+        `(createDrillAuction) Error creating drill auction: ${error.message}`,
+        error.stack,
+      );
+      throw new InternalServerErrorException('Failed to create drill auction');
+    }
+  }
+
+  /**
+   * Get all active drill auctions with the rarity of their drill, ending soonest first.
+   *
+   * If `sort` is `rarity`, the rarest drills come first instead (ending soonest first within the same rarity).
+   */
+  async getActiveDrillAuctions(
+    sort?: 'rarity',
+  ): Promise<Array<DrillAuction & { rarity: DrillRarity }>> {
+    const auctions = await this.drillAuctionModel
+      .find({ status: DrillAuctionStatus.ACTIVE })
+      .sort({ endsAt: 1 })
+      .lean();
+
+    const shopItems = await this.shopItemModel
+      .find(
+        { _id: { $in: auctions.map((auction) => auction.shopItemId) } },
+        { 'itemEffects.drillData.config': 1 },
+      )
+      .lean();
+    const rarityByShopItemId = new Map(
+      shopItems.map((shopItem) => [
+        shopItem._id.toString(),
+        drillRarity(shopItem.itemEffects?.drillData?.config),
+      ]),
+    );
+
+    const auctionsWithRarity = auctions.map((auction) => ({
+      ...auction,
+      rarity:
+        rarityByShopItemId.get(auction.shopItemId.toString()) ??
+        DrillRarity.COMMON,
+    })) as Array<DrillAuction & { rarity: DrillRarity }>;
+
+    if (sort === 'rarity') {
+      const rarityRank = Object.values(DrillRarity);
+      // `Array.prototype.sort` is stable, so auctions of the same rarity stay ending soonest first
+      auctionsWithRarity.sort(
+        (a, b) => rarityRank.indexOf(b.rarity) - rarityRank.indexOf(a.rarity),
+      );
+    }
+
+    return auctionsWithRarity;
+  }
+
+  /**
+   * Place a bid on a drill auction.
+   *
+   * The bid amount is held from the bidder's balance, and the previous highest bidder's held HASH is refunded.
+   */
+  async placeDrillAuctionBid(
+    auctionId: Types.ObjectId,
+    bidderId: Types.ObjectId,
+    amount: number,
+  ): Promise<DrillAuctionBid> {
+    try {
+      const auction = await this.drillAuctionModel.findById(auctionId).lean();
+      if (!auction) {
+        throw new NotFoundException('Drill auction not found');
+      }
+
+      const now = new Date();
+      if (
+        auction.status !== DrillAuctionStatus.ACTIVE ||
+        now < auction.startsAt ||
+        now > auction.endsAt
+      ) {
+        throw new BadRequestException('Drill auction is not active');
+      }
+
+      // Validate bid amount
+      if (amount < auction.startingBid) {
+        throw new BadRequestException(
+          `Bid must be at least ${auction.startingBid} HASH`,
+        );
+      }
+
+      if (amount <= auction.currentBid) {
+        throw new BadRequestException(
+          `Bid must be higher than the current bid of ${auction.currentBid} HASH`,
+        );
+      }
+
+      // Hold the bid amount
+      const holdResult = await this.operatorService.holdHASH(
+        bidderId,
+        amount,
+        HashTransactionCategory.BID_HOLD,
+        `Bid hold for drill auction ${auctionId}`,
+        auctionId,
+        'drill_auction',
+        { auctionId: auctionId.toString(), amount },
+      );
+
+      if (!holdResult.success) {
+        throw new BadRequestException(
+          holdResult.error || 'Failed to hold bid amount',
+        );
+      }
+
+      // Only update if nobody outbid us in the meantime
+      const updatedAuction = await this.drillAuctionModel.findOneAndUpdate(
+        {
+          _id: auctionId,
+          status: DrillAuctionStatus.ACTIVE,
+          currentBid: auction.currentBid,
+          currentBidderId: auction.currentBidderId,
+        },
+        { $set: { currentBid: amount, currentBidderId: bidderId } },
+      );
+
+      if (!updatedAuction) {
+        await this.operatorService.releaseHold(
+          bidderId,
+          amount,
+          HashTransactionCategory.BID_REFUND,
+          `Bid refund for drill auction ${auctionId} (outbid while placing)`,
+          auctionId,
+          'drill_auction',
+          { auctionId: auctionId.toString(), amount },
+        );
+
+        throw new BadRequestException(
+          'Drill auction was updated by another bid, please try again',
+        );
+      }
+
+      const bid = await this.drillAuctionBidModel.create({
+        auctionId,
+        bidderId,
+        amount,
+      });
+
+      // Refund the previous highest bidder
+      if (auction.currentBidderId) {
+        const refundResult = await this.operatorService.releaseHold(
+          auction.currentBidderId,
+          auction.currentBid,
+          HashTransactionCategory.BID_REFUND,
+          `Outbid refund for drill auction ${auctionId}`,
+          auctionId,
+          'drill_auction',
+          { auctionId: auctionId.toString(), amount: auction.currentBid },
+        );
+
+        if (!refundResult.success) {
+          this.logger.error(
+            `(placeDrillAuctionBid) Failed to refund ${auction.currentBid} HASH to operator ${auction.currentBidderId}: ${refundResult.error}`,
+          );
+        }
+      }
+
+      this.logger.log(
+        `Drill auction bid placed: ${bid._id} - ${amount} HASH by ${bidderId}`,
+      );
+      return bid;
+    } catch (error) {
+      if (
+        error instanceof NotFoundException ||
+        error instanceof BadRequestException
+      ) {
+        throw error;
+      }
+      this.logger.error(
+        `(placeDrillAuctionBid) Error placing drill auction bid: ${error.message}`,
+        error.stack,
+      );
+      throw new InternalServerErrorException('Failed to place bid');
+    }
+  }
+
+  /**
+   * Finalize an expired drill auction.
+   *
+   * Settles the winner's held HASH and only grants them the auctioned drill once that succeeded.
+   * If the held HASH can't be settled, the auction is marked as failed and no drill is granted.
+   */
+  async finalizeDrillAuction(auctionId: Types.ObjectId): Promise<void> {
+    try {
+      // Claim the auction first so it's only ever finalized once.
+      // The winner and price are read from the claimed document, since a bid may have come in since the auction was fetched.
+      const auction = await this.drillAuctionModel
+        .findOneAndUpdate(
+          {
+            _id: auctionId,
+            status: DrillAuctionStatus.ACTIVE,
+            endsAt: { $lte: new Date() },
+          },
+          { $set: { status: DrillAuctionStatus.FINALIZED } },
+          { new: true },
+        )
+        .lean();
+      if (!auction) {
+        return;
+      }
+
+      if (!auction.currentBidderId) {
+        this.logger.log(`Drill auction ended without bids: ${auctionId}`);
+        return;
+      }
+
+      const shopItem = await this.shopItemModel
+        .findById(auction.shopItemId, { itemEffects: 1 })
+        .lean();
+      const drillData = shopItem?.itemEffects?.drillData;
+
+      if (!drillData) {
+        this.logger.error(
+          `(finalizeDrillAuction) Shop item ${auction.shopItemId} no longer has drill data. Refunding the winning bid of auction ${auctionId}.`,
+        );
+
+        await this.failDrillAuction(auction, 'shop item has no drill data');
+        return;
+      }
+
+      const settleResult = await this.operatorService.settleHold(
+        auction.currentBidderId,
+        auction.currentBid,
+        HashTransactionCategory.AUCTION_WIN,
+        `Winning bid for drill auction ${auctionId}`,
+        auctionId,
+        'drill_auction',
+        { auctionId: auctionId.toString(), amount: auction.currentBid },
+      );
+
+      if (!settleResult.success) {
+        this.logger.error(
+          `(finalizeDrillAuction) Failed to settle ${auction.currentBid} HASH for operator ${auction.currentBidderId}: ${settleResult.error}`,
+        );
+
+        await this.failDrillAuction(auction, settleResult.error);
+        return;
+      }
+
+      const drillId = await this.drillService.createDrill(
+        auction.currentBidderId,
+        drillData.version,
+        drillData.config,
+        true,
+        drillData.baseEff,
+      );
+
+      await this.operatorService.updateCumulativeEffForSingleOperator(
+        auction.currentBidderId,
+      );
+
+      await this.drillAuctionModel.updateOne(
+        { _id: auctionId },
+        { $set: { drillId } },
+      );
+
+      this.logger.log(
+        `Drill auction finalized: ${auctionId} (Winner: ${auction.currentBidderId}, Price: ${auction.currentBid}, Drill: ${drillId})`,
+      );
+    } catch (error) {
+      this.logger.error(
+        `(finalizeDrillAuction) Error finalizing drill auction ${auctionId}: ${error.message}`,
+        error.stack,
+      );
+    }
+  }
+
+  /**
+   * Marks a claimed drill auction as failed and releases whatever the winner still has on hold for it.
+   */
+  private async failDrillAuction(
+    auction: Pick<DrillAuction, '_id' | 'currentBidderId' | 'currentBid'>,
+    reason: string,
+  ): Promise<void> {
+    await this.drillAuctionModel.updateOne(
+      { _id: auction._id },
+      { $set: { status: DrillAuctionStatus.FAILED } },
+    );
+
+    const releaseResult = await this.operatorService.releaseHold(
+      auction.currentBidderId,
+      auction.currentBid,
+      HashTransactionCategory.BID_REFUND,
+      `Bid refund for failed drill auction ${auction._id}`,
+      auction._id,
+      'drill_auction',
+      {
+        auctionId: auction._id.toString(),
+        amount: auction.currentBid,
+        reason,
+      },
+    );
+
+    if (!releaseResult.success) {
+      this.logger.error(
+        `(failDrillAuction) Failed to release ${auction.currentBid} HASH for operator ${auction.currentBidderId}: ${releaseResult.error}`,
+      );
+    }
+  }
 }
@@ -0,0 +1,142 @@
+import { Test, TestingModule } from '@nestjs/testing';
+import { ConfigService } from '@nestjs/config';
+import { JwtService } from '@nestjs/jwt';
+import { getModelToken } from '@nestjs/mongoose';
+import { createHmac } from 'crypto';
+import { TelegramAuthService } from './telegram-auth.service';
+import { Operator } from '../operators/schemas/operator.schema';
+import { OperatorService } from 'src/operators/operator.service';
+import { OperatorWalletService } from 'src/operators/operator-wallet.service';
+import { MixpanelService } from 'src/mixpanel/mixpanel.service';
+
+const BOT_TOKEN = '123456:TEST-bot-token';
+
+/**
+ * Signs `fields` the way Telegram does and returns the resulting `initData` string
+ */
+function signInitData(
+  fields: Record<string, string>,
+  botToken: string = BOT_TOKEN,
+): string {
+  const dataToCheck = Object.keys(fields)
+    .sort()
+    .map((key) => `${key}=${fields[key]}`)
+    .join('\n');
+
+  const secretKey = createHmac('sha256', 'WebAppData')
+    .update(botToken)
+    .digest();
+  const hash = createHmac('sha256', secretKey)
+    .update(dataToCheck)
+    .digest('hex');
+
+  return new URLSearchParams({ ...fields, hash }).toString();
+}
+
+/**
+ * Unit tests for validating Telegram WebApp `initData`
+ */
+describe('TelegramAuthService', () => {
+  let telegramAuthService: TelegramAuthService;
+
+  const fields = () => ({
+    auth_date: Math.floor(Date.now() / 1000).toString(),
+    query_id: 'AAHdF6IQAAAAAN0XohDhrOrc',
+    user: JSON.stringify({ id: 279058397, username: 'hashland_tester' }),
+  });
+
+  beforeEach(async () => {
+    const module: TestingModule = await Test.createTestingModule({
+      providers: [
+        TelegramAuthService,
+        {
+          provide: ConfigService,
+          useValue: { get: () => BOT_TOKEN },
+        },
+        { provide: JwtService, useValue: {} },
+        { provide: OperatorService, useValue: {} },
+        { provide: OperatorWalletService, useValue: {} },
+        { provide: MixpanelService, useValue: {} },
+        { provide: getModelToken(Operator.name), useValue: {} },
+      ],
+    }).compile();
+
+    telegramAuthService = module.get(TelegramAuthService);
+  });
+
+  it('should accept initData signed with the bot token', () => {
+    const initData = signInitData(fields());
+
+    expect(telegramAuthService.validateTelegramAuth(initData)).toBe(true);
+  });
+
+  it('should accept initData regardless of the order of its fields', () => {
+    const params = new URLSearchParams(signInitData(fields()));
+    const reversed = new URLSearchParams([...params.entries()].reverse());
+
+    expect(telegramAuthService.validateTelegramAuth(reversed.toString())).toBe(
+      true,
+    );
+  });
+
+  it('should reject initData with a tampered field', () => {
+    const params = new URLSearchParams(signInitData(fields()));
+    params.set('user', JSON.stringify({ id: 1, username: 'impostor' }));
+
+    expect(telegramAuthService.validateTelegramAuth(params.toString())).toBe(
+      false,
+    );
+  });
+
+  it('should reject initData with an added field', () => {
+    const params = new URLSearchParams(signInitData(fields()));
+    params.set('chat_type', 'private');
+
+    expect(telegramAuthService.validateTelegramAuth(params.toString())).toBe(
+      false,
+    );
+  });
+
+  it('should reject initData with a tampered hash', () => {
+    const params = new URLSearchParams(signInitData(fields()));
+    const hash = params.get('hash');
+    params.set('hash', (hash[0] === 'a' ? 'b' : 'a') + hash.slice(1));
+
+    expect(telegramAuthService.validateTelegramAuth(params.toString())).toBe(
+      false,
+    );
+  });
+
+  it('should reject initData with a malformed hash', () => {
+    const params = new URLSearchParams(signInitData(fields()));
+    params.set('hash', 'not-a-hash');
+
+    expect(telegramAuthService.validateTelegramAuth(params.toString())).toBe(
+      false,
+    );
+  });
+
+  it('should reject initData without a hash', () => {
+    const params = new URLSearchParams(signInitData(fields()));
+    params.delete('hash');
+
+    expect(telegramAuthService.validateTelegramAuth(params.toString())).toBe(
+      false,
+    );
+  });
+
+  it('should reject initData signed with another bot token', () => {
+    const initData = signInitData(fields(), '654321:OTHER-bot-token');
+
+    expect(telegramAuthService.validateTelegramAuth(initData)).toBe(false);
+  });
+
+  it('should reject initData older than a day', () => {
+    const initData = signInitData({
+      ...fields(),
+      auth_date: (Math.floor(Date.now() / 1000) - 86401).toString(),
+    });
+
+    expect(telegramAuthService.validateTelegramAuth(initData)).toBe(false);
+  });
+});
@@ -7,7 +7,7 @@ import { JwtService } from '@nestjs/jwt';
 import { InjectModel } from '@nestjs/mongoose';
 import { Model } from 'mongoose';
 import { ConfigService } from '@nestjs/config';
-import { createHmac } from 'crypto';
+import { createHmac, timingSafeEqual } from 'crypto';
 import { Operator } from '../operators/schemas/operator.schema';
 import {
   TelegramAuthDto,
@@ -77,6 +77,7 @@ export class TelegramAuthService {
           {
             id: parsedAuthData.user.id.toString(),
             username: parsedAuthData.user.username,
+            language: parsedAuthData.user.language_code,
           },
           {},
           authData.referralCode,
@@ -137,7 +138,7 @@ export class TelegramAuthService {
     initData.delete('hash');
 
     // Step 2: Check auth_date session
-    if (!auth_date) return false;
+    if (!auth_date || !hash) return false;
     const authDate = parseInt(auth_date, 10);
     const currentTime = Math.floor(Date.now() / 1000);
     if (currentTime - authDate > 86400) {
@@ -157,10 +158,14 @@ export class TelegramAuthService {
     // Apply it to the pairs array joined with linebreak
     const calculatedHash = createHmac('sha256', secretKey)
       .update(dataToCheck)
-      .digest('hex');
+      .digest();
 
-    // Step 6: Compare the hash values
-    return calculatedHash === hash;
+    // Step 6: Compare the hash values in constant time
+    const providedHash = Buffer.from(hash, 'hex');
+    return (
+      providedHash.length === calculatedHash.length &&
+      timingSafeEqual(providedHash, calculatedHash)
+    );
   }
 
   /**
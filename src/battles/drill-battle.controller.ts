@@ -0,0 +1,156 @@
+import {
+  Body,
+  Controller,
+  Get,
+  Param,
+  Post,
+  Query,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import {
+  AcceptDrillBattleDto,
+  CreateDrillBattleDto,
+} from 'src/common/dto/drill-battle.dto';
+import { GetLeaderboardQueryDto } from 'src/common/dto/leaderboard.dto';
+import { DrillBattleService } from './drill-battle.service';
+
+@ApiTags('Drill Battles')
+@Controller() // Routes live under both `/battles` and `/operators`
+export class DrillBattleController {
+  constructor(private readonly drillBattleService: DrillBattleService) {}
+
+  @ApiOperation({
+    summary: 'Challenge an operator to a drill battle',
+    description:
+      "Challenges another operator to a 1v1 drill battle with one of the authenticated operator's drills. The stakes are held until the battle is accepted or cancelled",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully created drill battle',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Invalid stakes, challenging self or insufficient $HASH',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Drill or challengee not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post('battles')
+  async createBattle(@Request() req, @Body() body: CreateDrillBattleDto) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillBattleService.createBattle(
+      operatorId,
+      new Types.ObjectId(body.drillId),
+      new Types.ObjectId(body.challengeeId),
+      body.stakes,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Accept a drill battle',
+    description:
+      'Accepts a drill battle the authenticated operator was challenged to and resolves it. The winner is drawn at random, weighted by the actual EFF of each drill, and receives both stakes',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the drill battle to accept',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully accepted and resolved drill battle',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Battle no longer available, challenger drill gone or insufficient $HASH',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pending drill battle or drill not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post('battles/:id/accept')
+  async acceptBattle(
+    @Request() req,
+    @Param('id') battleId: string,
+    @Body() body: AcceptDrillBattleDto,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillBattleService.acceptBattle(
+      operatorId,
+      new Types.ObjectId(battleId),
+      new Types.ObjectId(body.drillId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Cancel a drill battle',
+    description:
+      "Cancels one of the authenticated operator's drill battles that hasn't been accepted yet and releases the held stakes",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the drill battle to cancel',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully cancelled drill battle',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pending drill battle not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post('battles/:id/cancel')
+  async cancelBattle(@Request() req, @Param('id') battleId: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillBattleService.cancelBattle(
+      operatorId,
+      new Types.ObjectId(battleId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get battle history',
+    description:
+      'Fetches the drill battles an operator took part in, as challenger or challengee, newest first',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched battle history',
+  })
+  @Get('operators/:id/battle-history')
+  async fetchBattleHistory(
+    @Param('id') id: string,
+    @Query() query: GetLeaderboardQueryDto,
+  ) {
+    return this.drillBattleService.fetchBattleHistory(
+      new Types.ObjectId(id),
+      query.page,
+      query.limit,
+    );
+  }
+}
@@ -0,0 +1,26 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { DrillBattle, DrillBattleSchema } from './schemas/drill-battle.schema';
+import { Drill, DrillSchema } from 'src/drills/schemas/drill.schema';
+import {
+  Operator,
+  OperatorSchema,
+} from 'src/operators/schemas/operator.schema';
+import { OperatorModule } from 'src/operators/operator.module';
+import { DrillBattleService } from './drill-battle.service';
+import { DrillBattleController } from './drill-battle.controller';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: DrillBattle.name, schema: DrillBattleSchema },
+      { name: Drill.name, schema: DrillSchema },
+      { name: Operator.name, schema: OperatorSchema },
+    ]),
+    OperatorModule,
+  ],
+  controllers: [DrillBattleController], // Expose API endpoints
+  providers: [DrillBattleService], // Business logic for drill battles
+  exports: [DrillBattleService],
+})
+export class DrillBattleModule {}
@@ -0,0 +1,440 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { DrillBattle, DrillBattleStatus } from './schemas/drill-battle.schema';
+import { Drill } from 'src/drills/schemas/drill.schema';
+import { Operator } from 'src/operators/schemas/operator.schema';
+import { HashTransactionCategory } from 'src/operators/schemas/hash-transaction.schema';
+import { OperatorService } from 'src/operators/operator.service';
+import { ApiResponse } from 'src/common/dto/response.dto';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+@Injectable()
+export class DrillBattleService {
+  private readonly logger = new Logger(DrillBattleService.name);
+
+  constructor(
+    @InjectModel(DrillBattle.name)
+    private drillBattleModel: Model<DrillBattle>,
+    @InjectModel(Drill.name) private drillModel: Model<Drill>,
+    @InjectModel(Operator.name) private operatorModel: Model<Operator>,
+    private readonly operatorService: OperatorService,
+  ) {}
+
+  /**
+   * Challenges another operator to a drill battle. The stakes are held from the challenger's balance
+   * until the battle is accepted or cancelled.
+   */
+  async createBattle(
+    challengerId: Types.ObjectId,
+    challengerDrillId: Types.ObjectId,
+    challengeeId: Types.ObjectId,
+    stakes: number,
+  ): Promise<ApiResponse<{ battleId: Types.ObjectId }>> {
+    try {
+      if (stakes < GAME_CONSTANTS.BATTLES.MIN_STAKES) {
+        return new ApiResponse(
+          400,
+          `(createBattle) Stakes must be at least ${GAME_CONSTANTS.BATTLES.MIN_STAKES} $HASH.`,
+        );
+      }
+
+      if (challengerId.equals(challengeeId)) {
+        return new ApiResponse(
+          400,
+          `(createBattle) Operators cannot challenge themselves.`,
+        );
+      }
+
+      const [drillExists, challengeeExists] = await Promise.all([
+        this.drillModel.exists({
+          _id: challengerDrillId,
+          operatorId: challengerId,
+        }),
+        this.operatorModel.exists({ _id: challengeeId }),
+      ]);
+
+      if (!drillExists) {
+        return new ApiResponse(
+          404,
+          `(createBattle) Drill not found or not owned by operator.`,
+        );
+      }
+
+      if (!challengeeExists) {
+        return new ApiResponse(404, `(createBattle) Challengee not found.`);
+      }
+
+      const battleId = new Types.ObjectId();
+
+      const holdResult = await this.operatorService.holdHASH(
+        challengerId,
+        stakes,
+        HashTransactionCategory.DRILL_BATTLE_STAKE,
+        `Drill battle ${battleId} stakes`,
+        battleId,
+        'drill_battle',
+      );
+
+      if (!holdResult.success) {
+        return new ApiResponse(400, `(createBattle) ${holdResult.error}.`);
+      }
+
+      await this.drillBattleModel.create({
+        _id: battleId,
+        challengerId,
+        challengerDrillId,
+        challengeeId,
+        stakes,
+      });
+
+      this.logger.log(
+        `⚔️ (createBattle) Operator ${challengerId} challenged operator ${challengeeId} to a drill battle for ${stakes} $HASH.`,
+      );
+
+      return new ApiResponse(200, `(createBattle) Drill battle created.`, {
+        battleId,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(createBattle) Error creating drill battle: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Cancels a pending drill battle and releases the held stakes back to the challenger.
+   */
+  async cancelBattle(
+    challengerId: Types.ObjectId,
+    battleId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const battle = await this.drillBattleModel.findOneAndUpdate(
+        {
+          _id: battleId,
+          challengerId,
+          status: DrillBattleStatus.PENDING,
+          challengeeAccepted: false,
+        },
+        { $set: { status: DrillBattleStatus.CANCELLED } },
+      );
+
+      if (!battle) {
+        return new ApiResponse(
+          404,
+          `(cancelBattle) Pending drill battle not found.`,
+        );
+      }
+
+      await this.operatorService.releaseHold(
+        challengerId,
+        battle.stakes,
+        HashTransactionCategory.DRILL_BATTLE_STAKE,
+        `Drill battle ${battleId} cancelled`,
+        battleId,
+        'drill_battle',
+      );
+
+      return new ApiResponse(200, `(cancelBattle) Drill battle cancelled.`);
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(cancelBattle) Error cancelling drill battle: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Accepts a drill battle with one of the challengee's drills and resolves it right away.
+   *
+   * The challengee's stakes are held first, then the winner is drawn at random weighted by each drill's `actualEff`
+   * and receives both stakes.
+   */
+  async acceptBattle(
+    challengeeId: Types.ObjectId,
+    battleId: Types.ObjectId,
+    challengeeDrillId: Types.ObjectId,
+  ): Promise<
+    ApiResponse<{
+      winnerDrillId: Types.ObjectId;
+      winnerOperatorId: Types.ObjectId;
+      payout: number;
+    }>
+  > {
+    try {
+      const battle = await this.drillBattleModel
+        .findOne({
+          _id: battleId,
+          challengeeId,
+          status: DrillBattleStatus.PENDING,
+        })
+        .lean();
+
+      if (!battle) {
+        return new ApiResponse(
+          404,
+          `(acceptBattle) Pending drill battle not found.`,
+        );
+      }
+
+      const [challengerDrill, challengeeDrill] = await Promise.all([
+        this.drillModel
+          .findOne(
+            { _id: battle.challengerDrillId, operatorId: battle.challengerId },
+            { actualEff: 1 },
+          )
+          .lean(),
+        this.drillModel
+          .findOne(
+            { _id: challengeeDrillId, operatorId: challengeeId },
+            { actualEff: 1 },
+          )
+          .lean(),
+      ]);
+
+      if (!challengeeDrill) {
+        return new ApiResponse(
+          404,
+          `(acceptBattle) Drill not found or not owned by operator.`,
+        );
+      }
+
+      if (!challengerDrill) {
+        return new ApiResponse(
+          400,
+          `(acceptBattle) The challenger no longer owns their drill.`,
+        );
+      }
+
+      const holdResult = await this.operatorService.holdHASH(
+        challengeeId,
+        battle.stakes,
+        HashTransactionCategory.DRILL_BATTLE_STAKE,
+        `Drill battle ${battleId} stakes`,
+        battleId,
+        'drill_battle',
+      );
+
+      if (!holdResult.success) {
+        return new ApiResponse(400, `(acceptBattle) ${holdResult.error}.`);
+      }
+
+      // Claim the battle so it can only be accepted once
+      const claimed = await this.drillBattleModel.updateOne(
+        {
+          _id: battleId,
+          status: DrillBattleStatus.PENDING,
+          challengeeAccepted: false,
+        },
+        { $set: { challengeeAccepted: true, challengeeDrillId } },
+      );
+
+      if (claimed.modifiedCount === 0) {
+        await this.operatorService.releaseHold(
+          challengeeId,
+          battle.stakes,
+          HashTransactionCategory.DRILL_BATTLE_STAKE,
+          `Drill battle ${battleId} no longer available`,
+          battleId,
+          'drill_battle',
+        );
+
+        return new ApiResponse(
+          400,
+          `(acceptBattle) Drill battle is no longer available.`,
+        );
+      }
+
+      const challengerWins = this.drawChallengerWins(
+        challengerDrill.actualEff,
+        challengeeDrill.actualEff,
+      );
+      const winnerOperatorId = challengerWins
+        ? battle.challengerId
+        : challengeeId;
+      const winnerDrillId = challengerWins
+        ? battle.challengerDrillId
+        : challengeeDrillId;
+      const payout = battle.stakes * 2;
+
+      // Both stakes leave the escrow and are paid out to the winner.
+      // The winner is only paid once both stakes are settled, so a missing hold never mints $HASH.
+      const stakers = [battle.challengerId, challengeeId];
+      const settledStakers: Types.ObjectId[] = [];
+      let settleError: string | undefined;
+
+      for (const operatorId of stakers) {
+        const settleResult = await this.operatorService.settleHold(
+          operatorId,
+          battle.stakes,
+          HashTransactionCategory.DRILL_BATTLE_STAKE,
+          `Drill battle ${battleId} stakes settled`,
+          battleId,
+          'drill_battle',
+        );
+
+        if (!settleResult.success) {
+          settleError = settleResult.error;
+          break;
+        }
+
+        settledStakers.push(operatorId);
+      }
+
+      if (settleError) {
+        await this.voidBattle(battleId, battle.stakes, stakers, settledStakers);
+
+        return new ApiResponse(
+          400,
+          `(acceptBattle) Drill battle stakes could not be settled: ${settleError}.`,
+        );
+      }
+
+      await this.operatorService.addHASH(
+        winnerOperatorId,
+        payout,
+        HashTransactionCategory.DRILL_BATTLE_WIN,
+        `Drill battle ${battleId} won`,
+        battleId,
+        'drill_battle',
+      );
+
+      await this.drillBattleModel.updateOne(
+        { _id: battleId },
+        {
+          $set: {
+            status: DrillBattleStatus.RESOLVED,
+            winnerDrillId,
+            winnerOperatorId,
+            resolvedAt: new Date(),
+          },
+        },
+      );
+
+      this.logger.log(
+        `🏆 (acceptBattle) Drill ${winnerDrillId} of operator ${winnerOperatorId} won drill battle ${battleId} (${payout} $HASH).`,
+      );
+
+      return new ApiResponse(200, `(acceptBattle) Drill battle resolved.`, {
+        winnerDrillId,
+        winnerOperatorId,
+        payout,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(acceptBattle) Error accepting drill battle: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Calls off an accepted battle whose stakes couldn't all be settled.
+   *
+   * Stakes that were already settled are refunded, and the ones still on hold are released.
+   */
+  private async voidBattle(
+    battleId: Types.ObjectId,
+    stakes: number,
+    stakers: Types.ObjectId[],
+    settledStakers: Types.ObjectId[],
+  ): Promise<void> {
+    await this.drillBattleModel.updateOne(
+      { _id: battleId },
+      { $set: { status: DrillBattleStatus.VOIDED, resolvedAt: new Date() } },
+    );
+
+    for (const operatorId of stakers) {
+      const refundResult = settledStakers.some((id) => id.equals(operatorId))
+        ? await this.operatorService.addHASH(
+            operatorId,
+            stakes,
+            HashTransactionCategory.DRILL_BATTLE_STAKE,
+            `Drill battle ${battleId} voided, stakes refunded`,
+            battleId,
+            'drill_battle',
+          )
+        : await this.operatorService.releaseHold(
+            operatorId,
+            stakes,
+            HashTransactionCategory.DRILL_BATTLE_STAKE,
+            `Drill battle ${battleId} voided`,
+            battleId,
+            'drill_battle',
+          );
+
+      if (!refundResult.success) {
+        this.logger.error(
+          `(voidBattle) Failed to return the stakes of drill battle ${battleId} to operator ${operatorId}: ${refundResult.error}`,
+        );
+      }
+    }
+
+    this.logger.warn(
+      `⚠️ (voidBattle) Drill battle ${battleId} voided because its stakes could not be settled.`,
+    );
+  }
+
+  /**
+   * Fetches the drill battles an operator took part in (as challenger or challengee), newest first.
+   */
+  async fetchBattleHistory(
+    operatorId: Types.ObjectId,
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<ApiResponse<{ battles: DrillBattle[] }>> {
+    try {
+      const battles = await this.drillBattleModel
+        .find({
+          $or: [{ challengerId: operatorId }, { challengeeId: operatorId }],
+        })
+        .sort({ createdAt: -1 })
+        .skip((page - 1) * limit)
+        .limit(limit)
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(fetchBattleHistory) Battle history fetched.`,
+        { battles },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchBattleHistory) Error fetching battle history: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Draws the winner of a battle at random, weighted by each drill's `actualEff`.
+   *
+   * Returns `true` if the challenger's drill wins. If neither drill has any EFF, both have an equal chance.
+   */
+  private drawChallengerWins(
+    challengerEff: number,
+    challengeeEff: number,
+  ): boolean {
+    const totalEff = challengerEff + challengeeEff;
+
+    if (totalEff <= 0) {
+      return Math.random() < 0.5;
+    }
+
+    return Math.random() * totalEff < challengerEff;
+  }
+}
@@ -0,0 +1,152 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The lifecycle of a drill battle.
+ */
+export enum DrillBattleStatus {
+  /** The battle is waiting for the challengee to accept. The challenger's stakes are held from their balance. */
+  PENDING = 'pending',
+  /** The challengee accepted and the battle was resolved. The winner received both stakes. */
+  RESOLVED = 'resolved',
+  /** The challenger withdrew the battle before it was accepted. */
+  CANCELLED = 'cancelled',
+  /** The battle was accepted but a stake couldn't be settled, so both operators got their stakes back. */
+  VOIDED = 'voided',
+}
+
+/**
+ * `DrillBattle` represents a casual 1v1 battle between two operators' drills.
+ *
+ * Both operators stake the same amount of $HASH. Once the challengee accepts, the winner is drawn at random,
+ * weighted by each drill's `actualEff`, and receives both stakes.
+ */
+@Schema({ timestamps: true, collection: 'DrillBattles', versionKey: false })
+export class DrillBattle extends Document {
+  /**
+   * The database ID of the battle.
+   */
+  @ApiProperty({
+    description: 'The database ID of the battle',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who started the battle.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who started the battle',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  challengerId: Types.ObjectId;
+
+  /**
+   * The database ID of the challenger's drill.
+   */
+  @ApiProperty({
+    description: "The database ID of the challenger's drill",
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Drills' })
+  challengerDrillId: Types.ObjectId;
+
+  /**
+   * The database ID of the operator being challenged.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator being challenged',
+    example: '507f1f77bcf86cd799439014',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  challengeeId: Types.ObjectId;
+
+  /**
+   * The database ID of the challengee's drill (NULL until the challengee accepts).
+   */
+  @ApiProperty({
+    description: "The database ID of the challengee's drill",
+    example: '507f1f77bcf86cd799439015',
+    nullable: true,
+  })
+  @Prop({ type: Types.ObjectId, default: null, ref: 'Drills' })
+  challengeeDrillId: Types.ObjectId | null;
+
+  /**
+   * If the challengee accepted the battle.
+   */
+  @ApiProperty({
+    description: 'If the challengee accepted the battle',
+    example: false,
+  })
+  @Prop({ type: Boolean, default: false })
+  challengeeAccepted: boolean;
+
+  /**
+   * The amount of $HASH each operator stakes. The winner receives `stakes * 2`.
+   */
+  @ApiProperty({
+    description:
+      'The amount of $HASH each operator stakes. The winner receives stakes * 2',
+    example: 100,
+  })
+  @Prop({ type: Number, required: true })
+  stakes: number;
+
+  /**
+   * The database ID of the winning drill (NULL until the battle is resolved).
+   */
+  @ApiProperty({
+    description: 'The database ID of the winning drill',
+    example: '507f1f77bcf86cd799439013',
+    nullable: true,
+  })
+  @Prop({ type: Types.ObjectId, default: null, ref: 'Drills' })
+  winnerDrillId: Types.ObjectId | null;
+
+  /**
+   * The database ID of the operator who owns the winning drill (NULL until the battle is resolved).
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who owns the winning drill',
+    example: '507f1f77bcf86cd799439012',
+    nullable: true,
+  })
+  @Prop({ type: Types.ObjectId, default: null, ref: 'Operators' })
+  winnerOperatorId: Types.ObjectId | null;
+
+  /**
+   * When the battle was resolved (NULL until the battle is resolved).
+   */
+  @ApiProperty({
+    description: 'When the battle was resolved',
+    example: '2025-01-01T00:00:00.000Z',
+    nullable: true,
+  })
+  @Prop({ type: Date, default: null })
+  resolvedAt: Date | null;
+
+  /**
+   * The current status of the battle.
+   */
+  @ApiProperty({
+    description: 'The current status of the battle',
+    enum: DrillBattleStatus,
+    example: DrillBattleStatus.PENDING,
+  })
+  @Prop({
+    type: String,
+    enum: DrillBattleStatus,
+    default: DrillBattleStatus.PENDING,
+  })
+  status: DrillBattleStatus;
+}
+
+export const DrillBattleSchema = SchemaFactory.createForClass(DrillBattle);
+
+// Indexes for fetching an operator's battle history
+DrillBattleSchema.index({ challengerId: 1, createdAt: -1 });
+DrillBattleSchema.index({ challengeeId: 1, createdAt: -1 });
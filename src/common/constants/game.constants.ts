@@ -1,3 +1,5 @@
+import { DrillConfig, DrillRarity } from '../enums/drill.enum';
+
 /**
  * A set of constants that define Hashland's game rules and mechanics.
  */
@@ -11,6 +13,49 @@ export const GAME_CONSTANTS = {
      * The cooldown time (in seconds) for joining a pool after previously having joined a pool (assuming the operator leaves).
      */
     JOIN_POOL_COOLDOWN: 28_800, // 8 hours in seconds
+    /**
+     * The maximum length of an operator's avatar or banner URL.
+     */
+    PROFILE_MEDIA_MAX_URL_LENGTH: 512,
+    /**
+     * The CDN hosts that operators' avatar and banner images can be served from.
+     *
+     * Clients upload the images to the CDN directly; we only store the resulting URLs.
+     */
+    PROFILE_MEDIA_ALLOWED_HOST_PATTERN: /^([a-z0-9-]+\.)*cdn\.hashland\.com$/,
+    /**
+     * The cooldown time (in seconds) between drilling session history exports, regardless of format.
+     */
+    SESSION_EXPORT_COOLDOWN: 86_400, // 24 hours in seconds
+    /**
+     * The minimum and maximum duration (in seconds) of a scheduled drilling session.
+     */
+    SCHEDULED_SESSION_MIN_DURATION: 60, // 1 minute in seconds
+    SCHEDULED_SESSION_MAX_DURATION: 86_400, // 24 hours in seconds
+    /**
+     * How far ahead (in seconds) an operator can schedule a drilling session.
+     */
+    SCHEDULED_SESSION_MAX_LEAD_TIME: 604_800, // 7 days in seconds
+    /**
+     * The maximum number of pending scheduled drilling sessions an operator can have at once.
+     */
+    MAX_PENDING_SCHEDULED_SESSIONS: 10,
+    /**
+     * The drilling streaks (in days) that unlock a milestone achievement.
+     */
+    DRILLING_STREAK_MILESTONES: [7, 30],
+    /**
+     * The minimum asset equity (in USD) of an operator's linked wallets for the operator to be verified.
+     */
+    VERIFICATION_THRESHOLD_USD: 1000,
+    /**
+     * The multiplier applied to the EFF of a verified operator's drills when selecting a cycle's extractor.
+     */
+    VERIFIED_EXTRACTOR_EFF_MULTIPLIER: 1.05,
+    /**
+     * The number of days an activity event stays in the activity feed before MongoDB removes it.
+     */
+    ACTIVITY_EVENT_RETENTION_DAYS: 30,
   },
 
   /**
@@ -29,11 +74,20 @@ export const GAME_CONSTANTS = {
        * Efficiency credits given to the referrer.
        */
       EFF_CREDITS: 25,
-      /**
-       * Hash bonus given to the referrer.
-       */
-      HASH_BONUS: 0,
     },
+    /**
+     * The $HASH bonus given to the referrer per referral, which grows with the referrer's number of successful referrals
+     * (i.e. referrals whose rewards were applied, including the current one).
+     *
+     * Ordered by `minReferrals` ascending. The referrer gets the bonus of the highest tier they qualify for.
+     */
+    REFERRER_REWARD_TIERS: [
+      { tier: 1, minReferrals: 1, bonusHASHPerReferral: 50 },
+      { tier: 2, minReferrals: 10, bonusHASHPerReferral: 75 },
+      { tier: 3, minReferrals: 25, bonusHASHPerReferral: 100 },
+      { tier: 4, minReferrals: 50, bonusHASHPerReferral: 150 },
+      { tier: 5, minReferrals: 100, bonusHASHPerReferral: 250 },
+    ],
     /**
      * Rewards given to the referred user when signing up with a referral code.
      */
@@ -51,6 +105,18 @@ export const GAME_CONSTANTS = {
      * Length of the generated referral code.
      */
     CODE_LENGTH: 12,
+    /**
+     * The maximum number of levels that can be fetched for an operator's referral tree.
+     */
+    MAX_REFERRAL_TREE_DEPTH: 5,
+    /**
+     * How long (in seconds) an operator's direct referrals are cached for when fetching their referral tree.
+     */
+    REFERRAL_TREE_CHILDREN_CACHE_TTL: 60,
+    /**
+     * How long (in seconds) an operator's stats are cached for.
+     */
+    STATS_CACHE_TTL: 30,
   },
 
   /**
@@ -65,6 +131,13 @@ export const GAME_CONSTANTS = {
      * The duration of a drilling cycle in seconds.
      */
     CYCLE_DURATION: 8,
+    /**
+     * How strongly a cycle's complexity (number of active operators) shortens its estimated duration
+     * in the cycle countdown: `complexity = 1 + log10(activeOperators) * COUNTDOWN_COMPLEXITY_SCALING`.
+     *
+     * Cycles are currently created on a fixed `CYCLE_DURATION` schedule, so this is 0 (no shortening).
+     */
+    COUNTDOWN_COMPLEXITY_SCALING: 0,
     /**
      * If drilling cycle creation is enabled.
      *
@@ -85,6 +158,33 @@ export const GAME_CONSTANTS = {
      * The actual issuance will vary depending on which epoch the current cycle is in.
      */
     GENESIS_EPOCH_HASH_ISSUANCE: 512,
+    /**
+     * Drill degradation applied at the end of each cycle to every active drill that participated in the cycle
+     * but did not get selected as the extractor.
+     *
+     * The final increment is `basePercent * (1 + log10(activeOperators) * complexityScaling)`,
+     * so busier (more complex) cycles damage drills slightly more.
+     */
+    FAILED_EXTRACTION_DEGRADATION: {
+      /**
+       * The base degradation (in %) added to a drill per failed extraction attempt.
+       */
+      basePercent: 0.001,
+      /**
+       * How strongly the cycle's complexity (number of active operators) scales the base degradation.
+       */
+      complexityScaling: 0.25,
+      /**
+       * The maximum degradation (in %) a drill can reach.
+       */
+      maxPercent: 100,
+    },
+    /**
+     * The number of days a `DrillCycleParticipation` record is kept before MongoDB removes it.
+     *
+     * Must cover `DRILLS.MAINTENANCE.rateWindowCycles`, which estimates degradation rates from these records.
+     */
+    DRILL_PARTICIPATION_RETENTION_DAYS: 7,
   },
 
   /**
@@ -109,6 +209,14 @@ export const GAME_CONSTANTS = {
      * The cooldown time (in seconds) for toggling the active state of a drill.
      */
     ACTIVE_STATE_TOGGLE_COOLDOWN: 28_800, // 8 hours
+    /**
+     * How many drill groups an operator can create.
+     */
+    MAX_DRILL_GROUPS_PER_OPERATOR: 10,
+    /**
+     * The minimum trust score (0 to 1) required to enable multi-session mode (one drilling session per active drill).
+     */
+    MULTI_SESSION_MIN_TRUST_SCORE: 0.8,
     /**
      * The prerequisites for purchasing a Bulwark drill from the shop.
      */
@@ -148,6 +256,108 @@ export const GAME_CONSTANTS = {
        */
       maxFuelRequired: 950000,
     },
+    /**
+     * The EFF decay applied to the drills of operators that haven't drilled for a while (simulating equipment rust).
+     *
+     * Basic drills are excluded since their EFF is derived from the operator's asset equity.
+     */
+    INACTIVITY_DECAY: {
+      /**
+       * The number of days without a drilling session before an operator's drills start decaying.
+       */
+      inactivityDays: 7,
+      /**
+       * How much (in %) of a drill's `actualEff` is lost per day of inactivity.
+       */
+      decayPct: 1,
+    },
+    /**
+     * The minimum EFF each drill configuration can decay to from inactivity.
+     */
+    MIN_BASE_EFF: {
+      [DrillConfig.BASIC]: 1,
+      [DrillConfig.IRONBORE]: 1_000,
+      [DrillConfig.BULWARK]: 5_000,
+      [DrillConfig.TITAN]: 15_000,
+      [DrillConfig.DREADNOUGHT]: 40_000,
+    } as Record<DrillConfig, number>,
+    /**
+     * The rarity of each drill configuration.
+     */
+    CONFIG_RARITY: {
+      [DrillConfig.BASIC]: DrillRarity.COMMON,
+      [DrillConfig.IRONBORE]: DrillRarity.UNCOMMON,
+      [DrillConfig.BULWARK]: DrillRarity.RARE,
+      [DrillConfig.TITAN]: DrillRarity.RARE,
+      [DrillConfig.DREADNOUGHT]: DrillRarity.LEGENDARY,
+    } as Record<DrillConfig, DrillRarity>,
+    /**
+     * How much each rarity scales a drill's shop purchase cost and its minimum starting bid in drill auctions.
+     */
+    RARITY_MULTIPLIERS: {
+      [DrillRarity.COMMON]: 1,
+      [DrillRarity.UNCOMMON]: 1.1,
+      [DrillRarity.RARE]: 1.25,
+      [DrillRarity.LEGENDARY]: 1.5,
+    } as Record<DrillRarity, number>,
+    /**
+     * The minimum asset equity (in USD) an operator needs to buy a drill of each configuration from the shop.
+     *
+     * `null` means the configuration is available to everyone.
+     */
+    CONFIG_MIN_ASSET_EQUITY: {
+      [DrillConfig.BASIC]: null,
+      [DrillConfig.IRONBORE]: null,
+      [DrillConfig.BULWARK]: null,
+      [DrillConfig.TITAN]: null,
+      [DrillConfig.DREADNOUGHT]: 10000,
+    } as Record<DrillConfig, number | null>,
+    /**
+     * The minimum starting bid (in $HASH) of a drill auction for a common drill, before the rarity multiplier.
+     */
+    AUCTION_MIN_STARTING_BID: 100,
+    /**
+     * Opt-in insurance that compensates operators when an insured drill gets destroyed.
+     */
+    INSURANCE: {
+      /**
+       * The insurance premium (in % of the drill's TON purchase cost in the shop).
+       */
+      premiumPct: 10,
+      /**
+       * How much (in %) of the drill's purchase cost is paid out (in $HASH) when the drill is destroyed.
+       */
+      coveragePercent: 50,
+      /**
+       * How long (in days) an insurance stays active.
+       */
+      durationDays: 30,
+    },
+    /**
+     * How much (as a ratio of the base EFF) each upgrade level adds to a drill's EFF.
+     *
+     * A drill's EFF at a given level is `baseEff * (1 + UPGRADE_EFF_INCREASE_PER_LEVEL * (level - 1))`.
+     */
+    UPGRADE_EFF_INCREASE_PER_LEVEL: 0.15,
+    /**
+     * Drill maintenance (repairing degradation from failed extraction attempts).
+     */
+    MAINTENANCE: {
+      /**
+       * The cost (in % of the drill's TON purchase cost in the shop) of repairing a fully degraded drill.
+       *
+       * Partially degraded drills cost proportionally less.
+       */
+      fullRepairCostPct: 20,
+      /**
+       * The degradation (in %) at which a drill is considered in critical need of maintenance.
+       */
+      criticalDegradationPercent: 50,
+      /**
+       * How many recent cycles are used to estimate a drill's daily degradation rate.
+       */
+      rateWindowCycles: 10_800, // 1 day of 8-second cycles
+    },
   },
 
   /**
@@ -210,6 +420,221 @@ export const GAME_CONSTANTS = {
         ],
       },
     ],
+    /**
+     * The number of days without a drilling session before a pool operator is considered dormant,
+     * allowing the pool leader to reclaim their slot.
+     */
+    DORMANT_OPERATOR_DAYS: 14,
+    /**
+     * The cooldown time (in seconds) between dormant slot reclaims for a single pool.
+     */
+    DORMANT_SLOT_RECLAIM_COOLDOWN: 86_400, // 24 hours in seconds
+    /**
+     * The maximum time (in seconds) the drilling cycle reuses its cached pool synergy multipliers.
+     *
+     * The cache is invalidated whenever pool memberships change; this only bounds staleness after changes made outside of `PoolService`.
+     */
+    SYNERGY_MULTIPLIER_CACHE_TTL: 300, // 5 minutes in seconds
+    /**
+     * The number of operators a pool needs to exceed before its leader can split it into two sibling pools.
+     */
+    SPLIT_SOFT_CAP: 100,
+    /**
+     * The maximum length of a pool's external link (website, Discord, Twitter or Telegram) URL.
+     */
+    LINK_MAX_URL_LENGTH: 512,
+    /**
+     * The cooldown time (in seconds) between Telegram recruitment announcements for a single pool.
+     */
+    ANNOUNCEMENT_COOLDOWN: 14_400, // 4 hours in seconds
+    /**
+     * The number of days a pool invitation token (used in recruitment announcements) stays valid.
+     */
+    INVITATION_TOKEN_EXPIRY_DAYS: 7,
+    /**
+     * The number of consecutive cycles a pool operator can miss (i.e. not have an active drilling session)
+     * before the cycle rewards they'd receive while absent (e.g. the leader share) are forfeited to the pool treasury.
+     * The forfeit stops in the first cycle they participate in again.
+     */
+    FORFEIT_THRESHOLD: 10_800, // 24 hours of 8-second cycles
+    /**
+     * The number of days pool members have to vote on a treasury withdrawal proposal before it expires.
+     */
+    TREASURY_PROPOSAL_DURATION_DAYS: 3,
+    /**
+     * The share of a pool's members that need to vote in favour of a treasury withdrawal proposal for it to be approved.
+     */
+    TREASURY_PROPOSAL_QUORUM: 0.5,
+    /**
+     * The number of invited members that need to approve a join request for it to be approved without the leader.
+     */
+    JOIN_REQUEST_APPROVAL_QUORUM: 3,
+    /**
+     * The maximum length of a treasury withdrawal proposal's reason.
+     */
+    TREASURY_PROPOSAL_REASON_MAX_LENGTH: 500,
+    /**
+     * The furthest ahead (in days) a pool leader can set the deadline of an efficiency goal.
+     */
+    EFFICIENCY_GOAL_MAX_DURATION_DAYS: 30,
+    /**
+     * The maximum number of active efficiency goals a pool can have at once.
+     */
+    MAX_ACTIVE_EFFICIENCY_GOALS: 3,
+    /**
+     * The maximum number of past weeks a pool's activity heatmap can cover.
+     */
+    ACTIVITY_HEATMAP_MAX_WEEKS: 12,
+    /**
+     * Pool recommendations for operators without a pool.
+     *
+     * Pools the operator is eligible to join are ranked by
+     * `reputation * reputationWeight + normalized 7-day $HASH * hashWeight + free slot share * memberFitWeight`,
+     * where a pool's reputation is its members' average trust score.
+     */
+    RECOMMENDATIONS: {
+      /**
+       * How many pools are recommended.
+       */
+      count: 5,
+      /**
+       * How long (in seconds) an operator's recommendations are cached for.
+       */
+      cacheTTL: 300,
+      reputationWeight: 0.4,
+      hashWeight: 0.4,
+      memberFitWeight: 0.2,
+    },
+    /**
+     * Scales the pool synergy multiplier applied to the EFF of pool members' drills (`1 + log10(memberCount) * SYNERGY_COEFFICIENT`).
+     *
+     * E.g. a pool with 100 members gets a 1.2x boost.
+     */
+    SYNERGY_COEFFICIENT: 0.1,
+    /**
+     * The minimum ratio of a member's EFF to their pool's average member EFF for each efficiency tier.
+     * Members below the `silver` ratio are bronze.
+     */
+    MEMBER_EFF_TIER_RATIOS: {
+      silver: 0.5,
+      gold: 1,
+      platinum: 1.5,
+    },
+    /**
+     * How far the shares of a pool's reward system may deviate from adding up to exactly 1 (to allow for floating-point errors).
+     */
+    REWARD_SYSTEM_SHARE_TOLERANCE: 0.00001,
+    /**
+     * Named reward system templates that can be applied when creating a pool instead of choosing each share manually.
+     *
+     * Each reward system's shares must add up to 1.
+     */
+    REWARD_PRESETS: [
+      {
+        presetId: 1,
+        name: 'Balanced',
+        description:
+          'The default split between the extractor and the active pool operators.',
+        rewardSystem: {
+          extractorOperator: 0.48,
+          leader: 0.04,
+          activePoolOperators: 0.4,
+          activeGlobalOperators: 0.08,
+        },
+      },
+      {
+        presetId: 2,
+        name: 'Extractor Heavy',
+        description:
+          'Rewards the operator whose drill extracts the most, encouraging strong drills.',
+        rewardSystem: {
+          extractorOperator: 0.64,
+          leader: 0.04,
+          activePoolOperators: 0.24,
+          activeGlobalOperators: 0.08,
+        },
+      },
+      {
+        presetId: 3,
+        name: 'Equal Share',
+        description:
+          'Spreads most of the rewards across all active pool operators.',
+        rewardSystem: {
+          extractorOperator: 0.2,
+          leader: 0.04,
+          activePoolOperators: 0.68,
+          activeGlobalOperators: 0.08,
+        },
+      },
+      {
+        presetId: 4,
+        name: 'Leader Supported',
+        description:
+          'Gives the pool leader a bigger cut for managing and promoting the pool.',
+        rewardSystem: {
+          extractorOperator: 0.44,
+          leader: 0.1,
+          activePoolOperators: 0.38,
+          activeGlobalOperators: 0.08,
+        },
+      },
+    ],
+    /**
+     * How long (in seconds) a pool's stats are cached for.
+     */
+    STATS_CACHE_TTL: 30,
+  },
+
+  /**
+   * Guild constants.
+   */
+  GUILDS: {
+    /**
+     * The default maximum number of members a guild can have.
+     */
+    DEFAULT_MAX_MEMBERS: 50,
+    /**
+     * The highest `maxMembers` value a founder can choose for their guild.
+     */
+    MAX_MEMBERS_LIMIT: 200,
+  },
+
+  /**
+   * $HASH lending constants.
+   */
+  LOANS: {
+    /**
+     * The minimum amount of $HASH a loan offer can be for.
+     */
+    MIN_AMOUNT: 100,
+    /**
+     * The highest interest rate (in %) a lender can charge.
+     */
+    MAX_INTEREST_RATE_PCT: 50,
+    /**
+     * The longest loan duration (in days) a lender can offer.
+     */
+    MAX_DURATION_DAYS: 30,
+  },
+
+  /**
+   * Drill battle constants.
+   */
+  BATTLES: {
+    /**
+     * The minimum amount of $HASH each operator has to stake in a drill battle.
+     */
+    MIN_STAKES: 10,
+  },
+
+  /**
+   * Onboarding constants.
+   */
+  ONBOARDING: {
+    /**
+     * The one-time $HASH bonus credited to an operator once they complete every onboarding step.
+     */
+    BONUS_HASH: 250,
   },
 
   /**
@@ -262,6 +687,75 @@ export const GAME_CONSTANTS = {
     },
   },
 
+  /**
+   * $HASH staking constants.
+   */
+  STAKING: {
+    /**
+     * The minimum amount of $HASH that can be staked at once.
+     */
+    MIN_STAKE_AMOUNT: 100,
+    /**
+     * The available staking durations, the EFF boost (in %) each grants while the stake is active
+     * and the annual $HASH yield (in %) on the staked amount, accrued until the stake unlocks and paid out hourly.
+     */
+    DURATION_TIERS: [
+      { durationDays: 7, multiplierPct: 2, annualYieldPct: 2 },
+      { durationDays: 30, multiplierPct: 5, annualYieldPct: 5 },
+      { durationDays: 90, multiplierPct: 10, annualYieldPct: 10 },
+    ],
+    /**
+     * The maximum total EFF boost (in %) an operator can have from all of their active stakes combined.
+     */
+    MAX_TOTAL_BOOST_PCT: 25,
+    /**
+     * The minimum time (in milliseconds) between two staking reward payouts for the same stake.
+     *
+     * Slightly below an hour so that the hourly job never skips a stake because of scheduling jitter.
+     */
+    REWARD_MIN_INTERVAL_MS: 55 * 60 * 1000,
+  },
+
+  /**
+   * Operator trust score constants.
+   *
+   * The trust score (0 to 1) is a weighted sum of the components below, each normalized to 0 - 1.
+   * There is no withdrawal flow yet, so withdrawal history is not part of the score.
+   */
+  TRUST_SCORE: {
+    /**
+     * The weight of each trust score component. Must add up to 1.
+     */
+    weights: {
+      accountAge: 0.3,
+      cleanRecord: 0.2,
+      referralQuality: 0.2,
+      sessionConsistency: 0.3,
+    },
+    /**
+     * The account age (in days) at which the account age component is maxed out.
+     */
+    fullAccountAgeDays: 90,
+    /**
+     * The number of past days checked for drilling activity when computing session consistency.
+     */
+    sessionConsistencyWindowDays: 30,
+    /**
+     * The referral quality assigned to operators who haven't referred anyone.
+     */
+    noReferralsQuality: 0.5,
+  },
+
+  /**
+   * Skill tree constants.
+   */
+  SKILLS: {
+    /**
+     * The maximum total fuel consumption reduction (in %) an operator can get from their unlocked skills.
+     */
+    MAX_FUEL_CONSUMPTION_REDUCTION_PCT: 50,
+  },
+
   /**
    * Luck constants.
    */
@@ -2,6 +2,7 @@ import { Global, Module } from '@nestjs/common';
 import { MongooseModule } from '@nestjs/mongoose';
 import { DatabaseService } from './database.service';
 import { ConfigModule } from '@nestjs/config';
+import { requestIdPlugin } from './utils/request-context';
 
 /**
  * DatabaseModule initializes MongoDB connection
@@ -24,6 +25,8 @@ import { ConfigModule } from '@nestjs/config';
             console.log(
               `✅ MongoDB Connected: ${process.env.MONGO_URI}/${process.env.DATABASE_NAME}`,
             );
+            // Tag queries with the ID of the request that ran them
+            connection.plugin(requestIdPlugin);
             return connection;
           },
           // ✅ Connection Pooling Settings (optimized for high concurrency)
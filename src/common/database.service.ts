@@ -58,6 +58,13 @@ export class DatabaseService implements OnModuleInit, OnModuleDestroy {
     await this.connection.close();
   }
 
+  /**
+   * Pings MongoDB to check that the connection is alive.
+   */
+  async ping() {
+    await this.connection.db.admin().ping();
+  }
+
   getPoolStatus() {
     return {
       maxPoolSize: this.connection.getClient().options.maxPoolSize,
@@ -0,0 +1,21 @@
+import { applyDecorators, SetMetadata, UseGuards } from '@nestjs/common';
+import { ApiTooManyRequestsResponse } from '@nestjs/swagger';
+import { RATE_LIMIT_KEY, RateLimitGuard } from '../guards/rate-limit.guard';
+
+/**
+ * Decorator that limits a route to `maxRequests` per `windowSeconds`, per authenticated operator (or per IP).
+ *
+ * Must be placed above `@UseGuards(JwtAuthGuard)` so that the operator is authenticated before the request is counted.
+ * @param maxRequests Maximum number of requests per window
+ * @param windowSeconds Length of the window in seconds (default: 60)
+ * @returns Combined decorator with guard and Swagger documentation
+ */
+export const RateLimit = (maxRequests: number, windowSeconds: number = 60) => {
+  return applyDecorators(
+    SetMetadata(RATE_LIMIT_KEY, { maxRequests, windowSeconds }),
+    UseGuards(RateLimitGuard),
+    ApiTooManyRequestsResponse({
+      description: `Too Many Requests - Limited to ${maxRequests} requests per ${windowSeconds} seconds`,
+    }),
+  );
+};
@@ -0,0 +1,129 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { IsDateString, IsIn, IsOptional } from 'class-validator';
+
+export class GetPoolsCreatedQueryDto {
+  @ApiProperty({
+    description: 'Only count pools created at or after this date (ISO string)',
+    example: '2025-01-01T00:00:00.000Z',
+    required: false,
+  })
+  @IsOptional()
+  @IsDateString()
+  from?: string;
+
+  @ApiProperty({
+    description: 'Only count pools created before this date (ISO string)',
+    example: '2025-02-01T00:00:00.000Z',
+    required: false,
+  })
+  @IsOptional()
+  @IsDateString()
+  to?: string;
+
+  @ApiProperty({
+    description: 'The period length to group pools by',
+    enum: ['day', 'week'],
+    example: 'day',
+    required: false,
+    default: 'day',
+  })
+  @IsOptional()
+  @IsIn(['day', 'week'])
+  granularity?: 'day' | 'week';
+}
+
+export class PoolsCreatedPeriodDto {
+  @ApiProperty({
+    description: 'The start of the period',
+    example: '2025-01-01T00:00:00.000Z',
+  })
+  period: Date;
+
+  @ApiProperty({
+    description: 'The number of pools created during the period',
+    example: 3,
+  })
+  count: number;
+}
+
+export class GetRevenueQueryDto {
+  @ApiProperty({
+    description: 'Only count purchases made at or after this date (ISO string)',
+    example: '2025-01-01T00:00:00.000Z',
+    required: false,
+  })
+  @IsOptional()
+  @IsDateString()
+  from?: string;
+
+  @ApiProperty({
+    description: 'Only count purchases made before this date (ISO string)',
+    example: '2025-01-31T00:00:00.000Z',
+    required: false,
+  })
+  @IsOptional()
+  @IsDateString()
+  to?: string;
+}
+
+export class RevenueTotalsDto {
+  @ApiProperty({
+    description: 'TON received from one-off purchases (e.g. drills)',
+    example: 120.5,
+  })
+  totalTONReceivedPurchases: number;
+
+  @ApiProperty({
+    description: 'TON received from upgrades (e.g. max fuel)',
+    example: 30,
+  })
+  totalTONReceivedUpgrades: number;
+
+  @ApiProperty({
+    description: 'TON received from fuel replenishments',
+    example: 12.75,
+  })
+  totalTONReceivedFuel: number;
+
+  @ApiProperty({
+    description: 'TON received from extra active drill slots',
+    example: 45,
+  })
+  totalTONReceivedSlots: number;
+
+  @ApiProperty({
+    description: 'TON received from bundles',
+    example: 0,
+  })
+  totalTONReceivedBundles: number;
+
+  @ApiProperty({
+    description: 'The total TON received across all categories',
+    example: 208.25,
+  })
+  grandTotalTON: number;
+}
+
+export class RevenueDayDto extends RevenueTotalsDto {
+  @ApiProperty({
+    description: 'The start of the day (UTC)',
+    example: '2025-01-01T00:00:00.000Z',
+  })
+  day: Date;
+}
+
+export class RevenueResponseDto {
+  @ApiProperty({
+    description: 'The TON revenue totals within the date range',
+    type: RevenueTotalsDto,
+  })
+  totals: RevenueTotalsDto;
+
+  @ApiProperty({
+    description:
+      'The TON revenue per day, oldest first (only if both `from` and `to` are given and at most 30 days apart)',
+    type: [RevenueDayDto],
+    nullable: true,
+  })
+  daily: RevenueDayDto[] | null;
+}
@@ -0,0 +1,23 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { IsBoolean, IsNotEmpty, IsOptional, IsString } from 'class-validator';
+
+export class StartCompactionDto {
+  @ApiProperty({
+    description: 'The name of the collection to compact',
+    example: 'DrillingSessions',
+  })
+  @IsString()
+  @IsNotEmpty()
+  collection: string;
+
+  @ApiProperty({
+    description:
+      'Whether to also validate the collection afterwards to refresh its statistics',
+    example: true,
+    required: false,
+    default: false,
+  })
+  @IsOptional()
+  @IsBoolean()
+  validate?: boolean;
+}
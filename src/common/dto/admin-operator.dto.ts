@@ -0,0 +1,165 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { Transform, Type } from 'class-transformer';
+import {
+  IsBoolean,
+  IsEnum,
+  IsInt,
+  IsMongoId,
+  IsNumber,
+  IsOptional,
+  Max,
+  Min,
+} from 'class-validator';
+
+/**
+ * The sort orders available when listing operators as an admin.
+ */
+export enum AdminOperatorSort {
+  HASH_BALANCE_DESC = 'hash_balance_desc',
+  HASH_BALANCE_ASC = 'hash_balance_asc',
+  TOTAL_EARNED_HASH_DESC = 'total_earned_hash_desc',
+  TRUST_SCORE_DESC = 'trust_score_desc',
+  CREATED_AT_DESC = 'created_at_desc',
+  CREATED_AT_ASC = 'created_at_asc',
+}
+
+export class GetOperatorsByTrustQueryDto {
+  @ApiProperty({
+    description: 'The minimum trust score (inclusive)',
+    example: 0.8,
+    required: false,
+    default: 0,
+  })
+  @IsOptional()
+  @Type(() => Number)
+  @IsNumber()
+  @Min(0)
+  @Max(1)
+  minTrust?: number;
+
+  @ApiProperty({
+    description: 'The maximum trust score (inclusive)',
+    example: 1,
+    required: false,
+    default: 1,
+  })
+  @IsOptional()
+  @Type(() => Number)
+  @IsNumber()
+  @Min(0)
+  @Max(1)
+  maxTrust?: number;
+
+  @ApiProperty({
+    description: 'Page number for pagination (starting from 1)',
+    example: 1,
+    required: false,
+    default: 1,
+  })
+  @IsOptional()
+  @Type(() => Number)
+  @IsInt()
+  @Min(1)
+  page?: number;
+
+  @ApiProperty({
+    description: 'Number of items per page (max 100)',
+    example: 50,
+    required: false,
+    default: 50,
+  })
+  @IsOptional()
+  @Type(() => Number)
+  @IsInt()
+  @Min(1)
+  @Max(100)
+  limit?: number;
+}
+
+export class AdminListOperatorsQueryDto {
+  @ApiProperty({
+    description: 'The sort order of the operators',
+    enum: AdminOperatorSort,
+    required: false,
+    default: AdminOperatorSort.CREATED_AT_DESC,
+  })
+  @IsOptional()
+  @IsEnum(AdminOperatorSort)
+  sort?: AdminOperatorSort;
+
+  @ApiProperty({
+    description:
+      'If true, only banned operators are returned. If false, banned operators are excluded',
+    example: false,
+    required: false,
+  })
+  @IsOptional()
+  @Transform(({ value }) => value === true || value === 'true')
+  @IsBoolean()
+  filterBanned?: boolean;
+
+  @ApiProperty({
+    description:
+      'If true, only operators in a pool are returned. If false, only operators without a pool are returned',
+    example: true,
+    required: false,
+  })
+  @IsOptional()
+  @Transform(({ value }) => value === true || value === 'true')
+  @IsBoolean()
+  filterHasPool?: boolean;
+
+  @ApiProperty({
+    description: 'Number of operators to return (max 100)',
+    example: 50,
+    required: false,
+    default: 50,
+  })
+  @IsOptional()
+  @Type(() => Number)
+  @IsInt()
+  @Min(1)
+  @Max(100)
+  limit?: number;
+
+  @ApiProperty({
+    description:
+      'The ID of the last operator of the previous page (`nextCursor` of the previous response)',
+    example: '507f1f77bcf86cd799439011',
+    required: false,
+  })
+  @IsOptional()
+  @IsMongoId()
+  cursor?: string;
+}
+
+/**
+ * The status fields added to each operator in the admin operator listing.
+ */
+export class OperatorAdminStatusDto {
+  @ApiProperty({
+    description: 'Whether the operator currently has an active drilling session',
+    example: true,
+  })
+  isDrilling: boolean;
+
+  @ApiProperty({
+    description: 'The ID of the pool the operator is in (null if none)',
+    example: '507f1f77bcf86cd799439011',
+    nullable: true,
+  })
+  poolId: string | null;
+
+  @ApiProperty({
+    description: 'The number of drills the operator owns',
+    example: 3,
+  })
+  drillCount: number;
+
+  @ApiProperty({
+    description: "The operator's ban status",
+    enum: ['active', 'banned'],
+    example: 'active',
+  })
+  banStatus: 'active' | 'banned';
+}
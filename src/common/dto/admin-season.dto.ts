@@ -0,0 +1,108 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { Transform } from 'class-transformer';
+import { IsBoolean, IsOptional, IsString } from 'class-validator';
+
+export class ResetSeasonQueryDto {
+  @ApiProperty({
+    description:
+      'If true, only previews the reset (and returns a confirmation token) without writing anything',
+    example: true,
+    required: false,
+    default: false,
+  })
+  @IsOptional()
+  @Transform(({ value }) => value === true || value === 'true')
+  @IsBoolean()
+  dryRun?: boolean;
+}
+
+export class ResetSeasonDto {
+  @ApiProperty({
+    description:
+      'The confirmation token returned by the latest dry run. Required to execute the reset',
+    example: '9f86d081884c7d659a2feaa0c55ad015',
+    required: false,
+  })
+  @IsOptional()
+  @IsString()
+  confirmationToken?: string;
+}
+
+export class SeasonResetDiffDto {
+  @ApiProperty({
+    description: 'The number of operators whose HASH balance is set to 0',
+    example: 1200,
+  })
+  operatorsHASHBalanceReset: number;
+
+  @ApiProperty({
+    description: 'The number of operators whose current streak is set to 0',
+    example: 800,
+  })
+  operatorsStreakReset: number;
+
+  @ApiProperty({
+    description:
+      'The number of closed pool membership records moved to the archive',
+    example: 3400,
+  })
+  poolMembershipHistoriesArchived: number;
+}
+
+export class SeasonSummaryOperatorDto {
+  @ApiProperty({
+    description: 'The database ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  operatorId: string;
+
+  @ApiProperty({
+    description: 'The username of the operator',
+    example: 'satoshi',
+  })
+  username: string;
+
+  @ApiProperty({
+    description: "The operator's HASH balance at the end of the season",
+    example: 250000,
+  })
+  currentHASH: number;
+}
+
+export class SeasonSummaryDto {
+  @ApiProperty({
+    description: 'When the summary was generated',
+    example: '2025-06-01T00:00:00.000Z',
+  })
+  generatedAt: Date;
+
+  @ApiProperty({
+    description: 'The total number of operators',
+    example: 1500,
+  })
+  totalOperators: number;
+
+  @ApiProperty({
+    description: "The sum of all operators' HASH balances",
+    example: 12500000,
+  })
+  totalHASHBalance: number;
+
+  @ApiProperty({
+    description: 'The sum of all HASH ever earned by operators',
+    example: 30000000,
+  })
+  totalEarnedHASH: number;
+
+  @ApiProperty({
+    description: 'The longest current drilling streak (in days)',
+    example: 42,
+  })
+  longestCurrentStreak: number;
+
+  @ApiProperty({
+    description: 'The operators with the highest HASH balances',
+    type: [SeasonSummaryOperatorDto],
+  })
+  topOperators: SeasonSummaryOperatorDto[];
+}
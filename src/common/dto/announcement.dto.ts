@@ -0,0 +1,120 @@
+import { ApiProperty } from '@nestjs/swagger';
+import {
+  IsDateString,
+  IsEnum,
+  IsNotEmpty,
+  IsOptional,
+  IsString,
+  MaxLength,
+} from 'class-validator';
+import { AnnouncementType } from 'src/platform/schemas/announcement.schema';
+
+export class CreateAnnouncementDto {
+  @ApiProperty({
+    description: 'The title of the announcement',
+    example: 'Scheduled maintenance',
+  })
+  @IsString()
+  @IsNotEmpty()
+  @MaxLength(100)
+  title: string;
+
+  @ApiProperty({
+    description: 'The body of the announcement',
+    example: 'Drilling will be paused for 30 minutes at 12:00 UTC.',
+  })
+  @IsString()
+  @IsNotEmpty()
+  @MaxLength(1000)
+  body: string;
+
+  @ApiProperty({
+    description: 'The kind of notice the announcement is',
+    enum: AnnouncementType,
+    example: AnnouncementType.MAINTENANCE,
+    required: false,
+    default: AnnouncementType.INFO,
+  })
+  @IsOptional()
+  @IsEnum(AnnouncementType)
+  type?: AnnouncementType;
+
+  @ApiProperty({
+    description: 'When the announcement starts being shown',
+    example: '2025-01-01T12:00:00.000Z',
+  })
+  @IsDateString()
+  startsAt: string;
+
+  @ApiProperty({
+    description:
+      'When the announcement stops being shown. Omit to show it until it is removed',
+    example: '2025-01-02T12:00:00.000Z',
+    required: false,
+  })
+  @IsOptional()
+  @IsDateString()
+  endsAt?: string;
+
+  @ApiProperty({
+    description: 'The name of the admin creating the announcement',
+    example: 'hashland_admin',
+  })
+  @IsString()
+  @IsNotEmpty()
+  createdByAdmin: string;
+}
+
+export class UpdateAnnouncementDto {
+  @ApiProperty({
+    description: 'The title of the announcement',
+    example: 'Scheduled maintenance',
+    required: false,
+  })
+  @IsOptional()
+  @IsString()
+  @IsNotEmpty()
+  @MaxLength(100)
+  title?: string;
+
+  @ApiProperty({
+    description: 'The body of the announcement',
+    example: 'Drilling will be paused for 30 minutes at 12:00 UTC.',
+    required: false,
+  })
+  @IsOptional()
+  @IsString()
+  @IsNotEmpty()
+  @MaxLength(1000)
+  body?: string;
+
+  @ApiProperty({
+    description: 'The kind of notice the announcement is',
+    enum: AnnouncementType,
+    example: AnnouncementType.MAINTENANCE,
+    required: false,
+  })
+  @IsOptional()
+  @IsEnum(AnnouncementType)
+  type?: AnnouncementType;
+
+  @ApiProperty({
+    description: 'When the announcement starts being shown',
+    example: '2025-01-01T12:00:00.000Z',
+    required: false,
+  })
+  @IsOptional()
+  @IsDateString()
+  startsAt?: string;
+
+  @ApiProperty({
+    description:
+      'When the announcement stops being shown. Pass null to show it until it is removed',
+    example: '2025-01-02T12:00:00.000Z',
+    required: false,
+    nullable: true,
+  })
+  @IsOptional()
+  @IsDateString()
+  endsAt?: string | null;
+}
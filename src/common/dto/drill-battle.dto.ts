@@ -0,0 +1,36 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { IsMongoId, IsNumber, Min } from 'class-validator';
+import { GAME_CONSTANTS } from '../constants/game.constants';
+
+export class CreateDrillBattleDto {
+  @ApiProperty({
+    description: "The database ID of the challenger's drill",
+    example: '507f1f77bcf86cd799439011',
+  })
+  @IsMongoId()
+  drillId: string;
+
+  @ApiProperty({
+    description: 'The database ID of the operator to challenge',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @IsMongoId()
+  challengeeId: string;
+
+  @ApiProperty({
+    description: 'The amount of $HASH each operator stakes',
+    example: 100,
+  })
+  @IsNumber()
+  @Min(GAME_CONSTANTS.BATTLES.MIN_STAKES)
+  stakes: number;
+}
+
+export class AcceptDrillBattleDto {
+  @ApiProperty({
+    description: "The database ID of the challengee's drill",
+    example: '507f1f77bcf86cd799439013',
+  })
+  @IsMongoId()
+  drillId: string;
+}
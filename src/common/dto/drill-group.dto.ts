@@ -0,0 +1,57 @@
+import { ApiProperty } from '@nestjs/swagger';
+import {
+  ArrayMaxSize,
+  IsArray,
+  IsMongoId,
+  IsNotEmpty,
+  IsOptional,
+  IsString,
+  MaxLength,
+} from 'class-validator';
+import { GAME_CONSTANTS } from '../constants/game.constants';
+
+export class CreateDrillGroupDto {
+  @ApiProperty({
+    description: 'The name of the drill group',
+    example: 'Night shift',
+  })
+  @IsString()
+  @IsNotEmpty()
+  @MaxLength(32)
+  name: string;
+
+  @ApiProperty({
+    description: 'The database IDs of the drills to include in the group',
+    example: ['507f1f77bcf86cd799439013', '507f1f77bcf86cd799439014'],
+    type: [String],
+  })
+  @IsArray()
+  @ArrayMaxSize(GAME_CONSTANTS.DRILLS.MAX_ACTIVE_DRILLS_ALLOWED)
+  @IsMongoId({ each: true })
+  drillIds: string[];
+}
+
+export class UpdateDrillGroupDto {
+  @ApiProperty({
+    description: 'The new name of the drill group',
+    example: 'Day shift',
+    required: false,
+  })
+  @IsOptional()
+  @IsString()
+  @IsNotEmpty()
+  @MaxLength(32)
+  name?: string;
+
+  @ApiProperty({
+    description: 'The new database IDs of the drills in the group',
+    example: ['507f1f77bcf86cd799439013'],
+    type: [String],
+    required: false,
+  })
+  @IsOptional()
+  @IsArray()
+  @ArrayMaxSize(GAME_CONSTANTS.DRILLS.MAX_ACTIVE_DRILLS_ALLOWED)
+  @IsMongoId({ each: true })
+  drillIds?: string[];
+}
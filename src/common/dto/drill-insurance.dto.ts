@@ -0,0 +1,22 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { IsNotEmpty, IsString } from 'class-validator';
+
+export class InsureDrillDto {
+  @ApiProperty({
+    description: 'The TON wallet address the premium is paid from',
+    example: 'EQDrLq-X6jKZNHAScgghh0h1iog3StK71zfAxNOYVlPP70wY',
+  })
+  @IsString()
+  @IsNotEmpty()
+  address: string;
+
+  @ApiProperty({
+    description:
+      'The BOC of the premium payment transaction. Its payload item must be `DRILL_INSURANCE:<drillId>`',
+    example:
+      'te6cckECEQEAAzYAART/APSkE/S88sgLAQIBYgIDAgLMBAUCASAGBwIBIAgJAHW0qWl8sMnP...',
+  })
+  @IsString()
+  @IsNotEmpty()
+  boc: string;
+}
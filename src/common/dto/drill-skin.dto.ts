@@ -0,0 +1,74 @@
+import { ApiProperty } from '@nestjs/swagger';
+import {
+  IsMongoId,
+  IsNotEmpty,
+  IsNumber,
+  IsOptional,
+  IsString,
+  IsUrl,
+  MaxLength,
+  Min,
+} from 'class-validator';
+
+export class CreateDrillSkinDto {
+  @ApiProperty({
+    description: 'The name of the skin',
+    example: 'Molten Gold',
+  })
+  @IsString()
+  @IsNotEmpty()
+  @MaxLength(32)
+  name: string;
+
+  @ApiProperty({
+    description: 'The description of the skin',
+    example: 'A drill plated in molten gold.',
+    required: false,
+  })
+  @IsOptional()
+  @IsString()
+  @MaxLength(256)
+  description?: string;
+
+  @ApiProperty({
+    description: "The HTTPS URL of the skin's image",
+    example: 'https://cdn.hashland.com/skins/molten-gold.png',
+  })
+  @IsUrl({ protocols: ['https'], require_protocol: true })
+  imageURL: string;
+
+  @ApiProperty({
+    description: 'The price of applying the skin to a drill (in TON)',
+    example: 0.5,
+  })
+  @IsNumber()
+  @Min(0)
+  priceTON: number;
+}
+
+export class ApplyDrillSkinDto {
+  @ApiProperty({
+    description: 'The database ID of the skin to apply',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @IsMongoId()
+  skinId: string;
+
+  @ApiProperty({
+    description: 'The TON wallet address the skin is paid from',
+    example: 'EQDrLq-X6jKZNHAScgghh0h1iog3StK71zfAxNOYVlPP70wY',
+  })
+  @IsString()
+  @IsNotEmpty()
+  address: string;
+
+  @ApiProperty({
+    description:
+      'The BOC of the skin payment transaction. Its payload item must be `DRILL_SKIN:<drillId>`',
+    example:
+      'te6cckECEQEAAzYAART/APSkE/S88sgLAQIBYgIDAgLMBAUCASAGBwIBIAgJAHW0qWl8sMnP...',
+  })
+  @IsString()
+  @IsNotEmpty()
+  boc: string;
+}
@@ -0,0 +1,34 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { IsEnum, IsNotEmpty, IsString } from 'class-validator';
+import { DrillConfig } from 'src/common/enums/drill.enum';
+
+export class UpgradeDrillDto {
+  @ApiProperty({
+    description: 'The TON wallet address the upgrade is paid from',
+    example: 'EQDrLq-X6jKZNHAScgghh0h1iog3StK71zfAxNOYVlPP70wY',
+  })
+  @IsString()
+  @IsNotEmpty()
+  address: string;
+
+  @ApiProperty({
+    description:
+      'The BOC of the upgrade payment transaction. Its payload item must be `DRILL_UPGRADE:<drillId>` (or `DRILL_CONFIG_UPGRADE:<drillId>` for config upgrades)',
+    example:
+      'te6cckECEQEAAzYAART/APSkE/S88sgLAQIBYgIDAgLMBAUCASAGBwIBIAgJAHW0qWl8sMnP...',
+  })
+  @IsString()
+  @IsNotEmpty()
+  boc: string;
+}
+
+export class UpgradeDrillConfigDto extends UpgradeDrillDto {
+  @ApiProperty({
+    description:
+      "The configuration to upgrade the drill to. Must be the next one on the drill's upgrade path",
+    enum: DrillConfig,
+    example: DrillConfig.BULWARK,
+  })
+  @IsEnum(DrillConfig)
+  config: DrillConfig;
+}
@@ -0,0 +1,47 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { IsBoolean, IsEnum, IsOptional } from 'class-validator';
+import { Transform } from 'class-transformer';
+import { DrillConfig } from '../enums/drill.enum';
+import { GetLeaderboardQueryDto } from './leaderboard.dto';
+
+/**
+ * The ways an operator's drills can be sorted.
+ */
+export enum DrillSortOption {
+  ACTUAL_EFF_DESC = 'actual_eff_desc',
+  DEGRADATION_DESC = 'degradation_desc',
+  CONFIG = 'config',
+  LEVEL_DESC = 'level_desc',
+}
+
+export class GetOperatorDrillsQueryDto extends GetLeaderboardQueryDto {
+  @ApiProperty({
+    description: 'How to sort the drills',
+    enum: DrillSortOption,
+    required: false,
+    default: DrillSortOption.ACTUAL_EFF_DESC,
+  })
+  @IsOptional()
+  @IsEnum(DrillSortOption)
+  sort?: DrillSortOption;
+
+  @ApiProperty({
+    description:
+      'Only return drills that are (or are not) allowed to be extractors',
+    example: true,
+    required: false,
+  })
+  @IsOptional()
+  @Transform(({ value }) => value === true || value === 'true')
+  @IsBoolean()
+  extractorAllowed?: boolean;
+
+  @ApiProperty({
+    description: 'Only return drills with this configuration',
+    enum: DrillConfig,
+    required: false,
+  })
+  @IsOptional()
+  @IsEnum(DrillConfig)
+  config?: DrillConfig;
+}
@@ -0,0 +1,24 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { IsDateString, IsOptional } from 'class-validator';
+import { GetLeaderboardQueryDto } from './leaderboard.dto';
+
+export class GetCycleHistoryQueryDto extends GetLeaderboardQueryDto {
+  @ApiProperty({
+    description:
+      'Only return cycles started at or after this date (ISO string)',
+    example: '2025-01-01T00:00:00.000Z',
+    required: false,
+  })
+  @IsOptional()
+  @IsDateString()
+  from?: string;
+
+  @ApiProperty({
+    description: 'Only return cycles started before this date (ISO string)',
+    example: '2025-02-01T00:00:00.000Z',
+    required: false,
+  })
+  @IsOptional()
+  @IsDateString()
+  to?: string;
+}
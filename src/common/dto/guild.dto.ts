@@ -0,0 +1,72 @@
+import { ApiProperty } from '@nestjs/swagger';
+import {
+  IsInt,
+  IsNotEmpty,
+  IsOptional,
+  IsString,
+  Max,
+  MaxLength,
+  Min,
+} from 'class-validator';
+import { GAME_CONSTANTS } from '../constants/game.constants';
+
+export class CreateGuildDto {
+  @ApiProperty({
+    description: 'The name of the guild',
+    example: 'Deep Diggers',
+  })
+  @IsString()
+  @IsNotEmpty()
+  @MaxLength(32)
+  name: string;
+
+  @ApiProperty({
+    description: 'The maximum number of members the guild can have',
+    example: 50,
+    required: false,
+    default: GAME_CONSTANTS.GUILDS.DEFAULT_MAX_MEMBERS,
+  })
+  @IsOptional()
+  @IsInt()
+  @Min(1)
+  @Max(GAME_CONSTANTS.GUILDS.MAX_MEMBERS_LIMIT)
+  maxMembers?: number;
+}
+
+export class GuildLeaderboardEntryDto {
+  @ApiProperty({
+    description: 'The ranking position of the guild',
+    example: 1,
+  })
+  rank: number;
+
+  @ApiProperty({
+    description: 'The database ID of the guild',
+    example: '507f1f77bcf86cd799439011',
+  })
+  guildId: string;
+
+  @ApiProperty({
+    description: 'The name of the guild',
+    example: 'Deep Diggers',
+  })
+  name: string;
+
+  @ApiProperty({
+    description: 'The number of members in the guild',
+    example: 12,
+  })
+  memberCount: number;
+
+  @ApiProperty({
+    description: 'The maximum number of members the guild can have',
+    example: 50,
+  })
+  maxMembers: number;
+
+  @ApiProperty({
+    description: 'The total $HASH earned by all of the guild members',
+    example: 250000,
+  })
+  totalEarnedHASH: number;
+}
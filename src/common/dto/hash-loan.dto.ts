@@ -0,0 +1,33 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { IsInt, IsNumber, Max, Min } from 'class-validator';
+import { GAME_CONSTANTS } from '../constants/game.constants';
+
+export class CreateLoanOfferDto {
+  @ApiProperty({
+    description: 'The amount of $HASH to lend',
+    example: 1000,
+  })
+  @IsNumber()
+  @Min(GAME_CONSTANTS.LOANS.MIN_AMOUNT)
+  amount: number;
+
+  @ApiProperty({
+    description:
+      'The interest (in %) the borrower pays on top of the amount when repaying',
+    example: 10,
+  })
+  @IsNumber()
+  @Min(0)
+  @Max(GAME_CONSTANTS.LOANS.MAX_INTEREST_RATE_PCT)
+  interestRatePct: number;
+
+  @ApiProperty({
+    description:
+      'How many days the borrower has to repay the loan after accepting it',
+    example: 7,
+  })
+  @IsInt()
+  @Min(1)
+  @Max(GAME_CONSTANTS.LOANS.MAX_DURATION_DAYS)
+  durationDays: number;
+}
@@ -0,0 +1,26 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { Type } from 'class-transformer';
+import { IsIn, IsNumber, IsPositive } from 'class-validator';
+import { GAME_CONSTANTS } from '../constants/game.constants';
+
+export class StakeHashDto {
+  @ApiProperty({
+    description: 'The amount of $HASH to stake',
+    example: 1000,
+  })
+  @Type(() => Number)
+  @IsNumber()
+  @IsPositive()
+  amount: number;
+
+  @ApiProperty({
+    description: 'How long (in days) the $HASH will be locked for',
+    example: 30,
+    enum: GAME_CONSTANTS.STAKING.DURATION_TIERS.map(
+      (tier) => tier.durationDays,
+    ),
+  })
+  @Type(() => Number)
+  @IsIn(GAME_CONSTANTS.STAKING.DURATION_TIERS.map((tier) => tier.durationDays))
+  durationDays: number;
+}
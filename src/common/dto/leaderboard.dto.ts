@@ -7,6 +7,7 @@ import {
   Max,
 } from 'class-validator';
 import { Type } from 'class-transformer';
+import { DrillConfig, DrillVersion } from '../enums/drill.enum';
 
 export class LeaderboardEntryDto {
   @ApiProperty({
@@ -63,6 +64,49 @@ export class GetLeaderboardQueryDto {
   limit?: number;
 }
 
+export class TopSpenderEntryDto {
+  @ApiProperty({
+    description: 'The ranking position of the operator',
+    example: 1,
+  })
+  rank: number;
+
+  @ApiProperty({
+    description: 'The username of the operator',
+    example: 'hashland_whale',
+  })
+  username: string;
+
+  @ApiProperty({
+    description: 'The total amount of TON spent by the operator',
+    example: 250,
+  })
+  totalTONSpent: number;
+}
+
+export class TopSpendersResponseDto {
+  @ApiProperty({
+    description: 'Array of top spender entries',
+    type: [TopSpenderEntryDto],
+  })
+  leaderboard: TopSpenderEntryDto[];
+}
+
+export class GetTopSpendersQueryDto {
+  @ApiProperty({
+    description: 'Number of top spenders to return (max 100)',
+    example: 10,
+    required: false,
+    default: 10,
+  })
+  @IsOptional()
+  @IsNumber()
+  @IsPositive()
+  @Max(100)
+  @Type(() => Number)
+  limit?: number;
+}
+
 export class GetPoolLeaderboardQueryDto extends GetLeaderboardQueryDto {
   @ApiProperty({
     description: 'The ID of the pool to get the leaderboard for',
@@ -72,3 +116,178 @@ export class GetPoolLeaderboardQueryDto extends GetLeaderboardQueryDto {
   @IsString()
   poolId: string;
 }
+
+export class DrillLeaderboardEntryDto {
+  @ApiProperty({
+    description: 'The ranking position of the drill',
+    example: 1,
+  })
+  rank: number;
+
+  @ApiProperty({
+    description: 'The database ID of the drill',
+    example: '507f1f77bcf86cd799439011',
+  })
+  drillId: string;
+
+  @ApiProperty({
+    description: 'The username of the operator who owns the drill',
+    example: 'hashland_champion',
+    nullable: true,
+  })
+  ownerUsername: string | null;
+
+  @ApiProperty({
+    description: 'The configuration of the drill',
+    enum: DrillConfig,
+    example: DrillConfig.BASIC,
+  })
+  config: DrillConfig;
+
+  @ApiProperty({
+    description: 'The version of the drill',
+    enum: DrillVersion,
+    example: DrillVersion.BASIC,
+  })
+  version: DrillVersion;
+
+  @ApiProperty({
+    description: 'The current EFF rating of the drill',
+    example: 100,
+  })
+  actualEff: number;
+
+  @ApiProperty({
+    description:
+      'The number of cycles in which the drill was selected as the extractor',
+    example: 12,
+  })
+  extractorWins: number;
+
+  @ApiProperty({
+    description:
+      'The total amount of HASH earned by the drill as the extractor',
+    example: 4500,
+  })
+  totalEarnedHASH: number;
+}
+
+export class DrillLeaderboardResponseDto {
+  @ApiProperty({
+    description: 'Array of drill leaderboard entries',
+    type: [DrillLeaderboardEntryDto],
+  })
+  leaderboard: DrillLeaderboardEntryDto[];
+}
+
+export class GetDrillLeaderboardQueryDto {
+  @ApiProperty({
+    description: 'Number of drills to return (max 100)',
+    example: 20,
+    required: false,
+    default: 20,
+  })
+  @IsOptional()
+  @IsNumber()
+  @IsPositive()
+  @Max(100)
+  @Type(() => Number)
+  limit?: number;
+}
+
+export class OperatorLeaderboardEntryDto {
+  @ApiProperty({
+    description: 'The ranking position of the operator',
+    example: 1,
+  })
+  rank: number;
+
+  @ApiProperty({
+    description: 'The database ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  operatorId: string;
+
+  @ApiProperty({
+    description: 'The username of the operator',
+    example: 'hashland_champion',
+    nullable: true,
+  })
+  username: string | null;
+
+  @ApiProperty({
+    description:
+      'The total amount of HASH earned by the operator across all completed drilling sessions',
+    example: 5000,
+  })
+  totalHASH: number;
+
+  @ApiProperty({
+    description: 'The database ID of the pool the operator is in, if any',
+    example: '507f1f77bcf86cd799439012',
+    nullable: true,
+  })
+  poolId: string | null;
+}
+
+export class OperatorLeaderboardResponseDto {
+  @ApiProperty({
+    description: 'Array of operator leaderboard entries',
+    type: [OperatorLeaderboardEntryDto],
+  })
+  leaderboard: OperatorLeaderboardEntryDto[];
+}
+
+export class PoolLeaderboardEntryDto {
+  @ApiProperty({
+    description: 'The ranking position of the pool',
+    example: 1,
+  })
+  rank: number;
+
+  @ApiProperty({
+    description: 'The database ID of the pool',
+    example: '507f1f77bcf86cd799439012',
+  })
+  poolId: string;
+
+  @ApiProperty({
+    description: 'The name of the pool',
+    example: 'Hashland Pool',
+  })
+  name: string;
+
+  @ApiProperty({
+    description: 'The username of the pool leader',
+    example: 'hashland_leader',
+    nullable: true,
+  })
+  leaderUsername: string | null;
+
+  @ApiProperty({
+    description: "The total EFF of the pool members' active drills",
+    example: 125000,
+  })
+  totalEff: number;
+
+  @ApiProperty({
+    description: 'The number of operators in the pool',
+    example: 42,
+  })
+  memberCount: number;
+
+  @ApiProperty({
+    description:
+      "The total amount of HASH earned across the pool members' completed drilling sessions",
+    example: 250000,
+  })
+  totalHASHEarned: number;
+}
+
+export class PoolsLeaderboardResponseDto {
+  @ApiProperty({
+    description: 'Array of pool leaderboard entries',
+    type: [PoolLeaderboardEntryDto],
+  })
+  leaderboard: PoolLeaderboardEntryDto[];
+}
@@ -0,0 +1,17 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { IsBoolean, IsOptional } from 'class-validator';
+import { Transform } from 'class-transformer';
+import { GetLeaderboardQueryDto } from './leaderboard.dto';
+
+export class GetNotificationsQueryDto extends GetLeaderboardQueryDto {
+  @ApiProperty({
+    description: 'Whether to only return unread notifications',
+    example: true,
+    required: false,
+    default: false,
+  })
+  @IsOptional()
+  @IsBoolean()
+  @Transform(({ value }) => value === true || value === 'true')
+  unread?: boolean;
+}
@@ -3,6 +3,13 @@ import { Operator } from 'src/operators/schemas/operator.schema';
 import { OperatorWallet } from 'src/operators/schemas/operator-wallet.schema';
 import { Drill } from 'src/drills/schemas/drill.schema';
 import { Types } from 'mongoose';
+import {
+  IsDateString,
+  IsOptional,
+  IsString,
+  ValidateIf,
+} from 'class-validator';
+import { GetLeaderboardQueryDto } from './leaderboard.dto';
 
 export class GetOperatorResponseDto {
   @ApiProperty({
@@ -31,3 +38,50 @@ export class GetOperatorResponseDto {
   })
   poolId?: Types.ObjectId;
 }
+
+export class UpdateProfileMediaDto {
+  @ApiProperty({
+    description:
+      'The HTTPS URL of the avatar image on the CDN (null to remove it)',
+    example: 'https://cdn.hashland.com/avatars/507f1f77bcf86cd799439011.png',
+    required: false,
+    nullable: true,
+  })
+  @IsOptional()
+  @ValidateIf((_, value) => value !== null)
+  @IsString()
+  avatarURL?: string | null;
+
+  @ApiProperty({
+    description:
+      'The HTTPS URL of the banner image on the CDN (null to remove it)',
+    example: 'https://cdn.hashland.com/banners/507f1f77bcf86cd799439011.png',
+    required: false,
+    nullable: true,
+  })
+  @IsOptional()
+  @ValidateIf((_, value) => value !== null)
+  @IsString()
+  bannerURL?: string | null;
+}
+
+export class GetSessionHistoryQueryDto extends GetLeaderboardQueryDto {
+  @ApiProperty({
+    description:
+      'Only return sessions started at or after this date (ISO string)',
+    example: '2025-01-01T00:00:00.000Z',
+    required: false,
+  })
+  @IsOptional()
+  @IsDateString()
+  from?: string;
+
+  @ApiProperty({
+    description: 'Only return sessions started before this date (ISO string)',
+    example: '2025-02-01T00:00:00.000Z',
+    required: false,
+  })
+  @IsOptional()
+  @IsDateString()
+  to?: string;
+}
@@ -0,0 +1,46 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { IsInt, IsMongoId, IsNumber, IsOptional, Min } from 'class-validator';
+
+export class CreatePoolChallengeDto {
+  @ApiProperty({
+    description: 'The database ID of the pool issuing the challenge',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @IsMongoId()
+  challengingPoolId: string;
+
+  @ApiProperty({
+    description: 'The database ID of the pool being challenged',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @IsMongoId()
+  challengedPoolId: string;
+
+  @ApiProperty({
+    description: 'The number of drilling cycles the challenge lasts',
+    example: 100,
+  })
+  @IsInt()
+  @Min(1)
+  cycleCount: number;
+
+  @ApiProperty({
+    description:
+      'The first drilling cycle included in the challenge. Defaults to the next cycle',
+    example: 12345,
+    required: false,
+  })
+  @IsOptional()
+  @IsInt()
+  @Min(1)
+  startsAtCycle?: number;
+
+  @ApiProperty({
+    description:
+      'The amount of $HASH the losing pool transfers to the winning pool',
+    example: 5000,
+  })
+  @IsNumber()
+  @Min(0)
+  rewardHASH: number;
+}
@@ -0,0 +1,28 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { IsDateString, IsNumber, IsPositive } from 'class-validator';
+
+export class CreatePoolEfficiencyGoalDto {
+  @ApiProperty({
+    description: 'The EFF the pool has to reach before the deadline',
+    example: 50000,
+  })
+  @IsNumber()
+  @IsPositive()
+  targetEff: number;
+
+  @ApiProperty({
+    description: 'When the goal expires',
+    example: '2025-01-15T00:00:00.000Z',
+  })
+  @IsDateString()
+  deadline: string;
+
+  @ApiProperty({
+    description:
+      "The amount of $HASH each member receives from the pool's treasury once the goal is reached",
+    example: 25,
+  })
+  @IsNumber()
+  @IsPositive()
+  rewardHASHPerMember: number;
+}
@@ -0,0 +1,31 @@
+import { ApiProperty } from '@nestjs/swagger';
+import {
+  ArrayMaxSize,
+  ArrayNotEmpty,
+  IsArray,
+  IsBoolean,
+  IsMongoId,
+} from 'class-validator';
+
+export class InviteJoinRequestVotersDto {
+  @ApiProperty({
+    description:
+      'The database IDs of the pool members to invite to vote on the join request',
+    example: ['507f1f77bcf86cd799439012', '507f1f77bcf86cd799439013'],
+    type: [String],
+  })
+  @IsArray()
+  @ArrayNotEmpty()
+  @ArrayMaxSize(100)
+  @IsMongoId({ each: true })
+  operatorIds: string[];
+}
+
+export class PoolJoinRequestDecisionDto {
+  @ApiProperty({
+    description: 'Whether to approve (true) or reject (false) the join request',
+    example: true,
+  })
+  @IsBoolean()
+  approve: boolean;
+}
@@ -0,0 +1,11 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { IsMongoId } from 'class-validator';
+
+export class CreatePoolMergeRequestDto {
+  @ApiProperty({
+    description: 'The database ID of the pool to merge with',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @IsMongoId()
+  targetPoolId: string;
+}
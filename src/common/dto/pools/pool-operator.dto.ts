@@ -1,8 +1,14 @@
 import { ApiProperty } from '@nestjs/swagger';
 import { IsString, IsNotEmpty } from 'class-validator';
-import { IsNumber, IsOptional, IsPositive, Max } from 'class-validator';
+import {
+  IsNumber,
+  IsOptional,
+  IsPositive,
+  Max,
+} from 'class-validator';
 import { Type } from 'class-transformer';
 import { PoolOperator } from 'src/pools/schemas/pool-operator.schema';
+import { PoolMemberEffTier } from 'src/common/utils/pool';
 
 export class CreatePoolOperatorDto {
   @ApiProperty({
@@ -22,12 +28,26 @@ export class CreatePoolOperatorDto {
   poolId: string;
 }
 
+export class PoolOperatorWithEffTierDto extends PoolOperator {
+  @ApiProperty({
+    description:
+      "The operator's efficiency tier relative to the pool's average member EFF (below 0.5x: bronze, 0.5x-1x: silver, 1x-1.5x: gold, above 1.5x: platinum). Null if the operator was left out by the projection",
+    enum: PoolMemberEffTier,
+    example: PoolMemberEffTier.GOLD,
+    nullable: true,
+  })
+  effTier: PoolMemberEffTier | null;
+}
+
 export class GetPoolOperatorsResponseDto {
   @ApiProperty({
-    description: 'Array of pool operators with operator information',
-    type: [PoolOperator],
+    description:
+      'Array of pool operators with operator information and efficiency tiers',
+    type: [PoolOperatorWithEffTierDto],
   })
-  operators: Partial<PoolOperator[]>;
+  operators: (Partial<PoolOperator> & {
+    effTier: PoolMemberEffTier | null;
+  })[];
 
   @ApiProperty({
     description: 'Total count of pool operators',
@@ -100,6 +120,74 @@ export class GetPoolOperatorsQueryDto {
   populate?: boolean;
 }
 
+export class GetPoolMembersQueryDto {
+  @ApiProperty({
+    description:
+      'The `nextCursor` of the previous page (the join timestamp and ID of its last member). Omit to start from the earliest member',
+    example: '2025-01-01T00:00:00.000Z_507f1f77bcf86cd799439011',
+    required: false,
+  })
+  @IsOptional()
+  @IsString()
+  cursor?: string;
+
+  @ApiProperty({
+    description: 'Number of members to return (max 100)',
+    example: 50,
+    required: false,
+    default: 50,
+  })
+  @IsOptional()
+  @IsNumber()
+  @IsPositive()
+  @Max(100)
+  @Type(() => Number)
+  limit?: number;
+}
+
+export class PoolMemberDto {
+  @ApiProperty({
+    description: 'The database ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  operatorId: string;
+
+  @ApiProperty({
+    description: 'The Telegram username of the operator',
+    example: 'hashland_miner',
+    nullable: true,
+  })
+  username: string | null;
+
+  @ApiProperty({
+    description: 'When the operator joined the pool',
+    example: '2025-01-01T00:00:00.000Z',
+  })
+  joinedTimestamp: Date;
+
+  @ApiProperty({
+    description: "The total EFF of the operator's drills",
+    example: 1250.5,
+  })
+  cumulativeEff: number;
+}
+
+export class GetPoolMembersResponseDto {
+  @ApiProperty({
+    description: 'Members of the pool, ordered by join time',
+    type: [PoolMemberDto],
+  })
+  members: PoolMemberDto[];
+
+  @ApiProperty({
+    description:
+      'Cursor to pass to fetch the next page, or null if there are no more members',
+    example: '2025-01-02T00:00:00.000Z_507f1f77bcf86cd799439011',
+    nullable: true,
+  })
+  nextCursor: string | null;
+}
+
 export class GetPoolOperatorResponseDto {
   @ApiProperty({
     description: 'Pool operator details for the authenticated user',
@@ -0,0 +1,40 @@
+import { ApiProperty } from '@nestjs/swagger';
+import {
+  IsBoolean,
+  IsNotEmpty,
+  IsNumber,
+  IsPositive,
+  IsString,
+  MaxLength,
+} from 'class-validator';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+export class ProposeTreasuryWithdrawalDto {
+  @ApiProperty({
+    description: "The amount of $HASH to withdraw from the pool's treasury",
+    example: 500,
+  })
+  @IsNumber()
+  @IsPositive()
+  amount: number;
+
+  @ApiProperty({
+    description: 'Why the $HASH should be withdrawn',
+    example: 'Funding the next pool tournament entry',
+    maxLength: GAME_CONSTANTS.POOLS.TREASURY_PROPOSAL_REASON_MAX_LENGTH,
+  })
+  @IsString()
+  @IsNotEmpty()
+  @MaxLength(GAME_CONSTANTS.POOLS.TREASURY_PROPOSAL_REASON_MAX_LENGTH)
+  reason: string;
+}
+
+export class VoteTreasuryWithdrawalDto {
+  @ApiProperty({
+    description:
+      'Whether to vote in favour of (true) or against (false) the proposal',
+    example: true,
+  })
+  @IsBoolean()
+  inFavour: boolean;
+}
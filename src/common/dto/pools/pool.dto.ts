@@ -1,6 +1,25 @@
 import { ApiProperty } from '@nestjs/swagger';
-import { IsString, IsNotEmpty, IsOptional, IsNumber } from 'class-validator';
+import {
+  IsString,
+  IsNotEmpty,
+  IsOptional,
+  IsNumber,
+  IsArray,
+  IsMongoId,
+  ArrayNotEmpty,
+  MaxLength,
+  Matches,
+  IsInt,
+  Max,
+  Min,
+  ValidateNested,
+  ValidateIf,
+  IsBoolean,
+} from 'class-validator';
+import { Type } from 'class-transformer';
 import { Pool } from 'src/pools/schemas/pool.schema';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { PoolJoinPrerequisite } from 'src/common/utils/pool';
 
 export class GetAllPoolsResponseDto {
   @ApiProperty({
@@ -8,6 +27,114 @@ export class GetAllPoolsResponseDto {
     type: [Pool],
   })
   pools: Partial<Pool & { currentOperatorCount: number }>[];
+
+  @ApiProperty({
+    description: 'The total number of pools matching the filters',
+    example: 42,
+  })
+  total: number;
+}
+
+export class GetAllPoolsQueryDto {
+  @ApiProperty({
+    description:
+      'Page number for pagination (starting from 1). All pools are returned if neither `page` nor `limit` is given',
+    example: 1,
+    required: false,
+  })
+  @IsOptional()
+  @IsInt()
+  @Min(1)
+  @Type(() => Number)
+  page?: number;
+
+  @ApiProperty({
+    description: 'Number of pools per page (max 100)',
+    example: 20,
+    required: false,
+    default: 20,
+  })
+  @IsOptional()
+  @IsInt()
+  @Min(1)
+  @Max(100)
+  @Type(() => Number)
+  limit?: number;
+
+  @ApiProperty({
+    description: 'Only return pools led by this operator',
+    example: '507f1f77bcf86cd799439011',
+    required: false,
+  })
+  @IsOptional()
+  @IsMongoId()
+  leaderId?: string;
+}
+
+export class PoolRewardSystemDto {
+  @ApiProperty({
+    description: 'The share of rewards for the extractor operator',
+    example: 0.48,
+  })
+  @IsNumber()
+  @Min(0)
+  @Max(1)
+  extractorOperator: number;
+
+  @ApiProperty({
+    description: 'The share of rewards for the pool leader',
+    example: 0.04,
+  })
+  @IsNumber()
+  @Min(0)
+  @Max(1)
+  leader: number;
+
+  @ApiProperty({
+    description: 'The share of rewards for the active pool operators',
+    example: 0.4,
+  })
+  @IsNumber()
+  @Min(0)
+  @Max(1)
+  activePoolOperators: number;
+
+  @ApiProperty({
+    description:
+      'The share of rewards for active operators outside of the pool',
+    example: 0.08,
+  })
+  @IsNumber()
+  @Min(0)
+  @Max(1)
+  activeGlobalOperators: number;
+}
+
+export class RewardPresetDto {
+  @ApiProperty({
+    description: 'The ID of the reward preset',
+    example: 2,
+  })
+  presetId: number;
+
+  @ApiProperty({
+    description: 'The name of the reward preset',
+    example: 'Extractor Heavy',
+  })
+  name: string;
+
+  @ApiProperty({
+    description: 'What the reward preset is meant for',
+    example:
+      'Rewards the operator whose drill extracts the most, encouraging strong drills.',
+  })
+  description: string;
+
+  @ApiProperty({
+    description: 'The reward system applied by the preset',
+    type: PoolRewardSystemDto,
+  })
+  rewardSystem: PoolRewardSystemDto;
 }
 
 export class CreatePoolAdminDto {
@@ -36,4 +163,298 @@ export class CreatePoolAdminDto {
   @IsNumber()
   @IsOptional()
   maxOperators?: number | null;
+
+  @ApiProperty({
+    description:
+      'The ID of a reward preset to apply. Ignored if `rewardSystem` is provided',
+    example: 2,
+    required: false,
+  })
+  @IsOptional()
+  @IsInt()
+  presetId?: number;
+
+  @ApiProperty({
+    description:
+      'A custom reward system for the pool. Shares must add up to 1',
+    type: PoolRewardSystemDto,
+    required: false,
+  })
+  @IsOptional()
+  @ValidateNested()
+  @Type(() => PoolRewardSystemDto)
+  rewardSystem?: PoolRewardSystemDto;
+}
+
+export class SplitPoolDto {
+  @ApiProperty({
+    description:
+      'The database ID of the operator who will lead the new pool (must be a member of the pool being split)',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @IsMongoId()
+  newLeaderId: string;
+
+  @ApiProperty({
+    description:
+      'The database IDs of the operators to move to the new pool (the new leader is always moved)',
+    example: ['507f1f77bcf86cd799439012', '507f1f77bcf86cd799439013'],
+    type: [String],
+  })
+  @IsArray()
+  @ArrayNotEmpty()
+  @IsMongoId({ each: true })
+  memberIdsForNewPool: string[];
+
+  @ApiProperty({
+    description:
+      'The name of the new pool. If not provided, a name is generated from the original pool name',
+    example: 'hashland-pool-2',
+    required: false,
+  })
+  @IsOptional()
+  @IsString()
+  @MaxLength(16)
+  @Matches(/^[a-zA-Z0-9-_]+$/)
+  name?: string;
+}
+
+export class TransferPoolLeadershipDto {
+  @ApiProperty({
+    description:
+      'The database ID of the operator who will lead the pool (must be a member of the pool)',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @IsMongoId()
+  newLeaderId: string;
+}
+
+export class PoolPrerequisitesDto {
+  @ApiProperty({
+    description:
+      'The Telegram channel ID (numeric chat ID or @username) that operators must be a member of to join the pool',
+    example: '-1001234567890',
+    required: false,
+    nullable: true,
+  })
+  @IsOptional()
+  @IsString()
+  tgChannelId?: string | null;
+
+  @ApiProperty({
+    description:
+      'The minimum trust score (between 0 and 1) operators need to join the pool',
+    example: 0.8,
+    required: false,
+    nullable: true,
+  })
+  @IsOptional()
+  @IsNumber()
+  @Min(0)
+  @Max(1)
+  minTrustScore?: number | null;
+}
+
+export class UpdatePoolSettingsDto {
+  @ApiProperty({
+    description:
+      'The maximum number of operators allowed in the pool. Cannot be lower than the current member count',
+    example: 20,
+    required: false,
+  })
+  @IsOptional()
+  @IsInt()
+  @Min(1)
+  maxOperators?: number;
+
+  @ApiProperty({
+    description:
+      'The prerequisites to join the pool (null to let anyone join). Replaces the current prerequisites',
+    type: PoolPrerequisitesDto,
+    required: false,
+    nullable: true,
+  })
+  @IsOptional()
+  @ValidateNested()
+  @Type(() => PoolPrerequisitesDto)
+  joinPrerequisites?: PoolPrerequisitesDto | null;
+
+  @ApiProperty({
+    description:
+      'Whether operators need an approved join request to join the pool',
+    example: true,
+    required: false,
+  })
+  @IsOptional()
+  @IsBoolean()
+  requiresApproval?: boolean;
+}
+
+export class UpdatePoolLinksDto {
+  @ApiProperty({
+    description: 'The HTTPS website URL of the pool (null to remove it)',
+    example: 'https://hashland-pool.com',
+    required: false,
+    nullable: true,
+  })
+  @IsOptional()
+  @ValidateIf((_, value) => value !== null)
+  @IsString()
+  website?: string | null;
+
+  @ApiProperty({
+    description:
+      'The HTTPS Discord server invite URL of the pool (null to remove it)',
+    example: 'https://discord.gg/hashland',
+    required: false,
+    nullable: true,
+  })
+  @IsOptional()
+  @ValidateIf((_, value) => value !== null)
+  @IsString()
+  discord?: string | null;
+
+  @ApiProperty({
+    description:
+      'The HTTPS Twitter profile URL of the pool (null to remove it)',
+    example: 'https://x.com/hashland',
+    required: false,
+    nullable: true,
+  })
+  @IsOptional()
+  @ValidateIf((_, value) => value !== null)
+  @IsString()
+  twitter?: string | null;
+
+  @ApiProperty({
+    description:
+      'The HTTPS Telegram group or channel URL of the pool (null to remove it)',
+    example: 'https://t.me/hashland',
+    required: false,
+    nullable: true,
+  })
+  @IsOptional()
+  @ValidateIf((_, value) => value !== null)
+  @IsString()
+  telegram?: string | null;
+}
+
+export class GetPoolActivityHeatmapQueryDto {
+  @ApiProperty({
+    description: `The number of past weeks to cover (max ${GAME_CONSTANTS.POOLS.ACTIVITY_HEATMAP_MAX_WEEKS})`,
+    example: 4,
+    required: false,
+    default: 4,
+  })
+  @IsOptional()
+  @IsInt()
+  @Min(1)
+  @Max(GAME_CONSTANTS.POOLS.ACTIVITY_HEATMAP_MAX_WEEKS)
+  @Type(() => Number)
+  weeks?: number;
+}
+
+export class PoolActivityHeatmapCellDto {
+  @ApiProperty({
+    description:
+      'The average number of pool members who started drilling in this hour',
+    example: 3.5,
+  })
+  avgActiveMembers: number;
+
+  @ApiProperty({
+    description:
+      'The average $HASH earned by sessions pool members started in this hour',
+    example: 120.25,
+  })
+  avgEarnedHASH: number;
+}
+
+export class GetPoolActivityHeatmapResponseDto {
+  @ApiProperty({
+    description: 'The number of past weeks the heatmap covers',
+    example: 4,
+  })
+  weeks: number;
+
+  @ApiProperty({
+    description:
+      'A 7x24 matrix of activity cells, indexed by day of the week (0 = Sunday) and hour (UTC)',
+  })
+  heatmap: PoolActivityHeatmapCellDto[][];
+}
+
+export class PoolRecommendationDto {
+  @ApiProperty({
+    description: 'The ID of the pool',
+    example: '507f1f77bcf86cd799439011',
+  })
+  poolId: string;
+
+  @ApiProperty({
+    description: 'The name of the pool',
+    example: 'Hashland Miners',
+  })
+  name: string;
+
+  @ApiProperty({
+    description: 'The number of operators in the pool',
+    example: 42,
+  })
+  memberCount: number;
+
+  @ApiProperty({
+    description:
+      'The maximum number of operators the pool allows (null if unlimited)',
+    example: 100,
+    nullable: true,
+  })
+  maxOperators: number | null;
+
+  @ApiProperty({
+    description: "The average trust score (0 to 1) of the pool's members",
+    example: 0.82,
+  })
+  reputationScore: number;
+
+  @ApiProperty({
+    description: '$HASH issued to the pool in the last 7 days',
+    example: 15320.5,
+  })
+  hashTotal7d: number;
+
+  @ApiProperty({
+    description: 'The recommendation score (0 to 1, higher is better)',
+    example: 0.74,
+  })
+  score: number;
+}
+
+export class PoolJoinPrerequisiteFailureDto {
+  @ApiProperty({
+    description: 'The prerequisite that is not met',
+    enum: PoolJoinPrerequisite,
+    example: PoolJoinPrerequisite.MIN_TRUST_SCORE,
+  })
+  prerequisite: PoolJoinPrerequisite;
+
+  @ApiProperty({
+    description: 'Why the prerequisite is not met',
+    example: "The operator's trust score is below the pool's minimum of 0.8.",
+  })
+  reason: string;
+}
+
+export class PoolJoinEligibilityDto {
+  @ApiProperty({
+    description: 'Whether the operator meets every prerequisite to join',
+    example: false,
+  })
+  eligible: boolean;
+
+  @ApiProperty({
+    description: 'The prerequisites the operator does not meet',
+    type: [PoolJoinPrerequisiteFailureDto],
+  })
+  failingPrerequisites: PoolJoinPrerequisiteFailureDto[];
 }
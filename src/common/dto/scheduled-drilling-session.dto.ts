@@ -0,0 +1,41 @@
+import { ApiProperty } from '@nestjs/swagger';
+import {
+  IsDateString,
+  IsInt,
+  IsMongoId,
+  IsOptional,
+  Max,
+  Min,
+} from 'class-validator';
+import { Type } from 'class-transformer';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+export class ScheduleDrillingSessionDto {
+  @ApiProperty({
+    description: 'When the drilling session should start',
+    example: '2025-01-01T12:00:00.000Z',
+  })
+  @IsDateString()
+  scheduledStartAt: string;
+
+  @ApiProperty({
+    description: 'How long (in seconds) the drilling session should run for',
+    example: 3600,
+    minimum: GAME_CONSTANTS.OPERATORS.SCHEDULED_SESSION_MIN_DURATION,
+    maximum: GAME_CONSTANTS.OPERATORS.SCHEDULED_SESSION_MAX_DURATION,
+  })
+  @IsInt()
+  @Min(GAME_CONSTANTS.OPERATORS.SCHEDULED_SESSION_MIN_DURATION)
+  @Max(GAME_CONSTANTS.OPERATORS.SCHEDULED_SESSION_MAX_DURATION)
+  @Type(() => Number)
+  duration: number;
+
+  @ApiProperty({
+    description: 'The database ID of the drill group to drill with, if any',
+    example: '507f1f77bcf86cd799439011',
+    required: false,
+  })
+  @IsOptional()
+  @IsMongoId()
+  drillGroupId?: string;
+}
@@ -1,8 +1,22 @@
 import { ApiProperty } from '@nestjs/swagger';
 import { ShopItem } from 'src/shops/schemas/shop-item.schema';
-import { IsOptional, IsString } from 'class-validator';
+import {
+  ArrayMaxSize,
+  IsArray,
+  IsEnum,
+  IsInt,
+  IsNumber,
+  IsOptional,
+  IsString,
+  Max,
+  MaxLength,
+  Min,
+  ValidateNested,
+} from 'class-validator';
+import { Type } from 'class-transformer';
 import { ShopItemEffects } from 'src/common/schemas/shop-item-effect.schema';
 import { ShopItemType } from 'src/common/enums/shop.enum';
+import { DrillConfig, DrillVersion } from 'src/common/enums/drill.enum';
 
 export class GetShopItemsResponseDto {
   @ApiProperty({
@@ -52,3 +66,94 @@ export class GetShopItemsQueryDto {
   @IsOptional()
   projection?: string;
 }
+
+export class ShopItemUpgradeCostDto {
+  @ApiProperty({
+    description: 'The level the drill is upgraded to',
+    example: 2,
+  })
+  @IsInt()
+  @Min(2)
+  level: number;
+
+  @ApiProperty({
+    description: 'The TON cost of upgrading the drill to this level',
+    example: 1,
+  })
+  @IsNumber()
+  @Min(0)
+  costTON: number;
+}
+
+export class AddShopDrillDto {
+  @ApiProperty({
+    description:
+      'The configuration of the drill. The shop item is listed as `<config>_DRILL`',
+    enum: DrillConfig,
+    example: DrillConfig.IRONBORE,
+  })
+  @IsEnum(DrillConfig)
+  config: DrillConfig;
+
+  @ApiProperty({
+    description: 'The version of the drill',
+    enum: DrillVersion,
+    example: DrillVersion.BASIC,
+  })
+  @IsEnum(DrillVersion)
+  version: DrillVersion;
+
+  @ApiProperty({
+    description: 'The cost of the drill in TON',
+    example: 1,
+  })
+  @IsNumber()
+  @Min(0)
+  ton: number;
+
+  @ApiProperty({
+    description: 'The cost of the drill in BERA',
+    example: 1,
+  })
+  @IsNumber()
+  @Min(0)
+  bera: number;
+
+  @ApiProperty({
+    description: 'The base EFF rating of the drill',
+    example: 100,
+  })
+  @IsNumber()
+  @Min(0)
+  baseEff: number;
+
+  @ApiProperty({
+    description: 'The highest level the drill can be upgraded to',
+    example: 5,
+  })
+  @IsInt()
+  @Min(1)
+  @Max(100)
+  maxLevel: number;
+
+  @ApiProperty({
+    description:
+      'The cost of each level upgrade. Must contain exactly `maxLevel - 1` entries for levels 2 to `maxLevel`, in order',
+    type: [ShopItemUpgradeCostDto],
+  })
+  @IsArray()
+  @ArrayMaxSize(99)
+  @ValidateNested({ each: true })
+  @Type(() => ShopItemUpgradeCostDto)
+  upgradeCosts: ShopItemUpgradeCostDto[];
+
+  @ApiProperty({
+    description: 'The description of the drill',
+    example: 'A sturdy drill for early extraction.',
+    required: false,
+  })
+  @IsOptional()
+  @IsString()
+  @MaxLength(500)
+  description?: string;
+}
@@ -1,5 +1,19 @@
 import { ApiProperty } from '@nestjs/swagger';
-import { IsNotEmpty, IsOptional, IsString } from 'class-validator';
+import {
+  ArrayMaxSize,
+  ArrayNotEmpty,
+  IsArray,
+  IsDateString,
+  IsInt,
+  IsMongoId,
+  IsNotEmpty,
+  IsNumber,
+  IsOptional,
+  IsString,
+  Max,
+  MaxLength,
+  Min,
+} from 'class-validator';
 import { AllowedChain } from 'src/common/enums/chain.enum';
 import { ShopItemEffects } from 'src/common/schemas/shop-item-effect.schema';
 import { ApiResponse } from '../response.dto';
@@ -197,3 +211,68 @@ export class CheckPurchaseAllowedDataDto {
 export class CheckPurchaseAllowedResponseDto extends ApiResponse.withType(
   CheckPurchaseAllowedDataDto,
 ) {}
+
+export class AirdropDrillsDto {
+  @ApiProperty({
+    description: 'The database ID of the drill shop item to airdrop',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @IsMongoId()
+  shopItemId: string;
+
+  @ApiProperty({
+    description: 'The database IDs of the operators receiving the drill',
+    example: ['507f1f77bcf86cd799439012', '507f1f77bcf86cd799439013'],
+    type: [String],
+  })
+  @IsArray()
+  @ArrayNotEmpty()
+  @ArrayMaxSize(1000)
+  @IsMongoId({ each: true })
+  operatorIds: string[];
+
+  @ApiProperty({
+    description: 'The reason for the airdrop (e.g. a promotional campaign)',
+    example: 'launch',
+  })
+  @IsString()
+  @IsNotEmpty()
+  @MaxLength(64)
+  reason: string;
+}
+
+export class StartFlashSaleDto {
+  @ApiProperty({
+    description: 'The sale price in TON',
+    example: 0.5,
+  })
+  @IsNumber()
+  @Min(0)
+  ton: number;
+
+  @ApiProperty({
+    description: 'The sale price in BERA',
+    example: 0.5,
+  })
+  @IsNumber()
+  @Min(0)
+  bera: number;
+
+  @ApiProperty({
+    description: 'When the sale ends (ISO string)',
+    example: '2025-03-02T00:00:00.000Z',
+  })
+  @IsDateString()
+  endTime: string;
+}
+
+export class RestockShopDrillDto {
+  @ApiProperty({
+    description: 'The number of units to add to the remaining supply',
+    example: 50,
+  })
+  @IsInt()
+  @Min(1)
+  @Max(100000)
+  quantity: number;
+}
@@ -0,0 +1,43 @@
+import { ApiProperty } from '@nestjs/swagger';
+import {
+  IsDateString,
+  IsNotEmpty,
+  IsNumber,
+  IsString,
+  MaxLength,
+  Min,
+} from 'class-validator';
+
+export class CreateTournamentDto {
+  @ApiProperty({
+    description: 'The name of the tournament',
+    example: 'January Extraction Cup',
+  })
+  @IsString()
+  @IsNotEmpty()
+  @MaxLength(64)
+  name: string;
+
+  @ApiProperty({
+    description: 'When the tournament starts',
+    example: '2025-01-01T00:00:00.000Z',
+  })
+  @IsDateString()
+  startsAt: string;
+
+  @ApiProperty({
+    description: 'When the tournament ends',
+    example: '2025-02-01T00:00:00.000Z',
+  })
+  @IsDateString()
+  endsAt: string;
+
+  @ApiProperty({
+    description:
+      "The amount of $HASH split between the winning pool's members, paid out from the HASH Reserve",
+    example: 100000,
+  })
+  @IsNumber()
+  @Min(0)
+  prizeHASH: number;
+}
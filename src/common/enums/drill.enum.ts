@@ -16,3 +16,22 @@ export enum DrillConfig {
   TITAN = 'TITAN',
   DREADNOUGHT = 'DREADNOUGHT',
 }
+
+/**
+ * Represents how rare a drill configuration is, from most to least common.
+ */
+export enum DrillRarity {
+  COMMON = 'common',
+  UNCOMMON = 'uncommon',
+  RARE = 'rare',
+  LEGENDARY = 'legendary',
+}
+
+/**
+ * Represents a drill event that requires its NFT metadata to be synced.
+ */
+export enum DrillNFTSyncOperation {
+  CREATE = 'CREATE',
+  UPGRADE = 'UPGRADE',
+  TRANSFER = 'TRANSFER',
+}
@@ -14,3 +14,19 @@ export enum ShopItemType {
   UPGRADE_MAX_ACTIVE_DRILLS_9 = 'UPGRADE_MAX_ACTIVE_DRILLS_9',
   UPGRADE_MAX_ACTIVE_DRILLS_10 = 'UPGRADE_MAX_ACTIVE_DRILLS_10',
 }
+
+/**
+ * The revenue categories that shop purchases are grouped into.
+ */
+export enum ShopPurchaseCategory {
+  /** One-off items, such as drills. */
+  PURCHASE = 'purchase',
+  /** Upgrades, such as a higher max fuel. */
+  UPGRADE = 'upgrade',
+  /** Fuel replenishments. */
+  FUEL = 'fuel',
+  /** Extra active drill slots. */
+  SLOT = 'slot',
+  /** Bundles of multiple items. */
+  BUNDLE = 'bundle',
+}
@@ -0,0 +1,271 @@
+import {
+  ArgumentsHost,
+  BadRequestException,
+  CallHandler,
+  ExecutionContext,
+  ForbiddenException,
+  HttpException,
+  InternalServerErrorException,
+  NotFoundException,
+} from '@nestjs/common';
+import {
+  errorCodeForStatus,
+  HttpExceptionFilter,
+} from './http-exception.filter';
+import { ApiErrorResponse, ApiResponse } from '../dto/response.dto';
+import { requestContext } from '../utils/request-context';
+import { ApiErrorResponseInterceptor } from '../interceptors/api-error-response.interceptor';
+import { lastValueFrom, of } from 'rxjs';
+
+/**
+ * Minimal stand-in for Fastify's reply that records the status and body sent
+ */
+class MockReply {
+  statusCode: number | null = null;
+  body: any = null;
+
+  code(status: number) {
+    this.statusCode = status;
+    return this;
+  }
+
+  send(body: any) {
+    this.body = body;
+    return this;
+  }
+}
+
+/**
+ * Unit tests for the error envelope returned by the global exception filter
+ */
+describe('HttpExceptionFilter', () => {
+  let filter: HttpExceptionFilter;
+  let reply: MockReply;
+  let host: ArgumentsHost;
+
+  beforeEach(() => {
+    filter = new HttpExceptionFilter();
+    reply = new MockReply();
+    host = {
+      switchToHttp: () => ({
+        getResponse: () => reply,
+        getRequest: () => ({ raw: {} }),
+      }),
+    } as unknown as ArgumentsHost;
+  });
+
+  /**
+   * Asserts that the reply is an error envelope with the expected fields
+   */
+  const expectEnvelope = (
+    status: number,
+    code: string,
+    message: string,
+    requestId: string | null = null,
+  ) => {
+    expect(reply.statusCode).toBe(status);
+    expect(reply.body).toBeInstanceOf(ApiErrorResponse);
+    expect(reply.body).toEqual({
+      status,
+      message,
+      data: null,
+      code,
+      requestId,
+    });
+  };
+
+  it('wraps string exception messages', () => {
+    filter.catch(new BadRequestException('Invalid pool ID.'), host);
+
+    expectEnvelope(400, 'bad_request', 'Invalid pool ID.');
+  });
+
+  it('uses the message of an `ApiResponse` thrown by a service', () => {
+    filter.catch(
+      new InternalServerErrorException(
+        new ApiResponse(500, '(joinPool) Error joining pool.'),
+      ),
+      host,
+    );
+
+    expectEnvelope(500, 'internal_error', '(joinPool) Error joining pool.');
+  });
+
+  it('uses the message of an `ApiResponse` thrown by a controller', () => {
+    filter.catch(
+      new ForbiddenException(
+        new ApiResponse(403, 'Operators can only check their own eligibility.'),
+      ),
+      host,
+    );
+
+    expectEnvelope(
+      403,
+      'forbidden',
+      'Operators can only check their own eligibility.',
+    );
+  });
+
+  it('joins validation error messages', () => {
+    filter.catch(
+      new BadRequestException([
+        'limit must not be greater than 100',
+        'page must be an integer number',
+      ]),
+      host,
+    );
+
+    expectEnvelope(
+      400,
+      'bad_request',
+      'limit must not be greater than 100; page must be an integer number',
+    );
+  });
+
+  it('keeps a custom error code', () => {
+    filter.catch(
+      new HttpException({ message: 'Pool is full.', code: 'pool_full' }, 400),
+      host,
+    );
+
+    expectEnvelope(400, 'pool_full', 'Pool is full.');
+  });
+
+  it('falls back to the default message if the exception has none', () => {
+    filter.catch(new HttpException({}, 404), host);
+
+    expectEnvelope(404, 'not_found', 'An unexpected error occurred');
+  });
+
+  it('wraps unhandled errors as `internal_error`', () => {
+    const consoleError = jest
+      .spyOn(console, 'error')
+      .mockImplementation(() => undefined);
+
+    filter.catch(new Error('Cannot read properties of undefined'), host);
+
+    expectEnvelope(500, 'internal_error', 'An unexpected error occurred');
+    consoleError.mockRestore();
+  });
+
+  it('uses the status of built-in exceptions', () => {
+    filter.catch(new NotFoundException(), host);
+
+    expectEnvelope(404, 'not_found', 'Not Found');
+  });
+
+  it('includes the request ID set by the middleware', () => {
+    host = {
+      switchToHttp: () => ({
+        getResponse: () => reply,
+        getRequest: () => ({ raw: { requestId: 'request-1' } }),
+      }),
+    } as unknown as ArgumentsHost;
+
+    filter.catch(new NotFoundException('Pool not found.'), host);
+
+    expectEnvelope(404, 'not_found', 'Pool not found.', 'request-1');
+  });
+
+  it('falls back to the request ID of the current request context', () => {
+    requestContext.run({ requestId: 'request-2' }, () => {
+      filter.catch(new BadRequestException('Invalid pool ID.'), host);
+    });
+
+    expectEnvelope(400, 'bad_request', 'Invalid pool ID.', 'request-2');
+  });
+});
+
+/**
+ * Unit tests for the error envelope of error responses returned (rather than thrown) by controllers
+ */
+describe('ApiErrorResponseInterceptor', () => {
+  let interceptor: ApiErrorResponseInterceptor;
+  let reply: MockReply;
+  let context: ExecutionContext;
+
+  beforeEach(() => {
+    interceptor = new ApiErrorResponseInterceptor();
+    reply = new MockReply();
+    context = {
+      getType: () => 'http',
+      switchToHttp: () => ({
+        getResponse: () => reply,
+        getRequest: () => ({ raw: { requestId: 'request-1' } }),
+      }),
+    } as unknown as ExecutionContext;
+  });
+
+  /**
+   * Runs the interceptor on a controller returning `value`
+   */
+  const intercept = (value: any) =>
+    lastValueFrom(
+      interceptor.intercept(context, {
+        handle: () => of(value),
+      } as CallHandler),
+    );
+
+  it('wraps returned error responses and sets their status', async () => {
+    const body = await intercept(
+      new ApiResponse(403, '(joinPool) Pool requires approval to join.'),
+    );
+
+    expect(reply.statusCode).toBe(403);
+    expect(body).toBeInstanceOf(ApiErrorResponse);
+    expect(body).toEqual({
+      status: 403,
+      message: '(joinPool) Pool requires approval to join.',
+      data: null,
+      code: 'forbidden',
+      requestId: 'request-1',
+    });
+  });
+
+  it('keeps the data of returned error responses', async () => {
+    const body = await intercept(
+      new ApiResponse(403, '(checkPurchaseAllowed) Shop item is sold out.', {
+        purchaseAllowed: false,
+        reason: 'Shop item is sold out.',
+      }),
+    );
+
+    expect(reply.statusCode).toBe(403);
+    expect(body.code).toBe('forbidden');
+    expect(body.data).toEqual({
+      purchaseAllowed: false,
+      reason: 'Shop item is sold out.',
+    });
+  });
+
+  it('leaves successful responses untouched', async () => {
+    const response = new ApiResponse(200, '(joinPool) Joined pool.');
+
+    expect(await intercept(response)).toBe(response);
+    expect(reply.statusCode).toBeNull();
+  });
+
+  it('leaves non-`ApiResponse` values untouched', async () => {
+    const value = { status: 404, message: 'Not an ApiResponse' };
+
+    expect(await intercept(value)).toBe(value);
+    expect(reply.statusCode).toBeNull();
+  });
+});
+
+describe('errorCodeForStatus', () => {
+  it('converts HTTP status texts to snake case', () => {
+    expect(errorCodeForStatus(400)).toBe('bad_request');
+    expect(errorCodeForStatus(404)).toBe('not_found');
+    expect(errorCodeForStatus(429)).toBe('too_many_requests');
+    expect(errorCodeForStatus(503)).toBe('service_unavailable');
+  });
+
+  it('reports 500s as `internal_error`', () => {
+    expect(errorCodeForStatus(500)).toBe('internal_error');
+  });
+
+  it('falls back to `error` for unknown statuses', () => {
+    expect(errorCodeForStatus(599)).toBe('error');
+  });
+});
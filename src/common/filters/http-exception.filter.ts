@@ -3,33 +3,77 @@ import {
   Catch,
   ArgumentsHost,
   HttpException,
-  InternalServerErrorException,
 } from '@nestjs/common';
-import { FastifyReply } from 'fastify';
-import { ApiResponse } from '../dto/response.dto';
+import { STATUS_CODES } from 'http';
+import { FastifyReply, FastifyRequest } from 'fastify';
+import { ApiErrorResponse } from '../dto/response.dto';
+import { getRequestId } from '../utils/request-context';
+
+/**
+ * Fetches the error code for an HTTP status, e.g. `404` -> `not_found`.
+ *
+ * 500s are reported as `internal_error`.
+ */
+export const errorCodeForStatus = (status: number): string => {
+  if (status === 500) {
+    return 'internal_error';
+  }
+
+  return (STATUS_CODES[status] ?? 'error')
+    .toLowerCase()
+    .replace(/[^a-z0-9]+/g, '_')
+    .replace(/^_|_$/g, '');
+};
+
+/**
+ * Fetches the request ID set by `RequestIdMiddleware`, falling back to the current request context.
+ */
+export const requestIdOf = (request?: FastifyRequest): string | null =>
+  (request?.raw as { requestId?: string })?.requestId ?? getRequestId();
 
 @Catch()
 export class HttpExceptionFilter implements ExceptionFilter {
   catch(exception: unknown, host: ArgumentsHost) {
     const ctx = host.switchToHttp();
     const response = ctx.getResponse<FastifyReply>(); // Correctly using Fastify's response object
+    const request = ctx.getRequest<FastifyRequest>();
+
+    const requestId = requestIdOf(request);
 
     let status = 500;
     let message = 'An unexpected error occurred';
+    let code: string | undefined;
 
     if (exception instanceof HttpException) {
       status = exception.getStatus();
       const exceptionResponse = exception.getResponse();
-      message =
-        typeof exceptionResponse === 'string'
-          ? exceptionResponse
-          : (exceptionResponse as any).message;
+
+      if (typeof exceptionResponse === 'string') {
+        message = exceptionResponse;
+      } else {
+        const { message: responseMessage, code: responseCode } =
+          exceptionResponse as any;
+
+        // Validation errors come as a list of messages
+        if (Array.isArray(responseMessage)) {
+          message = responseMessage.join('; ');
+        } else if (responseMessage) {
+          message = responseMessage;
+        }
+        code = typeof responseCode === 'string' ? responseCode : undefined;
+      }
     } else {
-      console.error('❌ Unhandled Exception:', exception);
-      exception = new InternalServerErrorException(message);
+      console.error(`❌ Unhandled Exception (${requestId}):`, exception);
     }
 
+    const body = new ApiErrorResponse(
+      status,
+      code ?? errorCodeForStatus(status),
+      message,
+      requestId,
+    );
+
     // Fastify uses `response.code(status).send()`
-    response.code(status).send(new ApiResponse<null>(status, message));
+    response.code(status).send(body);
   }
 }
@@ -0,0 +1,71 @@
+import {
+  CanActivate,
+  ExecutionContext,
+  HttpException,
+  HttpStatus,
+  Injectable,
+} from '@nestjs/common';
+import { Reflector } from '@nestjs/core';
+import { RateLimitService } from '../services/rate-limit.service';
+import { ApiResponse } from '../dto/response.dto';
+
+/**
+ * Metadata key for the rate limit set by the `RateLimit` decorator.
+ */
+export const RATE_LIMIT_KEY = 'rateLimit';
+
+/**
+ * The rate limit of a route.
+ */
+export interface RateLimitOptions {
+  maxRequests: number;
+  windowSeconds: number;
+}
+
+/**
+ * Guard that limits how often a route can be called, per authenticated operator (or per IP for anonymous requests).
+ *
+ * Responds with 429 and a `Retry-After` header once the limit is exceeded.
+ */
+@Injectable()
+export class RateLimitGuard implements CanActivate {
+  constructor(
+    private readonly reflector: Reflector,
+    private readonly rateLimitService: RateLimitService,
+  ) {}
+
+  async canActivate(context: ExecutionContext): Promise<boolean> {
+    const options = this.reflector.get<RateLimitOptions>(
+      RATE_LIMIT_KEY,
+      context.getHandler(),
+    );
+
+    if (!options) {
+      return true;
+    }
+
+    const request = context.switchToHttp().getRequest();
+    const requester = request.user?.operatorId ?? request.ip;
+    const route = `${context.getClass().name}.${context.getHandler().name}`;
+
+    const { allowed, retryAfter } = await this.rateLimitService.consume(
+      `${route}:${requester}`,
+      options.maxRequests,
+      options.windowSeconds,
+    );
+
+    if (!allowed) {
+      context.switchToHttp().getResponse().header('Retry-After', retryAfter);
+
+      throw new HttpException(
+        new ApiResponse(
+          429,
+          `Too many requests. Please try again in ${retryAfter} seconds.`,
+        ),
+        HttpStatus.TOO_MANY_REQUESTS,
+      );
+    }
+
+    return true;
+  }
+}
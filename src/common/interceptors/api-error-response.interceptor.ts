@@ -0,0 +1,54 @@
+import {
+  CallHandler,
+  ExecutionContext,
+  Injectable,
+  NestInterceptor,
+} from '@nestjs/common';
+import { FastifyReply, FastifyRequest } from 'fastify';
+import { Observable, map } from 'rxjs';
+import { ApiErrorResponse, ApiResponse } from '../dto/response.dto';
+import {
+  errorCodeForStatus,
+  requestIdOf,
+} from '../filters/http-exception.filter';
+
+/**
+ * Shapes error `ApiResponse`s returned (rather than thrown) by controllers like `HttpExceptionFilter` shapes thrown exceptions.
+ *
+ * A returned `ApiResponse` with a `status` of 400 or above is sent as an `ApiErrorResponse` with that status as the HTTP status.
+ * Its `data` is kept, since some errors carry details (e.g. why a purchase isn't allowed).
+ */
+@Injectable()
+export class ApiErrorResponseInterceptor implements NestInterceptor {
+  intercept(context: ExecutionContext, next: CallHandler): Observable<any> {
+    if (context.getType() !== 'http') {
+      return next.handle();
+    }
+
+    const ctx = context.switchToHttp();
+
+    return next.handle().pipe(
+      map((value) => {
+        if (
+          !(value instanceof ApiResponse) ||
+          value instanceof ApiErrorResponse ||
+          value.status < 400
+        ) {
+          return value;
+        }
+
+        const body = new ApiErrorResponse(
+          value.status,
+          errorCodeForStatus(value.status),
+          value.message,
+          requestIdOf(ctx.getRequest<FastifyRequest>()),
+        );
+        body.data = value.data ?? null;
+
+        ctx.getResponse<FastifyReply>().code(value.status);
+
+        return body;
+      }),
+    );
+  }
+}
@@ -0,0 +1,31 @@
+import { Injectable, NestMiddleware } from '@nestjs/common';
+import { randomUUID } from 'crypto';
+import { IncomingMessage, ServerResponse } from 'http';
+import { requestContext } from '../utils/request-context';
+
+/**
+ * The response header the request's correlation ID is returned in.
+ */
+export const REQUEST_ID_HEADER = 'X-Request-ID';
+
+/**
+ * Middleware that assigns each request a correlation ID (UUIDv4).
+ *
+ * The ID is returned in the `X-Request-ID` response header, stored on the raw request as `requestId`
+ * and made available to everything that runs while handling the request via `getRequestId()`.
+ */
+@Injectable()
+export class RequestIdMiddleware implements NestMiddleware {
+  use(
+    req: IncomingMessage & { requestId?: string },
+    res: ServerResponse,
+    next: () => void,
+  ) {
+    const requestId = randomUUID();
+
+    req.requestId = requestId;
+    res.setHeader(REQUEST_ID_HEADER, requestId);
+
+    requestContext.run({ requestId }, next);
+  }
+}
@@ -1,5 +1,6 @@
 import { Module, Global, Logger } from '@nestjs/common';
 import { RedisService } from './redis.service';
+import { RateLimitService } from './services/rate-limit.service';
 import Redis from 'ioredis';
 
 @Global() // ✅ Makes RedisModule available globally (no need to import in every module)
@@ -72,7 +73,8 @@ import Redis from 'ioredis';
       },
     },
     RedisService,
+    RateLimitService,
   ],
-  exports: ['REDIS_CONNECTION', RedisService], // Export both connection & service
+  exports: ['REDIS_CONNECTION', RedisService, RateLimitService], // Export the connection & services
 })
 export class RedisModule {}
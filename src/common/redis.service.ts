@@ -98,6 +98,28 @@ export class RedisService {
     }, 'set');
   }
 
+  /**
+   * Set a value in Redis with an expiry (in seconds), only if the key doesn't exist yet.
+   *
+   * Returns `true` if the value was set.
+   */
+  async setIfNotExists(
+    key: string,
+    value: string,
+    expiryInSeconds: number,
+  ): Promise<boolean> {
+    return this.retryOperation(async () => {
+      const result = await this.redis.set(
+        key,
+        value,
+        'EX',
+        expiryInSeconds,
+        'NX',
+      );
+      return result === 'OK';
+    }, 'setIfNotExists');
+  }
+
   /**
    * Increment a Redis value (atomic operation).
    */
@@ -160,6 +182,13 @@ export class RedisService {
     return this.retryOperation(() => this.redis.mget(keys), 'mget');
   }
 
+  /**
+   * Ping Redis (without retries) to check that the connection is alive.
+   */
+  async ping(): Promise<string> {
+    return this.redis.ping();
+  }
+
   /**
    * Delete a key from Redis.
    * @param key The key to delete
@@ -169,6 +198,25 @@ export class RedisService {
     return this.retryOperation(() => this.redis.del(key), 'del');
   }
 
+  /**
+   * Set a key's expiry (in seconds).
+   * @returns 1 if the expiry was set, 0 if the key doesn't exist
+   */
+  async expire(key: string, expiryInSeconds: number): Promise<number> {
+    return this.retryOperation(
+      () => this.redis.expire(key, expiryInSeconds),
+      'expire',
+    );
+  }
+
+  /**
+   * Get the remaining time to live (in seconds) of a key.
+   * @returns -1 if the key has no expiry, -2 if it doesn't exist
+   */
+  async ttl(key: string): Promise<number> {
+    return this.retryOperation(() => this.redis.ttl(key), 'ttl');
+  }
+
   /**
    * Set multiple key-value pairs in Redis.
    * @param keyValuePairs Object containing key-value pairs
@@ -176,4 +224,63 @@ export class RedisService {
   async mset(keyValuePairs: Record<string, string>): Promise<'OK'> {
     return this.retryOperation(() => this.redis.mset(keyValuePairs), 'mset');
   }
+
+  /**
+   * Increment the score of a member in a sorted set (atomic operation).
+   * @param key The sorted set key
+   * @param member The member whose score to increment
+   * @param amount The amount to increment the score by
+   * @returns The new score of the member
+   */
+  async incrementSortedSetScore(
+    key: string,
+    member: string,
+    amount: number,
+  ): Promise<number> {
+    return this.retryOperation(async () => {
+      const score = await this.redis.zincrby(key, amount, member);
+      return parseFloat(score);
+    }, 'incrementSortedSetScore');
+  }
+
+  /**
+   * Get the highest scoring members of a sorted set.
+   * @param key The sorted set key
+   * @param count The number of members to return
+   * @returns Array of members with their scores, sorted by score descending
+   */
+  async getTopSortedSetMembers(
+    key: string,
+    count: number,
+  ): Promise<{ member: string; score: number }[]> {
+    return this.retryOperation(async () => {
+      const result = await this.redis.zrevrange(
+        key,
+        0,
+        count - 1,
+        'WITHSCORES',
+      );
+
+      // Result is a flat array of [member, score, member, score, ...]
+      const members: { member: string; score: number }[] = [];
+      for (let i = 0; i < result.length; i += 2) {
+        members.push({ member: result[i], score: parseFloat(result[i + 1]) });
+      }
+
+      return members;
+    }, 'getTopSortedSetMembers');
+  }
+
+  /**
+   * Publish a message to a Redis channel.
+   * @param channel The channel to publish to
+   * @param message The message to publish
+   * @returns The number of subscribers that received the message
+   */
+  async publish(channel: string, message: string): Promise<number> {
+    return this.retryOperation(
+      () => this.redis.publish(channel, message),
+      'publish',
+    );
+  }
 }
@@ -17,4 +17,16 @@ export class PoolPrerequisites {
   })
   @Prop({ required: false, default: null })
   tgChannelId?: string | null;
+
+  /**
+   * If `minTrustScore` is specified, the operator's `trustScore` must be at least this value
+   * to join the pool.
+   */
+  @ApiProperty({
+    description: 'The minimum trust score operators need to join the pool',
+    example: 0.8,
+    required: false,
+  })
+  @Prop({ type: Number, required: false, default: null })
+  minTrustScore?: number | null;
 }
@@ -28,6 +28,44 @@ export class ShopItemEffectDrillData {
   })
   @Prop({ required: true, default: 0 })
   baseEff: number;
+
+  @ApiProperty({
+    description: 'The highest level the drill can be upgraded to',
+    example: 5,
+    required: false,
+  })
+  @Prop({ required: false, default: 1 })
+  maxLevel?: number;
+
+  @ApiProperty({
+    description:
+      'The TON cost of each level upgrade, where index 0 is the upgrade from level 1 to level 2',
+    example: [1, 2, 4, 8],
+    type: [Number],
+    required: false,
+  })
+  @Prop({ type: [Number], required: false, default: [] })
+  upgradeCostsTON?: number[];
+
+  @ApiProperty({
+    description:
+      'The configuration this drill can be upgraded to (null if it is the last one on its upgrade path)',
+    enum: DrillConfig,
+    example: DrillConfig.BULWARK,
+    required: false,
+    nullable: true,
+  })
+  @Prop({ type: String, required: false, enum: DrillConfig, default: null })
+  upgradesToConfig?: DrillConfig | null;
+
+  @ApiProperty({
+    description: 'The TON cost of upgrading this drill to `upgradesToConfig`',
+    example: 10,
+    required: false,
+    nullable: true,
+  })
+  @Prop({ type: Number, required: false, default: null })
+  configUpgradeCostTON?: number | null;
 }
 
 /**
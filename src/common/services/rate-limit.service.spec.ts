@@ -0,0 +1,119 @@
+import { Test, TestingModule } from '@nestjs/testing';
+import { RateLimitService } from './rate-limit.service';
+import { RedisService } from '../redis.service';
+
+/**
+ * In-memory stand-in for the Redis commands used by `RateLimitService`
+ */
+class MockRedisService {
+  readonly counters = new Map<string, number>();
+  readonly ttls = new Map<string, number>();
+
+  async increment(key: string, amount: number = 1): Promise<number> {
+    const count = (this.counters.get(key) ?? 0) + amount;
+    this.counters.set(key, count);
+    return count;
+  }
+
+  async expire(key: string, expiryInSeconds: number): Promise<number> {
+    if (!this.counters.has(key)) return 0;
+    this.ttls.set(key, expiryInSeconds);
+    return 1;
+  }
+
+  async ttl(key: string): Promise<number> {
+    if (!this.counters.has(key)) return -2;
+    return this.ttls.get(key) ?? -1;
+  }
+
+  /**
+   * Simulates the window's key expiring
+   */
+  expireNow(key: string) {
+    this.counters.delete(key);
+    this.ttls.delete(key);
+  }
+}
+
+/**
+ * Unit tests for the Redis-backed rate limiter
+ */
+describe('RateLimitService', () => {
+  let rateLimitService: RateLimitService;
+  let redis: MockRedisService;
+
+  beforeEach(async () => {
+    redis = new MockRedisService();
+
+    const module: TestingModule = await Test.createTestingModule({
+      providers: [
+        RateLimitService,
+        { provide: RedisService, useValue: redis },
+      ],
+    }).compile();
+
+    rateLimitService = module.get(RateLimitService);
+  });
+
+  it('should allow requests up to the limit', async () => {
+    for (let i = 0; i < 5; i++) {
+      const result = await rateLimitService.consume('operator-1', 5, 60);
+      expect(result).toEqual({ allowed: true, retryAfter: 0 });
+    }
+  });
+
+  it('should reject requests over the limit with the time left in the window', async () => {
+    for (let i = 0; i < 5; i++) {
+      await rateLimitService.consume('operator-1', 5, 60);
+    }
+    redis.ttls.set('rate-limit:operator-1', 42);
+
+    const result = await rateLimitService.consume('operator-1', 5, 60);
+
+    expect(result).toEqual({ allowed: false, retryAfter: 42 });
+  });
+
+  it('should start the window on the first request', async () => {
+    await rateLimitService.consume('operator-1', 5, 60);
+
+    expect(redis.ttls.get('rate-limit:operator-1')).toBe(60);
+  });
+
+  it('should not extend the window on later requests', async () => {
+    await rateLimitService.consume('operator-1', 5, 60);
+    redis.ttls.set('rate-limit:operator-1', 30);
+
+    await rateLimitService.consume('operator-1', 5, 60);
+
+    expect(redis.ttls.get('rate-limit:operator-1')).toBe(30);
+  });
+
+  it('should set an expiry on counters left without one', async () => {
+    redis.counters.set('rate-limit:operator-1', 3);
+
+    await rateLimitService.consume('operator-1', 5, 60);
+
+    expect(redis.ttls.get('rate-limit:operator-1')).toBe(60);
+  });
+
+  it('should allow requests again once the window expired', async () => {
+    for (let i = 0; i < 6; i++) {
+      await rateLimitService.consume('operator-1', 5, 60);
+    }
+    redis.expireNow('rate-limit:operator-1');
+
+    const result = await rateLimitService.consume('operator-1', 5, 60);
+
+    expect(result.allowed).toBe(true);
+  });
+
+  it('should count each key separately', async () => {
+    for (let i = 0; i < 6; i++) {
+      await rateLimitService.consume('operator-1', 5, 60);
+    }
+
+    const result = await rateLimitService.consume('operator-2', 5, 60);
+
+    expect(result.allowed).toBe(true);
+  });
+});
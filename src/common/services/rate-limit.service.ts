@@ -0,0 +1,46 @@
+import { Injectable, Logger } from '@nestjs/common';
+import { RedisService } from '../redis.service';
+
+/**
+ * Counts requests per key in fixed time windows stored in Redis.
+ *
+ * Each window is a counter that is incremented with `INCR` and expires with `EXPIRE` once the window is over,
+ * so the limits are shared across all API instances.
+ */
+@Injectable()
+export class RateLimitService {
+  private readonly logger = new Logger(RateLimitService.name);
+  private readonly keyPrefix = 'rate-limit:';
+
+  constructor(private readonly redisService: RedisService) {}
+
+  /**
+   * Counts a request for `key` against the limit of `maxRequests` per `windowSeconds`.
+   *
+   * Returns whether the request is allowed and, if not, how many seconds are left until the window resets.
+   */
+  async consume(
+    key: string,
+    maxRequests: number,
+    windowSeconds: number,
+  ): Promise<{ allowed: boolean; retryAfter: number }> {
+    const redisKey = `${this.keyPrefix}${key}`;
+    const count = await this.redisService.increment(redisKey);
+
+    let ttl = await this.redisService.ttl(redisKey);
+    // The first request of a window starts it. Also covers counters left without an expiry.
+    if (count === 1 || ttl < 0) {
+      await this.redisService.expire(redisKey, windowSeconds);
+      ttl = windowSeconds;
+    }
+
+    if (count > maxRequests) {
+      this.logger.warn(
+        `⚠️ (consume) Rate limit of ${maxRequests} requests per ${windowSeconds}s exceeded for ${key}.`,
+      );
+      return { allowed: false, retryAfter: ttl };
+    }
+
+    return { allowed: true, retryAfter: 0 };
+  }
+}
@@ -0,0 +1,164 @@
+import {
+  drillConfigUpgradeCostTON,
+  drillEffAtLevel,
+  drillMinAssetEquity,
+  drillPurchaseCost,
+  drillRarity,
+  drillRarityMultiplier,
+  drillUpgradeCostTON,
+  meetsDrillMinAssetEquity,
+  scaleDrillEff,
+} from './drill';
+import { DrillConfig, DrillRarity } from 'src/common/enums/drill.enum';
+
+/**
+ * Unit tests for the drill upgrade helpers
+ */
+describe('drill upgrade helpers', () => {
+  describe('drillEffAtLevel', () => {
+    it('should keep the base EFF at level 1', () => {
+      expect(drillEffAtLevel(1_000, 1)).toBe(1_000);
+    });
+
+    it('should add 15% of the base EFF per level', () => {
+      expect(drillEffAtLevel(1_000, 2)).toBe(1_150);
+      expect(drillEffAtLevel(1_000, 5)).toBe(1_600);
+    });
+
+    it('should round to a whole EFF', () => {
+      expect(drillEffAtLevel(333, 2)).toBe(383);
+    });
+  });
+
+  describe('scaleDrillEff', () => {
+    it('should move an undamaged drill to its new full EFF', () => {
+      expect(scaleDrillEff(1_000, 1_000, 1_150)).toBe(1_150);
+    });
+
+    it('should keep the share of decay and damage', () => {
+      // 20% below its full EFF before the upgrade, so still 20% below after it
+      expect(scaleDrillEff(800, 1_000, 1_150)).toBe(920);
+    });
+
+    it('should fall back to the new full EFF without a previous full EFF', () => {
+      expect(scaleDrillEff(0, 0, 1_150)).toBe(1_150);
+    });
+  });
+
+  describe('drillUpgradeCostTON', () => {
+    const drillData = { maxLevel: 3, upgradeCostsTON: [1, 2.5] };
+
+    it('should return the cost of the next level', () => {
+      expect(drillUpgradeCostTON(drillData, 1)).toBe(1);
+      expect(drillUpgradeCostTON(drillData, 2)).toBe(2.5);
+    });
+
+    it('should reject upgrades at the max level', () => {
+      expect(drillUpgradeCostTON(drillData, 3)).toBeNull();
+    });
+
+    it('should reject upgrades past the max level', () => {
+      expect(drillUpgradeCostTON(drillData, 4)).toBeNull();
+    });
+
+    it('should reject upgrades for drills without upgrade levels', () => {
+      expect(drillUpgradeCostTON({}, 1)).toBeNull();
+    });
+
+    it('should reject upgrades without a cost for the next level', () => {
+      expect(
+        drillUpgradeCostTON({ maxLevel: 3, upgradeCostsTON: [1] }, 2),
+      ).toBeNull();
+    });
+  });
+
+  describe('drillConfigUpgradeCostTON', () => {
+    const drillData = {
+      upgradesToConfig: DrillConfig.BULWARK,
+      configUpgradeCostTON: 10,
+    };
+
+    it('should return the cost of upgrading to the next configuration', () => {
+      expect(drillConfigUpgradeCostTON(drillData, DrillConfig.BULWARK)).toBe(
+        10,
+      );
+    });
+
+    it('should reject upgrades that skip the upgrade path', () => {
+      expect(
+        drillConfigUpgradeCostTON(drillData, DrillConfig.DREADNOUGHT),
+      ).toBeNull();
+    });
+
+    it('should reject upgrades at the end of the upgrade path', () => {
+      expect(
+        drillConfigUpgradeCostTON(
+          { upgradesToConfig: null, configUpgradeCostTON: null },
+          DrillConfig.BULWARK,
+        ),
+      ).toBeNull();
+    });
+
+    it('should reject upgrades without a cost', () => {
+      expect(
+        drillConfigUpgradeCostTON(
+          { upgradesToConfig: DrillConfig.BULWARK },
+          DrillConfig.BULWARK,
+        ),
+      ).toBeNull();
+    });
+  });
+
+  describe('drillRarity', () => {
+    it('should return the rarity of each configuration', () => {
+      expect(drillRarity(DrillConfig.BASIC)).toBe(DrillRarity.COMMON);
+      expect(drillRarity(DrillConfig.IRONBORE)).toBe(DrillRarity.UNCOMMON);
+      expect(drillRarity(DrillConfig.TITAN)).toBe(DrillRarity.RARE);
+      expect(drillRarity(DrillConfig.DREADNOUGHT)).toBe(DrillRarity.LEGENDARY);
+    });
+  });
+
+  describe('drillRarityMultiplier', () => {
+    it('should not scale common drills', () => {
+      expect(drillRarityMultiplier(DrillConfig.BASIC)).toBe(1);
+    });
+
+    it('should scale rarer drills more', () => {
+      expect(drillRarityMultiplier(DrillConfig.DREADNOUGHT)).toBeGreaterThan(
+        drillRarityMultiplier(DrillConfig.BULWARK),
+      );
+    });
+  });
+
+  describe('drillPurchaseCost', () => {
+    it('should scale both prices by the rarity multiplier', () => {
+      expect(
+        drillPurchaseCost({ ton: 10, bera: 20 }, DrillConfig.DREADNOUGHT),
+      ).toEqual({ ton: 15, bera: 30 });
+    });
+
+    it('should keep the base cost of common drills', () => {
+      expect(
+        drillPurchaseCost({ ton: 10, bera: 20 }, DrillConfig.BASIC),
+      ).toEqual({ ton: 10, bera: 20 });
+    });
+  });
+
+  describe('meetsDrillMinAssetEquity', () => {
+    it('should allow anyone to buy configurations without a minimum', () => {
+      expect(drillMinAssetEquity(DrillConfig.BASIC)).toBeNull();
+      expect(meetsDrillMinAssetEquity(DrillConfig.BASIC, 0)).toBe(true);
+    });
+
+    it('should require the minimum asset equity for exclusive configurations', () => {
+      const minAssetEquity = drillMinAssetEquity(DrillConfig.DREADNOUGHT);
+
+      expect(
+        meetsDrillMinAssetEquity(DrillConfig.DREADNOUGHT, minAssetEquity - 1),
+      ).toBe(false);
+      expect(
+        meetsDrillMinAssetEquity(DrillConfig.DREADNOUGHT, minAssetEquity),
+      ).toBe(true);
+    });
+  });
+});
@@ -0,0 +1,108 @@
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { ShopItemEffectDrillData } from 'src/common/schemas/shop-item-effect.schema';
+import { DrillConfig, DrillRarity } from 'src/common/enums/drill.enum';
+
+/**
+ * Fetches a drill's EFF at the given upgrade level (`baseEff * (1 + UPGRADE_EFF_INCREASE_PER_LEVEL * (level - 1))`).
+ */
+export const drillEffAtLevel = (baseEff: number, level: number): number =>
+  Math.round(
+    baseEff *
+      (1 + GAME_CONSTANTS.DRILLS.UPGRADE_EFF_INCREASE_PER_LEVEL * (level - 1)),
+  );
+
+/**
+ * Scales a drill's current EFF when its full EFF (without decay or damage) changes from `fromFullEff` to `toFullEff`,
+ * e.g. when it's upgraded.
+ *
+ * The drill keeps the same share of decay and damage instead of being reset to its new full EFF.
+ */
+export const scaleDrillEff = (
+  actualEff: number,
+  fromFullEff: number,
+  toFullEff: number,
+): number =>
+  fromFullEff > 0
+    ? Math.round((actualEff * toFullEff) / fromFullEff)
+    : toFullEff;
+
+/**
+ * Fetches the TON cost of upgrading a drill from `currentLevel` to the next level.
+ *
+ * Returns `null` if the drill is already at its max level or no cost is set for the next level.
+ */
+export const drillUpgradeCostTON = (
+  drillData: Pick<ShopItemEffectDrillData, 'maxLevel' | 'upgradeCostsTON'>,
+  currentLevel: number,
+): number | null => {
+  if (currentLevel >= (drillData.maxLevel ?? 1)) {
+    return null;
+  }
+
+  return drillData.upgradeCostsTON?.[currentLevel - 1] ?? null;
+};
+
+/**
+ * Fetches the TON cost of upgrading a drill to `targetConfig`.
+ *
+ * Drills can only be upgraded to the next configuration on their upgrade path (`upgradesToConfig`),
+ * so `null` is returned for any other target or if no cost is set.
+ */
+export const drillConfigUpgradeCostTON = (
+  drillData: Pick<
+    ShopItemEffectDrillData,
+    'upgradesToConfig' | 'configUpgradeCostTON'
+  >,
+  targetConfig: DrillConfig,
+): number | null => {
+  if (drillData.upgradesToConfig !== targetConfig) {
+    return null;
+  }
+
+  return drillData.configUpgradeCostTON ?? null;
+};
+
+/**
+ * Fetches the rarity of a drill configuration.
+ */
+export const drillRarity = (config: DrillConfig): DrillRarity =>
+  GAME_CONSTANTS.DRILLS.CONFIG_RARITY[config] ?? DrillRarity.COMMON;
+
+/**
+ * Fetches how much a drill configuration's rarity scales its prices.
+ */
+export const drillRarityMultiplier = (config: DrillConfig): number =>
+  GAME_CONSTANTS.DRILLS.RARITY_MULTIPLIERS[drillRarity(config)];
+
+/**
+ * Fetches the effective purchase cost of a drill, i.e. its base shop purchase cost scaled by its configuration's rarity.
+ */
+export const drillPurchaseCost = (
+  baseCost: { ton: number; bera: number },
+  config: DrillConfig,
+): { ton: number; bera: number } => {
+  const multiplier = drillRarityMultiplier(config);
+
+  return {
+    ton: baseCost.ton * multiplier,
+    bera: baseCost.bera * multiplier,
+  };
+};
+
+/**
+ * Fetches the minimum asset equity (in USD) needed to buy a drill configuration (null if anyone can buy it).
+ */
+export const drillMinAssetEquity = (config: DrillConfig): number | null =>
+  GAME_CONSTANTS.DRILLS.CONFIG_MIN_ASSET_EQUITY[config] ?? null;
+
+/**
+ * Checks whether an operator with `assetEquity` meets a drill configuration's minimum asset equity.
+ */
+export const meetsDrillMinAssetEquity = (
+  config: DrillConfig,
+  assetEquity: number,
+): boolean => {
+  const minAssetEquity = drillMinAssetEquity(config);
+
+  return minAssetEquity === null || assetEquity >= minAssetEquity;
+};
@@ -1,3 +1,5 @@
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
 /**
  * Fetches the `actualEff` value for basic drills given an operator's weighted asset equity.
  */
@@ -16,3 +18,10 @@ export const equityToActualEff = (equity: number): number => {
 export const equityToEffMultiplier = (equity: number): number => {
   return 1 + Math.log(1 + 0.0000596 * equity);
 };
+
+/**
+ * Fetches the maximum total drill EFF an operator can hold given their asset equity (`EQUITY_TO_MAX_EFF` per 1 USD).
+ */
+export const equityToMaxEff = (equity: number): number => {
+  return equity * GAME_CONSTANTS.ECONOMY.EQUITY_TO_MAX_EFF;
+};
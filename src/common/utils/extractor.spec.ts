@@ -0,0 +1,122 @@
+import { Types } from 'mongoose';
+import { ExtractorCandidate, selectWeightedExtractor } from './extractor';
+
+/**
+ * Returns a seeded pseudo-random number generator (mulberry32), so distribution tests are reproducible.
+ */
+const seededRandom = (seed: number) => () => {
+  seed = (seed + 0x6d2b79f5) | 0;
+  let t = Math.imul(seed ^ (seed >>> 15), 1 | seed);
+  t = (t + Math.imul(t ^ (t >>> 7), 61 | t)) ^ t;
+  return ((t ^ (t >>> 14)) >>> 0) / 4294967296;
+};
+
+const candidate = (
+  drillId: string,
+  eff: number,
+  multipliers: Partial<ExtractorCandidate> = {},
+): ExtractorCandidate => ({
+  drillId,
+  eff,
+  operatorId: new Types.ObjectId(),
+  ...multipliers,
+});
+
+/**
+ * Unit tests for the weighted extractor selection
+ */
+describe('selectWeightedExtractor', () => {
+  it('should return null without candidates', () => {
+    expect(selectWeightedExtractor([], 1, 1)).toBeNull();
+  });
+
+  it('should return null if every candidate has zero weight', () => {
+    expect(
+      selectWeightedExtractor(
+        [candidate('a', 0), candidate('b', 0)],
+        1,
+        1,
+        seededRandom(1),
+      ),
+    ).toBeNull();
+  });
+
+  it('should always select the only candidate with any weight', () => {
+    const random = seededRandom(2);
+
+    for (let i = 0; i < 100; i++) {
+      const result = selectWeightedExtractor(
+        [
+          candidate('zero', 0),
+          candidate('drill', 100),
+          candidate('zero-synergy', 100, { synergyMultiplier: 0 }),
+        ],
+        0.8,
+        1.2,
+        random,
+      );
+
+      expect(result?.candidate.drillId).toBe('drill');
+    }
+  });
+
+  it('should apply the luck factor and the multipliers to the total weighted EFF', () => {
+    // A constant 0.5 draws a luck factor halfway between 1 and 2
+    const result = selectWeightedExtractor(
+      [
+        candidate('a', 100),
+        candidate('b', 100, { synergyMultiplier: 2, verifiedMultiplier: 1.5 }),
+      ],
+      1,
+      2,
+      () => 0.5,
+    );
+
+    expect(result?.totalWeightedEff).toBeCloseTo(100 * 1.5 + 100 * 3 * 1.5);
+  });
+
+  it('should select each candidate proportionally to its weighted EFF', () => {
+    const random = seededRandom(42);
+    const candidates = [
+      candidate('a', 100),
+      candidate('b', 300),
+      candidate('c', 200, { synergyMultiplier: 2 }),
+    ];
+    const runs = 20_000;
+    const selections = new Map<string, number>();
+
+    for (let i = 0; i < runs; i++) {
+      const { drillId } = selectWeightedExtractor(
+        candidates,
+        1,
+        1,
+        random,
+      ).candidate;
+      selections.set(drillId, (selections.get(drillId) ?? 0) + 1);
+    }
+
+    // Weights are 100 : 300 : 400
+    expect(selections.get('a') / runs).toBeCloseTo(1 / 8, 1);
+    expect(selections.get('b') / runs).toBeCloseTo(3 / 8, 1);
+    expect(selections.get('c') / runs).toBeCloseTo(4 / 8, 1);
+  });
+
+  it('should be deterministic for the same random sequence', () => {
+    const candidates = [
+      candidate('a', 100),
+      candidate('b', 100),
+      candidate('c', 100),
+    ];
+    const pick = (seed: number) => {
+      const random = seededRandom(seed);
+      return Array.from(
+        { length: 20 },
+        () =>
+          selectWeightedExtractor(candidates, 0.8, 1.2, random).candidate
+            .drillId,
+      );
+    };
+
+    expect(pick(7)).toEqual(pick(7));
+  });
+});
@@ -0,0 +1,50 @@
+import { Types } from 'mongoose';
+
+/**
+ * A drill that can be selected as a cycle's extractor.
+ */
+export interface ExtractorCandidate {
+  drillId: string;
+  eff: number;
+  operatorId: Types.ObjectId;
+  /** The synergy multiplier of the drill operator's pool (defaults to 1 if the operator isn't in a pool). */
+  synergyMultiplier?: number;
+  /** The multiplier for drills of verified operators (defaults to 1 if the operator isn't verified). */
+  verifiedMultiplier?: number;
+}
+
+/**
+ * Picks the extractor out of `candidates` with a probability proportional to each drill's EFF,
+ * multiplied by its pool synergy multiplier, its verified multiplier and a random luck factor between `minLuck` and `maxLuck`.
+ *
+ * Only depends on its inputs (and `random`), so it can be tested without the database or the drill cache.
+ * Returns `null` if there are no candidates.
+ */
+export const selectWeightedExtractor = (
+  candidates: Iterable<ExtractorCandidate>,
+  minLuck: number,
+  maxLuck: number,
+  random: () => number = Math.random,
+): { candidate: ExtractorCandidate; totalWeightedEff: number } | null => {
+  let selected: ExtractorCandidate | null = null;
+  let totalWeightedEff = 0;
+
+  // Compute a 'seed-always-select first' system.
+  // The first drill will be the first selected, then the second drill has a chance to knock it out of the extractor spot, and the third drill
+  // has a chance to knock either Drill 1 or 2 (whichever remains in place) out, and so on.
+  for (const candidate of candidates) {
+    const luck = minLuck + random() * (maxLuck - minLuck);
+    const weight =
+      candidate.eff *
+      (candidate.synergyMultiplier ?? 1) *
+      (candidate.verifiedMultiplier ?? 1) *
+      luck;
+    totalWeightedEff += weight;
+    // keep this candidate with probability weight/totalWeightedEff
+    if (random() * totalWeightedEff < weight) {
+      selected = candidate;
+    }
+  }
+
+  return selected ? { candidate: selected, totalWeightedEff } : null;
+};
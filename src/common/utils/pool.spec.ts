@@ -0,0 +1,124 @@
+import { validatePoolPrerequisites, validatePoolRewardSystem } from './pool';
+
+/**
+ * Unit tests for the pool config validators
+ */
+describe('pool config validators', () => {
+  describe('validatePoolRewardSystem', () => {
+    const balanced = {
+      extractorOperator: 0.48,
+      leader: 0.04,
+      activePoolOperators: 0.48,
+      activeGlobalOperators: 0,
+    };
+
+    it('should accept shares that add up to 1', () => {
+      expect(validatePoolRewardSystem(balanced)).toBeNull();
+    });
+
+    it('should accept a single share of 1', () => {
+      expect(
+        validatePoolRewardSystem({
+          extractorOperator: 1,
+          leader: 0,
+          activePoolOperators: 0,
+          activeGlobalOperators: 0,
+        }),
+      ).toBeNull();
+    });
+
+    it('should accept shares that only miss 1 by floating-point errors', () => {
+      expect(
+        validatePoolRewardSystem({
+          extractorOperator: 0.1,
+          leader: 0.2,
+          activePoolOperators: 0.7,
+          activeGlobalOperators: 0,
+        }),
+      ).toBeNull();
+    });
+
+    it('should reject shares that add up to less than 1', () => {
+      expect(
+        validatePoolRewardSystem({ ...balanced, activePoolOperators: 0.4 }),
+      ).toMatch(/add up to 1/);
+    });
+
+    it('should reject shares that add up to more than 1', () => {
+      expect(validatePoolRewardSystem({ ...balanced, leader: 0.1 })).toMatch(
+        /add up to 1/,
+      );
+    });
+
+    it('should reject an all-zero reward system', () => {
+      expect(
+        validatePoolRewardSystem({
+          extractorOperator: 0,
+          leader: 0,
+          activePoolOperators: 0,
+          activeGlobalOperators: 0,
+        }),
+      ).toMatch(/add up to 1/);
+    });
+
+    it('should reject percentages instead of fractions', () => {
+      expect(
+        validatePoolRewardSystem({
+          extractorOperator: 48,
+          leader: 4,
+          activePoolOperators: 48,
+          activeGlobalOperators: 0,
+        }),
+      ).toMatch(/between 0 and 1/);
+    });
+
+    it('should reject negative shares', () => {
+      expect(
+        validatePoolRewardSystem({
+          ...balanced,
+          leader: -0.04,
+          activePoolOperators: 0.56,
+        }),
+      ).toMatch(/between 0 and 1/);
+    });
+
+    it('should reject shares that are not finite numbers', () => {
+      expect(
+        validatePoolRewardSystem({ ...balanced, activeGlobalOperators: NaN }),
+      ).toMatch(/between 0 and 1/);
+    });
+  });
+
+  describe('validatePoolPrerequisites', () => {
+    it('should accept no prerequisites', () => {
+      expect(validatePoolPrerequisites({})).toBeNull();
+      expect(
+        validatePoolPrerequisites({ tgChannelId: null, minTrustScore: null }),
+      ).toBeNull();
+    });
+
+    it('should accept numeric chat IDs and public usernames', () => {
+      expect(
+        validatePoolPrerequisites({ tgChannelId: '-1001234567890' }),
+      ).toBeNull();
+      expect(
+        validatePoolPrerequisites({ tgChannelId: '@hashland_pool' }),
+      ).toBeNull();
+    });
+
+    it('should reject invalid Telegram channel IDs', () => {
+      expect(
+        validatePoolPrerequisites({ tgChannelId: 'https://t.me/pool' }),
+      ).toMatch(/Telegram channel ID/);
+    });
+
+    it('should reject a minimum trust score outside of 0 to 1', () => {
+      expect(validatePoolPrerequisites({ minTrustScore: 1.5 })).toMatch(
+        /trust score/,
+      );
+      expect(validatePoolPrerequisites({ minTrustScore: -0.1 })).toMatch(
+        /trust score/,
+      );
+    });
+  });
+});
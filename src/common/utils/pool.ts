@@ -0,0 +1,158 @@
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+/**
+ * Fetches the synergy multiplier applied to the EFF of a pool's drills given the pool's member count.
+ *
+ * Grows logarithmically with the pool size (`1 + log10(memberCount) * SYNERGY_COEFFICIENT`), so a solo or empty pool gets no boost.
+ */
+export const poolSynergyMultiplier = (memberCount: number): number => {
+  if (memberCount <= 1) {
+    return 1;
+  }
+
+  return (
+    1 + Math.log10(memberCount) * GAME_CONSTANTS.POOLS.SYNERGY_COEFFICIENT
+  );
+};
+
+/**
+ * Checks whether a pool has no open operator slots left. Pools without `maxOperators` are never full.
+ */
+export const isPoolFull = (pool: {
+  maxOperators?: number | null;
+  operatorCount?: number;
+}): boolean =>
+  pool.maxOperators !== null &&
+  pool.maxOperators !== undefined &&
+  (pool.operatorCount || 0) >= pool.maxOperators;
+
+/**
+ * Fetches the seconds left until an operator who last joined a pool at `lastJoinedPool` can join a pool again (0 if they can join now).
+ */
+export const poolJoinCooldownLeft = (
+  lastJoinedPool: Date | null | undefined,
+): number => {
+  if (!lastJoinedPool) {
+    return 0;
+  }
+
+  const cooldownEnd =
+    new Date(lastJoinedPool).getTime() +
+    GAME_CONSTANTS.OPERATORS.JOIN_POOL_COOLDOWN * 1000;
+
+  return Math.max(0, Math.ceil((cooldownEnd - Date.now()) / 1000));
+};
+
+/**
+ * A pool member's efficiency tier, relative to the average EFF of the pool's members.
+ */
+export enum PoolMemberEffTier {
+  BRONZE = 'bronze',
+  SILVER = 'silver',
+  GOLD = 'gold',
+  PLATINUM = 'platinum',
+}
+
+/**
+ * The prerequisites an operator must meet to join a pool.
+ */
+export enum PoolJoinPrerequisite {
+  /** The operator must not already be in a pool. */
+  NOT_IN_POOL = 'notInPool',
+  /** The pool must have an open slot. */
+  CAPACITY = 'capacity',
+  /** The operator's trust score must be at least the pool's `minTrustScore`. */
+  MIN_TRUST_SCORE = 'minTrustScore',
+  /** The operator must be a member of the pool's Telegram channel. */
+  TG_CHANNEL = 'tgChannel',
+  /** The operator must not have joined a pool within the last `OPERATORS.JOIN_POOL_COOLDOWN` seconds. */
+  JOIN_COOLDOWN = 'joinCooldown',
+  /** The pool must not require approval to join (otherwise, the operator must send a join request). */
+  APPROVAL_REQUIRED = 'approvalRequired',
+}
+
+/**
+ * Fetches a pool member's efficiency tier given their EFF and the average EFF of the pool's members
+ * (see `GAME_CONSTANTS.POOLS.MEMBER_EFF_TIER_RATIOS`).
+ *
+ * Members of a pool whose average EFF is 0 are all at the average, i.e. gold.
+ */
+export const poolMemberEffTier = (
+  eff: number,
+  averageEff: number,
+): PoolMemberEffTier => {
+  const { silver, gold, platinum } =
+    GAME_CONSTANTS.POOLS.MEMBER_EFF_TIER_RATIOS;
+  const ratio = averageEff > 0 ? eff / averageEff : 1;
+
+  if (ratio > platinum) return PoolMemberEffTier.PLATINUM;
+  if (ratio >= gold) return PoolMemberEffTier.GOLD;
+  if (ratio >= silver) return PoolMemberEffTier.SILVER;
+  return PoolMemberEffTier.BRONZE;
+};
+
+/**
+ * Validates a pool's reward system: every share must be between 0 and 1, and the shares must add up to 1
+ * (give or take `REWARD_SYSTEM_SHARE_TOLERANCE` to allow for floating-point errors).
+ *
+ * Returns the reason the reward system is invalid, or `null` if it's valid.
+ */
+export const validatePoolRewardSystem = (rewardSystem: {
+  extractorOperator: number;
+  leader: number;
+  activePoolOperators: number;
+  activeGlobalOperators: number;
+}): string | null => {
+  const shares = [
+    rewardSystem.extractorOperator,
+    rewardSystem.leader,
+    rewardSystem.activePoolOperators,
+    rewardSystem.activeGlobalOperators,
+  ];
+
+  if (
+    shares.some((share) => !Number.isFinite(share) || share < 0 || share > 1)
+  ) {
+    return 'Reward system shares must be between 0 and 1.';
+  }
+
+  const totalShare = shares.reduce((sum, share) => sum + share, 0);
+  const tolerance = GAME_CONSTANTS.POOLS.REWARD_SYSTEM_SHARE_TOLERANCE;
+  if (Math.abs(totalShare - 1) > tolerance) {
+    return `Reward system shares must add up to 1 (got ${totalShare}).`;
+  }
+
+  return null;
+};
+
+/**
+ * Validates a pool's join prerequisites:
+ * - `tgChannelId` must be a numeric Telegram chat ID (e.g. `-1001234567890`) or a public `@username`.
+ * - `minTrustScore` must be between 0 and 1, the range of an operator's `trustScore`.
+ *
+ * Returns the reason the prerequisites are invalid, or `null` if they're valid.
+ */
+export const validatePoolPrerequisites = (prerequisites: {
+  tgChannelId?: string | null;
+  minTrustScore?: number | null;
+}): string | null => {
+  const { tgChannelId, minTrustScore } = prerequisites;
+
+  if (
+    tgChannelId !== null &&
+    tgChannelId !== undefined &&
+    !/^(-?\d+|@[a-zA-Z0-9_]{5,32})$/.test(tgChannelId)
+  ) {
+    return 'Telegram channel ID must be a numeric chat ID or a @username.';
+  }
+
+  if (
+    minTrustScore !== null &&
+    minTrustScore !== undefined &&
+    (!Number.isFinite(minTrustScore) || minTrustScore < 0 || minTrustScore > 1)
+  ) {
+    return 'Minimum trust score must be between 0 and 1.';
+  }
+
+  return null;
+};
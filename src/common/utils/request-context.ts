@@ -0,0 +1,50 @@
+import { AsyncLocalStorage } from 'async_hooks';
+import { Schema } from 'mongoose';
+
+/**
+ * The data stored for the lifetime of each HTTP request.
+ */
+export interface RequestContext {
+  /** The request's correlation ID (also returned in the `X-Request-ID` header). */
+  requestId: string;
+}
+
+/**
+ * Carries the current request's context through every async call made while handling it (services, queries, etc.),
+ * so it doesn't need to be passed down explicitly.
+ *
+ * Populated by `RequestIdMiddleware`.
+ */
+export const requestContext = new AsyncLocalStorage<RequestContext>();
+
+/**
+ * Fetches the correlation ID of the request currently being handled (null outside of a request, e.g. in queue jobs).
+ */
+export const getRequestId = (): string | null =>
+  requestContext.getStore()?.requestId ?? null;
+
+/**
+ * Mongoose plugin that tags every query and aggregation run during a request with the request's ID as a `comment`,
+ * so slow queries in the MongoDB logs and profiler can be traced back to the request that ran them.
+ */
+export const requestIdPlugin = (schema: Schema) => {
+  schema.pre(
+    /^(find|count|update|delete|replace)/,
+    { document: false, query: true },
+    function () {
+      const requestId = getRequestId();
+
+      if (requestId && this.getOptions().comment === undefined) {
+        this.comment(requestId);
+      }
+    },
+  );
+
+  schema.pre('aggregate', function () {
+    const requestId = getRequestId();
+
+    if (requestId && this.options.comment === undefined) {
+      this.option({ comment: requestId });
+    }
+  });
+};
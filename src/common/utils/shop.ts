@@ -0,0 +1,28 @@
+import {
+  ShopItemType,
+  ShopPurchaseCategory,
+} from 'src/common/enums/shop.enum';
+
+/**
+ * The revenue category of each shop item.
+ */
+export const SHOP_ITEM_CATEGORIES: Record<ShopItemType, ShopPurchaseCategory> =
+  {
+    [ShopItemType.IRONBORE_DRILL]: ShopPurchaseCategory.PURCHASE,
+    [ShopItemType.BULWARK_DRILL]: ShopPurchaseCategory.PURCHASE,
+    [ShopItemType.TITAN_DRILL]: ShopPurchaseCategory.PURCHASE,
+    [ShopItemType.DREADNOUGHT_DRILL]: ShopPurchaseCategory.PURCHASE,
+    [ShopItemType.UPGRADE_MAX_FUEL]: ShopPurchaseCategory.UPGRADE,
+    [ShopItemType.REPLENISH_FUEL]: ShopPurchaseCategory.FUEL,
+    [ShopItemType.UPGRADE_MAX_ACTIVE_DRILLS_6]: ShopPurchaseCategory.SLOT,
+    [ShopItemType.UPGRADE_MAX_ACTIVE_DRILLS_7]: ShopPurchaseCategory.SLOT,
+    [ShopItemType.UPGRADE_MAX_ACTIVE_DRILLS_8]: ShopPurchaseCategory.SLOT,
+    [ShopItemType.UPGRADE_MAX_ACTIVE_DRILLS_9]: ShopPurchaseCategory.SLOT,
+    [ShopItemType.UPGRADE_MAX_ACTIVE_DRILLS_10]: ShopPurchaseCategory.SLOT,
+  };
+
+/**
+ * Fetches the revenue category of a shop item (defaults to `PURCHASE` for unknown items).
+ */
+export const shopItemCategory = (item: string): ShopPurchaseCategory =>
+  SHOP_ITEM_CATEGORIES[item as ShopItemType] ?? ShopPurchaseCategory.PURCHASE;
@@ -0,0 +1,33 @@
+import axios from 'axios';
+
+/**
+ * Checks whether a Telegram user is a member of a chat (e.g. a channel) via the Bot API's `getChatMember`.
+ *
+ * Doesn't depend on `TelegramService`, so it can be used by modules that `TelegramModule` itself depends on.
+ * The bot must be able to see the chat's members (i.e. be an administrator of the channel).
+ * Throws if the request to the Bot API fails.
+ */
+export const isTelegramChatMember = async (
+  botToken: string,
+  chatId: string,
+  userId: string,
+): Promise<boolean> => {
+  const response = await axios.get(
+    `https://api.telegram.org/bot${botToken}/getChatMember`,
+    {
+      params: {
+        chat_id: chatId,
+        user_id: userId,
+      },
+    },
+  );
+
+  if (!response.data.ok) {
+    return false;
+  }
+
+  // User is a member if they are a member, administrator, or creator
+  return ['member', 'administrator', 'creator'].includes(
+    response.data.result.status,
+  );
+};
@@ -1,4 +1,4 @@
-import { StateInit } from '@ton/core';
+import { Address, StateInit } from '@ton/core';
 import { Buffer } from 'buffer';
 
 /**
@@ -52,3 +52,15 @@ export function tryParsePublicKey(stateInit: StateInit): Buffer | null {
     return null;
   }
 }
+
+/**
+ * Converts a TON address in any form (bounceable, non-bounceable or raw) to its canonical raw form (`0:…`),
+ * so the same wallet always maps to the same stored address. Returns null if the address is invalid.
+ */
+export function canonicalTonAddress(address: string): string | null {
+  try {
+    return Address.parse(address).toRawString();
+  } catch {
+    return null;
+  }
+}
@@ -0,0 +1,134 @@
+import {
+  Body,
+  Controller,
+  Delete,
+  Get,
+  Param,
+  Post,
+  Put,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import {
+  CreateDrillGroupDto,
+  UpdateDrillGroupDto,
+} from 'src/common/dto/drill-group.dto';
+import { DrillGroupService } from './drill-group.service';
+
+@ApiTags('Drill Groups')
+@Controller('drills/groups')
+export class DrillGroupController {
+  constructor(private readonly drillGroupService: DrillGroupService) {}
+
+  @ApiOperation({
+    summary: 'Create a drill group',
+    description:
+      'Creates a named group of drills that can be selected when starting a drilling session',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully created drill group',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Drills not owned by operator or group limit reached',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post()
+  async createDrillGroup(@Request() req, @Body() body: CreateDrillGroupDto) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillGroupService.createDrillGroup(
+      operatorId,
+      body.name,
+      body.drillIds,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get drill groups',
+    description: "Fetches all of the authenticated operator's drill groups",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched drill groups',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get()
+  async fetchDrillGroups(@Request() req) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillGroupService.fetchDrillGroups(operatorId);
+  }
+
+  @ApiOperation({
+    summary: 'Update a drill group',
+    description: 'Updates the name and/or drills of a drill group',
+  })
+  @ApiParam({
+    name: 'groupId',
+    description: 'The ID of the drill group to update',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully updated drill group',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Drill group not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Put(':groupId')
+  async updateDrillGroup(
+    @Request() req,
+    @Param('groupId') groupId: string,
+    @Body() body: UpdateDrillGroupDto,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillGroupService.updateDrillGroup(
+      operatorId,
+      new Types.ObjectId(groupId),
+      body,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Delete a drill group',
+    description: 'Deletes a drill group. The drills themselves are unaffected',
+  })
+  @ApiParam({
+    name: 'groupId',
+    description: 'The ID of the drill group to delete',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully deleted drill group',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Drill group not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Delete(':groupId')
+  async deleteDrillGroup(@Request() req, @Param('groupId') groupId: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillGroupService.deleteDrillGroup(
+      operatorId,
+      new Types.ObjectId(groupId),
+    );
+  }
+}
@@ -0,0 +1,360 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { DrillGroup } from './schemas/drill-group.schema';
+import { Drill } from './schemas/drill.schema';
+import { Operator } from 'src/operators/schemas/operator.schema';
+import { DrillVersion } from 'src/common/enums/drill.enum';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class DrillGroupService {
+  private readonly logger = new Logger(DrillGroupService.name);
+
+  constructor(
+    @InjectModel(DrillGroup.name) private drillGroupModel: Model<DrillGroup>,
+    @InjectModel(Drill.name) private drillModel: Model<Drill>,
+    @InjectModel(Operator.name) private operatorModel: Model<Operator>,
+  ) {}
+
+  /**
+   * Creates a new drill group for an operator.
+   */
+  async createDrillGroup(
+    operatorId: Types.ObjectId,
+    name: string,
+    drillIds: string[],
+  ): Promise<ApiResponse<{ drillGroup: DrillGroup } | null>> {
+    try {
+      const groupCount = await this.drillGroupModel.countDocuments({
+        operatorId,
+      });
+
+      if (groupCount >= GAME_CONSTANTS.DRILLS.MAX_DRILL_GROUPS_PER_OPERATOR) {
+        return new ApiResponse(
+          400,
+          `(createDrillGroup) Operator can have at most ${GAME_CONSTANTS.DRILLS.MAX_DRILL_GROUPS_PER_OPERATOR} drill groups.`,
+        );
+      }
+
+      const validation = await this.validateDrillIds(operatorId, drillIds);
+
+      if (validation.error) {
+        return new ApiResponse(400, `(createDrillGroup) ${validation.error}`);
+      }
+
+      const drillGroup = await this.drillGroupModel.create({
+        operatorId,
+        name,
+        drillIds: validation.drillIds,
+      });
+
+      return new ApiResponse(
+        200,
+        `(createDrillGroup) Drill group created successfully.`,
+        { drillGroup },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(createDrillGroup) Error creating drill group: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches all drill groups of an operator.
+   */
+  async fetchDrillGroups(
+    operatorId: Types.ObjectId,
+  ): Promise<ApiResponse<{ drillGroups: DrillGroup[] }>> {
+    try {
+      const drillGroups = await this.drillGroupModel
+        .find({ operatorId })
+        .sort({ createdAt: 1 })
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(fetchDrillGroups) Drill groups fetched successfully.`,
+        { drillGroups },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchDrillGroups) Error fetching drill groups: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Updates the name and/or drills of an operator's drill group.
+   */
+  async updateDrillGroup(
+    operatorId: Types.ObjectId,
+    groupId: Types.ObjectId,
+    updates: { name?: string; drillIds?: string[] },
+  ): Promise<ApiResponse<{ drillGroup: DrillGroup } | null>> {
+    try {
+      const $set: Partial<Pick<DrillGroup, 'name' | 'drillIds'>> = {};
+
+      if (updates.name !== undefined) {
+        $set.name = updates.name;
+      }
+
+      if (updates.drillIds !== undefined) {
+        const validation = await this.validateDrillIds(
+          operatorId,
+          updates.drillIds,
+        );
+
+        if (validation.error) {
+          return new ApiResponse(400, `(updateDrillGroup) ${validation.error}`);
+        }
+
+        $set.drillIds = validation.drillIds;
+      }
+
+      const drillGroup = await this.drillGroupModel
+        .findOneAndUpdate({ _id: groupId, operatorId }, { $set }, { new: true })
+        .lean();
+
+      if (!drillGroup) {
+        return new ApiResponse(
+          404,
+          `(updateDrillGroup) Drill group not found.`,
+        );
+      }
+
+      return new ApiResponse(
+        200,
+        `(updateDrillGroup) Drill group updated successfully.`,
+        { drillGroup },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(updateDrillGroup) Error updating drill group: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Deletes an operator's drill group.
+   */
+  async deleteDrillGroup(
+    operatorId: Types.ObjectId,
+    groupId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const result = await this.drillGroupModel.deleteOne({
+        _id: groupId,
+        operatorId,
+      });
+
+      if (result.deletedCount === 0) {
+        return new ApiResponse(
+          404,
+          `(deleteDrillGroup) Drill group not found.`,
+        );
+      }
+
+      return new ApiResponse(
+        200,
+        `(deleteDrillGroup) Drill group deleted successfully.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(deleteDrillGroup) Error deleting drill group: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Makes the drills of a drill group the operator's active drills.
+   *
+   * Non-basic drills in the group are activated and all other non-basic drills are deactivated.
+   * Basic drills always stay active. Called when an operator starts a drilling session with a drill group.
+   *
+   * Like toggling a drill, applying a group can't switch drills that were toggled in the last `ACTIVE_STATE_TOGGLE_COOLDOWN` seconds.
+   * Drills that keep their state are not affected by the cooldown.
+   */
+  async applyDrillGroup(
+    operatorId: Types.ObjectId,
+    groupId: Types.ObjectId,
+  ): Promise<
+    ApiResponse<{ activatedCount: number; deactivatedCount: number } | null>
+  > {
+    try {
+      const [drillGroup, operator] = await Promise.all([
+        this.drillGroupModel.findOne({ _id: groupId, operatorId }).lean(),
+        this.operatorModel
+          .findById(operatorId, { maxActiveDrillsAllowed: 1 })
+          .lean(),
+      ]);
+
+      if (!drillGroup) {
+        return new ApiResponse(404, `(applyDrillGroup) Drill group not found.`);
+      }
+
+      if (!operator) {
+        return new ApiResponse(404, `(applyDrillGroup) Operator not found.`);
+      }
+
+      // Only consider drills the operator still owns (drills may have been removed since the group was created)
+      const [groupDrills, basicDrillCount] = await Promise.all([
+        this.drillModel
+          .find(
+            {
+              _id: { $in: drillGroup.drillIds },
+              operatorId,
+              version: { $ne: DrillVersion.BASIC },
+            },
+            { _id: 1 },
+          )
+          .lean(),
+        this.drillModel.countDocuments({
+          operatorId,
+          version: DrillVersion.BASIC,
+        }),
+      ]);
+
+      const groupDrillIds = groupDrills.map((drill) => drill._id);
+
+      if (
+        basicDrillCount + groupDrillIds.length >
+        operator.maxActiveDrillsAllowed
+      ) {
+        return new ApiResponse(
+          400,
+          `(applyDrillGroup) Drill group exceeds the max active drill limit of ${operator.maxActiveDrillsAllowed}.`,
+        );
+      }
+
+      const now = new Date();
+      const cooldownCutoff = new Date(
+        now.getTime() -
+          GAME_CONSTANTS.DRILLS.ACTIVE_STATE_TOGGLE_COOLDOWN * 1000,
+      );
+      const drillsToDeactivate = {
+        operatorId,
+        _id: { $nin: groupDrillIds },
+        version: { $ne: DrillVersion.BASIC },
+        active: true,
+      };
+      const drillsToActivate = {
+        _id: { $in: groupDrillIds },
+        active: false,
+        destroyedAt: null,
+      };
+
+      const drillsOnCooldown = await this.drillModel.countDocuments({
+        $or: [drillsToDeactivate, drillsToActivate],
+        lastActiveStateToggle: { $gte: cooldownCutoff },
+      });
+
+      if (drillsOnCooldown > 0) {
+        return new ApiResponse(
+          400,
+          `(applyDrillGroup) ${drillsOnCooldown} drill(s) would change state but have already been toggled in the last ${GAME_CONSTANTS.DRILLS.ACTIVE_STATE_TOGGLE_COOLDOWN / 60 / 60} hours.`,
+        );
+      }
+
+      // Repeat the cooldown in the filters, so drills toggled in the meantime are left as they are
+      const offCooldown = {
+        $or: [
+          { lastActiveStateToggle: null },
+          { lastActiveStateToggle: { $lt: cooldownCutoff } },
+        ],
+      };
+      const [deactivated, activated] = await Promise.all([
+        this.drillModel.updateMany(
+          { ...drillsToDeactivate, ...offCooldown },
+          { $set: { active: false, lastActiveStateToggle: now } },
+        ),
+        this.drillModel.updateMany(
+          { ...drillsToActivate, ...offCooldown },
+          { $set: { active: true, lastActiveStateToggle: now } },
+        ),
+      ]);
+
+      this.logger.log(
+        `🧰 (applyDrillGroup) Applied drill group ${groupId} for operator ${operatorId}: ${activated.modifiedCount} activated, ${deactivated.modifiedCount} deactivated.`,
+      );
+
+      return new ApiResponse(
+        200,
+        `(applyDrillGroup) Drill group applied successfully.`,
+        {
+          activatedCount: activated.modifiedCount,
+          deactivatedCount: deactivated.modifiedCount,
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(applyDrillGroup) Error applying drill group: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Checks that all `drillIds` belong to the operator and that the group fits within the operator's active drill limit.
+   *
+   * Returns the deduplicated drill IDs if valid, or an error message otherwise.
+   */
+  private async validateDrillIds(
+    operatorId: Types.ObjectId,
+    drillIds: string[],
+  ): Promise<{ drillIds: Types.ObjectId[]; error?: string }> {
+    const uniqueDrillIds = [...new Set(drillIds)].map(
+      (drillId) => new Types.ObjectId(drillId),
+    );
+
+    const [ownedDrillCount, operator] = await Promise.all([
+      this.drillModel.countDocuments({
+        _id: { $in: uniqueDrillIds },
+        operatorId,
+      }),
+      this.operatorModel
+        .findById(operatorId, { maxActiveDrillsAllowed: 1 })
+        .lean(),
+    ]);
+
+    if (!operator) {
+      return { drillIds: [], error: 'Operator not found.' };
+    }
+
+    if (ownedDrillCount !== uniqueDrillIds.length) {
+      return {
+        drillIds: [],
+        error: 'One or more drills not found or not owned by operator.',
+      };
+    }
+
+    if (uniqueDrillIds.length > operator.maxActiveDrillsAllowed) {
+      return {
+        drillIds: [],
+        error: `A drill group can contain at most ${operator.maxActiveDrillsAllowed} drills.`,
+      };
+    }
+
+    return { drillIds: uniqueDrillIds };
+  }
+}
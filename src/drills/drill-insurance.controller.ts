@@ -0,0 +1,65 @@
+import {
+  Body,
+  Controller,
+  Param,
+  Post,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { InsureDrillDto } from 'src/common/dto/drill-insurance.dto';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { DrillInsuranceService } from './drill-insurance.service';
+
+@ApiTags('Drill Insurance')
+@Controller('drills')
+export class DrillInsuranceController {
+  constructor(private readonly drillInsuranceService: DrillInsuranceService) {}
+
+  @ApiOperation({
+    summary: 'Insure a drill',
+    description: `Insures a drill for ${GAME_CONSTANTS.DRILLS.INSURANCE.durationDays} days after verifying the TON premium payment. If the drill is destroyed while insured, ${GAME_CONSTANTS.DRILLS.INSURANCE.coveragePercent}% of its purchase cost is paid out in $HASH.`,
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the drill to insure',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully insured drill',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Invalid payment, basic drill or drill already insured',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Drill not found or not owned by operator',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/insure')
+  async insureDrill(
+    @Request() req,
+    @Param('id') drillId: string,
+    @Body() body: InsureDrillDto,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillInsuranceService.insureDrill(
+      operatorId,
+      new Types.ObjectId(drillId),
+      body.address,
+      body.boc,
+    );
+  }
+}
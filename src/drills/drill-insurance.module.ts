@@ -0,0 +1,33 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import {
+  DrillInsurance,
+  DrillInsuranceSchema,
+} from './schemas/drill-insurance.schema';
+import { Drill, DrillSchema } from './schemas/drill.schema';
+import { ShopItem, ShopItemSchema } from 'src/shops/schemas/shop-item.schema';
+import {
+  Operator,
+  OperatorSchema,
+} from 'src/operators/schemas/operator.schema';
+import { TonModule } from 'src/ton/ton.module';
+import { OperatorModule } from 'src/operators/operator.module';
+import { DrillInsuranceService } from './drill-insurance.service';
+import { DrillInsuranceController } from './drill-insurance.controller';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: DrillInsurance.name, schema: DrillInsuranceSchema },
+      { name: Drill.name, schema: DrillSchema },
+      { name: ShopItem.name, schema: ShopItemSchema },
+      { name: Operator.name, schema: OperatorSchema },
+    ]),
+    TonModule,
+    OperatorModule,
+  ],
+  controllers: [DrillInsuranceController], // Expose API endpoints
+  providers: [DrillInsuranceService], // Business logic for drill insurance
+  exports: [MongooseModule, DrillInsuranceService], // Allow usage in other modules
+})
+export class DrillInsuranceModule {}
@@ -0,0 +1,151 @@
+import { Test, TestingModule } from '@nestjs/testing';
+import { getModelToken } from '@nestjs/mongoose';
+import { Types } from 'mongoose';
+import { DrillInsuranceService } from './drill-insurance.service';
+import { DrillInsurance } from './schemas/drill-insurance.schema';
+import { Drill } from './schemas/drill.schema';
+import { ShopItem } from 'src/shops/schemas/shop-item.schema';
+import { Operator } from 'src/operators/schemas/operator.schema';
+import { TonService } from 'src/ton/ton.service';
+import { OperatorService } from 'src/operators/operator.service';
+import { DrillConfig } from 'src/common/enums/drill.enum';
+import { leanResult } from '../../test/utils/mongoose-mocks';
+
+/**
+ * Unit tests for paying out the insurance of destroyed drills
+ */
+describe('DrillInsuranceService', () => {
+  let drillInsuranceService: DrillInsuranceService;
+
+  const operatorId = new Types.ObjectId();
+  const drillId = new Types.ObjectId();
+  const insuranceId = new Types.ObjectId();
+
+  const destroyedDrill = {
+    _id: drillId,
+    operatorId,
+    config: DrillConfig.BULWARK,
+    destroyedAt: new Date(),
+  };
+
+  /**
+   * The single insurance of the destroyed drill. `findOneAndUpdate` only claims it while `claimedAt` is null,
+   * like the atomic claim in MongoDB.
+   */
+  let insurance: {
+    _id: Types.ObjectId;
+    coveragePercent: number;
+    claimedAt: Date | null;
+  };
+
+  const drillInsuranceModel = {
+    findOneAndUpdate: jest.fn(),
+    updateOne: jest.fn(),
+    distinct: jest.fn(),
+  };
+  const drillModel = { find: jest.fn() };
+  const shopItemModel = { findOne: jest.fn() };
+  const operatorService = { addHASH: jest.fn() };
+
+  beforeEach(async () => {
+    jest.clearAllMocks();
+
+    insurance = { _id: insuranceId, coveragePercent: 50, claimedAt: null };
+
+    drillInsuranceModel.findOneAndUpdate.mockImplementation(async () => {
+      if (insurance.claimedAt) {
+        return null;
+      }
+
+      insurance.claimedAt = new Date();
+      return { ...insurance };
+    });
+    drillInsuranceModel.updateOne.mockImplementation(async (_, update) => {
+      insurance.claimedAt = update.$set.claimedAt;
+    });
+    drillInsuranceModel.distinct.mockImplementation(async () =>
+      insurance.claimedAt ? [] : [drillId],
+    );
+    drillModel.find.mockReturnValue(leanResult([destroyedDrill]));
+    shopItemModel.findOne.mockReturnValue(
+      leanResult({ purchaseCost: { ton: 10 } }),
+    );
+    operatorService.addHASH.mockResolvedValue({ success: true });
+
+    const module: TestingModule = await Test.createTestingModule({
+      providers: [
+        DrillInsuranceService,
+        {
+          provide: getModelToken(DrillInsurance.name),
+          useValue: drillInsuranceModel,
+        },
+        { provide: getModelToken(Drill.name), useValue: drillModel },
+        { provide: getModelToken(ShopItem.name), useValue: shopItemModel },
+        { provide: getModelToken(Operator.name), useValue: {} },
+        { provide: TonService, useValue: {} },
+        { provide: OperatorService, useValue: operatorService },
+      ],
+    }).compile();
+
+    drillInsuranceService = module.get(DrillInsuranceService);
+  });
+
+  describe('compensateDestroyedDrill', () => {
+    it('should compensate a destroyed insured drill exactly once', async () => {
+      const [first, second] = await Promise.all([
+        drillInsuranceService.compensateDestroyedDrill(destroyedDrill),
+        drillInsuranceService.compensateDestroyedDrill(destroyedDrill),
+      ]);
+
+      expect([first, second].sort()).toEqual([0, 5]);
+      expect(operatorService.addHASH).toHaveBeenCalledTimes(1);
+      expect(operatorService.addHASH).toHaveBeenCalledWith(
+        operatorId,
+        5,
+        expect.anything(),
+        expect.any(String),
+        insuranceId,
+        'DrillInsurance',
+      );
+    });
+
+    it('should only claim an insurance that was active when the drill got destroyed', async () => {
+      await drillInsuranceService.compensateDestroyedDrill(destroyedDrill);
+
+      expect(drillInsuranceModel.findOneAndUpdate).toHaveBeenCalledWith(
+        expect.objectContaining({
+          drillId,
+          claimedAt: null,
+          createdAt: { $lte: destroyedDrill.destroyedAt },
+          expiresAt: { $gt: destroyedDrill.destroyedAt },
+        }),
+        expect.anything(),
+      );
+    });
+  });
+
+  describe('compensateDestroyedDrills', () => {
+    it('should not compensate a drill again in later cycles', async () => {
+      const first = await drillInsuranceService.compensateDestroyedDrills();
+      const second = await drillInsuranceService.compensateDestroyedDrills();
+
+      expect(first).toEqual({ compensatedDrills: 1, failedDrills: 0 });
+      expect(second).toEqual({ compensatedDrills: 0, failedDrills: 0 });
+      expect(operatorService.addHASH).toHaveBeenCalledTimes(1);
+    });
+
+    it('should retry a failed payout in the next cycle', async () => {
+      operatorService.addHASH.mockResolvedValueOnce({
+        success: false,
+        error: 'Operator not found',
+      });
+
+      const first = await drillInsuranceService.compensateDestroyedDrills();
+      const second = await drillInsuranceService.compensateDestroyedDrills();
+
+      expect(first).toEqual({ compensatedDrills: 0, failedDrills: 1 });
+      expect(second).toEqual({ compensatedDrills: 1, failedDrills: 0 });
+      expect(operatorService.addHASH).toHaveBeenCalledTimes(2);
+    });
+  });
+});
@@ -0,0 +1,289 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { DrillInsurance } from './schemas/drill-insurance.schema';
+import { Drill } from './schemas/drill.schema';
+import { ShopItem } from 'src/shops/schemas/shop-item.schema';
+import { Operator } from 'src/operators/schemas/operator.schema';
+import { DrillConfig, DrillVersion } from 'src/common/enums/drill.enum';
+import { TonService } from 'src/ton/ton.service';
+import { TONPaymentFeature } from 'src/ton/schemas/ton-payment-claim.schema';
+import { OperatorService } from 'src/operators/operator.service';
+import { HashTransactionCategory } from 'src/operators/schemas/hash-transaction.schema';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class DrillInsuranceService {
+  private readonly logger = new Logger(DrillInsuranceService.name);
+
+  constructor(
+    @InjectModel(DrillInsurance.name)
+    private drillInsuranceModel: Model<DrillInsurance>,
+    @InjectModel(Drill.name) private drillModel: Model<Drill>,
+    @InjectModel(ShopItem.name) private shopItemModel: Model<ShopItem>,
+    @InjectModel(Operator.name) private operatorModel: Model<Operator>,
+    private readonly tonService: TonService,
+    private readonly operatorService: OperatorService,
+  ) {}
+
+  /**
+   * Insures a drill after verifying the TON premium payment.
+   *
+   * The premium is `INSURANCE.premiumPct` of the drill's TON purchase cost in the shop.
+   * A drill can only have one active insurance at a time.
+   */
+  async insureDrill(
+    operatorId: Types.ObjectId,
+    drillId: Types.ObjectId,
+    address: string,
+    boc: string,
+  ): Promise<ApiResponse<{ insurance: DrillInsurance } | null>> {
+    try {
+      const drill = await this.drillModel
+        .findOne(
+          { _id: drillId, operatorId },
+          { version: 1, config: 1, destroyedAt: 1 },
+        )
+        .lean();
+
+      if (!drill) {
+        return new ApiResponse(
+          404,
+          `(insureDrill) Drill not found or not owned by operator.`,
+        );
+      }
+
+      if (drill.version === DrillVersion.BASIC) {
+        return new ApiResponse(
+          400,
+          `(insureDrill) Basic drills cannot be insured.`,
+        );
+      }
+
+      if (drill.destroyedAt) {
+        return new ApiResponse(
+          400,
+          `(insureDrill) Destroyed drills cannot be insured.`,
+        );
+      }
+
+      const activeInsurance = await this.drillInsuranceModel.exists({
+        drillId,
+        claimedAt: null,
+        expiresAt: { $gt: new Date() },
+      });
+
+      if (activeInsurance) {
+        return new ApiResponse(
+          400,
+          `(insureDrill) Drill already has an active insurance.`,
+        );
+      }
+
+      const purchaseCostTON = await this.fetchDrillPurchaseCostTON(
+        drill.config,
+      );
+
+      if (!purchaseCostTON) {
+        return new ApiResponse(
+          400,
+          `(insureDrill) Drill has no shop purchase cost to insure.`,
+        );
+      }
+
+      const { premiumPct, coveragePercent, durationDays } =
+        GAME_CONSTANTS.DRILLS.INSURANCE;
+      const premiumTON = (purchaseCostTON * premiumPct) / 100;
+
+      const blockchainData = await this.tonService.verifyTONTransaction(
+        operatorId,
+        address,
+        boc,
+      );
+
+      if (!blockchainData) {
+        return new ApiResponse(
+          400,
+          `(insureDrill) Invalid blockchain transaction.`,
+        );
+      }
+
+      if (blockchainData.txPayload.cost < premiumTON) {
+        return new ApiResponse(
+          400,
+          `(insureDrill) Insufficient premium paid. Expected: ${premiumTON} TON, received: ${blockchainData.txPayload.cost} TON.`,
+        );
+      }
+
+      // Claim the payment before granting anything, so it can't be redeemed twice
+      const claimError = await this.tonService.claimTONPayment(
+        operatorId,
+        blockchainData,
+        TONPaymentFeature.DRILL_INSURANCE,
+        String(drillId),
+      );
+
+      if (claimError) {
+        return new ApiResponse(400, `(insureDrill) ${claimError}`);
+      }
+
+      const insurance = await this.drillInsuranceModel
+        .create({
+          drillId,
+          operatorId,
+          premiumTON: blockchainData.txPayload.cost,
+          coveragePercent,
+          expiresAt: new Date(Date.now() + durationDays * 86_400_000),
+          blockchainData,
+        })
+        .catch(async (err: any) => {
+          await this.tonService.releaseTONPayment(blockchainData.txHash);
+          throw err;
+        });
+
+      await this.operatorModel.updateOne(
+        { _id: operatorId },
+        { $inc: { totalTONSpent: blockchainData.txPayload.cost } },
+      );
+
+      this.logger.log(
+        `🛡️ (insureDrill) Operator ${operatorId} insured drill ${drillId} for ${blockchainData.txPayload.cost} TON.`,
+      );
+
+      return new ApiResponse(200, `(insureDrill) Drill insured successfully.`, {
+        insurance,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(insureDrill) Error insuring drill: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Pays out the insurance of every destroyed drill that was insured when it got destroyed and hasn't been compensated yet.
+   *
+   * Called after each cycle's drill damage step, so payouts that failed are retried in the next cycle.
+   */
+  async compensateDestroyedDrills(): Promise<{
+    compensatedDrills: number;
+    failedDrills: number;
+  }> {
+    const insuredDrillIds: Types.ObjectId[] =
+      await this.drillInsuranceModel.distinct('drillId', { claimedAt: null });
+
+    if (insuredDrillIds.length === 0) {
+      return { compensatedDrills: 0, failedDrills: 0 };
+    }
+
+    const destroyedDrills = await this.drillModel
+      .find(
+        { _id: { $in: insuredDrillIds }, destroyedAt: { $ne: null } },
+        { _id: 1, operatorId: 1, config: 1, destroyedAt: 1 },
+      )
+      .lean();
+
+    let compensatedDrills = 0;
+    let failedDrills = 0;
+    for (const drill of destroyedDrills) {
+      try {
+        if ((await this.compensateDestroyedDrill(drill)) > 0) {
+          compensatedDrills++;
+        }
+      } catch (err: any) {
+        failedDrills++;
+        this.logger.error(
+          `❌ (compensateDestroyedDrills) Error compensating destroyed drill ${drill._id}, retrying next cycle: ${err.message}`,
+        );
+      }
+    }
+
+    return { compensatedDrills, failedDrills };
+  }
+
+  /**
+   * Pays out the insurance of a destroyed drill, if the drill was insured when it got destroyed.
+   *
+   * Credits `purchaseCost * coveragePercent` to the operator's $HASH balance and marks the insurance as claimed,
+   * so a drill is compensated at most once. Returns the compensation paid (0 if the drill wasn't insured).
+   */
+  async compensateDestroyedDrill(
+    drill: Pick<Drill, '_id' | 'operatorId' | 'config' | 'destroyedAt'>,
+  ): Promise<number> {
+    const destroyedAt = drill.destroyedAt || new Date();
+
+    // Atomically claim the insurance to prevent double payouts
+    const insurance = await this.drillInsuranceModel.findOneAndUpdate(
+      {
+        drillId: drill._id,
+        operatorId: drill.operatorId,
+        claimedAt: null,
+        createdAt: { $lte: destroyedAt },
+        expiresAt: { $gt: destroyedAt },
+      },
+      { $set: { claimedAt: new Date() } },
+    );
+
+    if (!insurance) {
+      return 0;
+    }
+
+    const purchaseCost = await this.fetchDrillPurchaseCostTON(drill.config);
+    const compensation = (purchaseCost * insurance.coveragePercent) / 100;
+
+    if (compensation <= 0) {
+      return 0;
+    }
+
+    const credit = await this.operatorService.addHASH(
+      drill.operatorId,
+      compensation,
+      HashTransactionCategory.DRILL_INSURANCE_PAYOUT,
+      `Insurance payout for destroyed drill ${drill._id}`,
+      insurance._id,
+      'DrillInsurance',
+    );
+
+    if (!credit.success) {
+      // Revert the claim so the payout can be retried
+      await this.drillInsuranceModel.updateOne(
+        { _id: insurance._id },
+        { $set: { claimedAt: null } },
+      );
+
+      throw new Error(
+        `(compensateDestroyedDrill) Error paying out insurance ${insurance._id}: ${credit.error}`,
+      );
+    }
+
+    this.logger.log(
+      `🛡️ (compensateDestroyedDrill) Paid out ${compensation} $HASH to operator ${drill.operatorId} for destroyed drill ${drill._id}.`,
+    );
+
+    return compensation;
+  }
+
+  /**
+   * Fetches the TON purchase cost of a drill configuration from the shop.
+   */
+  private async fetchDrillPurchaseCostTON(
+    config: DrillConfig,
+  ): Promise<number> {
+    const shopItem = await this.shopItemModel
+      .findOne(
+        { 'itemEffects.drillData.config': config },
+        { purchaseCost: 1 },
+      )
+      .lean();
+
+    return shopItem?.purchaseCost?.ton || 0;
+  }
+}
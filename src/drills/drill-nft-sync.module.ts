@@ -0,0 +1,21 @@
+import { Module } from '@nestjs/common';
+import { ConfigModule } from '@nestjs/config';
+import { BullModule } from '@nestjs/bull';
+import { DrillNFTSyncService } from './drill-nft-sync.service';
+import { DrillNFTSyncQueue } from './drill-nft-sync.queue';
+
+@Module({
+  imports: [
+    ConfigModule,
+    BullModule.registerQueue({
+      name: 'drill-nft-sync-queue',
+      defaultJobOptions: {
+        removeOnComplete: true, // Remove completed jobs
+        removeOnFail: false, // Keep failed jobs for debugging
+      },
+    }),
+  ],
+  providers: [DrillNFTSyncService, DrillNFTSyncQueue],
+  exports: [DrillNFTSyncService],
+})
+export class DrillNFTSyncModule {}
@@ -0,0 +1,42 @@
+import { Processor, Process, OnGlobalQueueFailed } from '@nestjs/bull';
+import { Job } from 'bull';
+import { Injectable, Logger } from '@nestjs/common';
+import { DrillNFTSyncJob, DrillNFTSyncService } from './drill-nft-sync.service';
+
+@Injectable()
+@Processor('drill-nft-sync-queue')
+export class DrillNFTSyncQueue {
+  private readonly logger = new Logger(DrillNFTSyncQueue.name);
+
+  constructor(private readonly drillNFTSyncService: DrillNFTSyncService) {}
+
+  /**
+   * Updates a drill's on-chain NFT metadata.
+   *
+   * Errors are rethrown so that Bull re-queues the job with exponential backoff.
+   */
+  @Process({
+    name: 'sync-drill-nft',
+    concurrency: 1, // One at a time per instance; instances share a wallet lock
+  })
+  async handleSyncDrillNFT(job: Job<DrillNFTSyncJob>) {
+    try {
+      await this.drillNFTSyncService.updateMetadata(job.data);
+    } catch (error) {
+      this.logger.error(
+        `❌ (sync-drill-nft) Attempt ${job.attemptsMade + 1} failed for drill ${job.data.drillId}: ${error.message}`,
+      );
+      throw error;
+    }
+  }
+
+  /**
+   * Handle failed jobs in the queue.
+   */
+  @OnGlobalQueueFailed()
+  onFailed(jobId: number, err: Error) {
+    this.logger.error(
+      `❌ Drill NFT Sync Queue job ${jobId} has failed: ${err.message}`,
+    );
+  }
+}
@@ -0,0 +1,240 @@
+import { Injectable, Logger } from '@nestjs/common';
+import { ConfigService } from '@nestjs/config';
+import { InjectQueue } from '@nestjs/bull';
+import { Queue } from 'bull';
+import { Types } from 'mongoose';
+import { Address, beginCell, internal, SendMode, toNano } from '@ton/core';
+import { mnemonicToPrivateKey } from '@ton/crypto';
+import { TonClient, WalletContractV4 } from '@ton/ton';
+import { DrillNFTSyncOperation } from 'src/common/enums/drill.enum';
+import { RedisService } from 'src/common/redis.service';
+
+/**
+ * The maximum number of attempts for a single sync job before it's left in the failed set.
+ */
+const MAX_SYNC_ATTEMPTS = 5;
+
+/**
+ * The initial backoff delay (in ms) between sync attempts. Doubles on every retry.
+ */
+const SYNC_BACKOFF_DELAY = 5_000;
+
+/**
+ * How often (in ms) the sync wallet's seqno is polled while waiting for a transfer to be confirmed.
+ */
+const SEQNO_POLL_INTERVAL = 2_000;
+
+/**
+ * How long (in ms) to wait for the sync wallet's seqno to advance before the transfer is considered failed.
+ */
+const SEQNO_CONFIRMATION_TIMEOUT = 60_000;
+
+/**
+ * How long (in seconds) the sync wallet's lock is held at most, in case a worker dies while holding it.
+ *
+ * Must exceed `SEQNO_CONFIRMATION_TIMEOUT`.
+ */
+const WALLET_LOCK_EXPIRY = 90;
+
+/**
+ * A queued request to update a drill's on-chain NFT metadata.
+ */
+export interface DrillNFTSyncJob {
+  /** The database ID of the drill (as a string, since job data is serialized) */
+  drillId: string;
+  /** The address of the drill NFT contract */
+  contractAddress: string;
+  /** The drill lifecycle event that triggered the sync */
+  operation: DrillNFTSyncOperation;
+}
+
+@Injectable()
+export class DrillNFTSyncService {
+  private readonly logger = new Logger(DrillNFTSyncService.name);
+  private tonClient: TonClient | null = null; // ✅ Lazy Initialization
+
+  constructor(
+    private readonly configService: ConfigService,
+    private readonly redisService: RedisService,
+    @InjectQueue('drill-nft-sync-queue')
+    private readonly drillNFTSyncQueue: Queue<DrillNFTSyncJob>,
+  ) {}
+
+  /**
+   * Queues an on-chain metadata update for a drill's NFT.
+   *
+   * Failures are only logged so that the calling flow (e.g. a purchase) isn't interrupted.
+   */
+  async enqueueSync(
+    drillId: Types.ObjectId,
+    operation: DrillNFTSyncOperation,
+  ): Promise<void> {
+    try {
+      const contractAddress = this.configService.get<string>(
+        'DRILL_NFT_CONTRACT_ADDRESS',
+      );
+
+      if (!contractAddress) {
+        this.logger.warn(
+          `⚠️ (enqueueSync) DRILL_NFT_CONTRACT_ADDRESS is not set. Skipping NFT sync for drill ${drillId}.`,
+        );
+        return;
+      }
+
+      await this.drillNFTSyncQueue.add(
+        'sync-drill-nft',
+        {
+          drillId: drillId.toString(),
+          contractAddress,
+          operation,
+        },
+        {
+          attempts: MAX_SYNC_ATTEMPTS,
+          backoff: { type: 'exponential', delay: SYNC_BACKOFF_DELAY },
+        },
+      );
+
+      this.logger.log(
+        `📥 (enqueueSync) Queued ${operation} NFT sync for drill ${drillId}.`,
+      );
+    } catch (err: any) {
+      this.logger.error(
+        `❌ (enqueueSync) Error queueing NFT sync for drill ${drillId}: ${err.message}`,
+      );
+    }
+  }
+
+  /**
+   * Calls `updateMetadata` on the drill NFT contract, pointing the drill's item to its latest metadata URI.
+   *
+   * The op code comes from `DRILL_NFT_UPDATE_METADATA_OP`, which must match the deployed contract.
+   * Transfers from the sync wallet hold a Redis lock (shared by all instances), so that no two transfers use the same seqno.
+   * The job only succeeds once the wallet's seqno advanced, i.e. the transfer was accepted on-chain.
+   *
+   * Throws on failure so that the queue can retry the job.
+   */
+  async updateMetadata(job: DrillNFTSyncJob): Promise<void> {
+    const mnemonic = this.configService.get<string>(
+      'DRILL_NFT_SYNC_WALLET_MNEMONIC',
+    );
+    const metadataBaseUrl = this.configService.get<string>(
+      'DRILL_NFT_METADATA_BASE_URL',
+    );
+    const updateMetadataOp = Number(
+      this.configService.get<string>('DRILL_NFT_UPDATE_METADATA_OP'),
+    );
+
+    if (!mnemonic || !metadataBaseUrl) {
+      throw new Error(
+        '(updateMetadata) DRILL_NFT_SYNC_WALLET_MNEMONIC or DRILL_NFT_METADATA_BASE_URL is not set in the environment variables.',
+      );
+    }
+
+    if (
+      !Number.isInteger(updateMetadataOp) ||
+      updateMetadataOp <= 0 ||
+      updateMetadataOp > 0xffffffff
+    ) {
+      throw new Error(
+        '(updateMetadata) DRILL_NFT_UPDATE_METADATA_OP is not set to a valid 32-bit op code in the environment variables.',
+      );
+    }
+
+    const keyPair = await mnemonicToPrivateKey(mnemonic.split(' '));
+    const wallet = this.getTonClient().open(
+      WalletContractV4.create({ workchain: 0, publicKey: keyPair.publicKey }),
+    );
+
+    const body = beginCell()
+      .storeUint(updateMetadataOp, 32)
+      .storeUint(0, 64) // query ID
+      .storeStringRefTail(job.drillId)
+      .storeStringRefTail(`${metadataBaseUrl}/${job.drillId}.json`)
+      .endCell();
+
+    const lockKey = `drill-nft-sync-lock:${wallet.address.toString()}`;
+    await this.acquireWalletLock(lockKey, job.drillId);
+
+    try {
+      const seqno = await wallet.getSeqno();
+      await wallet.sendTransfer({
+        seqno,
+        secretKey: keyPair.secretKey,
+        sendMode: SendMode.PAY_GAS_SEPARATELY,
+        messages: [
+          internal({
+            to: Address.parse(job.contractAddress),
+            value: toNano('0.05'),
+            bounce: true,
+            body,
+          }),
+        ],
+      });
+
+      // The transfer is only accepted once the wallet's seqno moves past the one it was sent with
+      const deadline = Date.now() + SEQNO_CONFIRMATION_TIMEOUT;
+      while ((await wallet.getSeqno()) <= seqno) {
+        if (Date.now() >= deadline) {
+          throw new Error(
+            `(updateMetadata) Transfer with seqno ${seqno} was not confirmed within ${SEQNO_CONFIRMATION_TIMEOUT / 1000} seconds.`,
+          );
+        }
+
+        await new Promise((resolve) =>
+          setTimeout(resolve, SEQNO_POLL_INTERVAL),
+        );
+      }
+
+      this.logger.log(
+        `✅ (updateMetadata) Confirmed ${job.operation} metadata update for drill ${job.drillId} (seqno ${seqno}).`,
+      );
+    } finally {
+      await this.redisService.del(lockKey);
+    }
+  }
+
+  /**
+   * Waits until the sync wallet's lock is free and takes it.
+   *
+   * Throws if the lock couldn't be taken within `SEQNO_CONFIRMATION_TIMEOUT`, so that the queue retries the job later.
+   */
+  private async acquireWalletLock(lockKey: string, drillId: string) {
+    const deadline = Date.now() + SEQNO_CONFIRMATION_TIMEOUT;
+
+    while (
+      !(await this.redisService.setIfNotExists(
+        lockKey,
+        drillId,
+        WALLET_LOCK_EXPIRY,
+      ))
+    ) {
+      if (Date.now() >= deadline) {
+        throw new Error(
+          `(acquireWalletLock) The sync wallet is still busy with another transfer.`,
+        );
+      }
+
+      await new Promise((resolve) => setTimeout(resolve, SEQNO_POLL_INTERVAL));
+    }
+  }
+
+  /**
+   * Returns the TON HTTP API client, creating it on first use.
+   */
+  private getTonClient(): TonClient {
+    if (!this.tonClient) {
+      const endpoint = this.configService.get<string>('TON_API_ENDPOINT');
+      const apiKey = this.configService.get<string>('TON_API_KEY');
+
+      if (!endpoint || !apiKey) {
+        throw new Error(
+          '(getTonClient) TON_API_ENDPOINT or TON_API_KEY is not set in the environment variables.',
+        );
+      }
+
+      this.tonClient = new TonClient({ endpoint, apiKey });
+    }
+
+    return this.tonClient;
+  }
+}
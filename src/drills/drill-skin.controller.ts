@@ -0,0 +1,109 @@
+import {
+  Body,
+  Controller,
+  Get,
+  Param,
+  Post,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { ApplyDrillSkinDto } from 'src/common/dto/drill-skin.dto';
+import { DrillSkinService } from './drill-skin.service';
+
+@ApiTags('Drill Skins')
+@Controller('drills')
+export class DrillSkinController {
+  constructor(private readonly drillSkinService: DrillSkinService) {}
+
+  @ApiOperation({
+    summary: 'Get drill skins',
+    description:
+      'Fetches all cosmetic skins that can be applied to drills. Skins do not affect drill EFF',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved drill skins',
+  })
+  @Get('skins')
+  async fetchDrillSkins() {
+    return this.drillSkinService.fetchDrillSkins();
+  }
+
+  @ApiOperation({
+    summary: 'Get a drill',
+    description:
+      "Fetches one of the authenticated operator's drills along with its applied skin",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the drill',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved drill',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Drill not found or not owned by operator',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id')
+  async fetchDrill(@Request() req, @Param('id') drillId: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillSkinService.fetchDrill(
+      operatorId,
+      new Types.ObjectId(drillId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Apply a skin to a drill',
+    description:
+      'Applies a cosmetic skin to a drill after verifying the TON payment. Replaces any previously applied skin',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the drill to apply the skin to',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully applied skin',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid payment or skin already applied',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Drill or skin not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/apply-skin')
+  async applyDrillSkin(
+    @Request() req,
+    @Param('id') drillId: string,
+    @Body() body: ApplyDrillSkinDto,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillSkinService.applyDrillSkin(
+      operatorId,
+      new Types.ObjectId(drillId),
+      new Types.ObjectId(body.skinId),
+      body.address,
+      body.boc,
+    );
+  }
+}
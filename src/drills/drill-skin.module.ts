@@ -0,0 +1,31 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { DrillSkin, DrillSkinSchema } from './schemas/drill-skin.schema';
+import {
+  DrillSkinPurchase,
+  DrillSkinPurchaseSchema,
+} from './schemas/drill-skin-purchase.schema';
+import { Drill, DrillSchema } from './schemas/drill.schema';
+import {
+  Operator,
+  OperatorSchema,
+} from 'src/operators/schemas/operator.schema';
+import { TonModule } from 'src/ton/ton.module';
+import { DrillSkinService } from './drill-skin.service';
+import { DrillSkinController } from './drill-skin.controller';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: DrillSkin.name, schema: DrillSkinSchema },
+      { name: DrillSkinPurchase.name, schema: DrillSkinPurchaseSchema },
+      { name: Drill.name, schema: DrillSchema },
+      { name: Operator.name, schema: OperatorSchema },
+    ]),
+    TonModule,
+  ],
+  controllers: [DrillSkinController], // Expose API endpoints
+  providers: [DrillSkinService], // Business logic for drill skins
+  exports: [DrillSkinService], // Allow usage in other modules
+})
+export class DrillSkinModule {}
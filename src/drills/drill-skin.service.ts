@@ -0,0 +1,240 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { DrillSkin } from './schemas/drill-skin.schema';
+import { DrillSkinPurchase } from './schemas/drill-skin-purchase.schema';
+import { Drill } from './schemas/drill.schema';
+import { Operator } from 'src/operators/schemas/operator.schema';
+import { TonService } from 'src/ton/ton.service';
+import { TONPaymentFeature } from 'src/ton/schemas/ton-payment-claim.schema';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class DrillSkinService {
+  private readonly logger = new Logger(DrillSkinService.name);
+
+  constructor(
+    @InjectModel(DrillSkin.name) private drillSkinModel: Model<DrillSkin>,
+    @InjectModel(DrillSkinPurchase.name)
+    private drillSkinPurchaseModel: Model<DrillSkinPurchase>,
+    @InjectModel(Drill.name) private drillModel: Model<Drill>,
+    @InjectModel(Operator.name) private operatorModel: Model<Operator>,
+    private readonly tonService: TonService,
+  ) {}
+
+  /**
+   * Creates a new drill skin (admin only).
+   */
+  async createDrillSkin(
+    name: string,
+    description: string,
+    imageURL: string,
+    priceTON: number,
+  ): Promise<ApiResponse<{ skin: DrillSkin } | null>> {
+    try {
+      const existingSkin = await this.drillSkinModel.exists({ name });
+
+      if (existingSkin) {
+        return new ApiResponse(
+          400,
+          `(createDrillSkin) A skin named ${name} already exists.`,
+        );
+      }
+
+      const skin = await this.drillSkinModel.create({
+        name,
+        description,
+        imageURL,
+        priceTON,
+      });
+
+      this.logger.log(
+        `🎨 (createDrillSkin) Created drill skin ${name} for ${priceTON} TON.`,
+      );
+
+      return new ApiResponse(200, `(createDrillSkin) Drill skin created.`, {
+        skin,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(createDrillSkin) Error creating drill skin: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches all available drill skins.
+   */
+  async fetchDrillSkins(): Promise<ApiResponse<{ skins: DrillSkin[] } | null>> {
+    try {
+      const skins = await this.drillSkinModel
+        .find()
+        .sort({ priceTON: 1 })
+        .lean();
+
+      return new ApiResponse(200, `(fetchDrillSkins) Drill skins fetched.`, {
+        skins,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchDrillSkins) Error fetching drill skins: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches one of the operator's drills along with its applied skin (if any).
+   */
+  async fetchDrill(
+    operatorId: Types.ObjectId,
+    drillId: Types.ObjectId,
+  ): Promise<ApiResponse<{ drill: Drill; skin: DrillSkin | null } | null>> {
+    try {
+      const drill = await this.drillModel
+        .findOne({ _id: drillId, operatorId })
+        .lean();
+
+      if (!drill) {
+        return new ApiResponse(
+          404,
+          `(fetchDrill) Drill not found or not owned by operator.`,
+        );
+      }
+
+      const skin = drill.skinId
+        ? await this.drillSkinModel.findOne({ _id: drill.skinId }).lean()
+        : null;
+
+      return new ApiResponse(200, `(fetchDrill) Drill fetched.`, {
+        drill,
+        skin,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchDrill) Error fetching drill: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Applies a skin to one of the operator's drills after verifying the TON payment.
+   *
+   * Skins are purely cosmetic, so the drill's EFF is left untouched.
+   */
+  async applyDrillSkin(
+    operatorId: Types.ObjectId,
+    drillId: Types.ObjectId,
+    skinId: Types.ObjectId,
+    address: string,
+    boc: string,
+  ): Promise<ApiResponse<{ drillId: Types.ObjectId; skin: DrillSkin } | null>> {
+    try {
+      const [drill, skin] = await Promise.all([
+        this.drillModel
+          .findOne({ _id: drillId, operatorId }, { skinId: 1 })
+          .lean(),
+        this.drillSkinModel.findOne({ _id: skinId }).lean(),
+      ]);
+
+      if (!drill) {
+        return new ApiResponse(
+          404,
+          `(applyDrillSkin) Drill not found or not owned by operator.`,
+        );
+      }
+
+      if (!skin) {
+        return new ApiResponse(404, `(applyDrillSkin) Skin not found.`);
+      }
+
+      if (drill.skinId?.equals(skinId)) {
+        return new ApiResponse(
+          400,
+          `(applyDrillSkin) Skin is already applied to this drill.`,
+        );
+      }
+
+      const blockchainData = await this.tonService.verifyTONTransaction(
+        operatorId,
+        address,
+        boc,
+      );
+
+      if (!blockchainData) {
+        return new ApiResponse(
+          400,
+          `(applyDrillSkin) Invalid blockchain transaction.`,
+        );
+      }
+
+      if (blockchainData.txPayload.cost < skin.priceTON) {
+        return new ApiResponse(
+          400,
+          `(applyDrillSkin) Insufficient payment. Expected: ${skin.priceTON} TON, received: ${blockchainData.txPayload.cost} TON.`,
+        );
+      }
+
+      // Claim the payment before granting anything, so it can't be redeemed twice
+      const claimError = await this.tonService.claimTONPayment(
+        operatorId,
+        blockchainData,
+        TONPaymentFeature.DRILL_SKIN,
+        String(drillId),
+      );
+
+      if (claimError) {
+        return new ApiResponse(400, `(applyDrillSkin) ${claimError}`);
+      }
+
+      await this.drillSkinPurchaseModel
+        .create({
+          drillId,
+          operatorId,
+          skinId,
+          paidTON: blockchainData.txPayload.cost,
+          blockchainData,
+        })
+        .catch(async (err: any) => {
+          await this.tonService.releaseTONPayment(blockchainData.txHash);
+          throw err;
+        });
+
+      await Promise.all([
+        this.drillModel.updateOne({ _id: drillId }, { $set: { skinId } }),
+        this.operatorModel.updateOne(
+          { _id: operatorId },
+          { $inc: { totalTONSpent: blockchainData.txPayload.cost } },
+        ),
+      ]);
+
+      this.logger.log(
+        `🎨 (applyDrillSkin) Operator ${operatorId} applied skin ${skin.name} to drill ${drillId} for ${blockchainData.txPayload.cost} TON.`,
+      );
+
+      return new ApiResponse(200, `(applyDrillSkin) Skin applied to drill.`, {
+        drillId,
+        skin,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(applyDrillSkin) Error applying skin to drill: ${err.message}`,
+        ),
+      );
+    }
+  }
+}
@@ -0,0 +1,109 @@
+import {
+  Body,
+  Controller,
+  Param,
+  Post,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import {
+  UpgradeDrillConfigDto,
+  UpgradeDrillDto,
+} from 'src/common/dto/drill-upgrade.dto';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { DrillUpgradeService } from './drill-upgrade.service';
+
+@ApiTags('Drill Upgrades')
+@Controller('drills')
+export class DrillUpgradeController {
+  constructor(private readonly drillUpgradeService: DrillUpgradeService) {}
+
+  @ApiOperation({
+    summary: 'Upgrade a drill',
+    description: `Upgrades a drill to its next level after verifying the TON payment. Each level adds ${GAME_CONSTANTS.DRILLS.UPGRADE_EFF_INCREASE_PER_LEVEL * 100}% of the drill's base EFF.`,
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the drill to upgrade',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully upgraded drill',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Invalid payment, drill not upgradeable or already at its max level',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Drill not found or not owned by operator',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/upgrade')
+  async upgradeDrill(
+    @Request() req,
+    @Param('id') drillId: string,
+    @Body() body: UpgradeDrillDto,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillUpgradeService.upgradeDrill(
+      operatorId,
+      new Types.ObjectId(drillId),
+      body.address,
+      body.boc,
+    );
+  }
+
+  @ApiOperation({
+    summary: "Upgrade a drill's configuration",
+    description:
+      'Upgrades a drill to the next configuration on its upgrade path (e.g. IRONBORE to BULWARK) after verifying the TON payment. The drill keeps its level and its EFF is recalculated from the new configuration',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the drill to upgrade',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: "Successfully upgraded drill's configuration",
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Invalid payment or the configuration is not next on the upgrade path',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Drill not found or not owned by operator',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/upgrade-config')
+  async upgradeDrillConfig(
+    @Request() req,
+    @Param('id') drillId: string,
+    @Body() body: UpgradeDrillConfigDto,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillUpgradeService.upgradeDrillConfig(
+      operatorId,
+      new Types.ObjectId(drillId),
+      body.config,
+      body.address,
+      body.boc,
+    );
+  }
+}
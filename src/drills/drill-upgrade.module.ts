@@ -0,0 +1,38 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import {
+  DrillUpgrade,
+  DrillUpgradeSchema,
+} from './schemas/drill-upgrade.schema';
+import { Drill, DrillSchema } from './schemas/drill.schema';
+import {
+  DrillEffEvent,
+  DrillEffEventSchema,
+} from './schemas/drill-eff-event.schema';
+import { ShopItem, ShopItemSchema } from 'src/shops/schemas/shop-item.schema';
+import {
+  Operator,
+  OperatorSchema,
+} from 'src/operators/schemas/operator.schema';
+import { TonModule } from 'src/ton/ton.module';
+import { DrillNFTSyncModule } from './drill-nft-sync.module';
+import { DrillUpgradeService } from './drill-upgrade.service';
+import { DrillUpgradeController } from './drill-upgrade.controller';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: DrillUpgrade.name, schema: DrillUpgradeSchema },
+      { name: Drill.name, schema: DrillSchema },
+      { name: ShopItem.name, schema: ShopItemSchema },
+      { name: Operator.name, schema: OperatorSchema },
+      { name: DrillEffEvent.name, schema: DrillEffEventSchema },
+    ]),
+    TonModule,
+    DrillNFTSyncModule,
+  ],
+  controllers: [DrillUpgradeController], // Expose API endpoints
+  providers: [DrillUpgradeService], // Business logic for drill upgrades
+  exports: [MongooseModule, DrillUpgradeService], // Allow usage in other modules
+})
+export class DrillUpgradeModule {}
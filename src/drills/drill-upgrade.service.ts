@@ -0,0 +1,396 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { Drill } from './schemas/drill.schema';
+import {
+  DrillUpgrade,
+  DrillUpgradeType,
+} from './schemas/drill-upgrade.schema';
+import {
+  DrillEffEvent,
+  DrillEffEventReason,
+} from './schemas/drill-eff-event.schema';
+import { ShopItem } from 'src/shops/schemas/shop-item.schema';
+import { Operator } from 'src/operators/schemas/operator.schema';
+import { TonService } from 'src/ton/ton.service';
+import { TONPaymentFeature } from 'src/ton/schemas/ton-payment-claim.schema';
+import { DrillNFTSyncService } from './drill-nft-sync.service';
+import {
+  DrillConfig,
+  DrillNFTSyncOperation,
+} from 'src/common/enums/drill.enum';
+import { ApiResponse } from 'src/common/dto/response.dto';
+import {
+  drillConfigUpgradeCostTON,
+  drillEffAtLevel,
+  drillUpgradeCostTON,
+  scaleDrillEff,
+} from 'src/common/utils/drill';
+
+@Injectable()
+export class DrillUpgradeService {
+  private readonly logger = new Logger(DrillUpgradeService.name);
+
+  constructor(
+    @InjectModel(DrillUpgrade.name)
+    private drillUpgradeModel: Model<DrillUpgrade>,
+    @InjectModel(Drill.name) private drillModel: Model<Drill>,
+    @InjectModel(ShopItem.name) private shopItemModel: Model<ShopItem>,
+    @InjectModel(Operator.name) private operatorModel: Model<Operator>,
+    @InjectModel(DrillEffEvent.name)
+    private drillEffEventModel: Model<DrillEffEvent>,
+    private readonly tonService: TonService,
+    private readonly drillNFTSyncService: DrillNFTSyncService,
+  ) {}
+
+  /**
+   * Upgrades a drill to its next level after verifying the TON payment.
+   *
+   * The max level and the cost of each level come from the drill's shop entry. The drill's EFF is scaled by how much
+   * its full EFF grows with the new level (see `drillEffAtLevel`), so any decay or damage is kept.
+   */
+  async upgradeDrill(
+    operatorId: Types.ObjectId,
+    drillId: Types.ObjectId,
+    address: string,
+    boc: string,
+  ): Promise<ApiResponse<{ level: number; actualEff: number } | null>> {
+    try {
+      const drill = await this.drillModel
+        .findOne(
+          { _id: drillId, operatorId },
+          { config: 1, level: 1, actualEff: 1, active: 1 },
+        )
+        .lean();
+
+      if (!drill) {
+        return new ApiResponse(
+          404,
+          `(upgradeDrill) Drill not found or not owned by operator.`,
+        );
+      }
+
+      const shopItem = await this.shopItemModel
+        .findOne(
+          { 'itemEffects.drillData.config': drill.config },
+          { 'itemEffects.drillData': 1 },
+        )
+        .lean();
+      const drillData = shopItem?.itemEffects?.drillData;
+
+      if (!drillData) {
+        return new ApiResponse(
+          400,
+          `(upgradeDrill) ${drill.config} drills can't be upgraded.`,
+        );
+      }
+
+      const currentLevel = drill.level || 1;
+      const costTON = drillUpgradeCostTON(drillData, currentLevel);
+
+      if (costTON === null) {
+        return new ApiResponse(
+          400,
+          `(upgradeDrill) Drill is already at its max level (${currentLevel}).`,
+        );
+      }
+
+      const blockchainData = await this.tonService.verifyTONTransaction(
+        operatorId,
+        address,
+        boc,
+      );
+
+      if (!blockchainData) {
+        return new ApiResponse(
+          400,
+          `(upgradeDrill) Invalid blockchain transaction.`,
+        );
+      }
+
+      if (blockchainData.txPayload.cost < costTON) {
+        return new ApiResponse(
+          400,
+          `(upgradeDrill) Insufficient upgrade cost paid. Expected: ${costTON} TON, received: ${blockchainData.txPayload.cost} TON.`,
+        );
+      }
+
+      // Claim the payment before granting anything, so it can't be redeemed twice
+      const claimError = await this.tonService.claimTONPayment(
+        operatorId,
+        blockchainData,
+        TONPaymentFeature.DRILL_UPGRADE,
+        String(drillId),
+      );
+
+      if (claimError) {
+        return new ApiResponse(400, `(upgradeDrill) ${claimError}`);
+      }
+
+      const newLevel = currentLevel + 1;
+      // Scale the current EFF so any decay or damage carries over to the new level
+      const newEff = scaleDrillEff(
+        drill.actualEff,
+        drillEffAtLevel(drillData.baseEff, currentLevel),
+        drillEffAtLevel(drillData.baseEff, newLevel),
+      );
+
+      const upgrade = await this.drillUpgradeModel
+        .create({
+          drillId,
+          operatorId,
+          level: newLevel,
+          previousEff: drill.actualEff,
+          newEff,
+          costTON: blockchainData.txPayload.cost,
+          blockchainData,
+        })
+        .catch(async (err: any) => {
+          await this.tonService.releaseTONPayment(blockchainData.txHash);
+          throw err;
+        });
+
+      // Only upgrade the drill if it's still at the level and EFF the upgrade was calculated for
+      // (drills created before levels existed have no `level` yet)
+      const upgradedDrill = await this.drillModel.findOneAndUpdate(
+        {
+          _id: drillId,
+          operatorId,
+          level: currentLevel === 1 ? { $in: [1, null] } : currentLevel,
+          actualEff: drill.actualEff,
+        },
+        { $set: { level: newLevel, actualEff: newEff } },
+        { projection: { _id: 1 } },
+      );
+
+      if (!upgradedDrill) {
+        // Free up the payment so the operator can retry
+        await this.drillUpgradeModel.deleteOne({ _id: upgrade._id });
+        await this.tonService.releaseTONPayment(blockchainData.txHash);
+
+        return new ApiResponse(
+          400,
+          `(upgradeDrill) Drill changed during the upgrade. Please try again.`,
+        );
+      }
+
+      await this.operatorModel.updateOne(
+        { _id: operatorId },
+        {
+          $inc: {
+            totalTONSpent: blockchainData.txPayload.cost,
+            ...(drill.active && { cumulativeEff: newEff - drill.actualEff }),
+          },
+        },
+      );
+
+      // Sync the drill's NFT metadata on-chain in the background
+      await this.drillNFTSyncService.enqueueSync(
+        drillId,
+        DrillNFTSyncOperation.UPGRADE,
+      );
+
+      this.logger.log(
+        `⬆️ (upgradeDrill) Operator ${operatorId} upgraded drill ${drillId} to level ${newLevel} (EFF ${drill.actualEff} -> ${newEff}) for ${blockchainData.txPayload.cost} TON.`,
+      );
+
+      return new ApiResponse(200, `(upgradeDrill) Drill upgraded.`, {
+        level: newLevel,
+        actualEff: newEff,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(upgradeDrill) Error upgrading drill: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Upgrades a drill to the next configuration on its upgrade path (e.g. IRONBORE -> BULWARK) after verifying the TON payment.
+   *
+   * The upgrade path and its cost come from the drill's current shop entry (`upgradesToConfig` and `configUpgradeCostTON`),
+   * so `targetConfig` must be the next configuration on the path; skipping ahead is rejected.
+   * The drill keeps its level, and its EFF is scaled by how much its full EFF grows with the new configuration's base EFF
+   * (see `drillEffAtLevel`), so any decay or damage is kept.
+   */
+  async upgradeDrillConfig(
+    operatorId: Types.ObjectId,
+    drillId: Types.ObjectId,
+    targetConfig: DrillConfig,
+    address: string,
+    boc: string,
+  ): Promise<ApiResponse<{ config: DrillConfig; actualEff: number } | null>> {
+    try {
+      const drill = await this.drillModel
+        .findOne(
+          { _id: drillId, operatorId },
+          { config: 1, level: 1, actualEff: 1, active: 1 },
+        )
+        .lean();
+
+      if (!drill) {
+        return new ApiResponse(
+          404,
+          `(upgradeDrillConfig) Drill not found or not owned by operator.`,
+        );
+      }
+
+      const [currentShopItem, targetShopItem] = await Promise.all([
+        this.shopItemModel
+          .findOne(
+            { 'itemEffects.drillData.config': drill.config },
+            { 'itemEffects.drillData': 1 },
+          )
+          .lean(),
+        this.shopItemModel
+          .findOne(
+            { 'itemEffects.drillData.config': targetConfig },
+            { 'itemEffects.drillData': 1 },
+          )
+          .lean(),
+      ]);
+      const currentDrillData = currentShopItem?.itemEffects?.drillData;
+      const targetDrillData = targetShopItem?.itemEffects?.drillData;
+
+      const costTON = currentDrillData
+        ? drillConfigUpgradeCostTON(currentDrillData, targetConfig)
+        : null;
+
+      if (costTON === null || !currentDrillData || !targetDrillData) {
+        return new ApiResponse(
+          400,
+          `(upgradeDrillConfig) ${drill.config} drills can't be upgraded to ${targetConfig}. Next on the upgrade path: ${currentDrillData?.upgradesToConfig || 'none'}.`,
+        );
+      }
+
+      const blockchainData = await this.tonService.verifyTONTransaction(
+        operatorId,
+        address,
+        boc,
+      );
+
+      if (!blockchainData) {
+        return new ApiResponse(
+          400,
+          `(upgradeDrillConfig) Invalid blockchain transaction.`,
+        );
+      }
+
+      if (blockchainData.txPayload.cost < costTON) {
+        return new ApiResponse(
+          400,
+          `(upgradeDrillConfig) Insufficient upgrade cost paid. Expected: ${costTON} TON, received: ${blockchainData.txPayload.cost} TON.`,
+        );
+      }
+
+      // Claim the payment before granting anything, so it can't be redeemed twice
+      const claimError = await this.tonService.claimTONPayment(
+        operatorId,
+        blockchainData,
+        TONPaymentFeature.DRILL_CONFIG_UPGRADE,
+        String(drillId),
+      );
+
+      if (claimError) {
+        return new ApiResponse(400, `(upgradeDrillConfig) ${claimError}`);
+      }
+
+      const level = drill.level || 1;
+      // Scale the current EFF so any decay or damage carries over to the new configuration
+      const newEff = scaleDrillEff(
+        drill.actualEff,
+        drillEffAtLevel(currentDrillData.baseEff, level),
+        drillEffAtLevel(targetDrillData.baseEff, level),
+      );
+
+      const upgrade = await this.drillUpgradeModel
+        .create({
+          drillId,
+          operatorId,
+          type: DrillUpgradeType.CONFIG,
+          level,
+          previousConfig: drill.config,
+          config: targetConfig,
+          previousEff: drill.actualEff,
+          newEff,
+          costTON: blockchainData.txPayload.cost,
+          blockchainData,
+        })
+        .catch(async (err: any) => {
+          await this.tonService.releaseTONPayment(blockchainData.txHash);
+          throw err;
+        });
+
+      // Only upgrade the drill if it's still at the configuration and EFF the upgrade was calculated for
+      const upgradedDrill = await this.drillModel.findOneAndUpdate(
+        {
+          _id: drillId,
+          operatorId,
+          config: drill.config,
+          actualEff: drill.actualEff,
+        },
+        { $set: { config: targetConfig, actualEff: newEff } },
+        { projection: { _id: 1 } },
+      );
+
+      if (!upgradedDrill) {
+        // Free up the payment so the operator can retry
+        await this.drillUpgradeModel.deleteOne({ _id: upgrade._id });
+        await this.tonService.releaseTONPayment(blockchainData.txHash);
+
+        return new ApiResponse(
+          400,
+          `(upgradeDrillConfig) Drill changed during the upgrade. Please try again.`,
+        );
+      }
+
+      await Promise.all([
+        this.operatorModel.updateOne(
+          { _id: operatorId },
+          {
+            $inc: {
+              totalTONSpent: blockchainData.txPayload.cost,
+              ...(drill.active && { cumulativeEff: newEff - drill.actualEff }),
+            },
+          },
+        ),
+        this.drillEffEventModel.create({
+          drillId,
+          operatorId,
+          reason: DrillEffEventReason.CONFIG_UPGRADE,
+          previousEff: drill.actualEff,
+          newEff,
+        }),
+      ]);
+
+      // Sync the drill's NFT metadata on-chain in the background
+      await this.drillNFTSyncService.enqueueSync(
+        drillId,
+        DrillNFTSyncOperation.UPGRADE,
+      );
+
+      this.logger.log(
+        `⬆️ (upgradeDrillConfig) Operator ${operatorId} upgraded drill ${drillId} from ${drill.config} to ${targetConfig} (EFF ${drill.actualEff} -> ${newEff}) for ${blockchainData.txPayload.cost} TON.`,
+      );
+
+      return new ApiResponse(200, `(upgradeDrillConfig) Drill upgraded.`, {
+        config: targetConfig,
+        actualEff: newEff,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(upgradeDrillConfig) Error upgrading drill: ${err.message}`,
+        ),
+      );
+    }
+  }
+}
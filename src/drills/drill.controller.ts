@@ -1,17 +1,33 @@
 import {
+  BadRequestException,
   Body,
   Controller,
+  Get,
+  Param,
   Post,
+  Query,
   Request,
   UnauthorizedException,
   UseGuards,
 } from '@nestjs/common';
 import { DrillService } from './drill.service';
 import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
-import { ApiBearerAuth } from '@nestjs/swagger';
-import { Types } from 'mongoose';
-import { DrillConfig, DrillVersion } from 'src/common/enums/drill.enum';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+} from '@nestjs/swagger';
+import { isValidObjectId, Types } from 'mongoose';
+import {
+  DrillConfig,
+  DrillRarity,
+  DrillVersion,
+} from 'src/common/enums/drill.enum';
+import { drillRarity } from 'src/common/utils/drill';
+import { ApiResponse as AppApiResponse } from 'src/common/dto/response.dto';
 import { ConfigService } from '@nestjs/config';
+import { GetOperatorDrillsQueryDto } from 'src/common/dto/drill.dto';
 
 @Controller('drills')
 export class DrillController {
@@ -47,6 +63,175 @@ export class DrillController {
     );
   }
 
+  /**
+   * * Assigns a drill to an operator, as long as it keeps the operator within their max EFF allowed. Admin-only.
+   */
+  @Post('admin-assign')
+  async assignDrillToOperatorAdmin(
+    @Body('password') adminPassword: string,
+    @Body('operatorId') operatorId: string,
+    @Body('drillId') drillId: string,
+  ) {
+    if (adminPassword !== this.configService.get('ADMIN_PASSWORD')) {
+      throw new UnauthorizedException(
+        `(assignDrillToOperatorAdmin) Invalid admin password.`,
+      );
+    }
+
+    return this.drillService.assignDrillToOperator(
+      new Types.ObjectId(operatorId),
+      new Types.ObjectId(drillId),
+    );
+  }
+
+  @ApiOperation({
+    summary: "Get an operator's drills",
+    description:
+      "Fetches a paginated list of an operator's drills with their degradation and active insurance, optionally sorted and filtered",
+  })
+  @ApiParam({
+    name: 'operatorId',
+    description: 'The ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched operator drills',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid sort, filter or pagination parameters',
+  })
+  @Get('operator/:operatorId')
+  async fetchOperatorDrills(
+    @Param('operatorId') operatorId: string,
+    @Query() query: GetOperatorDrillsQueryDto,
+  ) {
+    return this.drillService.fetchOperatorDrills(
+      new Types.ObjectId(operatorId),
+      {
+        sort: query.sort,
+        extractorAllowed: query.extractorAllowed,
+        config: query.config,
+      },
+      query.page,
+      query.limit,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get drill configurations',
+    description: 'Fetches all drill configurations with their rarity',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched the drill configurations',
+  })
+  @Get('configs')
+  fetchDrillConfigs(): AppApiResponse<{
+    configs: Array<{ config: DrillConfig; rarity: DrillRarity }>;
+  }> {
+    return new AppApiResponse(
+      200,
+      `(fetchDrillConfigs) Drill configurations fetched.`,
+      {
+        configs: Object.values(DrillConfig).map((config) => ({
+          config,
+          rarity: drillRarity(config),
+        })),
+      },
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get drill versions',
+    description: 'Fetches all drill versions',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched the drill versions',
+  })
+  @Get('versions')
+  fetchDrillVersions(): AppApiResponse<{ versions: DrillVersion[] }> {
+    return new AppApiResponse(
+      200,
+      `(fetchDrillVersions) Drill versions fetched.`,
+      { versions: Object.values(DrillVersion) },
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get the drill catalog',
+    description:
+      "Fetches the drills for sale in the shop with their configuration's rarity. Rarer drills cost more: `purchaseCost` is the base cost scaled by the rarity multiplier. `minAssetEquityRequired` is the asset equity (in USD) needed to buy the drill (null if anyone can)",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched the drill catalog',
+  })
+  @Get('catalog')
+  async fetchDrillCatalog() {
+    return this.drillService.fetchDrillCatalog();
+  }
+
+  @ApiOperation({
+    summary: "Get an operator's drill maintenance schedule",
+    description:
+      "Fetches an operator's drills sorted by degradation, with each drill's maintenance cost and when it's projected to reach critical degradation",
+  })
+  @ApiParam({
+    name: 'operatorId',
+    description: 'The ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched the drill maintenance schedule',
+  })
+  @Get('operator/:operatorId/maintenance-schedule')
+  async fetchDrillMaintenanceSchedule(
+    @Param('operatorId') operatorId: string,
+  ) {
+    return this.drillService.fetchDrillMaintenanceSchedule(
+      new Types.ObjectId(operatorId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get a drill',
+    description:
+      "Fetches one of the authenticated operator's drills with its active insurance",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the drill',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched drill',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Drill not found or not owned by operator',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id')
+  async fetchOperatorDrill(@Request() req, @Param('id') drillId: string) {
+    if (!isValidObjectId(drillId)) {
+      throw new BadRequestException(
+        `(fetchOperatorDrill) Invalid drill ID provided: ${drillId}`,
+      );
+    }
+
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.drillService.fetchOperatorDrill(
+      operatorId,
+      new Types.ObjectId(drillId),
+    );
+  }
+
   @ApiBearerAuth()
   @UseGuards(JwtAuthGuard)
   @Post('toggle-active')
@@ -11,6 +11,25 @@ import {
   DrillingSession,
   DrillingSessionSchema,
 } from './schemas/drilling-session.schema';
+import {
+  DrillCycleParticipation,
+  DrillCycleParticipationSchema,
+} from './schemas/drill-cycle-participation.schema';
+import { DrillGroup, DrillGroupSchema } from './schemas/drill-group.schema';
+import { DrillGroupService } from './drill-group.service';
+import { DrillGroupController } from './drill-group.controller';
+import {
+  DrillEffEvent,
+  DrillEffEventSchema,
+} from './schemas/drill-eff-event.schema';
+import { BullModule } from '@nestjs/bull';
+import { DrillQueue } from './drill.queue';
+import { DrillNFTSyncModule } from './drill-nft-sync.module';
+import {
+  DrillInsurance,
+  DrillInsuranceSchema,
+} from './schemas/drill-insurance.schema';
+import { ShopItem, ShopItemSchema } from 'src/shops/schemas/shop-item.schema';
 
 @Module({
   imports: [
@@ -18,10 +37,27 @@ import {
       { name: Drill.name, schema: DrillSchema },
       { name: DrillingSession.name, schema: DrillingSessionSchema },
       { name: Operator.name, schema: OperatorSchema },
+      {
+        name: DrillCycleParticipation.name,
+        schema: DrillCycleParticipationSchema,
+      },
+      { name: DrillGroup.name, schema: DrillGroupSchema },
+      { name: DrillEffEvent.name, schema: DrillEffEventSchema },
+      { name: DrillInsurance.name, schema: DrillInsuranceSchema },
+      { name: ShopItem.name, schema: ShopItemSchema },
     ]),
+    BullModule.registerQueue({
+      name: 'drill-queue',
+      defaultJobOptions: {
+        attempts: 3, // Retry failed jobs 3 times
+        removeOnComplete: true, // Remove completed jobs
+        removeOnFail: false, // Keep failed jobs for debugging
+      },
+    }),
+    DrillNFTSyncModule,
   ],
-  providers: [DrillService],
-  exports: [MongooseModule, DrillService],
-  controllers: [DrillController],
+  providers: [DrillService, DrillGroupService, DrillQueue],
+  exports: [MongooseModule, DrillService, DrillGroupService],
+  controllers: [DrillController, DrillGroupController],
 })
 export class DrillModule {}
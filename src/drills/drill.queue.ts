@@ -0,0 +1,77 @@
+import {
+  Processor,
+  Process,
+  InjectQueue,
+  OnGlobalQueueFailed,
+} from '@nestjs/bull';
+import { Queue } from 'bull';
+import { Injectable, Logger, OnModuleInit } from '@nestjs/common';
+import { DrillService } from './drill.service';
+
+@Injectable()
+@Processor('drill-queue')
+export class DrillQueue implements OnModuleInit {
+  private readonly logger = new Logger(DrillQueue.name);
+  private readonly oneDayInMs = 24 * 60 * 60 * 1000; // 24 hours
+
+  constructor(
+    private readonly drillService: DrillService,
+    @InjectQueue('drill-queue') private readonly drillQueue: Queue,
+  ) {}
+
+  /**
+   * Called when the module initializes.
+   */
+  async onModuleInit() {
+    // ✅ Schedule Inactivity Decay (Every 24 Hours)
+    await this.ensureJobScheduled('apply-inactivity-decay', this.oneDayInMs);
+  }
+
+  /**
+   * Ensures a Bull job is scheduled, preventing duplicates.
+   */
+  private async ensureJobScheduled(jobName: string, intervalMs: number) {
+    const existingJobs = await this.drillQueue.getRepeatableJobs();
+    if (!existingJobs.some((job) => job.name === jobName)) {
+      await this.drillQueue.add(
+        jobName,
+        {},
+        {
+          repeat: { every: intervalMs },
+          removeOnComplete: true,
+          removeOnFail: false,
+        },
+      );
+      this.logger.log(
+        `✅ (drillQueue) Scheduled job: ${jobName} every ${intervalMs / 1000 / 60} minutes.`,
+      );
+    } else {
+      this.logger.log(`🔄 (drillQueue) Job already scheduled: ${jobName}.`);
+    }
+  }
+
+  /**
+   * Decays the EFF of drills owned by inactive operators (runs **every day**).
+   */
+  @Process({
+    name: 'apply-inactivity-decay',
+    concurrency: 1, // Limit to one concurrent job at a time
+  })
+  async handleInactivityDecay() {
+    try {
+      await this.drillService.applyInactivityDecay();
+    } catch (error) {
+      this.logger.error(
+        `❌ (apply-inactivity-decay) Error applying inactivity decay: ${error.message}`,
+      );
+    }
+  }
+
+  /**
+   * Handle failed jobs in the queue.
+   */
+  @OnGlobalQueueFailed()
+  onFailed(jobId: number, err: Error) {
+    this.logger.error(`❌ Drill Queue job ${jobId} has failed: ${err.message}`);
+  }
+}
@@ -0,0 +1,153 @@
+import { Test, TestingModule } from '@nestjs/testing';
+import { getModelToken } from '@nestjs/mongoose';
+import { Types } from 'mongoose';
+import { DrillService } from './drill.service';
+import { Drill } from './schemas/drill.schema';
+import { DrillingSession } from './schemas/drilling-session.schema';
+import { DrillCycleParticipation } from './schemas/drill-cycle-participation.schema';
+import { DrillEffEvent } from './schemas/drill-eff-event.schema';
+import { DrillInsurance } from './schemas/drill-insurance.schema';
+import { DrillNFTSyncService } from './drill-nft-sync.service';
+import { Operator } from 'src/operators/schemas/operator.schema';
+import { ShopItem } from 'src/shops/schemas/shop-item.schema';
+import { RedisService } from 'src/common/redis.service';
+import {
+  DrillNFTSyncOperation,
+  DrillVersion,
+} from 'src/common/enums/drill.enum';
+import { leanResult } from '../../test/utils/mongoose-mocks';
+
+/**
+ * Unit tests for assigning drills to operators
+ */
+describe('DrillService', () => {
+  let drillService: DrillService;
+
+  const operatorId = new Types.ObjectId();
+  const previousOwnerId = new Types.ObjectId();
+  const drillId = new Types.ObjectId();
+
+  const drillModel = {
+    findById: jest.fn(),
+    aggregate: jest.fn(),
+    findOneAndUpdate: jest.fn(),
+  };
+  const operatorModel = { findById: jest.fn() };
+  const redisService = {
+    setIfNotExists: jest.fn(),
+    del: jest.fn(),
+  };
+  const drillNFTSyncService = { enqueueSync: jest.fn() };
+
+  /**
+   * Sets up an operator with `assetEquity` (a max EFF of `assetEquity * 100`) whose drills add up to `currentEff`,
+   * and an inactive premium drill with `drillEff` owned by another operator.
+   */
+  const givenAssignment = (
+    assetEquity: number,
+    currentEff: number,
+    drillEff: number,
+  ) => {
+    operatorModel.findById.mockReturnValue(
+      leanResult({ _id: operatorId, assetEquity }),
+    );
+    drillModel.findById.mockReturnValue(
+      leanResult({
+        _id: drillId,
+        operatorId: previousOwnerId,
+        version: DrillVersion.PREMIUM,
+        active: false,
+        actualEff: drillEff,
+      }),
+    );
+    drillModel.aggregate.mockResolvedValue([
+      { _id: operatorId, totalDrillEff: currentEff },
+    ]);
+  };
+
+  beforeEach(async () => {
+    jest.clearAllMocks();
+    redisService.setIfNotExists.mockResolvedValue(true);
+    drillModel.findOneAndUpdate.mockResolvedValue({ _id: drillId });
+
+    const module: TestingModule = await Test.createTestingModule({
+      providers: [
+        DrillService,
+        { provide: getModelToken(Drill.name), useValue: drillModel },
+        { provide: getModelToken(Operator.name), useValue: operatorModel },
+        { provide: getModelToken(DrillingSession.name), useValue: {} },
+        { provide: getModelToken(DrillCycleParticipation.name), useValue: {} },
+        { provide: getModelToken(DrillEffEvent.name), useValue: {} },
+        { provide: getModelToken(DrillInsurance.name), useValue: {} },
+        { provide: getModelToken(ShopItem.name), useValue: {} },
+        { provide: DrillNFTSyncService, useValue: drillNFTSyncService },
+        { provide: RedisService, useValue: redisService },
+      ],
+    }).compile();
+
+    drillService = module.get(DrillService);
+  });
+
+  describe('assignDrillToOperator', () => {
+    it('should reject a drill that would exceed the max EFF allowed', async () => {
+      givenAssignment(100, 9_000, 1_500);
+
+      const response = await drillService.assignDrillToOperator(
+        operatorId,
+        drillId,
+      );
+
+      expect(response.status).toBe(400);
+      expect(drillModel.findOneAndUpdate).not.toHaveBeenCalled();
+      expect(drillNFTSyncService.enqueueSync).not.toHaveBeenCalled();
+    });
+
+    it('should assign a drill that reaches exactly the max EFF allowed', async () => {
+      givenAssignment(100, 9_000, 1_000);
+
+      const response = await drillService.assignDrillToOperator(
+        operatorId,
+        drillId,
+      );
+
+      expect(response.status).toBe(200);
+      expect(response.data).toEqual({
+        totalDrillEff: 10_000,
+        maxEffAllowed: 10_000,
+      });
+      expect(drillModel.findOneAndUpdate).toHaveBeenCalledWith(
+        { _id: drillId, operatorId: previousOwnerId, active: false },
+        { $set: { operatorId, lastActiveStateToggle: null } },
+        { projection: { _id: 1 } },
+      );
+      expect(drillNFTSyncService.enqueueSync).toHaveBeenCalledWith(
+        drillId,
+        DrillNFTSyncOperation.TRANSFER,
+      );
+    });
+
+    it('should reject assignments while another one holds the lock', async () => {
+      givenAssignment(100, 0, 1_000);
+      redisService.setIfNotExists.mockResolvedValue(false);
+
+      const response = await drillService.assignDrillToOperator(
+        operatorId,
+        drillId,
+      );
+
+      expect(response.status).toBe(400);
+      expect(drillModel.findOneAndUpdate).not.toHaveBeenCalled();
+      expect(redisService.del).not.toHaveBeenCalled();
+    });
+
+    it('should release the lock after a rejected assignment', async () => {
+      givenAssignment(100, 9_000, 1_500);
+
+      await drillService.assignDrillToOperator(operatorId, drillId);
+
+      expect(redisService.del).toHaveBeenCalledWith(
+        `drill-assign-lock:${operatorId}`,
+      );
+    });
+  });
+});
@@ -11,11 +11,34 @@ import { InjectModel } from '@nestjs/mongoose';
 import { Model, Types } from 'mongoose';
 import mongoose from 'mongoose';
 import { Drill } from './schemas/drill.schema';
-import { DrillConfig, DrillVersion } from 'src/common/enums/drill.enum';
+import {
+  DrillConfig,
+  DrillNFTSyncOperation,
+  DrillRarity,
+  DrillVersion,
+} from 'src/common/enums/drill.enum';
+import { DrillNFTSyncService } from './drill-nft-sync.service';
 import { Operator } from 'src/operators/schemas/operator.schema';
 import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
 import { ApiResponse } from 'src/common/dto/response.dto';
 import { DrillingSession } from './schemas/drilling-session.schema';
+import { DrillCycleParticipation } from './schemas/drill-cycle-participation.schema';
+import {
+  DrillEffEvent,
+  DrillEffEventReason,
+} from './schemas/drill-eff-event.schema';
+import { RedisService } from 'src/common/redis.service';
+import { selectWeightedExtractor } from 'src/common/utils/extractor';
+import { DrillInsurance } from './schemas/drill-insurance.schema';
+import { DrillSortOption } from 'src/common/dto/drill.dto';
+import { ShopItem } from 'src/shops/schemas/shop-item.schema';
+import { equityToMaxEff } from 'src/common/utils/equity';
+import {
+  drillMinAssetEquity,
+  drillPurchaseCost,
+  drillRarity,
+  drillRarityMultiplier,
+} from 'src/common/utils/drill';
 
 /**
  * Type for the change stream events for the drills collection.
@@ -26,6 +49,36 @@ type DrillChangeEvent =
   | mongoose.mongo.ChangeStreamReplaceDocument<Drill>
   | mongoose.mongo.ChangeStreamDeleteDocument;
 
+/**
+ * A drill along with its active insurance (null if the drill isn't insured).
+ */
+type DrillWithInsurance = Drill & {
+  insurance: { coveragePercent: number; expiresAt: Date } | null;
+};
+
+/**
+ * A drill destroyed by reaching the max degradation.
+ */
+export type DestroyedDrill = Pick<
+  Drill,
+  '_id' | 'operatorId' | 'config' | 'destroyedAt'
+>;
+
+/**
+ * A drill's entry in its operator's maintenance schedule.
+ */
+type DrillMaintenanceScheduleEntry = {
+  drillId: Types.ObjectId;
+  config: DrillConfig;
+  degradationPercent: number;
+  /** The estimated degradation (in %) the drill currently gains per day */
+  dailyDegradationRate: number;
+  maintenanceCostTON: number;
+  lastMaintainedAt: Date | null;
+  /** When the drill is projected to reach the critical degradation if left unmaintained (null if it isn't degrading) */
+  nextCriticalAt: Date | null;
+};
+
 @Injectable()
 export class DrillService implements OnModuleInit, OnModuleDestroy {
   private readonly logger = new Logger(DrillService.name);
@@ -45,6 +98,15 @@ export class DrillService implements OnModuleInit, OnModuleDestroy {
     @InjectModel(Operator.name) private operatorModel: Model<Operator>,
     @InjectModel(DrillingSession.name)
     private drillingSessionModel: Model<DrillingSession>,
+    @InjectModel(DrillCycleParticipation.name)
+    private drillCycleParticipationModel: Model<DrillCycleParticipation>,
+    @InjectModel(DrillEffEvent.name)
+    private drillEffEventModel: Model<DrillEffEvent>,
+    @InjectModel(DrillInsurance.name)
+    private drillInsuranceModel: Model<DrillInsurance>,
+    @InjectModel(ShopItem.name) private shopItemModel: Model<ShopItem>,
+    private readonly drillNFTSyncService: DrillNFTSyncService,
+    private readonly redisService: RedisService,
   ) {}
 
   /**
@@ -86,6 +148,10 @@ export class DrillService implements OnModuleInit, OnModuleDestroy {
       {
         $match: {
           operationType: { $in: ['insert', 'update', 'replace', 'delete'] },
+          // Degradation updates happen in bulk every cycle and don't affect extractor eligibility
+          'updateDescription.updatedFields.degradationPercent': {
+            $exists: false,
+          },
         },
       },
     ]);
@@ -158,60 +224,320 @@ export class DrillService implements OnModuleInit, OnModuleDestroy {
   /**
    * Selects an extractor using weighted probability.
    * Now runs entirely in-memory over `this.eligibleExtractorDrills`.
+   *
+   * Drills of pool members are weighted by their pool's synergy multiplier (`poolSynergyMultipliers`, keyed by operator ID),
+   * and drills of verified operators (`verifiedOperatorIds`) by `GAME_CONSTANTS.OPERATORS.VERIFIED_EXTRACTOR_EFF_MULTIPLIER`.
    */
-  selectExtractor(): {
+  selectExtractor(
+    poolSynergyMultipliers: Map<string, number> = new Map(),
+    verifiedOperatorIds: Set<string> = new Set(),
+  ): {
     drillId: Types.ObjectId;
     drillOperatorId: Types.ObjectId;
     eff: number;
     totalWeightedEff: number;
   } | null {
-    const MIN = GAME_CONSTANTS.LUCK.MIN_LUCK_MULTIPLIER;
-    const MAX = GAME_CONSTANTS.LUCK.MAX_LUCK_MULTIPLIER;
-
     if (this.eligibleExtractorDrills.size === 0) {
       this.logger.warn(`⚠️ (selectExtractor) No eligible drills found.`);
       return null;
     }
 
-    // One-pass streaming weighted sampling
-    let selected: {
-      id: string;
-      eff: number;
-      operatorId: Types.ObjectId;
-    } | null = null;
-    let totalW = 0;
-
-    // Compute a 'seed-always-select first' system.
-    // The first drill will be the first selected, then the second drill has a chance to knock it out of the extractor spot, and the third drill
-    // has a chance to knock either Drill 1 or 2 (whichever remains in place) out, and so on.
-    // This is more efficient than the two-step approach.
-    for (const [id, { eff, operatorId }] of this.eligibleExtractorDrills) {
-      const luck = MIN + Math.random() * (MAX - MIN);
-      const w = eff * luck;
-      totalW += w;
-      // keep this item with probability w/totalW
-      if (Math.random() * totalW < w) {
-        selected = { id, eff, operatorId };
-      }
-    }
+    const candidates = Array.from(
+      this.eligibleExtractorDrills,
+      ([drillId, { eff, operatorId }]) => ({
+        drillId,
+        eff,
+        operatorId,
+        synergyMultiplier: poolSynergyMultipliers.get(operatorId.toString()),
+        verifiedMultiplier: verifiedOperatorIds.has(operatorId.toString())
+          ? GAME_CONSTANTS.OPERATORS.VERIFIED_EXTRACTOR_EFF_MULTIPLIER
+          : 1,
+      }),
+    );
+    const result = selectWeightedExtractor(
+      candidates,
+      GAME_CONSTANTS.LUCK.MIN_LUCK_MULTIPLIER,
+      GAME_CONSTANTS.LUCK.MAX_LUCK_MULTIPLIER,
+    );
 
-    if (!selected) {
+    if (!result) {
       this.logger.warn(`⚠️ (selectExtractor) Floating-point fallback.`);
       return null;
     }
 
+    const { candidate: selected, totalWeightedEff } = result;
+
     this.logger.log(
-      `✅ (selectExtractor) Selected extractor: Drill ${selected.id} with ${selected.eff.toFixed(
+      `✅ (selectExtractor) Selected extractor: Drill ${selected.drillId} with ${selected.eff.toFixed(
         2,
-      )} EFF. Total W: ${totalW.toFixed(2)}.`,
+      )} EFF. Total W: ${totalWeightedEff.toFixed(2)}.`,
     );
 
     return {
-      drillId: new Types.ObjectId(selected.id),
+      drillId: new Types.ObjectId(selected.drillId),
       drillOperatorId: selected.operatorId,
       eff: selected.eff,
-      totalWeightedEff: totalW,
+      totalWeightedEff,
+    };
+  }
+
+  /**
+   * Damages all active drills of the cycle's active operators that weren't selected as the extractor.
+   *
+   * The degradation increment scales with the cycle's complexity (i.e. the number of active operators).
+   * Each participating drill is also stored as a `DrillCycleParticipation` record.
+   * Drills that reach the max degradation are destroyed and returned as `destroyedDrills`.
+   */
+  async applyFailedExtractionDamage(
+    cycleNumber: number,
+    activeOperatorIds: Types.ObjectId[],
+    extractorDrillId: Types.ObjectId | null,
+  ): Promise<{
+    damagedDrills: number;
+    degradationIncrease: number;
+    destroyedDrills: DestroyedDrill[];
+  }> {
+    if (activeOperatorIds.length === 0) {
+      return { damagedDrills: 0, degradationIncrease: 0, destroyedDrills: [] };
+    }
+
+    const { basePercent, complexityScaling, maxPercent } =
+      GAME_CONSTANTS.CYCLES.FAILED_EXTRACTION_DEGRADATION;
+
+    const cycleComplexity =
+      1 + Math.log10(activeOperatorIds.length) * complexityScaling;
+    const degradationIncrease = basePercent * cycleComplexity;
+
+    const participatingDrills = await this.drillModel
+      .find(
+        { operatorId: { $in: activeOperatorIds }, active: true },
+        { _id: 1, operatorId: 1 },
+      )
+      .lean();
+
+    if (participatingDrills.length === 0) {
+      return { damagedDrills: 0, degradationIncrease, destroyedDrills: [] };
+    }
+
+    const isDamaged = (drillId: Types.ObjectId) =>
+      !extractorDrillId || !drillId.equals(extractorDrillId);
+
+    const damagedDrillIds = participatingDrills
+      .filter((drill) => isDamaged(drill._id))
+      .map((drill) => drill._id);
+
+    await Promise.all([
+      this.drillModel.updateMany({ _id: { $in: damagedDrillIds } }, [
+        {
+          $set: {
+            degradationPercent: {
+              $min: [
+                maxPercent,
+                {
+                  $add: [
+                    { $ifNull: ['$degradationPercent', 0] },
+                    degradationIncrease,
+                  ],
+                },
+              ],
+            },
+          },
+        },
+      ]),
+      this.drillCycleParticipationModel.insertMany(
+        participatingDrills.map((drill) => ({
+          cycleNumber,
+          drillId: drill._id,
+          operatorId: drill.operatorId,
+          damagedInCycle: isDamaged(drill._id) ? cycleNumber : null,
+          degradationIncrease: isDamaged(drill._id) ? degradationIncrease : 0,
+        })),
+        { ordered: false },
+      ),
+    ]);
+
+    const destroyedDrills = await this.destroyWornOutDrills(
+      damagedDrillIds,
+      maxPercent,
+    );
+
+    this.logger.log(
+      `🔧 (applyFailedExtractionDamage) Damaged ${damagedDrillIds.length} drills by ${degradationIncrease.toFixed(4)}% in cycle #${cycleNumber} (${destroyedDrills.length} destroyed).`,
+    );
+
+    return {
+      damagedDrills: damagedDrillIds.length,
+      degradationIncrease,
+      destroyedDrills,
+    };
+  }
+
+  /**
+   * Destroys the given drills that reached `maxPercent` degradation, i.e. deactivates them and sets `destroyedAt`.
+   *
+   * Each drill is only destroyed once; only the drills destroyed by this call are returned.
+   */
+  private async destroyWornOutDrills(
+    drillIds: Types.ObjectId[],
+    maxPercent: number,
+  ): Promise<DestroyedDrill[]> {
+    const wornOutDrills = await this.drillModel
+      .find(
+        {
+          _id: { $in: drillIds },
+          degradationPercent: { $gte: maxPercent },
+          destroyedAt: null,
+        },
+        { _id: 1 },
+      )
+      .lean();
+
+    const destroyedDrills: DestroyedDrill[] = [];
+    for (const { _id } of wornOutDrills) {
+      const drill = await this.drillModel
+        .findOneAndUpdate(
+          { _id, destroyedAt: null },
+          { $set: { destroyedAt: new Date(), active: false } },
+          {
+            new: true,
+            projection: { _id: 1, operatorId: 1, config: 1, destroyedAt: 1 },
+          },
+        )
+        .lean();
+
+      if (drill) {
+        destroyedDrills.push(drill);
+      }
+    }
+
+    return destroyedDrills;
+  }
+
+  /**
+   * Counts an extraction win towards the extractor drill's stats and adds the $HASH it earned
+   * to the `leaderboard:drills` sorted set (used by the drill leaderboard).
+   */
+  async recordExtractorWin(
+    drillId: Types.ObjectId,
+    earnedHASH: number,
+  ): Promise<void> {
+    await this.drillModel.updateOne(
+      { _id: drillId },
+      { $inc: { extractorWins: 1, totalEarnedHASH: earnedHASH } },
+    );
+
+    await this.redisService.incrementSortedSetScore(
+      'leaderboard:drills',
+      drillId.toString(),
+      earnedHASH,
+    );
+  }
+
+  /**
+   * Decays the `actualEff` of all non-basic drills owned by operators who haven't had a drilling session
+   * in the last `INACTIVITY_DECAY.inactivityDays` days, simulating equipment rust.
+   *
+   * A drill never decays below its configuration's `MIN_BASE_EFF`. Each decay that was applied is stored as a `DrillEffEvent`.
+   * Called daily by `DrillQueue`.
+   */
+  async applyInactivityDecay(): Promise<{ decayedDrills: number }> {
+    const { inactivityDays, decayPct } = GAME_CONSTANTS.DRILLS.INACTIVITY_DECAY;
+    const cutoff = new Date(Date.now() - inactivityDays * 86_400_000);
+
+    // Operators with an ongoing session or a session that ended after the cutoff are still active
+    const activeOperatorIds: Types.ObjectId[] =
+      await this.drillingSessionModel.distinct('operatorId', {
+        $or: [{ endTime: null }, { endTime: { $gte: cutoff } }],
+      });
+
+    // Operators who joined after the cutoff haven't had the chance to be inactive yet
+    const inactiveOperatorIds: Types.ObjectId[] =
+      await this.operatorModel.distinct('_id', {
+        _id: { $nin: activeOperatorIds },
+        createdAt: { $lt: cutoff },
+      });
+
+    if (inactiveOperatorIds.length === 0) {
+      return { decayedDrills: 0 };
+    }
+
+    // Decay stops once a drill reaches its configuration's minimum EFF
+    const cursor = this.drillModel
+      .find(
+        {
+          operatorId: { $in: inactiveOperatorIds },
+          version: { $ne: DrillVersion.BASIC },
+          $or: Object.entries(GAME_CONSTANTS.DRILLS.MIN_BASE_EFF).map(
+            ([config, minEff]) => ({ config, actualEff: { $gt: minEff } }),
+          ),
+        },
+        { operatorId: 1, config: 1, actualEff: 1 },
+      )
+      .lean()
+      .cursor({ batchSize: 1_000 });
+
+    let decayedDrills = 0;
+    let pendingDecays: {
+      drillId: Types.ObjectId;
+      operatorId: Types.ObjectId;
+      previousEff: number;
+      newEff: number;
+    }[] = [];
+
+    const flush = async () => {
+      if (pendingDecays.length === 0) return;
+
+      // Only update if the EFF hasn't changed in the meantime
+      const results = await Promise.all(
+        pendingDecays.map((decay) =>
+          this.drillModel.updateOne(
+            { _id: decay.drillId, actualEff: decay.previousEff },
+            { $set: { actualEff: decay.newEff } },
+          ),
+        ),
+      );
+
+      // Only drills that were actually decayed get an event
+      const appliedDecays = pendingDecays.filter(
+        (_, i) => results[i].modifiedCount > 0,
+      );
+
+      if (appliedDecays.length > 0) {
+        await this.drillEffEventModel.insertMany(
+          appliedDecays.map((decay) => ({
+            ...decay,
+            reason: DrillEffEventReason.INACTIVITY_DECAY,
+          })),
+          { ordered: false },
+        );
+      }
+
+      decayedDrills += appliedDecays.length;
+      pendingDecays = [];
     };
+
+    for await (const drill of cursor) {
+      const minEff = GAME_CONSTANTS.DRILLS.MIN_BASE_EFF[drill.config];
+
+      pendingDecays.push({
+        drillId: drill._id,
+        operatorId: drill.operatorId,
+        previousEff: drill.actualEff,
+        newEff: Math.max(minEff, drill.actualEff * (1 - decayPct / 100)),
+      });
+
+      if (pendingDecays.length >= 100) {
+        await flush();
+      }
+    }
+
+    await flush();
+
+    this.logger.log(
+      `🦀 (applyInactivityDecay) Decayed ${decayedDrills} drills of ${inactiveOperatorIds.length} inactive operators by ${decayPct}%.`,
+    );
+
+    return { decayedDrills };
   }
 
   /**
@@ -232,6 +558,8 @@ export class DrillService implements OnModuleInit, OnModuleDestroy {
             maxActiveDrillsAllowed: 1,
             effCredits: 1,
             effMultiplier: 1,
+            stakingBoostPct: 1,
+            skillEffBonusPct: 1,
           })
           .lean(),
         this.drillModel.countDocuments({ operatorId, active: true }),
@@ -276,6 +604,8 @@ export class DrillService implements OnModuleInit, OnModuleDestroy {
           _id: drillId,
           operatorId,
           version: { $ne: DrillVersion.BASIC },
+          // Destroyed drills can only be deactivated
+          ...(state && { destroyedAt: null }),
           $or: [
             { lastActiveStateToggle: null },
             {
@@ -299,7 +629,7 @@ export class DrillService implements OnModuleInit, OnModuleDestroy {
 
       if (!updatedDrill) {
         throw new BadRequestException(
-          `(toggleDrillActiveState) Either one of these errors occured: Drill not found, does not belong to operator, is a Basic Drill (non-toggleable) or has already been toggled in the last ${GAME_CONSTANTS.DRILLS.ACTIVE_STATE_TOGGLE_COOLDOWN / 60 / 60} hours.`,
+          `(toggleDrillActiveState) Either one of these errors occured: Drill not found, does not belong to operator, is a Basic Drill (non-toggleable), is destroyed (can only be deactivated) or has already been toggled in the last ${GAME_CONSTANTS.DRILLS.ACTIVE_STATE_TOGGLE_COOLDOWN / 60 / 60} hours.`,
         );
       }
 
@@ -318,6 +648,8 @@ export class DrillService implements OnModuleInit, OnModuleDestroy {
 
       const effMultiplier = operator.effMultiplier || 1;
       const effCredits = operator.effCredits || 0;
+      const stakingBoost = 1 + (operator.stakingBoostPct || 0) / 100;
+      const skillBoost = 1 + (operator.skillEffBonusPct || 0) / 100;
 
       // Get a new luck factor for the operator
       const luckFactor =
@@ -331,7 +663,8 @@ export class DrillService implements OnModuleInit, OnModuleDestroy {
       );
 
       const cumulativeEff =
-        totalDrillEff * effMultiplier * luckFactor + effCredits;
+        totalDrillEff * effMultiplier * stakingBoost * skillBoost * luckFactor +
+        effCredits;
 
       await this.operatorModel.updateOne(
         { _id: operatorId },
@@ -459,12 +792,486 @@ export class DrillService implements OnModuleInit, OnModuleDestroy {
 
       const insertedDrill = await this.drillModel.create(drill);
 
+      // Sync the new drill's NFT metadata on-chain in the background
+      await this.drillNFTSyncService.enqueueSync(
+        insertedDrill._id,
+        DrillNFTSyncOperation.CREATE,
+      );
+
       return insertedDrill._id;
     } catch (err: any) {
       throw new Error(`(createDrill) Error creating drill: ${err.message}`);
     }
   }
 
+  /**
+   * (Admin only) Assigns an inactive premium drill to an operator.
+   *
+   * The operator's total drill EFF including the assigned drill can't exceed their max EFF allowed (see `equityToMaxEff`).
+   * Assignments to the same operator hold a Redis lock, so concurrent assignments can't both pass the check.
+   */
+  async assignDrillToOperator(
+    operatorId: Types.ObjectId,
+    drillId: Types.ObjectId,
+  ): Promise<
+    ApiResponse<{ totalDrillEff: number; maxEffAllowed: number } | null>
+  > {
+    const lockKey = `drill-assign-lock:${operatorId}`;
+    const locked = await this.redisService.setIfNotExists(
+      lockKey,
+      drillId.toString(),
+      30,
+    );
+
+    if (!locked) {
+      return new ApiResponse(
+        400,
+        `(assignDrillToOperator) Another drill is being assigned to this operator. Please try again.`,
+      );
+    }
+
+    try {
+      const [operator, drill] = await Promise.all([
+        this.operatorModel.findById(operatorId, { assetEquity: 1 }).lean(),
+        this.drillModel
+          .findById(drillId, {
+            operatorId: 1,
+            version: 1,
+            active: 1,
+            actualEff: 1,
+          })
+          .lean(),
+      ]);
+
+      if (!operator) {
+        return new ApiResponse(
+          404,
+          `(assignDrillToOperator) Operator not found.`,
+        );
+      }
+
+      if (!drill) {
+        return new ApiResponse(404, `(assignDrillToOperator) Drill not found.`);
+      }
+
+      if (drill.operatorId.equals(operatorId)) {
+        return new ApiResponse(
+          400,
+          `(assignDrillToOperator) Drill is already owned by this operator.`,
+        );
+      }
+
+      if (drill.version === DrillVersion.BASIC) {
+        return new ApiResponse(
+          400,
+          `(assignDrillToOperator) Basic drills cannot be assigned.`,
+        );
+      }
+
+      if (drill.active) {
+        return new ApiResponse(
+          400,
+          `(assignDrillToOperator) Drill must be deactivated before it can be assigned.`,
+        );
+      }
+
+      const drillAgg = await this.drillModel.aggregate([
+        { $match: { operatorId } },
+        {
+          $group: {
+            _id: '$operatorId',
+            totalDrillEff: { $sum: '$actualEff' },
+          },
+        },
+      ]);
+
+      const totalDrillEff = (drillAgg[0]?.totalDrillEff || 0) + drill.actualEff;
+      const maxEffAllowed = equityToMaxEff(operator.assetEquity || 0);
+
+      if (totalDrillEff > maxEffAllowed) {
+        return new ApiResponse(
+          400,
+          `(assignDrillToOperator) Assigning this drill would raise the operator's total drill EFF to ${totalDrillEff}, above their max of ${maxEffAllowed}.`,
+        );
+      }
+
+      // Only reassign the drill if it wasn't transferred or activated in the meantime
+      const assignedDrill = await this.drillModel.findOneAndUpdate(
+        { _id: drillId, operatorId: drill.operatorId, active: false },
+        { $set: { operatorId, lastActiveStateToggle: null } },
+        { projection: { _id: 1 } },
+      );
+
+      if (!assignedDrill) {
+        return new ApiResponse(
+          400,
+          `(assignDrillToOperator) Drill changed during the assignment. Please try again.`,
+        );
+      }
+
+      // Sync the drill's NFT ownership on-chain in the background
+      await this.drillNFTSyncService.enqueueSync(
+        drillId,
+        DrillNFTSyncOperation.TRANSFER,
+      );
+
+      this.logger.log(
+        `🔁 (assignDrillToOperator) Assigned drill ${drillId} from operator ${drill.operatorId} to operator ${operatorId}. Total drill EFF: ${totalDrillEff}/${maxEffAllowed}.`,
+      );
+
+      return new ApiResponse(
+        200,
+        `(assignDrillToOperator) Drill assigned to operator.`,
+        { totalDrillEff, maxEffAllowed },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(assignDrillToOperator) Error assigning drill: ${err.message}`,
+        ),
+      );
+    } finally {
+      await this.redisService.del(lockKey);
+    }
+  }
+
+  /**
+   * Fetches an operator's drills, each with its active insurance (if any), along with the total EFF of all of the operator's drills
+   * (unaffected by filters and pagination).
+   *
+   * Sort options map to a fixed set of fields, so query input never ends up in the sort stage as-is.
+   */
+  async fetchOperatorDrills(
+    operatorId: Types.ObjectId,
+    options: {
+      sort?: DrillSortOption;
+      extractorAllowed?: boolean;
+      config?: DrillConfig;
+    },
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<
+    ApiResponse<{
+      drills: DrillWithInsurance[];
+      total: number;
+      totalDrillEff: number;
+    }>
+  > {
+    try {
+      const sortFields: Record<DrillSortOption, Record<string, 1 | -1>> = {
+        [DrillSortOption.ACTUAL_EFF_DESC]: { actualEff: -1 },
+        [DrillSortOption.DEGRADATION_DESC]: { degradationPercent: -1 },
+        [DrillSortOption.CONFIG]: { config: 1, actualEff: -1 },
+        [DrillSortOption.LEVEL_DESC]: { level: -1 },
+      };
+      const sort = sortFields[options.sort ?? DrillSortOption.ACTUAL_EFF_DESC];
+
+      const filter = {
+        operatorId,
+        ...(options.extractorAllowed !== undefined && {
+          extractorAllowed: options.extractorAllowed,
+        }),
+        ...(options.config && { config: options.config }),
+      };
+
+      const [drills, total, drillAgg] = await Promise.all([
+        this.drillModel
+          .find(filter)
+          .sort({ ...sort, _id: 1 })
+          .skip((page - 1) * limit)
+          .limit(limit)
+          .lean(),
+        this.drillModel.countDocuments(filter),
+        this.drillModel.aggregate([
+          { $match: { operatorId } },
+          {
+            $group: {
+              _id: '$operatorId',
+              totalDrillEff: { $sum: '$actualEff' },
+            },
+          },
+        ]),
+      ]);
+
+      const insurances = await this.drillInsuranceModel
+        .find(
+          {
+            drillId: { $in: drills.map((drill) => drill._id) },
+            claimedAt: null,
+            expiresAt: { $gt: new Date() },
+          },
+          { drillId: 1, coveragePercent: 1, expiresAt: 1 },
+        )
+        .lean();
+      const insuranceMap = new Map(
+        insurances.map((insurance) => [
+          insurance.drillId.toString(),
+          insurance,
+        ]),
+      );
+
+      return new ApiResponse(
+        200,
+        `(fetchOperatorDrills) Operator drills fetched.`,
+        {
+          drills: drills.map((drill) => {
+            const insurance = insuranceMap.get(drill._id.toString());
+
+            return {
+              ...drill,
+              insurance: insurance
+                ? {
+                    coveragePercent: insurance.coveragePercent,
+                    expiresAt: insurance.expiresAt,
+                  }
+                : null,
+            };
+          }) as DrillWithInsurance[],
+          total,
+          totalDrillEff: drillAgg[0]?.totalDrillEff || 0,
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchOperatorDrills) Error fetching operator drills: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches the drills for sale in the shop with their configuration's rarity.
+   *
+   * `purchaseCost` is what the drill actually costs, i.e. its base `basePurchaseCost` scaled by `rarityMultiplier`.
+   * `minAssetEquityRequired` lets clients grey out drills the operator can't buy yet.
+   */
+  async fetchDrillCatalog(): Promise<
+    ApiResponse<{
+      drills: Array<{
+        shopItemId: Types.ObjectId;
+        item: string;
+        version: DrillVersion;
+        config: DrillConfig;
+        baseEff: number;
+        maxLevel: number;
+        rarity: DrillRarity;
+        rarityMultiplier: number;
+        basePurchaseCost: { ton: number; bera: number };
+        purchaseCost: { ton: number; bera: number };
+        minAssetEquityRequired: number | null;
+      }>;
+    }>
+  > {
+    try {
+      const shopItems = await this.shopItemModel
+        .find(
+          { 'itemEffects.drillData': { $ne: null }, active: { $ne: false } },
+          { item: 1, 'itemEffects.drillData': 1, purchaseCost: 1 },
+        )
+        .lean();
+
+      const drills = shopItems
+        .filter((shopItem) => shopItem.itemEffects?.drillData)
+        .map((shopItem) => {
+          const { version, config, baseEff, maxLevel } =
+            shopItem.itemEffects.drillData;
+
+          return {
+            shopItemId: shopItem._id as Types.ObjectId,
+            item: shopItem.item,
+            version,
+            config,
+            baseEff,
+            maxLevel: maxLevel ?? 1,
+            rarity: drillRarity(config),
+            rarityMultiplier: drillRarityMultiplier(config),
+            basePurchaseCost: shopItem.purchaseCost,
+            purchaseCost: drillPurchaseCost(shopItem.purchaseCost, config),
+            minAssetEquityRequired: drillMinAssetEquity(config),
+          };
+        });
+
+      return new ApiResponse(
+        200,
+        `(fetchDrillCatalog) Drill catalog fetched.`,
+        { drills },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchDrillCatalog) Error fetching drill catalog: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches an operator's drills ordered by degradation (most degraded first), along with what it costs to maintain each drill
+   * and when it's projected to reach `MAINTENANCE.criticalDegradationPercent` if left unmaintained.
+   *
+   * The projection uses each drill's degradation over the last `MAINTENANCE.rateWindowCycles` cycles as its daily degradation rate.
+   */
+  async fetchDrillMaintenanceSchedule(
+    operatorId: Types.ObjectId,
+  ): Promise<ApiResponse<{ drills: DrillMaintenanceScheduleEntry[] }>> {
+    try {
+      const {
+        fullRepairCostPct,
+        criticalDegradationPercent,
+        rateWindowCycles,
+      } = GAME_CONSTANTS.DRILLS.MAINTENANCE;
+
+      const cycleNumberStr = await this.redisService.get(
+        'drilling-cycle:current',
+      );
+      const currentCycleNumber = cycleNumberStr
+        ? parseInt(cycleNumberStr, 10)
+        : 0;
+
+      const [drills, recentDegradation, shopItems] = await Promise.all([
+        this.drillModel
+          .find(
+            { operatorId },
+            { config: 1, degradationPercent: 1, lastMaintainedAt: 1 },
+          )
+          .sort({ degradationPercent: -1, _id: 1 })
+          .lean(),
+        this.drillCycleParticipationModel.aggregate<{
+          _id: Types.ObjectId;
+          degradationIncrease: number;
+        }>([
+          {
+            $match: {
+              operatorId,
+              cycleNumber: { $gt: currentCycleNumber - rateWindowCycles },
+              degradationIncrease: { $gt: 0 },
+            },
+          },
+          {
+            $group: {
+              _id: '$drillId',
+              degradationIncrease: { $sum: '$degradationIncrease' },
+            },
+          },
+        ]),
+        this.shopItemModel
+          .find(
+            { 'itemEffects.drillData.config': { $exists: true } },
+            { 'itemEffects.drillData.config': 1, purchaseCost: 1 },
+          )
+          .lean(),
+      ]);
+
+      const windowDays =
+        (rateWindowCycles * GAME_CONSTANTS.CYCLES.CYCLE_DURATION) / 86_400;
+      const dailyRateMap = new Map(
+        recentDegradation.map((entry) => [
+          entry._id.toString(),
+          entry.degradationIncrease / windowDays,
+        ]),
+      );
+      const purchaseCostTONMap = new Map(
+        shopItems.map((shopItem) => [
+          shopItem.itemEffects.drillData.config,
+          shopItem.purchaseCost?.ton || 0,
+        ]),
+      );
+
+      const now = Date.now();
+
+      return new ApiResponse(
+        200,
+        `(fetchDrillMaintenanceSchedule) Drill maintenance schedule fetched.`,
+        {
+          drills: drills.map((drill) => {
+            const degradationPercent = drill.degradationPercent || 0;
+            const dailyDegradationRate =
+              dailyRateMap.get(drill._id.toString()) || 0;
+            const purchaseCostTON = purchaseCostTONMap.get(drill.config) || 0;
+
+            let nextCriticalAt: Date | null = null;
+            if (degradationPercent >= criticalDegradationPercent) {
+              nextCriticalAt = new Date(now);
+            } else if (dailyDegradationRate > 0) {
+              const daysUntilCritical =
+                (criticalDegradationPercent - degradationPercent) /
+                dailyDegradationRate;
+              nextCriticalAt = new Date(now + daysUntilCritical * 86_400_000);
+            }
+
+            return {
+              drillId: drill._id,
+              config: drill.config,
+              degradationPercent,
+              dailyDegradationRate,
+              maintenanceCostTON:
+                (purchaseCostTON * fullRepairCostPct * degradationPercent) /
+                10_000,
+              lastMaintainedAt: drill.lastMaintainedAt ?? null,
+              nextCriticalAt,
+            };
+          }),
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchDrillMaintenanceSchedule) Error fetching drill maintenance schedule: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches one of an operator's drills, with its active insurance (if any).
+   *
+   * Drills owned by other operators are reported as not found.
+   */
+  async fetchOperatorDrill(
+    operatorId: Types.ObjectId,
+    drillId: Types.ObjectId,
+  ): Promise<ApiResponse<{ drill: DrillWithInsurance } | null>> {
+    try {
+      const [drill, insurance] = await Promise.all([
+        this.drillModel.findOne({ _id: drillId, operatorId }).lean(),
+        this.drillInsuranceModel
+          .findOne(
+            { drillId, claimedAt: null, expiresAt: { $gt: new Date() } },
+            { coveragePercent: 1, expiresAt: 1 },
+          )
+          .lean(),
+      ]);
+
+      if (!drill) {
+        return new ApiResponse(404, `(fetchOperatorDrill) Drill not found.`);
+      }
+
+      return new ApiResponse(200, `(fetchOperatorDrill) Drill fetched.`, {
+        drill: {
+          ...drill,
+          insurance: insurance
+            ? {
+                coveragePercent: insurance.coveragePercent,
+                expiresAt: insurance.expiresAt,
+              }
+            : null,
+        } as DrillWithInsurance,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchOperatorDrill) Error fetching drill: ${err.message}`,
+        ),
+      );
+    }
+  }
+
   /**
    * Fetches all drills that have `extractorAllowed` set to `true`.
    *
@@ -6,6 +6,7 @@ import {
   UseGuards,
   Param,
   Request,
+  Query,
 } from '@nestjs/common';
 import { DrillingCycleService } from './drilling-cycle.service';
 import { RedisService } from 'src/common/redis.service';
@@ -14,6 +15,7 @@ import { InjectQueue } from '@nestjs/bull';
 import { Queue } from 'bull';
 import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
 import { ApiResponse } from 'src/common/dto/response.dto';
+import { GetCycleHistoryQueryDto } from 'src/common/dto/drilling-cycle.dto';
 
 // Health check response types for type safety
 interface ComponentStatus {
@@ -71,6 +73,27 @@ export class DrillingCycleController {
     return this.drillingCycleService.getCurrentCycleNumber();
   }
 
+  /**
+   * Fetches the countdown to the next extraction (end of the current cycle) from Redis.
+   */
+  @Get('countdown')
+  async getCycleCountdown() {
+    return this.drillingCycleService.getCycleCountdown();
+  }
+
+  /**
+   * Fetches a paginated history of completed drilling cycles, optionally filtered by start time.
+   */
+  @Get()
+  async getCycleHistory(@Query() query: GetCycleHistoryQueryDto) {
+    return this.drillingCycleService.fetchCycleHistory(
+      query.from ? new Date(query.from) : undefined,
+      query.to ? new Date(query.to) : undefined,
+      query.page,
+      query.limit,
+    );
+  }
+
   /**
    * Gets a cycle's extended data, such as the extractor-related data and reward share data.
    */
@@ -11,6 +11,7 @@ import { DrillingCycleController } from './drilling-cycle.controller';
 import { DrillingSessionModule } from './drilling-session.module';
 import { OperatorModule } from 'src/operators/operator.module';
 import { DrillModule } from './drill.module';
+import { DrillInsuranceModule } from './drill-insurance.module';
 import { PoolOperatorModule } from 'src/pools/pool-operator.module';
 import { PoolModule } from 'src/pools/pool.module';
 import { RedisModule } from 'src/common/redis.module';
@@ -26,10 +27,17 @@ import {
 import { DrillingCycleQueue } from './drilling-cycle.queue';
 import { OperatorWalletModule } from 'src/operators/operator-wallet.module';
 import { HashReserveModule } from 'src/hash-reserve/hash-reserve.module';
+import { MissionModule } from 'src/missions/mission.module';
+import { TournamentModule } from 'src/tournaments/tournament.module';
+import { OnboardingModule } from 'src/onboarding/onboarding.module';
 import {
   DrillingCycleRewardShare,
   DrillingCycleRewardShareSchema,
 } from './schemas/drilling-crs.schema';
+import {
+  ActivityEvent,
+  ActivityEventSchema,
+} from 'src/operators/schemas/activity-event.schema';
 
 @Module({
   imports: [
@@ -37,12 +45,16 @@ import {
     DrillingSessionModule, // Import DrillingSessionModule
     OperatorModule, // Import OperatorModule
     DrillModule, // Import DrillModule
+    DrillInsuranceModule, // Import DrillInsuranceModule
     PoolOperatorModule, // Import PoolOperatorModule
     PoolModule, // Import PoolModule
     RedisModule, // Import RedisModule
     DrillingGatewayModule, // Import DrillingGatewayModule
     OperatorWalletModule, // Import OperatorWalletModule
     HashReserveModule, // Import HashReserveModule
+    MissionModule, // Import MissionModule
+    TournamentModule, // Import TournamentModule
+    OnboardingModule, // Import OnboardingModule
     MongooseModule.forFeature([
       { name: DrillingCycle.name, schema: DrillingCycleSchema },
       { name: DrillingSession.name, schema: DrillingSessionSchema },
@@ -51,6 +63,7 @@ import {
         name: DrillingCycleRewardShare.name,
         schema: DrillingCycleRewardShareSchema,
       },
+      { name: ActivityEvent.name, schema: ActivityEventSchema },
     ]),
     BullModule.registerQueue({
       name: 'drilling-cycles',
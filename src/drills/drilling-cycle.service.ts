@@ -13,20 +13,44 @@ import { performance } from 'perf_hooks'; // Import high-precision timer
 import { DrillingSessionService } from './drilling-session.service';
 import { PoolOperator } from 'src/pools/schemas/pool-operator.schema';
 import { Pool } from 'src/pools/schemas/pool.schema';
+import {
+  PoolEvent,
+  PoolEventType,
+} from 'src/pools/schemas/pool-event.schema';
+import { PoolRewardDistribution } from 'src/pools/schemas/pool-reward-distribution.schema';
 import { OperatorService } from 'src/operators/operator.service';
 import { DrillService } from './drill.service';
+import { DrillInsuranceService } from './drill-insurance.service';
 import { DrillingGatewayService } from 'src/gateway/drilling.gateway.service';
 import { DrillingSession } from './schemas/drilling-session.schema';
 import { Operator } from 'src/operators/schemas/operator.schema';
 import { DrillingGateway } from 'src/gateway/drilling.gateway';
 import { OperatorWalletService } from 'src/operators/operator-wallet.service';
 import { HashReserveService } from 'src/hash-reserve/hash-reserve.service';
+import { PoolChallengeService } from 'src/pools/pool-challenge.service';
+import { TournamentService } from 'src/tournaments/tournament.service';
+import { MissionService } from 'src/missions/mission.service';
+import { MissionTargetType } from 'src/missions/schemas/daily-mission.schema';
+import { OnboardingService } from 'src/onboarding/onboarding.service';
+import { OnboardingStep } from 'src/onboarding/schemas/onboarding-progress.schema';
 import { DrillingCycleRewardShare } from './schemas/drilling-crs.schema';
+import {
+  ActivityEvent,
+  ActivityEventType,
+} from 'src/operators/schemas/activity-event.schema';
+import { poolSynergyMultiplier } from 'src/common/utils/pool';
 @Injectable()
 export class DrillingCycleService {
   private readonly logger = new Logger(DrillingCycleService.name);
   private readonly redisCycleKey = 'drilling-cycle:current';
+  private readonly redisCycleCountdownKey = 'drilling-cycle:countdown';
   private readonly cycleDuration = GAME_CONSTANTS.CYCLES.CYCLE_DURATION * 1000; // Convert to ms
+  // Pool synergy multipliers only change with pool memberships, so they're cached between cycles
+  private poolSynergyMultipliersCache: {
+    version: string | null;
+    fetchedAt: number;
+    multipliers: Map<string, number>;
+  } | null = null;
 
   constructor(
     @InjectModel(DrillingCycle.name)
@@ -36,17 +60,27 @@ export class DrillingCycleService {
     @InjectModel(PoolOperator.name)
     private poolOperatorModel: Model<PoolOperator>,
     @InjectModel(Pool.name) private poolModel: Model<Pool>,
+    @InjectModel(PoolEvent.name) private poolEventModel: Model<PoolEvent>,
+    @InjectModel(PoolRewardDistribution.name)
+    private poolRewardDistributionModel: Model<PoolRewardDistribution>,
     @InjectModel(Operator.name) private operatorModel: Model<Operator>,
     @InjectModel(DrillingCycleRewardShare.name)
     private drillingCycleRewardShareModel: Model<DrillingCycleRewardShare>,
+    @InjectModel(ActivityEvent.name)
+    private activityEventModel: Model<ActivityEvent>,
     private readonly operatorWalletService: OperatorWalletService,
     private readonly redisService: RedisService,
     private readonly drillingSessionService: DrillingSessionService,
     private readonly drillService: DrillService,
+    private readonly drillInsuranceService: DrillInsuranceService,
     private readonly operatorService: OperatorService,
     private readonly drillingGatewayService: DrillingGatewayService,
     private readonly drillingGateway: DrillingGateway,
     private readonly hashReserveService: HashReserveService,
+    private readonly poolChallengeService: PoolChallengeService,
+    private readonly tournamentService: TournamentService,
+    private readonly missionService: MissionService,
+    private readonly onboardingService: OnboardingService,
   ) {}
 
   /**
@@ -82,6 +116,129 @@ export class DrillingCycleService {
     };
   }
 
+  /**
+   * Fetches a page of completed drilling cycles, newest first, with each extractor's username resolved.
+   *
+   * Cycles can optionally be filtered to those started within `[from, to)`.
+   * The totals cover every cycle in the queried window, not only the current page.
+   */
+  async fetchCycleHistory(
+    from?: Date,
+    to?: Date,
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<
+    ApiResponse<{
+      cycles: Array<{
+        cycleNumber: number;
+        startTime: Date;
+        endTime: Date;
+        cycleDurationSeconds: number;
+        extractorOperatorId: Types.ObjectId | null;
+        extractorUsername: string | null;
+        activeOperators: number;
+        issuedHASH: number;
+      }>;
+      totalCycles: number;
+      totalHASHIssued: number;
+    }>
+  > {
+    try {
+      if (from && to && from >= to) {
+        return new ApiResponse(
+          400,
+          `(fetchCycleHistory) \`from\` must be before \`to\`.`,
+        );
+      }
+
+      const startTime: Record<string, Date> = {};
+      if (from) startTime.$gte = from;
+      if (to) startTime.$lt = to;
+
+      const [result] = await this.drillingCycleModel.aggregate([
+        {
+          $match: {
+            endTime: { $ne: null },
+            ...(from || to ? { startTime } : {}),
+          },
+        },
+        {
+          $facet: {
+            cycles: [
+              { $sort: { cycleNumber: -1 } },
+              { $skip: (page - 1) * limit },
+              { $limit: limit },
+              {
+                $lookup: {
+                  from: 'Operators',
+                  localField: 'extractorOperatorId',
+                  foreignField: '_id',
+                  as: 'extractorOperator',
+                  pipeline: [{ $project: { 'usernameData.username': 1 } }],
+                },
+              },
+              {
+                $project: {
+                  _id: 0,
+                  cycleNumber: 1,
+                  startTime: 1,
+                  endTime: 1,
+                  cycleDurationSeconds: {
+                    $round: [
+                      {
+                        $divide: [
+                          { $subtract: ['$endTime', '$startTime'] },
+                          1000,
+                        ],
+                      },
+                    ],
+                  },
+                  extractorOperatorId: 1,
+                  extractorUsername: {
+                    $ifNull: [
+                      {
+                        $first: '$extractorOperator.usernameData.username',
+                      },
+                      null,
+                    ],
+                  },
+                  activeOperators: 1,
+                  issuedHASH: 1,
+                },
+              },
+            ],
+            totals: [
+              {
+                $group: {
+                  _id: null,
+                  totalCycles: { $sum: 1 },
+                  totalHASHIssued: { $sum: '$issuedHASH' },
+                },
+              },
+            ],
+          },
+        },
+      ]);
+
+      return new ApiResponse(
+        200,
+        `(fetchCycleHistory) Cycle history fetched.`,
+        {
+          cycles: result.cycles,
+          totalCycles: result.totals[0]?.totalCycles ?? 0,
+          totalHASHIssued: result.totals[0]?.totalHASHIssued ?? 0,
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchCycleHistory) Error fetching cycle history: ${err.message}`,
+        ),
+      );
+    }
+  }
+
   /**
    * Gets a cycle's extended data, such as the extractor-related data and reward share data.
    */
@@ -301,6 +458,9 @@ export class DrillingCycleService {
         );
       }
 
+      // Cache the countdown so that clients can poll it without hitting the database
+      await this.cacheCycleCountdown(newCycleNumber, now, activeOperators);
+
       const endFetchTime = performance.now();
 
       this.logger.log(
@@ -374,7 +534,14 @@ export class DrillingCycleService {
 
     // ✅ Step 2: Select extractor
     const selectExtractorTime = performance.now();
-    const extractorData = await this.drillService.selectExtractor();
+    const [poolSynergyMultipliers, verifiedOperatorIds] = await Promise.all([
+      this.fetchPoolSynergyMultipliers(),
+      this.fetchVerifiedOperatorIds(),
+    ]);
+    const extractorData = this.drillService.selectExtractor(
+      poolSynergyMultipliers,
+      verifiedOperatorIds,
+    );
     // Store the total weighted efficiency from extractor selection
     const totalWeightedEff = extractorData?.totalWeightedEff || 0;
 
@@ -396,11 +563,37 @@ export class DrillingCycleService {
     const rewardShares = await this.distributeCycleRewards(
       extractorOperatorId,
       issuedHASH,
+      extractorData?.drillId || null,
+      cycleNumber,
     );
     this.logger.debug(
       `⏱️ Step 3 (Distribute rewards): ${(performance.now() - distributeRewardsTime).toFixed(2)}ms`,
     );
 
+    // ✅ Step 3.1: Count the extraction win towards the extractor's missions and onboarding
+    if (extractorOperatorId) {
+      await this.missionService.incrementProgress(
+        [extractorOperatorId],
+        MissionTargetType.EXTRACTION_WINS,
+      );
+      await this.onboardingService.completeStep(
+        [extractorOperatorId],
+        OnboardingStep.WIN_EXTRACTION,
+      );
+      // The activity feed is secondary to the cycle, so a failed write is only logged
+      await this.activityEventModel
+        .create({
+          operatorId: extractorOperatorId,
+          type: ActivityEventType.EXTRACTION_WIN,
+          data: { cycleNumber, drillId: extractorData?.drillId || null },
+        })
+        .catch((err: any) => {
+          this.logger.error(
+            `❌ (endCurrentCycle) Error recording extraction win activity for operator ${extractorOperatorId}: ${err.message}`,
+          );
+        });
+    }
+
     // ✅ Step 4: Process Fuel for ALL Operators
     const processFuelTime = performance.now();
     await this.processFuelForAllOperators(cycleNumber);
@@ -408,6 +601,42 @@ export class DrillingCycleService {
       `⏱️ Step 4 (Process fuel): ${(performance.now() - processFuelTime).toFixed(2)}ms`,
     );
 
+    // ✅ Step 4.1: Damage drills that failed to extract this cycle
+    const drillDamageTime = performance.now();
+    try {
+      const activeOperatorIds =
+        await this.drillingSessionService.fetchActiveDrillingSessionOperatorIds();
+
+      const { destroyedDrills } =
+        await this.drillService.applyFailedExtractionDamage(
+          cycleNumber,
+          activeOperatorIds,
+          extractorData?.drillId || null,
+        );
+
+      // Destroyed drills were deactivated, so their operators' EFF changed
+      const destroyedDrillOperatorIds = new Set(
+        destroyedDrills.map((drill) => drill.operatorId.toString()),
+      );
+      for (const operatorId of destroyedDrillOperatorIds) {
+        await this.operatorService.updateCumulativeEffForSingleOperator(
+          new Types.ObjectId(operatorId),
+        );
+      }
+
+      // Also retries payouts that failed in previous cycles
+      await this.drillInsuranceService.compensateDestroyedDrills();
+    } catch (err: any) {
+      // Drill damage should never prevent the cycle from completing
+      this.logger.error(
+        `❌ (endCurrentCycle) Failed to apply drill damage for cycle #${cycleNumber}: ${err.message}`,
+        err.stack,
+      );
+    }
+    this.logger.debug(
+      `⏱️ Step 4.1 (Apply drill damage): ${(performance.now() - drillDamageTime).toFixed(2)}ms`,
+    );
+
     // ✅ Step 5: Update the cycle
     const updateCycleTime = performance.now();
     const latestCycle = await this.drillingCycleModel.findOneAndUpdate(
@@ -448,6 +677,38 @@ export class DrillingCycleService {
       `⏱️ Step 5.1 (Recalibrate session counters): ${(performance.now() - recalibrateTime).toFixed(2)}ms`,
     );
 
+    // ✅ Step 5.2: Resolve pool challenges that ended with this cycle
+    const resolveChallengesTime = performance.now();
+    try {
+      await this.poolChallengeService.resolveEndedChallenges(cycleNumber);
+    } catch (err: any) {
+      // Challenge resolution should never prevent the cycle from completing
+      this.logger.error(
+        `❌ (endCurrentCycle) Failed to resolve pool challenges for cycle #${cycleNumber}: ${err.message}`,
+        err.stack,
+      );
+    }
+    this.logger.debug(
+      `⏱️ Step 5.2 (Resolve pool challenges): ${(performance.now() - resolveChallengesTime).toFixed(2)}ms`,
+    );
+
+    // ✅ Step 5.3: Score the extractor's pool in ongoing tournaments
+    if (extractorOperatorId) {
+      const tournamentScoreTime = performance.now();
+      try {
+        await this.tournamentService.recordExtractionWin(extractorOperatorId);
+      } catch (err: any) {
+        // Tournament scoring should never prevent the cycle from completing
+        this.logger.error(
+          `❌ (endCurrentCycle) Failed to record tournament score for cycle #${cycleNumber}: ${err.message}`,
+          err.stack,
+        );
+      }
+      this.logger.debug(
+        `⏱️ Step 5.3 (Record tournament score): ${(performance.now() - tournamentScoreTime).toFixed(2)}ms`,
+      );
+    }
+
     // ✅ Step 6: Complete any stopping sessions
     const completeSessionsTime = performance.now();
     const completionResult =
@@ -487,10 +748,14 @@ export class DrillingCycleService {
 
   /**
    * Distributes $HASH rewards to operators at the end of a drilling cycle.
+   *
+   * If the extractor is in a pool, the split is also recorded as a `PoolRewardDistribution` for the pool's reward history.
    */
   async distributeCycleRewards(
     extractorOperatorId: Types.ObjectId | null, // ✅ Extractor operator ID can be null
     issuedHash: number,
+    extractorDrillId: Types.ObjectId | null = null,
+    cycleNumber: number | null = null,
   ): Promise<{ operatorId: Types.ObjectId; amount: number }[]> {
     const startTime = performance.now();
     const rewardData: { operatorId: Types.ObjectId; amount: number }[] = [];
@@ -542,6 +807,16 @@ export class DrillingCycleService {
     // Track pools for reward updates
     const poolRewards = new Map<string, number>();
     const poolOperatorRewards = new Map<string, number>();
+    // Rewards of pool members absent for `FORFEIT_THRESHOLD` cycles that go to their pool's treasury instead
+    const forfeitedRewards: {
+      poolId: Types.ObjectId;
+      operatorId: Types.ObjectId;
+      amount: number;
+    }[] = [];
+    // $HASH earned by the extractor drill this cycle (for the drill leaderboard)
+    let extractorDrillReward = 0;
+    // How the issued $HASH was split in the extractor's pool (for the pool's reward history)
+    let poolRewardDistribution: Partial<PoolRewardDistribution> | null = null;
 
     // ✅ Step 4: Calculate rewards based on extractor status
     if (extractorOperatorId === null) {
@@ -602,6 +877,7 @@ export class DrillingCycleService {
           { operatorId: extractorOperatorId, amount: extractorReward }, // Extractor Reward
           ...weightedRewards, // Active Operators' Rewards
         );
+        extractorDrillReward = extractorReward;
         this.logger.debug(
           `⏱️ (distributeCycleRewards) Step 5a - Calculate SOLO rewards: ${(performance.now() - soloRewardTime).toFixed(2)}ms`,
         );
@@ -755,12 +1031,49 @@ export class DrillingCycleService {
           });
         }
 
+        // ✅ Step 7.1: Members that have been absent for too many cycles forfeit the rewards they'd get without participating (i.e. the leader share)
+        const dormantRecipientIds = await this.fetchDormantPoolMemberIds(
+          poolOperator.pool,
+          poolRewardsToAdd
+            .map((reward) => reward.operatorId)
+            .filter((id) => !activePoolOperatorIds.has(id.toString())),
+        );
+
+        for (const reward of poolRewardsToAdd) {
+          if (dormantRecipientIds.has(reward.operatorId.toString())) {
+            forfeitedRewards.push({
+              poolId: poolOperator.pool,
+              operatorId: reward.operatorId,
+              amount: reward.amount,
+            });
+            reward.amount = 0;
+          }
+        }
+        const leaderForfeited =
+          !!pool.leaderId &&
+          dormantRecipientIds.has(pool.leaderId.toString());
+
         // Add all poolRewardsToAdd along with the weighted pool rewards
         rewardData.push(
           ...poolRewardsToAdd,
           ...weightedPoolRewards,
           ...weightedGlobalRewards,
         );
+        extractorDrillReward = extractorReward;
+        poolRewardDistribution = {
+          poolId: poolOperator.pool,
+          cycleNumber,
+          issuedHASH: issuedHash,
+          extractorOperatorId,
+          extractorReward,
+          leaderId: pool.leaderId ?? null,
+          leaderReward:
+            pool.leaderId && !leaderForfeited ? leaderReward : 0,
+          activePoolOperatorsReward: weightedPoolOperators.length
+            ? activePoolReward
+            : 0,
+          activePoolOperatorCount: weightedPoolOperators.length,
+        };
         this.logger.debug(
           `⏱️ (distributeCycleRewards) Step 5b - Calculate POOL rewards: ${(performance.now() - poolRewardTime).toFixed(2)}ms`,
         );
@@ -771,6 +1084,11 @@ export class DrillingCycleService {
       }
     }
 
+    // ✅ Step 7.2: Credit forfeited rewards to the pool treasuries (before any rewards are issued, so a failed credit fails the whole step)
+    if (forfeitedRewards.length > 0) {
+      await this.creditPoolTreasuries(forfeitedRewards);
+    }
+
     // ✅ Step 8: Batch Issue Rewards
     await this.batchIssueHashRewards(rewardData);
 
@@ -779,6 +1097,36 @@ export class DrillingCycleService {
       await this.updatePoolAndOperatorRewards(poolRewards, poolOperatorRewards);
     }
 
+    // ✅ Step 9.2: Count this cycle as missed for absent pool members and reset the count of participating ones
+    await this.updateConsecutiveMissedCycles(allActiveOperatorIds);
+
+    // ✅ Step 9.3: Update the extractor drill's stats and the drill leaderboard
+    if (extractorDrillId) {
+      try {
+        await this.drillService.recordExtractorWin(
+          extractorDrillId,
+          extractorDrillReward,
+        );
+      } catch (err: any) {
+        // The drill leaderboard should never prevent rewards from being distributed
+        this.logger.error(
+          `❌ (distributeCycleRewards) Failed to record extractor win for drill ${extractorDrillId}: ${err.message}`,
+        );
+      }
+    }
+
+    // ✅ Step 9.4: Record the pool reward distribution in the pool's reward history
+    if (poolRewardDistribution) {
+      try {
+        await this.poolRewardDistributionModel.create(poolRewardDistribution);
+      } catch (err: any) {
+        // The reward history should never prevent rewards from being distributed
+        this.logger.error(
+          `❌ (distributeCycleRewards) Failed to record pool reward distribution for pool ${poolRewardDistribution.poolId}: ${err.message}`,
+        );
+      }
+    }
+
     // ✅ Step 10: Group rewards by operator ID and remove null entries
     const groupedRewardMap = new Map<string, number>();
 
@@ -830,10 +1178,9 @@ export class DrillingCycleService {
     // Step 11: Send to Hash Reserve if there are any unissued rewards
     // Loop through the reward data again and check how much HASH is sent compared to the `issuedHash`.
     // If the total is less than the issuedHash, add the difference to the reserve.
-    const totalIssuedHash = rewardData.reduce(
-      (sum, reward) => sum + reward.amount,
-      0,
-    );
+    const totalIssuedHash =
+      rewardData.reduce((sum, reward) => sum + reward.amount, 0) +
+      forfeitedRewards.reduce((sum, forfeit) => sum + forfeit.amount, 0);
 
     this.logger.debug(
       `(distributeCycleRewards) Total HASH rewarded to operators: ${totalIssuedHash}, issuedHash: ${issuedHash}`,
@@ -865,6 +1212,207 @@ export class DrillingCycleService {
     return rewardShares;
   }
 
+  /**
+   * Fetches the synergy multiplier of each pool member's pool (based on the pool's member count), keyed by operator ID.
+   *
+   * Operators in solo pools (or in no pool) are left out since they get no boost.
+   * The multipliers are cached until `PoolService.invalidatePoolSynergyMultipliers` bumps their version in Redis,
+   * or for `SYNERGY_MULTIPLIER_CACHE_TTL` seconds at most.
+   */
+  private async fetchPoolSynergyMultipliers(): Promise<Map<string, number>> {
+    const poolSynergyMultipliers = new Map<string, number>();
+
+    try {
+      const version = await this.redisService.get(
+        'pool:synergy-multipliers:version',
+      );
+      const cache = this.poolSynergyMultipliersCache;
+
+      if (
+        cache &&
+        cache.version === version &&
+        Date.now() - cache.fetchedAt <
+          GAME_CONSTANTS.POOLS.SYNERGY_MULTIPLIER_CACHE_TTL * 1000
+      ) {
+        return cache.multipliers;
+      }
+
+      const fetchedAt = Date.now();
+      const pools = await this.poolOperatorModel.aggregate<{
+        _id: Types.ObjectId;
+        operatorIds: Types.ObjectId[];
+      }>([
+        { $group: { _id: '$pool', operatorIds: { $push: '$operator' } } },
+        { $match: { 'operatorIds.1': { $exists: true } } },
+      ]);
+
+      for (const { operatorIds } of pools) {
+        const multiplier = poolSynergyMultiplier(operatorIds.length);
+        for (const operatorId of operatorIds) {
+          poolSynergyMultipliers.set(operatorId.toString(), multiplier);
+        }
+      }
+
+      this.poolSynergyMultipliersCache = {
+        version,
+        fetchedAt,
+        multipliers: poolSynergyMultipliers,
+      };
+    } catch (error) {
+      this.logger.error(
+        `❌ (fetchPoolSynergyMultipliers) Error fetching pool synergy multipliers: ${error.message}`,
+        error.stack,
+      );
+      // Don't rethrow; extractor selection falls back to the last fetched (or unboosted) weights
+      return this.poolSynergyMultipliersCache?.multipliers ?? new Map();
+    }
+
+    return poolSynergyMultipliers;
+  }
+
+  /**
+   * Fetches the IDs of all verified operators, whose drills get a small boost in extractor selection.
+   */
+  private async fetchVerifiedOperatorIds(): Promise<Set<string>> {
+    try {
+      const operators = await this.operatorModel
+        .find({ verified: true }, { _id: 1 })
+        .lean();
+
+      return new Set(operators.map((operator) => operator._id.toString()));
+    } catch (error) {
+      this.logger.error(
+        `❌ (fetchVerifiedOperatorIds) Error fetching verified operators: ${error.message}`,
+        error.stack,
+      );
+      // Don't rethrow; extractor selection falls back to unboosted weights
+      return new Set();
+    }
+  }
+
+  /**
+   * Fetches which of the given members of a pool have missed at least `FORFEIT_THRESHOLD` consecutive cycles.
+   */
+  private async fetchDormantPoolMemberIds(
+    poolId: Types.ObjectId,
+    operatorIds: Types.ObjectId[],
+  ): Promise<Set<string>> {
+    if (operatorIds.length === 0) {
+      return new Set();
+    }
+
+    const dormantMembers = await this.poolOperatorModel
+      .find(
+        {
+          pool: poolId,
+          operator: { $in: operatorIds },
+          consecutiveMissedCycles: {
+            $gte: GAME_CONSTANTS.POOLS.FORFEIT_THRESHOLD,
+          },
+        },
+        { operator: 1 },
+      )
+      .lean();
+
+    return new Set(dormantMembers.map((member) => member.operator.toString()));
+  }
+
+  /**
+   * Counts the cycle as missed for pool members without an active drilling session and resets the count of the ones with one.
+   *
+   * The count stops at `FORFEIT_THRESHOLD`, so members that have been dormant for longer aren't rewritten every cycle.
+   */
+  private async updateConsecutiveMissedCycles(
+    activeOperatorIds: Types.ObjectId[],
+  ): Promise<void> {
+    await Promise.all([
+      this.poolOperatorModel.updateMany(
+        {
+          operator: { $nin: activeOperatorIds },
+          consecutiveMissedCycles: {
+            $lt: GAME_CONSTANTS.POOLS.FORFEIT_THRESHOLD,
+          },
+        },
+        { $inc: { consecutiveMissedCycles: 1 } },
+      ),
+      this.poolOperatorModel.updateMany(
+        {
+          operator: { $in: activeOperatorIds },
+          consecutiveMissedCycles: { $gt: 0 },
+        },
+        { $set: { consecutiveMissedCycles: 0 } },
+      ),
+    ]);
+  }
+
+  /**
+   * Credits the rewards forfeited by dormant pool members to their pools' treasuries
+   * and logs a `REWARD_FORFEIT` pool event for each of them.
+   *
+   * Each pool is credited on its own and retried up to 3 times, so a retry never credits another pool twice.
+   * Throws if a pool still can't be credited, since the forfeited $HASH would otherwise be lost.
+   */
+  private async creditPoolTreasuries(
+    forfeitedRewards: {
+      poolId: Types.ObjectId;
+      operatorId: Types.ObjectId;
+      amount: number;
+    }[],
+  ): Promise<void> {
+    const treasuryCredits = new Map<string, number>();
+    for (const { poolId, amount } of forfeitedRewards) {
+      const poolIdStr = poolId.toString();
+      treasuryCredits.set(
+        poolIdStr,
+        (treasuryCredits.get(poolIdStr) || 0) + amount,
+      );
+    }
+
+    const maxRetries = 3;
+    for (const [poolId, amount] of treasuryCredits.entries()) {
+      for (let attempt = 1; ; attempt++) {
+        try {
+          await this.poolModel.updateOne(
+            { _id: new Types.ObjectId(poolId) },
+            { $inc: { treasuryHASH: amount } },
+          );
+          break;
+        } catch (error) {
+          if (attempt >= maxRetries) {
+            throw new Error(
+              `(creditPoolTreasuries) Error crediting ${amount} $HASH to pool ${poolId}'s treasury after ${attempt} attempts: ${error.message}`,
+            );
+          }
+
+          this.logger.warn(
+            `⚠️ (creditPoolTreasuries) Error crediting pool ${poolId}'s treasury, retrying (attempt ${attempt}): ${error.message}`,
+          );
+        }
+      }
+    }
+
+    try {
+      await this.poolEventModel.insertMany(
+        forfeitedRewards.map(({ poolId, operatorId, amount }) => ({
+          poolId,
+          type: PoolEventType.REWARD_FORFEIT,
+          operatorId,
+          amount,
+        })),
+      );
+    } catch (error) {
+      // The treasuries are already credited; the events are only a log
+      this.logger.error(
+        `❌ (creditPoolTreasuries) Error logging forfeit events: ${error.message}`,
+        error.stack,
+      );
+    }
+
+    this.logger.log(
+      `🏦 (creditPoolTreasuries) Forfeited the rewards of ${forfeitedRewards.length} dormant pool members to ${treasuryCredits.size} pool treasuries.`,
+    );
+  }
+
   /**
    * Updates the total rewards for pools and pool operators in a single operation.
    * This method efficiently updates the total rewards without causing circular dependencies.
@@ -1024,10 +1572,22 @@ export class DrillingCycleService {
 
       // If they have an active session, also update the session's earnedHASH
       if (operatorsWithActiveSessions.has(operatorIdStr)) {
+        // Operators in multi-session mode have one session per drill, each receiving its `effShare` of the reward
         sessionUpdateOps.push({
-          updateOne: {
+          updateMany: {
             filter: { operatorId, endTime: null },
-            update: { $inc: { earnedHASH: amount } },
+            update: [
+              {
+                $set: {
+                  earnedHASH: {
+                    $add: [
+                      '$earnedHASH',
+                      { $multiply: [amount, { $ifNull: ['$effShare', 1] }] },
+                    ],
+                  },
+                },
+              },
+            ],
           },
         });
 
@@ -1081,6 +1641,78 @@ export class DrillingCycleService {
     });
   }
 
+  /**
+   * Stores the current cycle's countdown data in Redis.
+   *
+   * `estimatedEndTime = cycleStartTime + CYCLE_DURATION / complexity`.
+   */
+  private async cacheCycleCountdown(
+    cycleNumber: number,
+    cycleStartTime: Date,
+    activeOperators: number,
+  ): Promise<void> {
+    try {
+      const complexity =
+        1 +
+        Math.log10(Math.max(1, activeOperators)) *
+          GAME_CONSTANTS.CYCLES.COUNTDOWN_COMPLEXITY_SCALING;
+      const estimatedEndTime = new Date(
+        cycleStartTime.getTime() + this.cycleDuration / complexity,
+      );
+
+      await this.redisService.set(
+        this.redisCycleCountdownKey,
+        JSON.stringify({
+          currentCycleNumber: cycleNumber,
+          cycleStartTime,
+          estimatedEndTime,
+          complexity,
+          activeOperators,
+        }),
+      );
+    } catch (err: any) {
+      this.logger.error(
+        `❌ (cacheCycleCountdown) Error caching countdown for cycle #${cycleNumber}: ${err.message}`,
+      );
+    }
+  }
+
+  /**
+   * Fetches the countdown to the end of the current cycle (i.e. the next extraction) from Redis.
+   */
+  async getCycleCountdown(): Promise<
+    ApiResponse<{
+      currentCycleNumber: number;
+      cycleStartTime: string;
+      estimatedEndTime: string;
+      secondsRemaining: number;
+      complexity: number;
+      activeOperators: number;
+    } | null>
+  > {
+    const countdownStr = await this.redisService.get(
+      this.redisCycleCountdownKey,
+    );
+
+    if (!countdownStr) {
+      return new ApiResponse(
+        404,
+        `(getCycleCountdown) No active cycle countdown found.`,
+      );
+    }
+
+    const countdown = JSON.parse(countdownStr);
+    const secondsRemaining = Math.max(
+      0,
+      (new Date(countdown.estimatedEndTime).getTime() - Date.now()) / 1000,
+    );
+
+    return new ApiResponse(200, `(getCycleCountdown) Fetched.`, {
+      ...countdown,
+      secondsRemaining,
+    });
+  }
+
   /**
    * Resets the cycle number in Redis (only if required, for example for debugging/testing).
    */
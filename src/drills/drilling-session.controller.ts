@@ -0,0 +1,64 @@
+import {
+  BadRequestException,
+  Controller,
+  Get,
+  Param,
+  Res,
+} from '@nestjs/common';
+import { ApiOperation, ApiParam, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { FastifyReply } from 'fastify';
+import { isValidObjectId, Types } from 'mongoose';
+import { DrillingSessionService } from './drilling-session.service';
+
+@ApiTags('Drilling Sessions')
+@Controller('drilling-sessions')
+export class DrillingSessionController {
+  constructor(private readonly drillingSessionService: DrillingSessionService) {}
+
+  @ApiOperation({
+    summary: "Get an operator's active drilling session",
+    description:
+      "Fetches the operator's open drilling session with the seconds elapsed since it started. Meant for polling the session status",
+  })
+  @ApiParam({
+    name: 'operatorId',
+    description: 'The ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved active drilling session',
+  })
+  @ApiResponse({
+    status: 204,
+    description: 'The operator has no active drilling session',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid operator ID',
+  })
+  @Get('active/:operatorId')
+  async fetchActiveDrillingSession(
+    @Param('operatorId') operatorId: string,
+    @Res({ passthrough: true }) reply: FastifyReply,
+  ) {
+    if (!isValidObjectId(operatorId)) {
+      throw new BadRequestException(
+        `(fetchActiveDrillingSession) Invalid operator ID provided: ${operatorId}`,
+      );
+    }
+
+    const response =
+      await this.drillingSessionService.fetchActiveDrillingSession(
+        new Types.ObjectId(operatorId),
+      );
+
+    // No body for 204s, so clients can simply check the status code
+    if (response.status === 204) {
+      reply.status(204);
+      return;
+    }
+
+    return response;
+  }
+}
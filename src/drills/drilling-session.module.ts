@@ -6,9 +6,20 @@ import {
   DrillingSessionSchema,
 } from './schemas/drilling-session.schema';
 import { DrillingSessionService } from './drilling-session.service';
+import { DrillingSessionController } from './drilling-session.controller';
 import { OperatorModule } from 'src/operators/operator.module';
 import { RedisModule } from 'src/common/redis.module';
 import { OperatorWalletModule } from 'src/operators/operator-wallet.module';
+import { DrillModule } from './drill.module';
+import { MissionModule } from 'src/missions/mission.module';
+import { OnboardingModule } from 'src/onboarding/onboarding.module';
+import { TelegramModule } from 'src/telegram/telegram.module';
+import { LeaderboardModule } from 'src/leaderboard/leaderboard.module';
+import { Drill, DrillSchema } from './schemas/drill.schema';
+import {
+  Operator,
+  OperatorSchema,
+} from 'src/operators/schemas/operator.schema';
 
 @Module({
   imports: [
@@ -16,11 +27,18 @@ import { OperatorWalletModule } from 'src/operators/operator-wallet.module';
     RedisModule, // Import the RedisModule
     OperatorModule, // Import the OperatorModule
     OperatorWalletModule, // Import the OperatorWalletModule
+    DrillModule, // Import the DrillModule (for drill groups)
+    MissionModule, // Import the MissionModule (for mission progress)
+    OnboardingModule, // Import the OnboardingModule (for onboarding progress)
+    TelegramModule, // Import the TelegramModule (for streak milestone notifications)
+    LeaderboardModule, // Import the LeaderboardModule (for operator leaderboard cache invalidation)
     MongooseModule.forFeature([
       { name: DrillingSession.name, schema: DrillingSessionSchema },
+      { name: Drill.name, schema: DrillSchema },
+      { name: Operator.name, schema: OperatorSchema },
     ]),
   ],
-  controllers: [],
+  controllers: [DrillingSessionController],
   providers: [DrillingSessionService],
   exports: [DrillingSessionService], // Export so other modules can use DrillingCycleService
 })
@@ -0,0 +1,241 @@
+import { Test, TestingModule } from '@nestjs/testing';
+import { getModelToken } from '@nestjs/mongoose';
+import { Types } from 'mongoose';
+import {
+  DrillingSessionService,
+  DrillingSessionStatus,
+} from './drilling-session.service';
+import { DrillingSession } from './schemas/drilling-session.schema';
+import { Drill } from './schemas/drill.schema';
+import { DrillGroupService } from './drill-group.service';
+import { Operator } from 'src/operators/schemas/operator.schema';
+import { RedisService } from 'src/common/redis.service';
+import { OperatorService } from 'src/operators/operator.service';
+import { OperatorWalletService } from 'src/operators/operator-wallet.service';
+import { MissionService } from 'src/missions/mission.service';
+import { OnboardingService } from 'src/onboarding/onboarding.service';
+import { TelegramService } from 'src/telegram/telegram.service';
+import { LeaderboardService } from 'src/leaderboard/leaderboard.service';
+import { leanResult } from '../../test/utils/mongoose-mocks';
+
+/**
+ * Unit tests for starting drilling sessions and accruing their earned $HASH
+ */
+describe('DrillingSessionService', () => {
+  let drillingSessionService: DrillingSessionService;
+
+  const operatorId = new Types.ObjectId();
+
+  const drillingSessionModel = {
+    findOne: jest.fn(),
+    create: jest.fn(),
+    insertMany: jest.fn(),
+    updateMany: jest.fn(),
+  };
+  const drillModel = { find: jest.fn() };
+  const operatorModel = { findById: jest.fn() };
+  const redisService = {
+    get: jest.fn(),
+    set: jest.fn(),
+    del: jest.fn(),
+    increment: jest.fn(),
+  };
+  const operatorService = {
+    updateCumulativeEffForSingleOperator: jest.fn(),
+    hasEnoughFuel: jest.fn(),
+    updateDrillingStreaks: jest.fn(),
+  };
+
+  /**
+   * Sets up the operator's session in Redis (`null` for none) and their open session record in MongoDB (`null` for none).
+   */
+  const givenSessions = (
+    redisSession: Record<string, unknown> | null,
+    mongoSession: Record<string, unknown> | null = null,
+  ) => {
+    redisService.get.mockResolvedValue(
+      redisSession ? JSON.stringify(redisSession) : null,
+    );
+    drillingSessionModel.findOne.mockReturnValue({
+      sort: jest.fn().mockReturnValue(leanResult(mongoSession)),
+    });
+  };
+
+  beforeEach(async () => {
+    jest.clearAllMocks();
+
+    operatorService.hasEnoughFuel.mockResolvedValue(true);
+    operatorService.updateDrillingStreaks.mockResolvedValue([]);
+    operatorModel.findById.mockReturnValue(
+      leanResult({ multiSessionMode: false }),
+    );
+
+    const module: TestingModule = await Test.createTestingModule({
+      providers: [
+        DrillingSessionService,
+        {
+          provide: getModelToken(DrillingSession.name),
+          useValue: drillingSessionModel,
+        },
+        { provide: getModelToken(Drill.name), useValue: drillModel },
+        { provide: getModelToken(Operator.name), useValue: operatorModel },
+        { provide: RedisService, useValue: redisService },
+        { provide: OperatorService, useValue: operatorService },
+        {
+          provide: OperatorWalletService,
+          useValue: {
+            updateAssetEquityForOperator: jest.fn().mockResolvedValue(null),
+          },
+        },
+        { provide: DrillGroupService, useValue: {} },
+        { provide: MissionService, useValue: { incrementProgress: jest.fn() } },
+        { provide: OnboardingService, useValue: { completeStep: jest.fn() } },
+        { provide: TelegramService, useValue: { notifyOperator: jest.fn() } },
+        {
+          provide: LeaderboardService,
+          useValue: { invalidateOperatorLeaderboardCache: jest.fn() },
+        },
+      ],
+    }).compile();
+
+    drillingSessionService = module.get(DrillingSessionService);
+  });
+
+  describe('startDrillingSession', () => {
+    it('should refuse to start a second session while one is open in Redis', async () => {
+      givenSessions({
+        operatorId: operatorId.toString(),
+        endTime: null,
+        status: DrillingSessionStatus.ACTIVE,
+      });
+
+      const response =
+        await drillingSessionService.startDrillingSession(operatorId);
+
+      expect(response.status).toBe(400);
+      expect(redisService.set).not.toHaveBeenCalled();
+      expect(drillingSessionModel.create).not.toHaveBeenCalled();
+    });
+
+    it('should refuse to start a second session while one is open in MongoDB', async () => {
+      givenSessions(null, { _id: new Types.ObjectId(), operatorId });
+
+      const response =
+        await drillingSessionService.startDrillingSession(operatorId);
+
+      expect(response.status).toBe(400);
+      expect(redisService.set).not.toHaveBeenCalled();
+      expect(drillingSessionModel.create).not.toHaveBeenCalled();
+    });
+
+    it('should start a waiting session once the previous session ended', async () => {
+      givenSessions({
+        operatorId: operatorId.toString(),
+        endTime: new Date().toISOString(),
+        status: DrillingSessionStatus.COMPLETED,
+      });
+
+      const response =
+        await drillingSessionService.startDrillingSession(operatorId);
+
+      expect(response.status).toBe(200);
+      expect(JSON.parse(redisService.set.mock.calls[0][1])).toMatchObject({
+        operatorId: operatorId.toString(),
+        endTime: null,
+        earnedHASH: 0,
+        status: DrillingSessionStatus.WAITING,
+      });
+      expect(drillingSessionModel.create).toHaveBeenCalledWith(
+        expect.objectContaining({ operatorId, earnedHASH: 0 }),
+      );
+    });
+
+    it("should give each drill session its share of the operator's EFF in multi-session mode", async () => {
+      const strongDrillId = new Types.ObjectId();
+      const weakDrillId = new Types.ObjectId();
+
+      givenSessions(null);
+      operatorModel.findById.mockReturnValue(
+        leanResult({ multiSessionMode: true }),
+      );
+      drillModel.find.mockReturnValue(
+        leanResult([
+          { _id: strongDrillId, actualEff: 300 },
+          { _id: weakDrillId, actualEff: 100 },
+        ]),
+      );
+
+      await drillingSessionService.startDrillingSession(operatorId);
+
+      expect(drillingSessionModel.insertMany).toHaveBeenCalledWith([
+        expect.objectContaining({ drillId: strongDrillId, effShare: 0.75 }),
+        expect.objectContaining({ drillId: weakDrillId, effShare: 0.25 }),
+      ]);
+    });
+  });
+
+  describe('earned $HASH', () => {
+    it('should add cycle rewards to an active session', async () => {
+      givenSessions({
+        operatorId: operatorId.toString(),
+        endTime: null,
+        earnedHASH: 10,
+        status: DrillingSessionStatus.ACTIVE,
+      });
+
+      const updated = await drillingSessionService.updateSessionEarnedHash(
+        operatorId,
+        5,
+      );
+
+      expect(updated).toBe(true);
+      expect(JSON.parse(redisService.set.mock.calls[0][1]).earnedHASH).toBe(
+        15,
+      );
+    });
+
+    it('should not add cycle rewards to a session that is still waiting', async () => {
+      givenSessions({
+        operatorId: operatorId.toString(),
+        endTime: null,
+        earnedHASH: 0,
+        status: DrillingSessionStatus.WAITING,
+      });
+
+      const updated = await drillingSessionService.updateSessionEarnedHash(
+        operatorId,
+        5,
+      );
+
+      expect(updated).toBe(false);
+      expect(redisService.set).not.toHaveBeenCalled();
+    });
+
+    it("should split the session's earned $HASH between its records by EFF share when it ends", async () => {
+      givenSessions({
+        operatorId: operatorId.toString(),
+        endTime: null,
+        earnedHASH: 100,
+        status: DrillingSessionStatus.ACTIVE,
+      });
+
+      const response = await drillingSessionService.forceEndDrillingSession(
+        operatorId,
+        1,
+      );
+
+      expect(response.status).toBe(200);
+      expect(drillingSessionModel.updateMany).toHaveBeenCalledWith(
+        { operatorId, endTime: null },
+        [
+          {
+            $set: {
+              endTime: expect.any(Date),
+              earnedHASH: { $multiply: [100, { $ifNull: ['$effShare', 1] }] },
+            },
+          },
+        ],
+      );
+    });
+  });
+});
@@ -1,4 +1,8 @@
-import { Injectable, Logger } from '@nestjs/common';
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
 import { InjectModel } from '@nestjs/mongoose';
 import { DrillingSession } from './schemas/drilling-session.schema';
 import { Model, Types } from 'mongoose';
@@ -7,6 +11,15 @@ import { ApiResponse } from 'src/common/dto/response.dto';
 import { OperatorService } from 'src/operators/operator.service';
 import { RedisDrillingSession } from 'src/gateway/drilling.gateway.types';
 import { OperatorWalletService } from 'src/operators/operator-wallet.service';
+import { DrillGroupService } from './drill-group.service';
+import { MissionService } from 'src/missions/mission.service';
+import { MissionTargetType } from 'src/missions/schemas/daily-mission.schema';
+import { OnboardingService } from 'src/onboarding/onboarding.service';
+import { OnboardingStep } from 'src/onboarding/schemas/onboarding-progress.schema';
+import { TelegramService } from 'src/telegram/telegram.service';
+import { Drill } from './schemas/drill.schema';
+import { Operator } from 'src/operators/schemas/operator.schema';
+import { LeaderboardService } from 'src/leaderboard/leaderboard.service';
 
 // Define session status enum
 export enum DrillingSessionStatus {
@@ -23,13 +36,25 @@ export class DrillingSessionService {
   private readonly redisWaitingSessionsKey = 'drilling:waitingSessionsCount';
   private readonly redisStoppingSessionsKey = 'drilling:stoppingSessionsCount';
   private readonly redisSessionKeyPrefix = 'drilling:session:';
+  private readonly redisActiveSessionIdKeyPrefix =
+    'drilling:active-session-id:';
+  private readonly activeSessionIdCacheTTL = 5; // 5 seconds
 
   constructor(
     @InjectModel(DrillingSession.name)
     private drillingSessionModel: Model<DrillingSession>,
+    @InjectModel(Drill.name)
+    private drillModel: Model<Drill>,
+    @InjectModel(Operator.name)
+    private operatorModel: Model<Operator>,
     private readonly redisService: RedisService,
     private readonly operatorService: OperatorService,
     private readonly operatorWalletService: OperatorWalletService,
+    private readonly drillGroupService: DrillGroupService,
+    private readonly missionService: MissionService,
+    private readonly onboardingService: OnboardingService,
+    private readonly telegramService: TelegramService,
+    private readonly leaderboardService: LeaderboardService,
   ) {}
 
   /**
@@ -40,14 +65,25 @@ export class DrillingSessionService {
     return `${this.redisSessionKeyPrefix}${operatorId.toString()}`;
   }
 
+  /**
+   * Generates a Redis key for an operator's cached active session ID (see `findActiveSession`).
+   * @param operatorId The operator ID
+   */
+  private getActiveSessionIdKey(operatorId: Types.ObjectId | string): string {
+    return `${this.redisActiveSessionIdKeyPrefix}${operatorId.toString()}`;
+  }
+
   /**
    * Creates a new drilling session in Redis.
    *
    * Called whenever an operator starts drilling for $HASH.
    * The session starts in WAITING status until the next drilling cycle begins.
+   *
+   * If `drillGroupId` is provided, only the drills in that drill group (plus basic drills) will contribute to the session.
    */
   async startDrillingSession(
     operatorId: Types.ObjectId,
+    drillGroupId?: Types.ObjectId,
   ): Promise<ApiResponse<null>> {
     try {
       const operatorIdStr = operatorId.toString();
@@ -84,6 +120,14 @@ export class DrillingSessionService {
         }
       }
 
+      // Also check MongoDB, so an operator never has two open session records
+      if (await this.findActiveSession(operatorId)) {
+        return new ApiResponse<null>(
+          400,
+          `(startDrillingSession) Operator already has an active drilling session.`,
+        );
+      }
+
       // Check if the operator's current fuel is enough.
       if (!(await this.operatorService.hasEnoughFuel(operatorId))) {
         return new ApiResponse<null>(
@@ -92,6 +136,26 @@ export class DrillingSessionService {
         );
       }
 
+      // Pre-select the contributing drills from the drill group (if provided)
+      if (drillGroupId) {
+        const applyResponse = await this.drillGroupService.applyDrillGroup(
+          operatorId,
+          drillGroupId,
+        );
+
+        if (applyResponse.status !== 200) {
+          return new ApiResponse<null>(
+            applyResponse.status,
+            `(startDrillingSession) ${applyResponse.message}`,
+          );
+        }
+
+        // Recalculate cumulativeEff with the newly selected drills
+        await this.operatorService.updateCumulativeEffForSingleOperator(
+          operatorId,
+        );
+      }
+
       // Create a new drilling session in Redis
       const newSession: RedisDrillingSession = {
         operatorId: operatorIdStr,
@@ -114,11 +178,13 @@ export class DrillingSessionService {
       );
 
       // Also store in MongoDB for historical records (initial creation)
-      await this.drillingSessionModel.create({
-        operatorId,
-        startTime: new Date(),
-        earnedHASH: 0,
-      });
+      await this.createSessionRecords(operatorId);
+      await this.invalidateActiveSessionIdCache([operatorId]);
+
+      await this.onboardingService.completeStep(
+        [operatorId],
+        OnboardingStep.START_SESSION,
+      );
 
       return new ApiResponse<null>(
         200,
@@ -132,6 +198,73 @@ export class DrillingSessionService {
     }
   }
 
+  /**
+   * Creates the MongoDB drilling session record(s) for an operator that just started drilling.
+   *
+   * Operators in multi-session mode get one session per active drill, each with an `effShare` equal to
+   * the drill's share of the operator's total active drill EFF. Otherwise, a single aggregate session is created.
+   */
+  private async createSessionRecords(
+    operatorId: Types.ObjectId,
+  ): Promise<void> {
+    const startTime = new Date();
+
+    const operator = await this.operatorModel
+      .findById(operatorId, { multiSessionMode: 1 })
+      .lean();
+
+    if (operator?.multiSessionMode) {
+      const drills = await this.drillModel
+        .find({ operatorId, active: true }, { _id: 1, actualEff: 1 })
+        .lean();
+
+      const totalEff = drills.reduce((sum, drill) => sum + drill.actualEff, 0);
+
+      if (totalEff > 0) {
+        await this.drillingSessionModel.insertMany(
+          drills.map((drill) => ({
+            operatorId,
+            drillId: drill._id,
+            startTime,
+            earnedHASH: 0,
+            effShare: drill.actualEff / totalEff,
+          })),
+        );
+
+        this.logger.log(
+          `✅ (createSessionRecords) Operator ${operatorId} started ${drills.length} drill sessions (multi-session mode).`,
+        );
+
+        return;
+      }
+    }
+
+    await this.drillingSessionModel.create({
+      operatorId,
+      startTime,
+      earnedHASH: 0,
+    });
+  }
+
+  /**
+   * Builds the update that ends an operator's MongoDB drilling session(s).
+   *
+   * `totalEarnedHASH` is the total $HASH earned by the operator during the session;
+   * each session record receives its `effShare` of it (the full amount for regular sessions).
+   */
+  private getEndSessionUpdate(endTime: Date, totalEarnedHASH: number) {
+    return [
+      {
+        $set: {
+          endTime,
+          earnedHASH: {
+            $multiply: [totalEarnedHASH, { $ifNull: ['$effShare', 1] }],
+          },
+        },
+      },
+    ];
+  }
+
   /**
    * Activates all waiting drilling sessions when a new cycle begins.
    * Called by the DrillingCycleService when a new cycle is created.
@@ -284,18 +417,21 @@ export class DrillingSessionService {
         };
       }
 
-      // Update MongoDB for historical records in bulk
+      // Update MongoDB for historical records in bulk.
+      // `updateMany` so that operators in multi-session mode have all of their drill sessions ended at once.
+      const now = new Date();
       const bulkOps = stoppingSessions.map(({ operatorId, session }) => ({
-        updateOne: {
+        updateMany: {
           filter: { operatorId, endTime: null },
-          update: {
-            endTime: new Date(),
-            earnedHASH: session.earnedHASH,
-          },
+          update: this.getEndSessionUpdate(now, session.earnedHASH),
         },
       }));
 
       await this.drillingSessionModel.bulkWrite(bulkOps);
+      await this.invalidateActiveSessionIdCache(
+        stoppingSessions.map(({ operatorId }) => operatorId),
+      );
+      await this.leaderboardService.invalidateOperatorLeaderboardCache();
 
       // Create a map of operator IDs to earned HASH for notifications
       const earnedHASHMap = new Map<string, number>();
@@ -319,6 +455,12 @@ export class DrillingSessionService {
       // Extract operator IDs for notifications
       const operatorIds = stoppingSessions.map(({ operatorId }) => operatorId);
 
+      await this.missionService.incrementProgress(
+        operatorIds,
+        MissionTargetType.COMPLETE_DRILLING_SESSIONS,
+      );
+      await this.updateDrillingStreaks(operatorIds);
+
       this.logger.log(
         `🏁 (completeStoppingSessionsForEndCycle) Completed ${stoppingSessions.length} stopping sessions for cycle #${cycleNumber}`,
       );
@@ -435,14 +577,13 @@ export class DrillingSessionService {
       const previousStatus = session.status;
       const now = new Date();
 
-      // Update MongoDB for historical record
-      await this.drillingSessionModel.findOneAndUpdate(
+      // Update MongoDB for historical record (ends all drill sessions if in multi-session mode)
+      await this.drillingSessionModel.updateMany(
         { operatorId, endTime: null },
-        {
-          endTime: now,
-          earnedHASH: session.earnedHASH,
-        },
+        this.getEndSessionUpdate(now, session.earnedHASH),
       );
+      await this.invalidateActiveSessionIdCache([operatorId]);
+      await this.leaderboardService.invalidateOperatorLeaderboardCache();
 
       // Delete from Redis
       await this.redisService.del(sessionKey);
@@ -456,6 +597,12 @@ export class DrillingSessionService {
         await this.redisService.increment(this.redisStoppingSessionsKey, -1);
       }
 
+      await this.missionService.incrementProgress(
+        [operatorId],
+        MissionTargetType.COMPLETE_DRILLING_SESSIONS,
+      );
+      await this.updateDrillingStreaks([operatorId]);
+
       this.logger.log(
         `🛑 (forceEndDrillingSession) Operator ${operatorId} force stopped drilling in cycle #${cycleNumber}.`,
       );
@@ -472,6 +619,33 @@ export class DrillingSessionService {
     }
   }
 
+  /**
+   * Updates the daily drilling streaks of operators whose drilling session just ended,
+   * and notifies the operators who reached a streak milestone.
+   *
+   * Streaks are secondary to ending the session, so errors are only logged.
+   */
+  private async updateDrillingStreaks(
+    operatorIds: Types.ObjectId[],
+  ): Promise<void> {
+    try {
+      const milestones =
+        await this.operatorService.updateDrillingStreaks(operatorIds);
+
+      for (const { operatorId, streak } of milestones) {
+        await this.telegramService.notifyOperator(
+          operatorId,
+          `${streak}-day drilling streak!`,
+          `You completed a drilling session ${streak} days in a row. Keep it up!`,
+        );
+      }
+    } catch (err: any) {
+      this.logger.warn(
+        `(updateDrillingStreaks) Error updating drilling streaks: ${err.message}`,
+      );
+    }
+  }
+
   /**
    * Updates the earned HASH for an active drilling session.
    */
@@ -641,6 +815,101 @@ export class DrillingSessionService {
     }
   }
 
+  /**
+   * Finds an operator's open MongoDB drilling session (with no `endTime`), or `null` if they aren't drilling.
+   *
+   * Operators in multi-session mode have one open session per drill, in which case the first one is returned.
+   * With `useCache`, the session's ID (or its absence) is cached in Redis for a few seconds to reduce the database load
+   * of clients polling the session status.
+   */
+  async findActiveSession(
+    operatorId: Types.ObjectId,
+    useCache: boolean = false,
+  ): Promise<DrillingSession | null> {
+    const cacheKey = this.getActiveSessionIdKey(operatorId);
+
+    if (useCache) {
+      const cachedSessionId = await this.redisService.get(cacheKey);
+
+      if (cachedSessionId === 'none') return null;
+
+      if (cachedSessionId) {
+        return this.drillingSessionModel
+          .findOne({ _id: new Types.ObjectId(cachedSessionId), endTime: null })
+          .lean();
+      }
+    }
+
+    const session = await this.drillingSessionModel
+      .findOne({ operatorId, endTime: null })
+      .sort({ startTime: 1 })
+      .lean();
+
+    if (useCache) {
+      await this.redisService.set(
+        cacheKey,
+        session ? session._id.toString() : 'none',
+        this.activeSessionIdCacheTTL,
+      );
+    }
+
+    return session;
+  }
+
+  /**
+   * Fetches an operator's active drilling session along with how long it has been running.
+   *
+   * Returns a 204 response if the operator has no active session.
+   */
+  async fetchActiveDrillingSession(operatorId: Types.ObjectId): Promise<
+    ApiResponse<{
+      session: DrillingSession & { elapsedSeconds: number };
+    } | null>
+  > {
+    try {
+      const session = await this.findActiveSession(operatorId, true);
+
+      if (!session) {
+        return new ApiResponse(
+          204,
+          `(fetchActiveDrillingSession) Operator has no active drilling session.`,
+        );
+      }
+
+      const elapsedSeconds = Math.max(
+        0,
+        Math.floor((Date.now() - new Date(session.startTime).getTime()) / 1000),
+      );
+
+      return new ApiResponse(
+        200,
+        `(fetchActiveDrillingSession) Active drilling session fetched.`,
+        { session: { ...session, elapsedSeconds } },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchActiveDrillingSession) Error fetching active drilling session: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Removes the cached active session IDs of the given operators (see `findActiveSession`),
+   * so that polling clients see a started or ended session right away.
+   */
+  private async invalidateActiveSessionIdCache(
+    operatorIds: Types.ObjectId[],
+  ): Promise<void> {
+    await Promise.all(
+      operatorIds.map((operatorId) =>
+        this.redisService.del(this.getActiveSessionIdKey(operatorId)),
+      ),
+    );
+  }
+
   /**
    * Recalibrates session counters in Redis by counting the actual number of sessions
    * in each status. This helps fix counter drift that may occur due to race conditions.
@@ -0,0 +1,142 @@
+import {
+  Body,
+  Controller,
+  Delete,
+  ForbiddenException,
+  Param,
+  Post,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { RateLimit } from 'src/common/decorators/rate-limit.decorator';
+import { ApiResponse as AppApiResponse } from 'src/common/dto/response.dto';
+import { ScheduleDrillingSessionDto } from 'src/common/dto/scheduled-drilling-session.dto';
+import { ScheduledDrillingSessionService } from './scheduled-drilling-session.service';
+
+@ApiTags('Scheduled Drilling Sessions')
+@Controller('operators')
+export class ScheduledDrillingSessionController {
+  constructor(
+    private readonly scheduledDrillingSessionService: ScheduledDrillingSessionService,
+  ) {}
+
+  @ApiOperation({
+    summary: 'Schedule a drilling session',
+    description:
+      'Books a drilling session for the authenticated operator that is started at the scheduled time (once the operator is connected over WebSocket) and stopped after the given duration',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the authenticated operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully scheduled drilling session',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Invalid start time or duration, or too many pending scheduled sessions',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Cannot schedule sessions for another operator',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Drill group not found',
+  })
+  @ApiBearerAuth()
+  @RateLimit(10)
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/scheduled-sessions')
+  async scheduleDrillingSession(
+    @Request() req,
+    @Param('id') id: string,
+    @Body() body: ScheduleDrillingSessionDto,
+  ) {
+    const operatorId = this.assertOwnOperator(req, id);
+
+    return this.scheduledDrillingSessionService.scheduleDrillingSession(
+      operatorId,
+      new Date(body.scheduledStartAt),
+      body.duration,
+      body.drillGroupId ? new Types.ObjectId(body.drillGroupId) : undefined,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Cancel a scheduled drilling session',
+    description:
+      "Cancels one of the authenticated operator's scheduled drilling sessions that hasn't started yet",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the authenticated operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiParam({
+    name: 'scheduleId',
+    description: 'The ID of the scheduled session to cancel',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully cancelled scheduled session',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Scheduled session is no longer pending',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Cannot cancel sessions of another operator',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Scheduled session not found',
+  })
+  @ApiBearerAuth()
+  @RateLimit(10)
+  @UseGuards(JwtAuthGuard)
+  @Delete(':id/scheduled-sessions/:scheduleId')
+  async cancelScheduledDrillingSession(
+    @Request() req,
+    @Param('id') id: string,
+    @Param('scheduleId') scheduleId: string,
+  ) {
+    const operatorId = this.assertOwnOperator(req, id);
+
+    return this.scheduledDrillingSessionService.cancelScheduledDrillingSession(
+      operatorId,
+      new Types.ObjectId(scheduleId),
+    );
+  }
+
+  /**
+   * Ensures the operator ID in the route is the authenticated operator, since operators can only
+   * manage their own scheduled sessions.
+   */
+  private assertOwnOperator(req, id: string): Types.ObjectId {
+    if (id !== req.user.operatorId) {
+      throw new ForbiddenException(
+        new AppApiResponse(
+          403,
+          `(scheduledSessions) Operators can only manage their own scheduled sessions.`,
+        ),
+      );
+    }
+
+    return new Types.ObjectId(req.user.operatorId);
+  }
+}
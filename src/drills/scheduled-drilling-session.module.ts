@@ -0,0 +1,39 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { BullModule } from '@nestjs/bull';
+import {
+  ScheduledDrillingSession,
+  ScheduledDrillingSessionSchema,
+} from './schemas/scheduled-drilling-session.schema';
+import { DrillGroup, DrillGroupSchema } from './schemas/drill-group.schema';
+import { DrillingSessionModule } from './drilling-session.module';
+import { DrillingGatewayModule } from 'src/gateway/drilling.gateway.module';
+import { ScheduledDrillingSessionService } from './scheduled-drilling-session.service';
+import { ScheduledDrillingSessionController } from './scheduled-drilling-session.controller';
+import { ScheduledDrillingSessionQueue } from './scheduled-drilling-session.queue';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      {
+        name: ScheduledDrillingSession.name,
+        schema: ScheduledDrillingSessionSchema,
+      },
+      { name: DrillGroup.name, schema: DrillGroupSchema },
+    ]),
+    BullModule.registerQueue({
+      name: 'scheduled-drilling-session-queue',
+      defaultJobOptions: {
+        attempts: 3, // Retry failed jobs 3 times
+        removeOnComplete: true, // Remove completed jobs
+        removeOnFail: false, // Keep failed jobs for debugging
+      },
+    }),
+    DrillingSessionModule,
+    DrillingGatewayModule,
+  ],
+  controllers: [ScheduledDrillingSessionController], // Expose API endpoints
+  providers: [ScheduledDrillingSessionService, ScheduledDrillingSessionQueue],
+  exports: [ScheduledDrillingSessionService],
+})
+export class ScheduledDrillingSessionModule {}
@@ -0,0 +1,93 @@
+import {
+  Processor,
+  Process,
+  InjectQueue,
+  OnGlobalQueueFailed,
+} from '@nestjs/bull';
+import { Queue } from 'bull';
+import { Injectable, Logger, OnModuleInit } from '@nestjs/common';
+import { ScheduledDrillingSessionService } from './scheduled-drilling-session.service';
+
+@Injectable()
+@Processor('scheduled-drilling-session-queue')
+export class ScheduledDrillingSessionQueue implements OnModuleInit {
+  private readonly logger = new Logger(ScheduledDrillingSessionQueue.name);
+  private readonly oneMinuteInMs = 60 * 1000; // 1 minute
+
+  constructor(
+    private readonly scheduledDrillingSessionService: ScheduledDrillingSessionService,
+    @InjectQueue('scheduled-drilling-session-queue')
+    private readonly scheduledDrillingSessionQueue: Queue,
+  ) {}
+
+  /**
+   * Called when the module initializes.
+   */
+  async onModuleInit() {
+    // ✅ Schedule Scheduled Drilling Session Processing (Every Minute)
+    await this.ensureJobScheduled(
+      'process-scheduled-drilling-sessions',
+      this.oneMinuteInMs,
+    );
+  }
+
+  /**
+   * Ensures a Bull job is scheduled, preventing duplicates.
+   */
+  private async ensureJobScheduled(jobName: string, intervalMs: number) {
+    const existingJobs =
+      await this.scheduledDrillingSessionQueue.getRepeatableJobs();
+    if (!existingJobs.some((job) => job.name === jobName)) {
+      await this.scheduledDrillingSessionQueue.add(
+        jobName,
+        {},
+        {
+          repeat: { every: intervalMs },
+          removeOnComplete: true,
+          removeOnFail: false,
+        },
+      );
+      this.logger.log(
+        `✅ (scheduledDrillingSessionQueue) Scheduled job: ${jobName} every ${intervalMs / 1000 / 60} minutes.`,
+      );
+    } else {
+      this.logger.log(
+        `🔄 (scheduledDrillingSessionQueue) Job already scheduled: ${jobName}.`,
+      );
+    }
+  }
+
+  /**
+   * Starts scheduled drilling sessions that are due and stops the ones that have run for their duration (runs **every minute**).
+   */
+  @Process({
+    name: 'process-scheduled-drilling-sessions',
+    concurrency: 1, // Limit to one concurrent job at a time
+  })
+  async handleProcessScheduledDrillingSessions() {
+    try {
+      const endedCount =
+        await this.scheduledDrillingSessionService.endDueScheduledSessions();
+      const startedCount =
+        await this.scheduledDrillingSessionService.startDueScheduledSessions();
+
+      this.logger.debug(
+        `(process-scheduled-drilling-sessions) Started ${startedCount} and ended ${endedCount} scheduled drilling sessions.`,
+      );
+    } catch (error) {
+      this.logger.error(
+        `❌ (process-scheduled-drilling-sessions) Error processing scheduled drilling sessions: ${error.message}`,
+      );
+    }
+  }
+
+  /**
+   * Handle failed jobs in the queue.
+   */
+  @OnGlobalQueueFailed()
+  onFailed(jobId: number, err: Error) {
+    this.logger.error(
+      `❌ Scheduled Drilling Session Queue job ${jobId} has failed: ${err.message}`,
+    );
+  }
+}
@@ -0,0 +1,383 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import {
+  ScheduledDrillingSession,
+  ScheduledDrillingSessionStatus,
+} from './schemas/scheduled-drilling-session.schema';
+import { DrillGroup } from './schemas/drill-group.schema';
+import { DrillingSessionService } from './drilling-session.service';
+import { RedisService } from 'src/common/redis.service';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { ApiResponse } from 'src/common/dto/response.dto';
+import { DrillingGateway } from 'src/gateway/drilling.gateway';
+
+@Injectable()
+export class ScheduledDrillingSessionService {
+  private readonly logger = new Logger(ScheduledDrillingSessionService.name);
+
+  constructor(
+    @InjectModel(ScheduledDrillingSession.name)
+    private scheduledDrillingSessionModel: Model<ScheduledDrillingSession>,
+    @InjectModel(DrillGroup.name)
+    private drillGroupModel: Model<DrillGroup>,
+    private readonly drillingSessionService: DrillingSessionService,
+    private readonly redisService: RedisService,
+    private readonly drillingGateway: DrillingGateway,
+  ) {}
+
+  /**
+   * Books a drilling session for an operator that will be started at `scheduledStartAt`
+   * and stopped after `duration` seconds.
+   *
+   * Fuel and existing sessions are only checked when the session is started.
+   * Like any other drilling session, it only starts while the operator is connected over WebSocket.
+   */
+  async scheduleDrillingSession(
+    operatorId: Types.ObjectId,
+    scheduledStartAt: Date,
+    duration: number,
+    drillGroupId?: Types.ObjectId,
+  ): Promise<
+    ApiResponse<{ scheduledSession: ScheduledDrillingSession } | null>
+  > {
+    try {
+      const now = Date.now();
+      const {
+        SCHEDULED_SESSION_MIN_DURATION,
+        SCHEDULED_SESSION_MAX_DURATION,
+        SCHEDULED_SESSION_MAX_LEAD_TIME,
+        MAX_PENDING_SCHEDULED_SESSIONS,
+      } = GAME_CONSTANTS.OPERATORS;
+
+      if (scheduledStartAt.getTime() <= now) {
+        return new ApiResponse(
+          400,
+          `(scheduleDrillingSession) Scheduled start time must be in the future.`,
+        );
+      }
+
+      if (
+        scheduledStartAt.getTime() - now >
+        SCHEDULED_SESSION_MAX_LEAD_TIME * 1000
+      ) {
+        return new ApiResponse(
+          400,
+          `(scheduleDrillingSession) Sessions can only be scheduled up to ${SCHEDULED_SESSION_MAX_LEAD_TIME} seconds in advance.`,
+        );
+      }
+
+      if (
+        duration < SCHEDULED_SESSION_MIN_DURATION ||
+        duration > SCHEDULED_SESSION_MAX_DURATION
+      ) {
+        return new ApiResponse(
+          400,
+          `(scheduleDrillingSession) Duration must be between ${SCHEDULED_SESSION_MIN_DURATION} and ${SCHEDULED_SESSION_MAX_DURATION} seconds.`,
+        );
+      }
+
+      if (drillGroupId) {
+        const drillGroupExists = await this.drillGroupModel.exists({
+          _id: drillGroupId,
+          operatorId,
+        });
+
+        if (!drillGroupExists) {
+          return new ApiResponse(
+            404,
+            `(scheduleDrillingSession) Drill group not found.`,
+          );
+        }
+      }
+
+      const pendingCount =
+        await this.scheduledDrillingSessionModel.countDocuments({
+          operatorId,
+          status: ScheduledDrillingSessionStatus.PENDING,
+        });
+
+      if (pendingCount >= MAX_PENDING_SCHEDULED_SESSIONS) {
+        return new ApiResponse(
+          400,
+          `(scheduleDrillingSession) Operator already has ${MAX_PENDING_SCHEDULED_SESSIONS} pending scheduled sessions.`,
+        );
+      }
+
+      const scheduled = await this.scheduledDrillingSessionModel.create({
+        operatorId,
+        scheduledStartAt,
+        duration,
+        scheduledEndAt: new Date(scheduledStartAt.getTime() + duration * 1000),
+        drillGroupId: drillGroupId ?? null,
+      });
+
+      this.logger.log(
+        `📅 (scheduleDrillingSession) Operator ${operatorId} scheduled a ${duration}s drilling session at ${scheduledStartAt.toISOString()}.`,
+      );
+
+      return new ApiResponse(
+        200,
+        `(scheduleDrillingSession) Drilling session scheduled.`,
+        { scheduledSession: scheduled },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(scheduleDrillingSession) Error scheduling drilling session: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Cancels an operator's scheduled drilling session. Only sessions that haven't started yet can be cancelled.
+   */
+  async cancelScheduledDrillingSession(
+    operatorId: Types.ObjectId,
+    scheduleId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const cancelled =
+        await this.scheduledDrillingSessionModel.findOneAndUpdate(
+          {
+            _id: scheduleId,
+            operatorId,
+            status: ScheduledDrillingSessionStatus.PENDING,
+          },
+          { $set: { status: ScheduledDrillingSessionStatus.CANCELLED } },
+        );
+
+      if (!cancelled) {
+        const exists = await this.scheduledDrillingSessionModel.exists({
+          _id: scheduleId,
+          operatorId,
+        });
+
+        if (!exists) {
+          return new ApiResponse(
+            404,
+            `(cancelScheduledDrillingSession) Scheduled session not found.`,
+          );
+        }
+
+        return new ApiResponse(
+          400,
+          `(cancelScheduledDrillingSession) Only pending scheduled sessions can be cancelled.`,
+        );
+      }
+
+      this.logger.log(
+        `🗑️ (cancelScheduledDrillingSession) Operator ${operatorId} cancelled scheduled session ${scheduleId}.`,
+      );
+
+      return new ApiResponse(
+        200,
+        `(cancelScheduledDrillingSession) Scheduled session cancelled.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(cancelScheduledDrillingSession) Error cancelling scheduled session: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Starts the drilling sessions of all pending scheduled sessions whose start time has passed.
+   *
+   * Drilling sessions are stopped when the operator's primary socket disconnects, so a scheduled session
+   * stays pending until the operator is connected, and its drilling session is tracked with one of their sockets.
+   * If the operator doesn't connect before the session would have ended, it fails.
+   *
+   * Returns the number of drilling sessions started.
+   */
+  async startDueScheduledSessions(): Promise<number> {
+    const dueSessions = await this.scheduledDrillingSessionModel
+      .find(
+        {
+          status: ScheduledDrillingSessionStatus.PENDING,
+          scheduledStartAt: { $lte: new Date() },
+        },
+        { _id: 1, operatorId: 1, scheduledStartAt: 1, duration: 1 },
+      )
+      .sort({ scheduledStartAt: 1 })
+      .lean();
+
+    let startedCount = 0;
+
+    for (const dueSession of dueSessions) {
+      const { _id } = dueSession;
+
+      try {
+        const operatorIdStr = dueSession.operatorId.toString();
+
+        if (
+          !this.drillingGateway.getConnectedSocketForOperator(operatorIdStr)
+        ) {
+          const missedEndAt =
+            dueSession.scheduledStartAt.getTime() + dueSession.duration * 1000;
+
+          if (missedEndAt <= Date.now()) {
+            await this.scheduledDrillingSessionModel.updateOne(
+              { _id, status: ScheduledDrillingSessionStatus.PENDING },
+              {
+                $set: {
+                  status: ScheduledDrillingSessionStatus.FAILED,
+                  failureReason:
+                    'Operator was not connected during the scheduled session.',
+                },
+              },
+            );
+          }
+
+          continue;
+        }
+
+        // Claim the session first so a concurrent run can't start it twice
+        const scheduledSession = await this.scheduledDrillingSessionModel
+          .findOneAndUpdate(
+            { _id, status: ScheduledDrillingSessionStatus.PENDING },
+            { $set: { status: ScheduledDrillingSessionStatus.STARTED } },
+            { new: true },
+          )
+          .lean();
+
+        if (!scheduledSession) continue;
+
+        const { operatorId, drillGroupId, duration } = scheduledSession;
+        const response = await this.drillingSessionService.startDrillingSession(
+          operatorId,
+          drillGroupId ?? undefined,
+        );
+
+        if (response.status !== 200) {
+          await this.scheduledDrillingSessionModel.updateOne(
+            { _id },
+            {
+              $set: {
+                status: ScheduledDrillingSessionStatus.FAILED,
+                failureReason: response.message,
+              },
+            },
+          );
+
+          this.logger.warn(
+            `⚠️ (startDueScheduledSessions) Failed to start scheduled session ${_id} for operator ${operatorId}: ${response.message}`,
+          );
+          continue;
+        }
+
+        const tracked =
+          await this.drillingGateway.trackStartedDrillingSession(operatorIdStr);
+
+        if (!tracked) {
+          // The operator disconnected while the session was starting, so stop it again and wait for them to reconnect
+          await this.drillingSessionService.forceEndDrillingSession(
+            operatorId,
+            await this.fetchCurrentCycleNumber(),
+          );
+          await this.scheduledDrillingSessionModel.updateOne(
+            { _id },
+            { $set: { status: ScheduledDrillingSessionStatus.PENDING } },
+          );
+          continue;
+        }
+
+        // Run for the full duration, even if the worker picked the session up late
+        await this.scheduledDrillingSessionModel.updateOne(
+          { _id },
+          { $set: { scheduledEndAt: new Date(Date.now() + duration * 1000) } },
+        );
+
+        startedCount++;
+      } catch (err: any) {
+        this.logger.error(
+          `❌ (startDueScheduledSessions) Error starting scheduled session ${_id}: ${err.message}`,
+        );
+      }
+    }
+
+    return startedCount;
+  }
+
+  /**
+   * Stops the drilling sessions of all started scheduled sessions that have run for their duration.
+   *
+   * The drilling session enters STOPPING status and completes at the end of the current cycle,
+   * the same way as when an operator stops drilling manually.
+   *
+   * Returns the number of scheduled sessions ended.
+   */
+  async endDueScheduledSessions(): Promise<number> {
+    const dueSessions = await this.scheduledDrillingSessionModel
+      .find(
+        {
+          status: ScheduledDrillingSessionStatus.STARTED,
+          scheduledEndAt: { $lte: new Date() },
+        },
+        { _id: 1 },
+      )
+      .lean();
+
+    if (dueSessions.length === 0) return 0;
+
+    const cycleNumber = await this.fetchCurrentCycleNumber();
+
+    let endedCount = 0;
+
+    for (const { _id } of dueSessions) {
+      try {
+        // Claim the session first so a concurrent run can't stop it twice
+        const scheduledSession = await this.scheduledDrillingSessionModel
+          .findOneAndUpdate(
+            { _id, status: ScheduledDrillingSessionStatus.STARTED },
+            { $set: { status: ScheduledDrillingSessionStatus.ENDED } },
+            { new: true },
+          )
+          .lean();
+
+        if (!scheduledSession) continue;
+
+        const response =
+          await this.drillingSessionService.initiateStopDrillingSession(
+            scheduledSession.operatorId,
+            cycleNumber,
+          );
+
+        // The operator may have already stopped drilling manually, which is fine
+        if (response.status !== 200) {
+          this.logger.debug(
+            `(endDueScheduledSessions) Drilling session of operator ${scheduledSession.operatorId} was not stopped: ${response.message}`,
+          );
+        }
+
+        endedCount++;
+      } catch (err: any) {
+        this.logger.error(
+          `❌ (endDueScheduledSessions) Error ending scheduled session ${_id}: ${err.message}`,
+        );
+      }
+    }
+
+    return endedCount;
+  }
+
+  /**
+   * Fetches the current drilling cycle number.
+   */
+  private async fetchCurrentCycleNumber(): Promise<number> {
+    // Directly fetch from Redis to prevent circular dependency with DrillingCycleService.
+    const cycleNumberStr = await this.redisService.get(
+      'drilling-cycle:current',
+    );
+    return cycleNumberStr ? parseInt(cycleNumberStr, 10) : 0;
+  }
+}
@@ -0,0 +1,109 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+/**
+ * `DrillCycleParticipation` represents an active drill that took part in a drilling cycle.
+ *
+ * Records are removed by MongoDB after `CYCLES.DRILL_PARTICIPATION_RETENTION_DAYS` days.
+ */
+@Schema({
+  timestamps: false,
+  collection: 'DrillCycleParticipations',
+  versionKey: false,
+})
+export class DrillCycleParticipation extends Document {
+  /**
+   * The database ID of the participation instance.
+   */
+  @ApiProperty({
+    description: 'The database ID of the participation instance',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({
+    type: Types.ObjectId,
+    default: () => new Types.ObjectId(),
+  })
+  _id: Types.ObjectId;
+
+  /**
+   * The cycle number the drill participated in.
+   */
+  @ApiProperty({
+    description: 'The cycle number the drill participated in',
+    example: 1,
+  })
+  @Prop({ type: Number, required: true, index: true })
+  cycleNumber: number;
+
+  /**
+   * The database ID of the drill.
+   */
+  @ApiProperty({
+    description: 'The database ID of the drill',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Drills' })
+  drillId: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who owns the drill.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who owns the drill',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The cycle number in which the drill got damaged from a failed extraction attempt.
+   *
+   * `null` if the drill was the extractor of the cycle (i.e. not damaged).
+   */
+  @ApiProperty({
+    description:
+      'The cycle number in which the drill got damaged from a failed extraction attempt (null if not damaged)',
+    example: 1,
+    nullable: true,
+  })
+  @Prop({ type: Number, default: null })
+  damagedInCycle: number | null;
+
+  /**
+   * The degradation (in %) added to the drill in this cycle.
+   */
+  @ApiProperty({
+    description: 'The degradation (in %) added to the drill in this cycle',
+    example: 0.001,
+  })
+  @Prop({ type: Number, required: true, default: 0 })
+  degradationIncrease: number;
+
+  /**
+   * When the participation was recorded.
+   */
+  @ApiProperty({
+    description: 'When the participation was recorded',
+    example: '2025-01-01T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true, default: Date.now })
+  participatedAt: Date;
+}
+
+/**
+ * Generate the Mongoose schema for DrillCycleParticipation.
+ */
+export const DrillCycleParticipationSchema = SchemaFactory.createForClass(
+  DrillCycleParticipation,
+);
+
+// Remove old records automatically, since every participating drill gets one each cycle
+DrillCycleParticipationSchema.index(
+  { participatedAt: 1 },
+  {
+    expireAfterSeconds:
+      GAME_CONSTANTS.CYCLES.DRILL_PARTICIPATION_RETENTION_DAYS * 86_400,
+  },
+);
@@ -0,0 +1,96 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The reason a drill's `actualEff` changed.
+ */
+export enum DrillEffEventReason {
+  INACTIVITY_DECAY = 'inactivity_decay',
+  CONFIG_UPGRADE = 'config_upgrade',
+}
+
+/**
+ * `DrillEffEvent` records a change to a drill's `actualEff` outside of regular level upgrades,
+ * e.g. inactivity decay or an upgrade to the next drill configuration.
+ */
+@Schema({ timestamps: true, collection: 'DrillEffEvents', versionKey: false })
+export class DrillEffEvent extends Document {
+  /**
+   * The database ID of the event.
+   */
+  @ApiProperty({
+    description: 'The database ID of the event',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({
+    type: Types.ObjectId,
+    default: () => new Types.ObjectId(),
+  })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the drill.
+   */
+  @ApiProperty({
+    description: 'The database ID of the drill',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Drills' })
+  drillId: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who owns the drill.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who owns the drill',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The reason for the EFF change.
+   */
+  @ApiProperty({
+    description: 'The reason for the EFF change',
+    enum: DrillEffEventReason,
+    example: DrillEffEventReason.INACTIVITY_DECAY,
+  })
+  @Prop({ type: String, required: true, enum: DrillEffEventReason })
+  reason: DrillEffEventReason;
+
+  /**
+   * The drill's `actualEff` before the change.
+   */
+  @ApiProperty({
+    description: "The drill's actualEff before the change",
+    example: 10000,
+  })
+  @Prop({ type: Number, required: true })
+  previousEff: number;
+
+  /**
+   * The drill's `actualEff` after the change.
+   */
+  @ApiProperty({
+    description: "The drill's actualEff after the change",
+    example: 9900,
+  })
+  @Prop({ type: Number, required: true })
+  newEff: number;
+
+  /**
+   * The timestamp when the event was created.
+   */
+  @ApiProperty({
+    description: 'The timestamp when the event was created',
+    example: '2024-03-19T12:00:00.000Z',
+  })
+  createdAt: Date;
+}
+
+/**
+ * Generate the Mongoose schema for DrillEffEvent.
+ */
+export const DrillEffEventSchema = SchemaFactory.createForClass(DrillEffEvent);
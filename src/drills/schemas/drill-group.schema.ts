@@ -0,0 +1,60 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `DrillGroup` represents a named subset of an operator's drills.
+ *
+ * Operators can pick a drill group when starting a drilling session to choose which drills contribute to it.
+ */
+@Schema({ timestamps: true, collection: 'DrillGroups', versionKey: false })
+export class DrillGroup extends Document {
+  /**
+   * The database ID of the drill group.
+   */
+  @ApiProperty({
+    description: 'The database ID of the drill group',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({
+    type: Types.ObjectId,
+    default: () => new Types.ObjectId(),
+  })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who owns the drill group.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who owns the drill group',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The name of the drill group.
+   */
+  @ApiProperty({
+    description: 'The name of the drill group',
+    example: 'Night shift',
+  })
+  @Prop({ type: String, required: true })
+  name: string;
+
+  /**
+   * The database IDs of the drills in this group.
+   */
+  @ApiProperty({
+    description: 'The database IDs of the drills in this group',
+    example: ['507f1f77bcf86cd799439013', '507f1f77bcf86cd799439014'],
+    type: [String],
+  })
+  @Prop({ type: [Types.ObjectId], ref: 'Drills', default: [] })
+  drillIds: Types.ObjectId[];
+}
+
+/**
+ * Generate the Mongoose schema for DrillGroup.
+ */
+export const DrillGroupSchema = SchemaFactory.createForClass(DrillGroup);
@@ -0,0 +1,112 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+import { BlockchainData } from 'src/common/schemas/blockchain-payment.schema';
+
+/**
+ * `DrillInsurance` represents an opt-in insurance bought for a drill, compensating the operator if the drill gets destroyed.
+ */
+@Schema({ timestamps: true, collection: 'DrillInsurances', versionKey: false })
+export class DrillInsurance extends Document {
+  /**
+   * The database ID of the insurance.
+   */
+  @ApiProperty({
+    description: 'The database ID of the insurance',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({
+    type: Types.ObjectId,
+    default: () => new Types.ObjectId(),
+  })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the insured drill.
+   */
+  @ApiProperty({
+    description: 'The database ID of the insured drill',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Drills' })
+  drillId: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who owns the drill.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who owns the drill',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The premium paid for the insurance (in TON).
+   */
+  @ApiProperty({
+    description: 'The premium paid for the insurance (in TON)',
+    example: 0.5,
+  })
+  @Prop({ type: Number, required: true })
+  premiumTON: number;
+
+  /**
+   * How much (in %) of the drill's purchase cost is paid out if the drill is destroyed.
+   */
+  @ApiProperty({
+    description:
+      "How much (in %) of the drill's purchase cost is paid out if the drill is destroyed",
+    example: 50,
+  })
+  @Prop({ type: Number, required: true, min: 0, max: 100 })
+  coveragePercent: number;
+
+  /**
+   * When the insurance expires.
+   */
+  @ApiProperty({
+    description: 'When the insurance expires',
+    example: '2024-04-19T12:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  expiresAt: Date;
+
+  /**
+   * When the insurance was paid out. `null` if it hasn't been claimed.
+   */
+  @ApiProperty({
+    description: 'When the insurance was paid out (null if not claimed)',
+    example: null,
+    nullable: true,
+  })
+  @Prop({ type: Date, default: null })
+  claimedAt: Date | null;
+
+  /**
+   * The blockchain data of the premium payment.
+   */
+  @ApiProperty({
+    description: 'The blockchain data of the premium payment',
+    type: BlockchainData,
+  })
+  @Prop({ type: BlockchainData, required: true })
+  blockchainData: BlockchainData;
+
+  /**
+   * The timestamp when the insurance was bought.
+   */
+  @ApiProperty({
+    description: 'The timestamp when the insurance was bought',
+    example: '2024-03-19T12:00:00.000Z',
+  })
+  createdAt: Date;
+}
+
+/**
+ * Generate the Mongoose schema for DrillInsurance.
+ */
+export const DrillInsuranceSchema =
+  SchemaFactory.createForClass(DrillInsurance);
+
+DrillInsuranceSchema.index({ 'blockchainData.txHash': 1 });
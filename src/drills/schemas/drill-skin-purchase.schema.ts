@@ -0,0 +1,79 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+import { BlockchainData } from 'src/common/schemas/blockchain-payment.schema';
+
+/**
+ * `DrillSkinPurchase` represents a TON payment for applying a skin to a drill.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'DrillSkinPurchases',
+  versionKey: false,
+})
+export class DrillSkinPurchase extends Document {
+  /**
+   * The database ID of the skin purchase.
+   */
+  @ApiProperty({
+    description: 'The database ID of the skin purchase',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the drill the skin was applied to.
+   */
+  @ApiProperty({
+    description: 'The database ID of the drill the skin was applied to',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Drills' })
+  drillId: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who bought the skin.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who bought the skin',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The database ID of the skin.
+   */
+  @ApiProperty({
+    description: 'The database ID of the skin',
+    example: '507f1f77bcf86cd799439014',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'DrillSkins' })
+  skinId: Types.ObjectId;
+
+  /**
+   * The amount paid for the skin (in TON).
+   */
+  @ApiProperty({
+    description: 'The amount paid for the skin (in TON)',
+    example: 0.5,
+  })
+  @Prop({ type: Number, required: true })
+  paidTON: number;
+
+  /**
+   * The blockchain data of the payment.
+   */
+  @ApiProperty({
+    description: 'The blockchain data of the payment',
+    type: BlockchainData,
+  })
+  @Prop({ type: BlockchainData, required: true })
+  blockchainData: BlockchainData;
+}
+
+export const DrillSkinPurchaseSchema =
+  SchemaFactory.createForClass(DrillSkinPurchase);
+
+DrillSkinPurchaseSchema.index({ 'blockchainData.txHash': 1 });
@@ -0,0 +1,63 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `DrillSkin` represents a cosmetic skin that operators can buy with TON and apply to their drills.
+ *
+ * Skins only change how a drill looks and have no effect on its EFF.
+ */
+@Schema({ timestamps: true, collection: 'DrillSkins', versionKey: false })
+export class DrillSkin extends Document {
+  /**
+   * The database ID of the skin.
+   */
+  @ApiProperty({
+    description: 'The database ID of the skin',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The name of the skin.
+   */
+  @ApiProperty({
+    description: 'The name of the skin',
+    example: 'Molten Gold',
+  })
+  @Prop({ type: String, required: true, unique: true })
+  name: string;
+
+  /**
+   * The description of the skin.
+   */
+  @ApiProperty({
+    description: 'The description of the skin',
+    example: 'A drill plated in molten gold.',
+  })
+  @Prop({ type: String, required: true, default: '' })
+  description: string;
+
+  /**
+   * The URL of the skin's image.
+   */
+  @ApiProperty({
+    description: "The URL of the skin's image",
+    example: 'https://cdn.hashland.com/skins/molten-gold.png',
+  })
+  @Prop({ type: String, required: true })
+  imageURL: string;
+
+  /**
+   * The price of applying the skin to a drill (in TON).
+   */
+  @ApiProperty({
+    description: 'The price of applying the skin to a drill (in TON)',
+    example: 0.5,
+  })
+  @Prop({ type: Number, required: true, min: 0 })
+  priceTON: number;
+}
+
+export const DrillSkinSchema = SchemaFactory.createForClass(DrillSkin);
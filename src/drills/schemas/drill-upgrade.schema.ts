@@ -0,0 +1,163 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+import { BlockchainData } from 'src/common/schemas/blockchain-payment.schema';
+import { DrillConfig } from 'src/common/enums/drill.enum';
+
+/**
+ * What a drill upgrade changed.
+ */
+export enum DrillUpgradeType {
+  /** The drill was upgraded to its next level. */
+  LEVEL = 'LEVEL',
+  /** The drill was upgraded to the next configuration on its upgrade path. */
+  CONFIG = 'CONFIG',
+}
+
+/**
+ * `DrillUpgrade` represents a paid upgrade of a drill to its next level or configuration.
+ */
+@Schema({ timestamps: true, collection: 'DrillUpgrades', versionKey: false })
+export class DrillUpgrade extends Document {
+  /**
+   * The database ID of the upgrade.
+   */
+  @ApiProperty({
+    description: 'The database ID of the upgrade',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({
+    type: Types.ObjectId,
+    default: () => new Types.ObjectId(),
+  })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the upgraded drill.
+   */
+  @ApiProperty({
+    description: 'The database ID of the upgraded drill',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Drills' })
+  drillId: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who owns the drill.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who owns the drill',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * What the upgrade changed.
+   */
+  @ApiProperty({
+    description: 'What the upgrade changed',
+    enum: DrillUpgradeType,
+    example: DrillUpgradeType.LEVEL,
+  })
+  @Prop({
+    type: String,
+    required: true,
+    enum: DrillUpgradeType,
+    default: DrillUpgradeType.LEVEL,
+  })
+  type: DrillUpgradeType;
+
+  /**
+   * The drill's level after the upgrade (unchanged by configuration upgrades).
+   */
+  @ApiProperty({
+    description: "The drill's level after the upgrade",
+    example: 2,
+  })
+  @Prop({ type: Number, required: true, min: 1 })
+  level: number;
+
+  /**
+   * The drill's configuration before a configuration upgrade (null for level upgrades).
+   */
+  @ApiProperty({
+    description:
+      "The drill's configuration before a configuration upgrade (null for level upgrades)",
+    enum: DrillConfig,
+    example: DrillConfig.IRONBORE,
+    nullable: true,
+  })
+  @Prop({ type: String, enum: DrillConfig, default: null })
+  previousConfig: DrillConfig | null;
+
+  /**
+   * The drill's configuration after a configuration upgrade (null for level upgrades).
+   */
+  @ApiProperty({
+    description:
+      "The drill's configuration after a configuration upgrade (null for level upgrades)",
+    enum: DrillConfig,
+    example: DrillConfig.BULWARK,
+    nullable: true,
+  })
+  @Prop({ type: String, enum: DrillConfig, default: null })
+  config: DrillConfig | null;
+
+  /**
+   * The drill's EFF before the upgrade.
+   */
+  @ApiProperty({
+    description: "The drill's EFF before the upgrade",
+    example: 1000,
+  })
+  @Prop({ type: Number, required: true })
+  previousEff: number;
+
+  /**
+   * The drill's EFF after the upgrade.
+   */
+  @ApiProperty({
+    description: "The drill's EFF after the upgrade",
+    example: 1150,
+  })
+  @Prop({ type: Number, required: true })
+  newEff: number;
+
+  /**
+   * The cost paid for the upgrade (in TON).
+   */
+  @ApiProperty({
+    description: 'The cost paid for the upgrade (in TON)',
+    example: 1,
+  })
+  @Prop({ type: Number, required: true })
+  costTON: number;
+
+  /**
+   * The blockchain data of the upgrade payment.
+   */
+  @ApiProperty({
+    description: 'The blockchain data of the upgrade payment',
+    type: BlockchainData,
+  })
+  @Prop({ type: BlockchainData, required: true })
+  blockchainData: BlockchainData;
+
+  /**
+   * The timestamp when the drill was upgraded.
+   */
+  @ApiProperty({
+    description: 'The timestamp when the drill was upgraded',
+    example: '2024-03-19T12:00:00.000Z',
+  })
+  createdAt: Date;
+}
+
+/**
+ * Generate the Mongoose schema for DrillUpgrade.
+ */
+export const DrillUpgradeSchema = SchemaFactory.createForClass(DrillUpgrade);
+
+// A transaction can only pay for one upgrade
+DrillUpgradeSchema.index({ 'blockchainData.txHash': 1 }, { unique: true });
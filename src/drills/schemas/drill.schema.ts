@@ -104,6 +104,92 @@ export class Drill extends Document {
   })
   @Prop({ type: Number, required: true, default: 0, index: true })
   actualEff: number;
+
+  /**
+   * The upgrade level of the drill, starting at 1.
+   */
+  @ApiProperty({
+    description: 'The upgrade level of the drill, starting at 1',
+    example: 1,
+  })
+  @Prop({ type: Number, required: true, default: 1, min: 1 })
+  level: number;
+
+  /**
+   * How damaged the drill currently is (in %), caused by failed extraction attempts.
+   */
+  @ApiProperty({
+    description:
+      'How damaged the drill currently is (in %), caused by failed extraction attempts',
+    example: 2.5,
+  })
+  @Prop({ type: Number, required: true, default: 0, min: 0, max: 100 })
+  degradationPercent: number;
+
+  /**
+   * When the drill's degradation was last repaired (NULL if the drill was never maintained).
+   */
+  @ApiProperty({
+    description:
+      "When the drill's degradation was last repaired (null if never maintained)",
+    example: null,
+    nullable: true,
+  })
+  @Prop({ type: Date, default: null })
+  lastMaintainedAt: Date | null;
+
+  /**
+   * When the drill was destroyed by reaching the max degradation (NULL if the drill is intact).
+   *
+   * Destroyed drills are deactivated and can't be activated again.
+   */
+  @ApiProperty({
+    description:
+      'When the drill was destroyed by reaching the max degradation (null if intact)',
+    example: null,
+    nullable: true,
+  })
+  @Prop({ type: Date, default: null })
+  destroyedAt: Date | null;
+
+  /**
+   * The number of cycles in which this drill was selected as the extractor.
+   */
+  @ApiProperty({
+    description:
+      'The number of cycles in which this drill was selected as the extractor',
+    example: 12,
+  })
+  @Prop({ type: Number, required: true, default: 0 })
+  extractorWins: number;
+
+  /**
+   * The total amount of $HASH earned by this drill as the extractor.
+   */
+  @ApiProperty({
+    description:
+      'The total amount of $HASH earned by this drill as the extractor',
+    example: 4500,
+  })
+  @Prop({ type: Number, required: true, default: 0 })
+  totalEarnedHASH: number;
+
+  /**
+   * The database ID of the cosmetic skin applied to the drill (NULL if the drill uses its default look).
+   */
+  @ApiProperty({
+    description:
+      'The database ID of the cosmetic skin applied to the drill (null for the default look)',
+    example: null,
+    nullable: true,
+  })
+  @Prop({
+    type: Types.ObjectId,
+    required: false,
+    default: null,
+    ref: 'DrillSkins',
+  })
+  skinId: Types.ObjectId | null;
 }
 
 export const DrillSchema = SchemaFactory.createForClass(Drill);
@@ -61,3 +61,6 @@ export class DrillingCycleRewardShare extends Document {
 export const DrillingCycleRewardShareSchema = SchemaFactory.createForClass(
   DrillingCycleRewardShare,
 );
+
+// Index for summing an operator's reward shares over a range of cycles
+DrillingCycleRewardShareSchema.index({ operatorId: 1, cycleNumber: 1 });
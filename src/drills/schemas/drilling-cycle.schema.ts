@@ -74,3 +74,8 @@ export class DrillingCycle extends Document {
 }
 
 export const DrillingCycleSchema = SchemaFactory.createForClass(DrillingCycle);
+
+// Index for fetching the cycles that ended within a time window
+DrillingCycleSchema.index({ endTime: 1 });
+// Index for counting an operator's extraction wins within a time window
+DrillingCycleSchema.index({ extractorOperatorId: 1, endTime: 1 });
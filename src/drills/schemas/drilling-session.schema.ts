@@ -19,6 +19,24 @@ export class DrillingSession extends Document {
   @Prop({ type: Types.ObjectId, ref: 'Operators', required: true, index: true })
   operatorId: Types.ObjectId;
 
+  /**
+   * The database ID of the drill this session tracks.
+   *
+   * Only set when the operator drills in multi-session mode (one session per drill).
+   * NULL for regular sessions, which aggregate all of the operator's drills.
+   */
+  @Prop({ type: Types.ObjectId, ref: 'Drills', default: null, index: true })
+  drillId?: Types.ObjectId | null;
+
+  /**
+   * The share (0 to 1) of the operator's earned $HASH attributed to this session.
+   *
+   * For multi-session mode, this is the drill's EFF divided by the operator's total active drill EFF at the start of the session.
+   * Regular sessions always have a share of 1.
+   */
+  @Prop({ type: Number, required: true, default: 1 })
+  effShare: number;
+
   /**
    * The start time of the drilling session.
    */
@@ -40,3 +58,6 @@ export class DrillingSession extends Document {
 
 export const DrillingSessionSchema =
   SchemaFactory.createForClass(DrillingSession);
+
+// Index for paginating an operator's session history by start time
+DrillingSessionSchema.index({ operatorId: 1, startTime: -1 });
@@ -0,0 +1,138 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The lifecycle of a scheduled drilling session.
+ */
+export enum ScheduledDrillingSessionStatus {
+  /** The session hasn't started yet, or is waiting for the operator to connect. */
+  PENDING = 'pending',
+  /** The drilling session was started and will be stopped at `scheduledEndAt`. */
+  STARTED = 'started',
+  /** The drilling session was stopped after running for its duration. */
+  ENDED = 'ended',
+  /** The operator cancelled the session before it started. */
+  CANCELLED = 'cancelled',
+  /** The drilling session couldn't be started (e.g. not enough fuel, already drilling or the operator never connected). */
+  FAILED = 'failed',
+}
+
+/**
+ * `ScheduledDrillingSession` represents a drilling session an operator booked in advance.
+ *
+ * A background worker starts the drilling session at `scheduledStartAt` and stops it at `scheduledEndAt`.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'ScheduledDrillingSessions',
+  versionKey: false,
+})
+export class ScheduledDrillingSession extends Document {
+  /**
+   * The database ID of the scheduled session.
+   */
+  @ApiProperty({
+    description: 'The database ID of the scheduled session',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who scheduled the session.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who scheduled the session',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * When the drilling session should start.
+   */
+  @ApiProperty({
+    description: 'When the drilling session should start',
+    example: '2025-01-01T12:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  scheduledStartAt: Date;
+
+  /**
+   * How long (in seconds) the drilling session should run for.
+   */
+  @ApiProperty({
+    description: 'How long (in seconds) the drilling session should run for',
+    example: 3600,
+  })
+  @Prop({ type: Number, required: true })
+  duration: number;
+
+  /**
+   * When the drilling session should be stopped (`scheduledStartAt` + `duration`).
+   *
+   * Updated to the actual start time + `duration` once the session is started.
+   */
+  @ApiProperty({
+    description:
+      'When the drilling session should be stopped (scheduledStartAt + duration)',
+    example: '2025-01-01T13:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  scheduledEndAt: Date;
+
+  /**
+   * The drill group to drill with, if any.
+   */
+  @ApiProperty({
+    description: 'The database ID of the drill group to drill with, if any',
+    example: '507f1f77bcf86cd799439013',
+    nullable: true,
+  })
+  @Prop({ type: Types.ObjectId, ref: 'DrillGroups', default: null })
+  drillGroupId: Types.ObjectId | null;
+
+  /**
+   * The status of the scheduled session.
+   */
+  @ApiProperty({
+    description: 'The status of the scheduled session',
+    enum: ScheduledDrillingSessionStatus,
+    example: ScheduledDrillingSessionStatus.PENDING,
+  })
+  @Prop({
+    type: String,
+    enum: ScheduledDrillingSessionStatus,
+    default: ScheduledDrillingSessionStatus.PENDING,
+  })
+  status: ScheduledDrillingSessionStatus;
+
+  /**
+   * Why the drilling session couldn't be started (only set if `status` is `FAILED`).
+   */
+  @ApiProperty({
+    description:
+      "Why the drilling session couldn't be started (only set if status is failed)",
+    example: 'Operator does not have enough fuel to start a drilling session.',
+    nullable: true,
+  })
+  @Prop({ type: String, default: null })
+  failureReason: string | null;
+}
+
+/**
+ * Generate the Mongoose schema for ScheduledDrillingSession.
+ */
+export const ScheduledDrillingSessionSchema = SchemaFactory.createForClass(
+  ScheduledDrillingSession,
+);
+
+// Index for the background worker starting due sessions
+ScheduledDrillingSessionSchema.index({ status: 1, scheduledStartAt: 1 });
+
+// Index for the background worker stopping sessions that ran for their duration
+ScheduledDrillingSessionSchema.index({ status: 1, scheduledEndAt: 1 });
+
+// Index for counting an operator's pending sessions
+ScheduledDrillingSessionSchema.index({ operatorId: 1, status: 1 });
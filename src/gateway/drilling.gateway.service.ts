@@ -199,6 +199,95 @@ export class DrillingGatewayService {
     }
   }
 
+  /**
+   * Notifies a lender that one of their $HASH loans wasn't repaid by its due date.
+   *
+   * @param lenderId The ID of the operator who lent the $HASH
+   * @param loan The overdue loan's details
+   */
+  async notifyLoanOverdue(
+    lenderId: Types.ObjectId,
+    loan: {
+      loanId: Types.ObjectId;
+      borrowerId: Types.ObjectId;
+      amount: number;
+      dueAt: Date;
+    },
+  ) {
+    const lenderIdStr = lenderId.toString();
+    const socketIds =
+      this.drillingGateway.getAllSocketsForOperator(lenderIdStr);
+
+    const overdueMessage = {
+      message: `Your loan of ${loan.amount} $HASH is overdue.`,
+      loanId: loan.loanId.toString(),
+      borrowerId: loan.borrowerId.toString(),
+      amount: loan.amount,
+      dueAt: loan.dueAt,
+    };
+
+    for (const socketId of socketIds) {
+      if (this.drillingGateway.server.sockets.sockets.has(socketId)) {
+        this.drillingGateway.server
+          .to(socketId)
+          .emit('loan-overdue', overdueMessage);
+      }
+    }
+
+    this.logger.log(
+      `⏰ Notified lender ${lenderIdStr} on ${socketIds.length} device(s) that loan ${loan.loanId} is overdue`,
+    );
+  }
+
+  /**
+   * Notifies an operator that they unlocked an achievement.
+   *
+   * @param operatorId The ID of the operator who unlocked the achievement
+   * @param achievement The achievement's details
+   */
+  async notifyAchievementUnlocked(
+    operatorId: Types.ObjectId,
+    achievement: {
+      name: string;
+      message: string;
+      rewardHASH: number;
+    },
+  ) {
+    const operatorIdStr = operatorId.toString();
+    const socketIds =
+      this.drillingGateway.getAllSocketsForOperator(operatorIdStr);
+
+    for (const socketId of socketIds) {
+      if (this.drillingGateway.server.sockets.sockets.has(socketId)) {
+        this.drillingGateway.server
+          .to(socketId)
+          .emit('achievement-unlocked', achievement);
+      }
+    }
+
+    this.logger.log(
+      `🏅 Notified operator ${operatorIdStr} on ${socketIds.length} device(s) that they unlocked achievement ${achievement.name}`,
+    );
+  }
+
+  /**
+   * Notifies all connected operators that a limited-edition shop item was restocked.
+   *
+   * @param restock The restocked shop item's details
+   */
+  notifyShopRestock(restock: {
+    shopItemId: string;
+    item: string;
+    quantity: number;
+    remainingSupply: number;
+  }) {
+    this.drillingGateway.server.emit('shop-restock', restock);
+
+    this.logger.log(
+      `📦 Notified all operators that ${restock.item} was restocked (${restock.remainingSupply} left)`,
+    );
+  }
+
   /**
    * Notifies all active operators about the latest drilling cycle.
    *
@@ -4,6 +4,8 @@ import {
   OnGatewayConnection,
   OnGatewayDisconnect,
   SubscribeMessage,
+  MessageBody,
+  ConnectedSocket,
 } from '@nestjs/websockets';
 import { Server, Socket } from 'socket.io';
 import { Logger, OnModuleInit } from '@nestjs/common';
@@ -14,6 +16,7 @@ import {
 import { OperatorService } from 'src/operators/operator.service';
 import { Types, Model } from 'mongoose';
 import { RedisService } from 'src/common/redis.service';
+import { RateLimitService } from 'src/common/services/rate-limit.service';
 import { JwtService } from '@nestjs/jwt';
 import {
   DrillingStartedResponse,
@@ -85,6 +88,7 @@ export class DrillingGateway
     private readonly drillingSessionService: DrillingSessionService,
     private readonly operatorService: OperatorService,
     private readonly redisService: RedisService,
+    private readonly rateLimitService: RateLimitService,
     private readonly jwtService: JwtService,
     @InjectModel(DrillingCycleRewardShare.name)
     private rewardShareModel: Model<DrillingCycleRewardShare>,
@@ -426,6 +430,78 @@ export class DrillingGateway
     return this.activeDrillingOperators.get(operatorId);
   }
 
+  /**
+   * Gets a socket ID of an operator that is still connected.
+   *
+   * @param operatorId The operator ID to look up
+   * @returns A connected socket ID if the operator is online, undefined otherwise
+   */
+  getConnectedSocketForOperator(operatorId: string): string | undefined {
+    return this.getAllSocketsForOperator(operatorId).find((socketId) =>
+      this.server.sockets.sockets.has(socketId),
+    );
+  }
+
+  /**
+   * Tracks an operator's newly started drilling session with one of their connected sockets as the primary socket,
+   * so the session is stopped once the operator disconnects, and notifies all of the operator's sockets.
+   *
+   * Used for sessions that weren't started through `start-drilling` (e.g. scheduled sessions).
+   *
+   * @param operatorId The operator ID
+   * @returns `false` if the operator has no connected socket to track the session with
+   */
+  async trackStartedDrillingSession(operatorId: string): Promise<boolean> {
+    const primarySocketId = this.getConnectedSocketForOperator(operatorId);
+    if (!primarySocketId) {
+      return false;
+    }
+
+    await this.setPrimaryDrillingSocket(operatorId, primarySocketId);
+
+    this.logger.log(
+      `🔄 Operator ${operatorId} started drilling in waiting status (primary socket: ${primarySocketId})`,
+    );
+
+    return true;
+  }
+
+  /**
+   * Tracks the operator as actively drilling with `socketId` as their primary socket,
+   * and notifies all of the operator's sockets that their drilling session started.
+   */
+  private async setPrimaryDrillingSocket(
+    operatorId: string,
+    socketId: string,
+  ): Promise<string[]> {
+    this.activeDrillingOperators.set(operatorId, socketId);
+    await this.saveActiveDrillingOperatorsToRedis();
+
+    // Send drilling started response to all connected sockets for this operator
+    const allSockets = this.getAllSocketsForOperator(operatorId);
+    const drillingStarted = {
+      message: 'Drilling session started successfully',
+      status: DrillingSessionStatus.WAITING,
+    } as DrillingStartedResponse;
+
+    const drillingInfo = {
+      message:
+        'Your drilling session will be activated at the start of the next cycle',
+    } as DrillingInfoResponse;
+
+    for (const id of allSockets) {
+      if (this.server.sockets.sockets.has(id)) {
+        this.server.to(id).emit('drilling-started', drillingStarted);
+        this.server.to(id).emit('drilling-info', drillingInfo);
+      }
+    }
+
+    // Broadcast updated counts
+    this.broadcastOnlineOperators();
+
+    return allSockets;
+  }
+
   /**
    * Emits the updated online operator count to all connected clients.
    * - Helps frontend display real-time active user count.
@@ -437,14 +513,42 @@ export class DrillingGateway
     } as OnlineOperatorUpdateResponse);
   }
 
+  /**
+   * Counts a drilling session start/stop request against the operator's rate limit (10 per minute).
+   *
+   * Emits a `drilling-error` and returns `false` if the limit is exceeded.
+   */
+  private async consumeSessionRateLimit(
+    client: Socket,
+    operatorId: string,
+  ): Promise<boolean> {
+    const { allowed, retryAfter } = await this.rateLimitService.consume(
+      `drilling-session:${operatorId}`,
+      10,
+      60,
+    );
+
+    if (!allowed) {
+      client.emit('drilling-error', {
+        message: `Too many requests. Please try again in ${retryAfter} seconds`,
+      } as DrillingErrorResponse);
+    }
+
+    return allowed;
+  }
+
   /**
    * WebSocket event handler for starting a drilling session.
    *
    * @param client The WebSocket client.
+   * @param data Optional payload containing the drill group to drill with.
    * @returns Success/failure message.
    */
   @SubscribeMessage('start-drilling')
-  async startDrilling(client: Socket) {
+  async startDrilling(
+    @ConnectedSocket() client: Socket,
+    @MessageBody() data?: { drillGroupId?: string },
+  ) {
     try {
       const operatorId = client.data.operatorId;
 
@@ -455,6 +559,8 @@ export class DrillingGateway
         return;
       }
 
+      if (!(await this.consumeSessionRateLimit(client, operatorId))) return;
+
       // Convert string ID to MongoDB ObjectId
       const objectId = new Types.ObjectId(operatorId);
 
@@ -467,36 +573,25 @@ export class DrillingGateway
         return;
       }
 
+      if (data?.drillGroupId && !Types.ObjectId.isValid(data.drillGroupId)) {
+        client.emit('drilling-error', {
+          message: 'Invalid drill group ID',
+        } as DrillingErrorResponse);
+        return;
+      }
+
       // Start drilling session
-      const response =
-        await this.drillingSessionService.startDrillingSession(objectId);
+      const response = await this.drillingSessionService.startDrillingSession(
+        objectId,
+        data?.drillGroupId ? new Types.ObjectId(data.drillGroupId) : undefined,
+      );
 
       if (response.status === 200) {
         // Track this operator as actively drilling with this socket as the primary
-        this.activeDrillingOperators.set(operatorId, client.id);
-        await this.saveActiveDrillingOperatorsToRedis();
-
-        // Send drilling started response to all connected sockets for this operator
-        const allSockets = this.getAllSocketsForOperator(operatorId);
-        const drillingStarted = {
-          message: 'Drilling session started successfully',
-          status: DrillingSessionStatus.WAITING,
-        } as DrillingStartedResponse;
-
-        const drillingInfo = {
-          message:
-            'Your drilling session will be activated at the start of the next cycle',
-        } as DrillingInfoResponse;
-
-        for (const socketId of allSockets) {
-          if (this.server.sockets.sockets.has(socketId)) {
-            this.server.to(socketId).emit('drilling-started', drillingStarted);
-            this.server.to(socketId).emit('drilling-info', drillingInfo);
-          }
-        }
-
-        // Broadcast updated counts
-        this.broadcastOnlineOperators();
+        const allSockets = await this.setPrimaryDrillingSocket(
+          operatorId,
+          client.id,
+        );
 
         this.mixpanelService.track(EVENT_CONSTANTS.DRILLING_START, {
           distinct_id: operatorId,
@@ -650,6 +745,8 @@ export class DrillingGateway
         return;
       }
 
+      if (!(await this.consumeSessionRateLimit(client, operatorId))) return;
+
       // Convert string ID to MongoDB ObjectId
       const objectId = new Types.ObjectId(operatorId);
 
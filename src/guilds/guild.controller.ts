@@ -0,0 +1,122 @@
+import {
+  Body,
+  Controller,
+  Delete,
+  Get,
+  Param,
+  Post,
+  Query,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { CreateGuildDto } from 'src/common/dto/guild.dto';
+import { GetLeaderboardQueryDto } from 'src/common/dto/leaderboard.dto';
+import { GuildService } from './guild.service';
+
+@ApiTags('Guilds')
+@Controller('guilds') // Base route: `/guilds`
+export class GuildController {
+  constructor(private readonly guildService: GuildService) {}
+
+  @ApiOperation({
+    summary: 'Get guild leaderboard',
+    description:
+      'Fetches all guilds ranked by the total $HASH earned by their members',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved guilds',
+  })
+  @Get()
+  async fetchGuildLeaderboard(@Query() query: GetLeaderboardQueryDto) {
+    return this.guildService.fetchGuildLeaderboard(query.page, query.limit);
+  }
+
+  @ApiOperation({
+    summary: 'Create a guild',
+    description:
+      'Founds a new guild with the authenticated operator as its first member',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully created guild',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Already in a guild or name taken',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post()
+  async createGuild(@Request() req, @Body() body: CreateGuildDto) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.guildService.createGuild(
+      operatorId,
+      body.name,
+      body.maxMembers,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Join a guild',
+    description: 'Adds the authenticated operator to a guild',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the guild to join',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully joined guild',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Already in a guild or guild is full',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Guild not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/join')
+  async joinGuild(@Request() req, @Param('id') id: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.guildService.joinGuild(operatorId, new Types.ObjectId(id));
+  }
+
+  @ApiOperation({
+    summary: 'Leave a guild',
+    description: 'Removes the authenticated operator from a guild',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the guild to leave',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully left guild',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Operator is not a member of this guild',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Delete(':id/leave')
+  async leaveGuild(@Request() req, @Param('id') id: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.guildService.leaveGuild(operatorId, new Types.ObjectId(id));
+  }
+}
@@ -0,0 +1,19 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { Guild, GuildSchema } from './schemas/guild.schema';
+import { GuildMember, GuildMemberSchema } from './schemas/guild-member.schema';
+import { GuildService } from './guild.service';
+import { GuildController } from './guild.controller';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: Guild.name, schema: GuildSchema },
+      { name: GuildMember.name, schema: GuildMemberSchema },
+    ]),
+  ],
+  controllers: [GuildController], // Expose API endpoints
+  providers: [GuildService], // Business logic for guilds
+  exports: [MongooseModule, GuildService], // Allow usage in other modules
+})
+export class GuildModule {}
@@ -0,0 +1,259 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { Guild } from './schemas/guild.schema';
+import { GuildMember } from './schemas/guild-member.schema';
+import { ApiResponse } from 'src/common/dto/response.dto';
+import { GuildLeaderboardEntryDto } from 'src/common/dto/guild.dto';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+@Injectable()
+export class GuildService {
+  private readonly logger = new Logger(GuildService.name);
+
+  constructor(
+    @InjectModel(Guild.name) private guildModel: Model<Guild>,
+    @InjectModel(GuildMember.name) private guildMemberModel: Model<GuildMember>,
+  ) {}
+
+  /**
+   * Fetches the guild leaderboard, ranking guilds by the total $HASH earned by their members.
+   */
+  async fetchGuildLeaderboard(
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<ApiResponse<{ leaderboard: GuildLeaderboardEntryDto[] }>> {
+    try {
+      const skip = (page - 1) * limit;
+
+      const guilds = await this.guildModel.aggregate<
+        Omit<GuildLeaderboardEntryDto, 'rank' | 'guildId'> & {
+          _id: Types.ObjectId;
+        }
+      >([
+        {
+          $lookup: {
+            from: 'GuildMembers',
+            localField: '_id',
+            foreignField: 'guildId',
+            as: 'members',
+          },
+        },
+        {
+          $lookup: {
+            from: 'Operators',
+            localField: 'members.operatorId',
+            foreignField: '_id',
+            as: 'operators',
+          },
+        },
+        {
+          $project: {
+            name: 1,
+            maxMembers: 1,
+            memberCount: { $size: '$members' },
+            totalEarnedHASH: { $sum: '$operators.totalEarnedHASH' },
+          },
+        },
+        { $sort: { totalEarnedHASH: -1, _id: 1 } },
+        { $skip: skip },
+        { $limit: limit },
+      ]);
+
+      const leaderboard = guilds.map((guild, index) => ({
+        rank: skip + index + 1,
+        guildId: guild._id.toString(),
+        name: guild.name,
+        memberCount: guild.memberCount,
+        maxMembers: guild.maxMembers,
+        totalEarnedHASH: guild.totalEarnedHASH,
+      }));
+
+      return new ApiResponse(
+        200,
+        `(fetchGuildLeaderboard) Guild leaderboard fetched successfully.`,
+        { leaderboard },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchGuildLeaderboard) Error fetching guild leaderboard: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Creates a guild founded by the operator, who automatically becomes its first member.
+   */
+  async createGuild(
+    founderId: Types.ObjectId,
+    name: string,
+    maxMembers: number = GAME_CONSTANTS.GUILDS.DEFAULT_MAX_MEMBERS,
+  ): Promise<ApiResponse<{ guild: Guild } | null>> {
+    try {
+      if (await this.guildMemberModel.exists({ operatorId: founderId })) {
+        return new ApiResponse(
+          400,
+          `(createGuild) Operator is already in a guild.`,
+        );
+      }
+
+      const guild = await this.guildModel.create({
+        name,
+        founderId,
+        maxMembers,
+      });
+
+      try {
+        await this.guildMemberModel.create({
+          guildId: guild._id,
+          operatorId: founderId,
+          joinedAt: new Date(),
+        });
+      } catch (err: any) {
+        // The founder joined another guild in the meantime
+        await this.guildModel.deleteOne({ _id: guild._id });
+        if (err.code === 11000) {
+          return new ApiResponse(
+            400,
+            `(createGuild) Operator is already in a guild.`,
+          );
+        }
+        throw err;
+      }
+
+      this.logger.log(
+        `🛡️ (createGuild) Operator ${founderId} founded guild ${guild.name}.`,
+      );
+
+      return new ApiResponse(200, `(createGuild) Guild created successfully.`, {
+        guild,
+      });
+    } catch (err: any) {
+      if (err.code === 11000) {
+        return new ApiResponse(
+          400,
+          `(createGuild) A guild with this name already exists.`,
+        );
+      }
+
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(createGuild) Error creating guild: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Adds the operator to a guild, as long as they aren't in one already and the guild isn't full.
+   */
+  async joinGuild(
+    operatorId: Types.ObjectId,
+    guildId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const guild = await this.guildModel
+        .findById(guildId, { maxMembers: 1 })
+        .lean();
+
+      if (!guild) {
+        return new ApiResponse(404, `(joinGuild) Guild not found.`);
+      }
+
+      const memberCount = await this.guildMemberModel.countDocuments({
+        guildId,
+      });
+
+      if (memberCount >= guild.maxMembers) {
+        return new ApiResponse(400, `(joinGuild) Guild is full.`);
+      }
+
+      try {
+        await this.guildMemberModel.create({
+          guildId,
+          operatorId,
+          joinedAt: new Date(),
+        });
+      } catch (err: any) {
+        if (err.code === 11000) {
+          return new ApiResponse(
+            400,
+            `(joinGuild) Operator is already in a guild.`,
+          );
+        }
+        throw err;
+      }
+
+      return new ApiResponse(200, `(joinGuild) Joined guild successfully.`);
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(500, `(joinGuild) Error joining guild: ${err.message}`),
+      );
+    }
+  }
+
+  /**
+   * Removes the operator from a guild.
+   *
+   * If the founder leaves, the longest-standing member becomes the new founder.
+   * If the last member leaves, the guild is deleted.
+   */
+  async leaveGuild(
+    operatorId: Types.ObjectId,
+    guildId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const removed = await this.guildMemberModel.deleteOne({
+        guildId,
+        operatorId,
+      });
+
+      if (removed.deletedCount === 0) {
+        return new ApiResponse(
+          404,
+          `(leaveGuild) Operator is not a member of this guild.`,
+        );
+      }
+
+      const guild = await this.guildModel
+        .findById(guildId, { founderId: 1 })
+        .lean();
+
+      if (guild?.founderId.equals(operatorId)) {
+        const nextFounder = await this.guildMemberModel
+          .findOne({ guildId }, { operatorId: 1 })
+          .sort({ joinedAt: 1 })
+          .lean();
+
+        if (nextFounder) {
+          await this.guildModel.updateOne(
+            { _id: guildId },
+            { $set: { founderId: nextFounder.operatorId } },
+          );
+        } else {
+          await this.guildModel.deleteOne({ _id: guildId });
+          this.logger.log(
+            `🗑️ (leaveGuild) Guild ${guildId} deleted after its last member left.`,
+          );
+        }
+      }
+
+      return new ApiResponse(200, `(leaveGuild) Left guild successfully.`);
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(leaveGuild) Error leaving guild: ${err.message}`,
+        ),
+      );
+    }
+  }
+}
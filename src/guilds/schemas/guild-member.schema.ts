@@ -0,0 +1,58 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `GuildMember` represents an operator's membership in a guild.
+ *
+ * An operator can be a member of at most one guild at a time.
+ */
+@Schema({ timestamps: true, collection: 'GuildMembers', versionKey: false })
+export class GuildMember extends Document {
+  /**
+   * The database ID of the guild member.
+   */
+  @ApiProperty({
+    description: 'The database ID of the guild member',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the guild.
+   */
+  @ApiProperty({
+    description: 'The database ID of the guild',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Guilds' })
+  guildId: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who joined the guild.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who joined the guild',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({
+    type: Types.ObjectId,
+    required: true,
+    unique: true,
+    ref: 'Operators',
+  })
+  operatorId: Types.ObjectId;
+
+  /**
+   * When the operator joined the guild.
+   */
+  @ApiProperty({
+    description: 'When the operator joined the guild',
+    example: '2025-01-01T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true, default: Date.now })
+  joinedAt: Date;
+}
+
+export const GuildMemberSchema = SchemaFactory.createForClass(GuildMember);
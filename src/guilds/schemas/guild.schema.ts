@@ -0,0 +1,59 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+/**
+ * `Guild` represents a loose association of operators.
+ *
+ * Unlike pools, guilds share no rewards; they only appear together on the guild leaderboard.
+ */
+@Schema({ timestamps: true, collection: 'Guilds', versionKey: false })
+export class Guild extends Document {
+  /**
+   * The database ID of the guild.
+   */
+  @ApiProperty({
+    description: 'The database ID of the guild',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The name of the guild.
+   */
+  @ApiProperty({
+    description: 'The name of the guild',
+    example: 'Deep Diggers',
+  })
+  @Prop({ type: String, required: true, unique: true })
+  name: string;
+
+  /**
+   * The database ID of the operator who founded the guild.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who founded the guild',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Operators' })
+  founderId: Types.ObjectId;
+
+  /**
+   * The maximum number of members the guild can have.
+   */
+  @ApiProperty({
+    description: 'The maximum number of members the guild can have',
+    example: 50,
+  })
+  @Prop({
+    type: Number,
+    required: true,
+    min: 1,
+    default: GAME_CONSTANTS.GUILDS.DEFAULT_MAX_MEMBERS,
+  })
+  maxMembers: number;
+}
+
+export const GuildSchema = SchemaFactory.createForClass(Guild);
@@ -29,6 +29,34 @@ export class HashReserveService {
     );
   }
 
+  /**
+   * Withdraws up to `amount` $HASH from the HASH Reserve.
+   *
+   * Returns the amount actually withdrawn, which is capped at the $HASH currently in the reserve.
+   */
+  async withdrawFromHASHReserve(amount: number): Promise<number> {
+    if (amount <= 0) return 0;
+
+    const totalHASH = await this.getTotalHASHReserved();
+    const withdrawn = Math.min(amount, totalHASH);
+    if (withdrawn <= 0) return 0;
+
+    // Only withdraw if the reserve still holds enough (guards against concurrent withdrawals)
+    const result = await this.hashReserveModel.findOneAndUpdate(
+      { totalHASH: { $gte: withdrawn } },
+      { $inc: { totalHASH: -withdrawn } },
+      { new: true },
+    );
+
+    if (!result) return 0;
+
+    this.logger.log(
+      `✅ (withdrawFromHASHReserve) Withdrew ${withdrawn} $HASH from reserve. New total: ${result.totalHASH}`,
+    );
+
+    return withdrawn;
+  }
+
   /**
    * Fetches the current total HASH reserved.
    */
@@ -1,13 +1,24 @@
-import { Controller, Get, Query } from '@nestjs/common';
+import { Controller, Get, Query, Res } from '@nestjs/common';
 import { ApiOperation, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { FastifyReply } from 'fastify';
 import { ApiResponse as AppApiResponse } from 'src/common/dto/response.dto';
 import { LeaderboardService } from './leaderboard.service';
 import { Types } from 'mongoose';
 import {
+  DrillLeaderboardEntryDto,
+  DrillLeaderboardResponseDto,
+  GetDrillLeaderboardQueryDto,
   GetLeaderboardQueryDto,
   GetPoolLeaderboardQueryDto,
+  GetTopSpendersQueryDto,
   LeaderboardEntryDto,
   LeaderboardResponseDto,
+  OperatorLeaderboardEntryDto,
+  OperatorLeaderboardResponseDto,
+  PoolLeaderboardEntryDto,
+  PoolsLeaderboardResponseDto,
+  TopSpenderEntryDto,
+  TopSpendersResponseDto,
 } from 'src/common/dto/leaderboard.dto';
 
 @ApiTags('Leaderboard')
@@ -37,6 +48,108 @@ export class LeaderboardController {
     return this.leaderboardService.getLeaderboard(query.page, query.limit);
   }
 
+  @ApiOperation({
+    summary: 'Get operator leaderboard',
+    description:
+      'Fetches a paginated leaderboard of operators sorted by the total HASH earned across their completed drilling sessions. The `X-Cache` header tells whether the page was served from the cache (`HIT`) or not (`MISS`)',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved operator leaderboard',
+    type: OperatorLeaderboardResponseDto,
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid pagination parameters',
+  })
+  @Get('operators')
+  async getOperatorLeaderboard(
+    @Query() query: GetLeaderboardQueryDto,
+    @Res({ passthrough: true }) reply: FastifyReply,
+  ): Promise<AppApiResponse<{
+    leaderboard: OperatorLeaderboardEntryDto[];
+  }> | null> {
+    const { response, cacheHit } =
+      await this.leaderboardService.getOperatorLeaderboard(
+        query.page,
+        query.limit,
+      );
+
+    reply.header('X-Cache', cacheHit ? 'HIT' : 'MISS');
+    return response;
+  }
+
+  @ApiOperation({
+    summary: 'Get pools leaderboard',
+    description:
+      "Fetches a paginated leaderboard of all pools sorted by the total EFF of their members' active drills. Pools without members are included with zero values",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved pools leaderboard',
+    type: PoolsLeaderboardResponseDto,
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid pagination parameters',
+  })
+  @Get('pools')
+  async getPoolsLeaderboard(
+    @Query() query: GetLeaderboardQueryDto,
+  ): Promise<AppApiResponse<{
+    leaderboard: PoolLeaderboardEntryDto[];
+  }> | null> {
+    return this.leaderboardService.getPoolsLeaderboard(
+      query.page,
+      query.limit,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get top spenders leaderboard',
+    description: 'Fetches the operators who have spent the most TON',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved top spenders',
+    type: TopSpendersResponseDto,
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid limit',
+  })
+  @Get('top-spenders')
+  async getTopSpenders(
+    @Query() query: GetTopSpendersQueryDto,
+  ): Promise<AppApiResponse<{
+    leaderboard: TopSpenderEntryDto[];
+  }> | null> {
+    return this.leaderboardService.getTopSpenders(query.limit);
+  }
+
+  @ApiOperation({
+    summary: 'Get drill leaderboard',
+    description:
+      'Fetches the best-performing drills across all operators sorted by HASH earned as the extractor',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved drill leaderboard',
+    type: DrillLeaderboardResponseDto,
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid limit',
+  })
+  @Get('drills')
+  async getDrillLeaderboard(
+    @Query() query: GetDrillLeaderboardQueryDto,
+  ): Promise<AppApiResponse<{
+    leaderboard: DrillLeaderboardEntryDto[];
+  }> | null> {
+    return this.leaderboardService.getDrillLeaderboard(query.limit);
+  }
+
   @ApiOperation({
     summary: 'Get pool leaderboard',
     description:
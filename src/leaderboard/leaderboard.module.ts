@@ -10,12 +10,21 @@ import {
   PoolOperator,
   PoolOperatorSchema,
 } from 'src/pools/schemas/pool-operator.schema';
+import { Drill, DrillSchema } from 'src/drills/schemas/drill.schema';
+import { Pool, PoolSchema } from 'src/pools/schemas/pool.schema';
+import {
+  DrillingSession,
+  DrillingSessionSchema,
+} from 'src/drills/schemas/drilling-session.schema';
 
 @Module({
   imports: [
     MongooseModule.forFeature([
       { name: Operator.name, schema: OperatorSchema },
       { name: PoolOperator.name, schema: PoolOperatorSchema },
+      { name: Drill.name, schema: DrillSchema },
+      { name: DrillingSession.name, schema: DrillingSessionSchema },
+      { name: Pool.name, schema: PoolSchema },
     ]),
   ],
   controllers: [LeaderboardController],
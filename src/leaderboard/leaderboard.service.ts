@@ -4,16 +4,35 @@ import { InjectModel } from '@nestjs/mongoose';
 import { ApiResponse } from 'src/common/dto/response.dto';
 import { Operator } from 'src/operators/schemas/operator.schema';
 import { PoolOperator } from 'src/pools/schemas/pool-operator.schema';
-import { LeaderboardEntryDto } from 'src/common/dto/leaderboard.dto';
+import { Drill } from 'src/drills/schemas/drill.schema';
+import { DrillingSession } from 'src/drills/schemas/drilling-session.schema';
+import { Pool } from 'src/pools/schemas/pool.schema';
+import { RedisService } from 'src/common/redis.service';
+import {
+  DrillLeaderboardEntryDto,
+  LeaderboardEntryDto,
+  OperatorLeaderboardEntryDto,
+  PoolLeaderboardEntryDto,
+  TopSpenderEntryDto,
+} from 'src/common/dto/leaderboard.dto';
 
 @Injectable()
 export class LeaderboardService {
   private readonly logger = new Logger(LeaderboardService.name);
+  private readonly operatorLeaderboardCachePrefix = 'leaderboard:operators:';
+  private readonly operatorLeaderboardCacheTTL = 60; // 1 minute in seconds
+  private readonly poolsLeaderboardCachePrefix = 'leaderboard:pools:';
+  private readonly poolsLeaderboardCacheTTL = 30; // 30 seconds
 
   constructor(
     @InjectModel(Operator.name) private readonly operatorModel: Model<Operator>,
     @InjectModel(PoolOperator.name)
     private readonly poolOperatorModel: Model<PoolOperator>,
+    @InjectModel(Drill.name) private readonly drillModel: Model<Drill>,
+    @InjectModel(DrillingSession.name)
+    private readonly drillingSessionModel: Model<DrillingSession>,
+    @InjectModel(Pool.name) private readonly poolModel: Model<Pool>,
+    private readonly redisService: RedisService,
   ) {}
 
   /**
@@ -77,6 +96,479 @@ export class LeaderboardService {
     }
   }
 
+  /**
+   * Fetches the operators ranked by the total $HASH earned across all of their completed drilling sessions, with pagination.
+   *
+   * Each page is cached in Redis for a minute and invalidated whenever drilling sessions end
+   * (see `invalidateOperatorLeaderboardCache`). `cacheHit` tells whether the page came from the cache.
+   */
+  async getOperatorLeaderboard(
+    page: number = 1,
+    limit: number = 100,
+  ): Promise<{
+    response: ApiResponse<{
+      leaderboard: OperatorLeaderboardEntryDto[];
+    }> | null;
+    cacheHit: boolean;
+  }> {
+    // Page number must be a positive integer
+    if (isNaN(page) || page < 1) {
+      return {
+        response: new ApiResponse(
+          400,
+          '(getOperatorLeaderboard) Leaderboard pagination page value invalid.',
+        ),
+        cacheHit: false,
+      };
+    }
+
+    // Max limit at 100
+    if (isNaN(limit) || limit < 1 || limit > 100) {
+      return {
+        response: new ApiResponse(
+          400,
+          '(getOperatorLeaderboard) Leaderboard pagination limit value invalid.',
+        ),
+        cacheHit: false,
+      };
+    }
+
+    try {
+      const cacheKey = `${this.operatorLeaderboardCachePrefix}${page}:${limit}`;
+      const cachedLeaderboard = await this.redisService.get(cacheKey);
+
+      if (cachedLeaderboard) {
+        return {
+          response: new ApiResponse(
+            200,
+            `(getOperatorLeaderboard) Successfully fetched operator leaderboard.`,
+            { leaderboard: JSON.parse(cachedLeaderboard) },
+          ),
+          cacheHit: true,
+        };
+      }
+
+      const skip = (page - 1) * limit;
+      const rankings = await this.drillingSessionModel.aggregate<{
+        _id: Types.ObjectId;
+        totalHASH: number;
+        username: string | null;
+        poolId: Types.ObjectId | null;
+      }>([
+        { $match: { endTime: { $ne: null } } },
+        {
+          $group: { _id: '$operatorId', totalHASH: { $sum: '$earnedHASH' } },
+        },
+        // Tie-break on the operator ID so pages don't overlap
+        { $sort: { totalHASH: -1, _id: 1 } },
+        { $skip: skip },
+        { $limit: limit },
+        {
+          $lookup: {
+            from: 'Operators',
+            localField: '_id',
+            foreignField: '_id',
+            as: 'operator',
+          },
+        },
+        {
+          $lookup: {
+            from: 'PoolOperators',
+            localField: '_id',
+            foreignField: 'operator',
+            as: 'poolOperator',
+          },
+        },
+        {
+          $project: {
+            totalHASH: 1,
+            username: {
+              $ifNull: [
+                { $arrayElemAt: ['$operator.usernameData.username', 0] },
+                null,
+              ],
+            },
+            poolId: {
+              $ifNull: [{ $arrayElemAt: ['$poolOperator.pool', 0] }, null],
+            },
+          },
+        },
+      ]);
+
+      const rankedLeaderboard: OperatorLeaderboardEntryDto[] = rankings.map(
+        (ranking, index) => ({
+          rank: index + 1 + skip,
+          operatorId: ranking._id.toString(),
+          username: ranking.username,
+          totalHASH: ranking.totalHASH,
+          poolId: ranking.poolId?.toString() ?? null,
+        }),
+      );
+
+      await this.redisService.set(
+        cacheKey,
+        JSON.stringify(rankedLeaderboard),
+        this.operatorLeaderboardCacheTTL,
+      );
+
+      return {
+        response: new ApiResponse(
+          200,
+          `(getOperatorLeaderboard) Successfully fetched operator leaderboard.`,
+          { leaderboard: rankedLeaderboard },
+        ),
+        cacheHit: false,
+      };
+    } catch (err: any) {
+      this.logger.error(
+        `(getOperatorLeaderboard) Error fetching operator leaderboard: ${err.message}`,
+      );
+      return {
+        response: new ApiResponse(
+          500,
+          '(getOperatorLeaderboard) Internal server error',
+        ),
+        cacheHit: false,
+      };
+    }
+  }
+
+  /**
+   * Removes all cached pages of the operator leaderboard.
+   *
+   * Called whenever drilling sessions end, as their earned $HASH changes the rankings.
+   */
+  async invalidateOperatorLeaderboardCache(): Promise<void> {
+    try {
+      const keys = await this.redisService.scanKeys(
+        `${this.operatorLeaderboardCachePrefix}*`,
+      );
+
+      await Promise.all(keys.map((key) => this.redisService.del(key)));
+    } catch (err: any) {
+      this.logger.error(
+        `(invalidateOperatorLeaderboardCache) Error invalidating operator leaderboard cache: ${err.message}`,
+      );
+    }
+  }
+
+  /**
+   * Fetches all pools ranked by the total EFF of their members' active drills, with pagination.
+   *
+   * Pools without members are still ranked, with zero EFF and $HASH earned. Each page is cached in Redis for 30 seconds.
+   */
+  async getPoolsLeaderboard(
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<ApiResponse<{
+    leaderboard: PoolLeaderboardEntryDto[];
+  }> | null> {
+    // Page number must be a positive integer
+    if (isNaN(page) || page < 1) {
+      return new ApiResponse(
+        400,
+        '(getPoolsLeaderboard) Leaderboard pagination page value invalid.',
+      );
+    }
+
+    // Max limit at 100
+    if (isNaN(limit) || limit < 1 || limit > 100) {
+      return new ApiResponse(
+        400,
+        '(getPoolsLeaderboard) Leaderboard pagination limit value invalid.',
+      );
+    }
+
+    try {
+      const cacheKey = `${this.poolsLeaderboardCachePrefix}${page}:${limit}`;
+      const cachedLeaderboard = await this.redisService.get(cacheKey);
+
+      if (cachedLeaderboard) {
+        return new ApiResponse(
+          200,
+          `(getPoolsLeaderboard) Successfully fetched pools leaderboard.`,
+          { leaderboard: JSON.parse(cachedLeaderboard) },
+        );
+      }
+
+      const skip = (page - 1) * limit;
+      const rankings = await this.poolModel.aggregate<{
+        _id: Types.ObjectId;
+        name: string;
+        leaderUsername: string | null;
+        totalEff: number;
+        memberCount: number;
+        totalHASHEarned: number;
+      }>([
+        {
+          $lookup: {
+            from: 'PoolOperators',
+            localField: '_id',
+            foreignField: 'pool',
+            as: 'members',
+          },
+        },
+        {
+          $project: {
+            name: 1,
+            leaderId: 1,
+            memberIds: '$members.operator',
+          },
+        },
+        // Sum the EFF of the members' active drills (empty pools get no drills, i.e. 0 EFF)
+        {
+          $lookup: {
+            from: 'Drills',
+            let: { memberIds: '$memberIds' },
+            pipeline: [
+              {
+                $match: {
+                  $expr: { $in: ['$operatorId', '$$memberIds'] },
+                  active: true,
+                },
+              },
+              { $group: { _id: null, totalEff: { $sum: '$actualEff' } } },
+            ],
+            as: 'drillEff',
+          },
+        },
+        {
+          $addFields: {
+            memberCount: { $size: '$memberIds' },
+            totalEff: {
+              $ifNull: [{ $arrayElemAt: ['$drillEff.totalEff', 0] }, 0],
+            },
+          },
+        },
+        // Tie-break on the pool ID so pages don't overlap
+        { $sort: { totalEff: -1, _id: 1 } },
+        { $skip: skip },
+        { $limit: limit },
+        // Only look up the $HASH earned and the leader for the pools on this page
+        {
+          $lookup: {
+            from: 'DrillingSessions',
+            let: { memberIds: '$memberIds' },
+            pipeline: [
+              {
+                $match: {
+                  $expr: { $in: ['$operatorId', '$$memberIds'] },
+                  endTime: { $ne: null },
+                },
+              },
+              {
+                $group: { _id: null, totalHASH: { $sum: '$earnedHASH' } },
+              },
+            ],
+            as: 'sessionHASH',
+          },
+        },
+        {
+          $lookup: {
+            from: 'Operators',
+            localField: 'leaderId',
+            foreignField: '_id',
+            as: 'leader',
+          },
+        },
+        {
+          $project: {
+            name: 1,
+            memberCount: 1,
+            totalEff: 1,
+            totalHASHEarned: {
+              $ifNull: [{ $arrayElemAt: ['$sessionHASH.totalHASH', 0] }, 0],
+            },
+            leaderUsername: {
+              $ifNull: [
+                { $arrayElemAt: ['$leader.usernameData.username', 0] },
+                null,
+              ],
+            },
+          },
+        },
+      ]);
+
+      const rankedLeaderboard: PoolLeaderboardEntryDto[] = rankings.map(
+        (ranking, index) => ({
+          rank: index + 1 + skip,
+          poolId: ranking._id.toString(),
+          name: ranking.name,
+          leaderUsername: ranking.leaderUsername,
+          totalEff: ranking.totalEff,
+          memberCount: ranking.memberCount,
+          totalHASHEarned: ranking.totalHASHEarned,
+        }),
+      );
+
+      await this.redisService.set(
+        cacheKey,
+        JSON.stringify(rankedLeaderboard),
+        this.poolsLeaderboardCacheTTL,
+      );
+
+      return new ApiResponse(
+        200,
+        `(getPoolsLeaderboard) Successfully fetched pools leaderboard.`,
+        { leaderboard: rankedLeaderboard },
+      );
+    } catch (err: any) {
+      this.logger.error(
+        `(getPoolsLeaderboard) Error fetching pools leaderboard: ${err.message}`,
+      );
+      return new ApiResponse(
+        500,
+        '(getPoolsLeaderboard) Internal server error',
+      );
+    }
+  }
+
+  /**
+   * Fetches the operators who have spent the most TON.
+   */
+  async getTopSpenders(limit: number = 10): Promise<ApiResponse<{
+    leaderboard: TopSpenderEntryDto[];
+  }> | null> {
+    // Max limit at 100
+    if (isNaN(limit) || limit < 1 || limit > 100) {
+      return new ApiResponse(
+        400,
+        '(getTopSpenders) Leaderboard limit value invalid.',
+      );
+    }
+
+    try {
+      const leaderboard = await this.operatorModel
+        .find(
+          { totalTONSpent: { $gt: 0 } },
+          {
+            'usernameData.username': 1,
+            totalTONSpent: 1,
+          },
+        )
+        .sort({ totalTONSpent: -1 })
+        .limit(limit)
+        .lean();
+
+      // Map the leaderboard to include the rank
+      const rankedLeaderboard = leaderboard.map((operator, index) => ({
+        rank: index + 1,
+        username: operator.usernameData.username,
+        totalTONSpent: operator.totalTONSpent,
+      }));
+
+      return new ApiResponse(
+        200,
+        `(getTopSpenders) Successfully fetched top spenders.`,
+        { leaderboard: rankedLeaderboard },
+      );
+    } catch (err: any) {
+      this.logger.error(
+        `(getTopSpenders) Error fetching top spenders: ${err.message}`,
+      );
+      return new ApiResponse(500, '(getTopSpenders) Internal server error');
+    }
+  }
+
+  /**
+   * Fetches the best-performing drills across all operators, ranked by the $HASH they earned as the extractor.
+   *
+   * Rankings are read from the `leaderboard:drills` sorted set, which is updated each cycle for the extractor drill.
+   */
+  async getDrillLeaderboard(limit: number = 20): Promise<ApiResponse<{
+    leaderboard: DrillLeaderboardEntryDto[];
+  }> | null> {
+    // Max limit at 100
+    if (isNaN(limit) || limit < 1 || limit > 100) {
+      return new ApiResponse(
+        400,
+        '(getDrillLeaderboard) Leaderboard limit value invalid.',
+      );
+    }
+
+    try {
+      const rankings = await this.redisService.getTopSortedSetMembers(
+        'leaderboard:drills',
+        limit,
+      );
+
+      if (rankings.length === 0) {
+        return new ApiResponse(
+          200,
+          `(getDrillLeaderboard) Successfully fetched drill leaderboard.`,
+          { leaderboard: [] },
+        );
+      }
+
+      const drills = await this.drillModel
+        .find(
+          {
+            _id: {
+              $in: rankings.map(({ member }) => new Types.ObjectId(member)),
+            },
+          },
+          {
+            operatorId: 1,
+            config: 1,
+            version: 1,
+            actualEff: 1,
+            extractorWins: 1,
+          },
+        )
+        .lean();
+      const drillMap = new Map(
+        drills.map((drill) => [drill._id.toString(), drill]),
+      );
+
+      // Fetch the usernames of the drills' owners
+      const owners = await this.operatorModel
+        .find(
+          { _id: { $in: drills.map((drill) => drill.operatorId) } },
+          { 'usernameData.username': 1 },
+        )
+        .lean();
+      const ownerUsernameMap = new Map(
+        owners.map((owner) => [
+          owner._id.toString(),
+          owner.usernameData.username,
+        ]),
+      );
+
+      // Map the rankings to include the rank, skipping drills that no longer exist
+      const rankedLeaderboard: DrillLeaderboardEntryDto[] = [];
+      for (const { member, score } of rankings) {
+        const drill = drillMap.get(member);
+        if (!drill) continue;
+
+        rankedLeaderboard.push({
+          rank: rankedLeaderboard.length + 1,
+          drillId: member,
+          ownerUsername:
+            ownerUsernameMap.get(drill.operatorId.toString()) || null,
+          config: drill.config,
+          version: drill.version,
+          actualEff: drill.actualEff,
+          extractorWins: drill.extractorWins,
+          totalEarnedHASH: score,
+        });
+      }
+
+      return new ApiResponse(
+        200,
+        `(getDrillLeaderboard) Successfully fetched drill leaderboard.`,
+        { leaderboard: rankedLeaderboard },
+      );
+    } catch (err: any) {
+      this.logger.error(
+        `(getDrillLeaderboard) Error fetching drill leaderboard: ${err.message}`,
+      );
+      return new ApiResponse(
+        500,
+        '(getDrillLeaderboard) Internal server error',
+      );
+    }
+  }
+
   /**
    * Fetches the leaderboard for a specific pool with pagination.
    *
@@ -0,0 +1,157 @@
+import {
+  Body,
+  Controller,
+  Delete,
+  Get,
+  Param,
+  Post,
+  Query,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { CreateLoanOfferDto } from 'src/common/dto/hash-loan.dto';
+import { GetLeaderboardQueryDto } from 'src/common/dto/leaderboard.dto';
+import { HASHLoanService } from './hash-loan.service';
+
+@ApiTags('Loans')
+@Controller('loans') // Base route: `/loans`
+export class HASHLoanController {
+  constructor(private readonly hashLoanService: HASHLoanService) {}
+
+  @ApiOperation({
+    summary: 'Get open loan offers',
+    description: 'Fetches all loan offers that are waiting for a borrower',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved loan offers',
+  })
+  @Get()
+  async fetchOpenLoanOffers(@Query() query: GetLeaderboardQueryDto) {
+    return this.hashLoanService.fetchOpenLoanOffers(query.page, query.limit);
+  }
+
+  @ApiOperation({
+    summary: 'Offer a loan',
+    description:
+      'Creates a loan offer from the authenticated operator. The loan amount is held until the offer is accepted or cancelled',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully created loan offer',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid loan terms or insufficient $HASH',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post()
+  async createLoanOffer(@Request() req, @Body() body: CreateLoanOfferDto) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.hashLoanService.createLoanOffer(
+      operatorId,
+      body.amount,
+      body.interestRatePct,
+      body.durationDays,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Cancel a loan offer',
+    description:
+      "Cancels one of the authenticated operator's open loan offers and releases the held $HASH",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the loan offer to cancel',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully cancelled loan offer',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Open loan offer not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Delete(':id')
+  async cancelLoanOffer(@Request() req, @Param('id') id: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.hashLoanService.cancelLoanOffer(
+      operatorId,
+      new Types.ObjectId(id),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Accept a loan offer',
+    description:
+      'Borrows the $HASH of a loan offer. The loan is due after the offer duration',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the loan offer to accept',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully accepted loan',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Own offer or offer no longer available',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Open loan offer not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/accept')
+  async acceptLoan(@Request() req, @Param('id') id: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.hashLoanService.acceptLoan(operatorId, new Types.ObjectId(id));
+  }
+
+  @ApiOperation({
+    summary: 'Repay a loan',
+    description:
+      'Repays a loan borrowed by the authenticated operator, plus interest',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the loan to repay',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully repaid loan',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Insufficient $HASH or already repaid',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Outstanding loan not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/repay')
+  async repayLoan(@Request() req, @Param('id') id: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.hashLoanService.repayLoan(operatorId, new Types.ObjectId(id));
+  }
+}
@@ -0,0 +1,38 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { BullModule } from '@nestjs/bull';
+import { HASHLoan, HASHLoanSchema } from './schemas/hash-loan.schema';
+import {
+  Operator,
+  OperatorSchema,
+} from 'src/operators/schemas/operator.schema';
+import { OperatorModule } from 'src/operators/operator.module';
+import { DrillingGatewayModule } from 'src/gateway/drilling.gateway.module';
+import { TelegramModule } from 'src/telegram/telegram.module';
+import { HASHLoanService } from './hash-loan.service';
+import { HASHLoanController } from './hash-loan.controller';
+import { HASHLoanQueue } from './hash-loan.queue';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: HASHLoan.name, schema: HASHLoanSchema },
+      { name: Operator.name, schema: OperatorSchema },
+    ]),
+    BullModule.registerQueue({
+      name: 'hash-loan-queue',
+      defaultJobOptions: {
+        attempts: 3, // Retry failed jobs 3 times
+        removeOnComplete: true, // Remove completed jobs
+        removeOnFail: false, // Keep failed jobs for debugging
+      },
+    }),
+    OperatorModule,
+    DrillingGatewayModule, // For notifying lenders about overdue loans
+    TelegramModule, // For Telegram and in-app notifications
+  ],
+  controllers: [HASHLoanController], // Expose API endpoints
+  providers: [HASHLoanService, HASHLoanQueue],
+  exports: [HASHLoanService],
+})
+export class HASHLoanModule {}
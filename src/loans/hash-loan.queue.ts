@@ -0,0 +1,79 @@
+import {
+  Processor,
+  Process,
+  InjectQueue,
+  OnGlobalQueueFailed,
+} from '@nestjs/bull';
+import { Queue } from 'bull';
+import { Injectable, Logger, OnModuleInit } from '@nestjs/common';
+import { HASHLoanService } from './hash-loan.service';
+
+@Injectable()
+@Processor('hash-loan-queue')
+export class HASHLoanQueue implements OnModuleInit {
+  private readonly logger = new Logger(HASHLoanQueue.name);
+  private readonly oneHourInMs = 60 * 60 * 1000; // 1 hour
+
+  constructor(
+    private readonly hashLoanService: HASHLoanService,
+    @InjectQueue('hash-loan-queue') private readonly hashLoanQueue: Queue,
+  ) {}
+
+  /**
+   * Called when the module initializes.
+   */
+  async onModuleInit() {
+    // ✅ Schedule Overdue Loan Check (Every 1 Hour)
+    await this.ensureJobScheduled('flag-overdue-loans', this.oneHourInMs);
+  }
+
+  /**
+   * Ensures a Bull job is scheduled, preventing duplicates.
+   */
+  private async ensureJobScheduled(jobName: string, intervalMs: number) {
+    const existingJobs = await this.hashLoanQueue.getRepeatableJobs();
+    if (!existingJobs.some((job) => job.name === jobName)) {
+      await this.hashLoanQueue.add(
+        jobName,
+        {},
+        {
+          repeat: { every: intervalMs },
+          removeOnComplete: true,
+          removeOnFail: false,
+        },
+      );
+      this.logger.log(
+        `✅ (hashLoanQueue) Scheduled job: ${jobName} every ${intervalMs / 1000 / 60} minutes.`,
+      );
+    } else {
+      this.logger.log(`🔄 (hashLoanQueue) Job already scheduled: ${jobName}.`);
+    }
+  }
+
+  /**
+   * Flags loans that weren't repaid by their due date (runs **every hour**).
+   */
+  @Process({
+    name: 'flag-overdue-loans',
+    concurrency: 1, // Limit to one concurrent job at a time
+  })
+  async handleFlagOverdueLoans() {
+    try {
+      await this.hashLoanService.flagOverdueLoans();
+    } catch (error) {
+      this.logger.error(
+        `❌ (flag-overdue-loans) Error flagging overdue loans: ${error.message}`,
+      );
+    }
+  }
+
+  /**
+   * Handle failed jobs in the queue.
+   */
+  @OnGlobalQueueFailed()
+  onFailed(jobId: number, err: Error) {
+    this.logger.error(
+      `❌ HASH Loan Queue job ${jobId} has failed: ${err.message}`,
+    );
+  }
+}
@@ -0,0 +1,434 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { HASHLoan, HASHLoanStatus } from './schemas/hash-loan.schema';
+import { Operator } from 'src/operators/schemas/operator.schema';
+import { HashTransactionCategory } from 'src/operators/schemas/hash-transaction.schema';
+import { OperatorService } from 'src/operators/operator.service';
+import { DrillingGatewayService } from 'src/gateway/drilling.gateway.service';
+import { TelegramService } from 'src/telegram/telegram.service';
+import { ApiResponse } from 'src/common/dto/response.dto';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+@Injectable()
+export class HASHLoanService {
+  private readonly logger = new Logger(HASHLoanService.name);
+
+  constructor(
+    @InjectModel(HASHLoan.name) private hashLoanModel: Model<HASHLoan>,
+    @InjectModel(Operator.name) private operatorModel: Model<Operator>,
+    private readonly operatorService: OperatorService,
+    private readonly drillingGatewayService: DrillingGatewayService,
+    private readonly telegramService: TelegramService,
+  ) {}
+
+  /**
+   * Creates a loan offer. The loan amount is held from the lender's balance until the offer is accepted or cancelled.
+   */
+  async createLoanOffer(
+    lenderId: Types.ObjectId,
+    amount: number,
+    interestRatePct: number,
+    durationDays: number,
+  ): Promise<ApiResponse<{ loanId: Types.ObjectId }>> {
+    try {
+      const { MIN_AMOUNT, MAX_INTEREST_RATE_PCT, MAX_DURATION_DAYS } =
+        GAME_CONSTANTS.LOANS;
+
+      if (amount < MIN_AMOUNT) {
+        return new ApiResponse(
+          400,
+          `(createLoanOffer) Loan amount must be at least ${MIN_AMOUNT} $HASH.`,
+        );
+      }
+
+      if (interestRatePct < 0 || interestRatePct > MAX_INTEREST_RATE_PCT) {
+        return new ApiResponse(
+          400,
+          `(createLoanOffer) Interest rate must be between 0 and ${MAX_INTEREST_RATE_PCT}%.`,
+        );
+      }
+
+      if (durationDays < 1 || durationDays > MAX_DURATION_DAYS) {
+        return new ApiResponse(
+          400,
+          `(createLoanOffer) Loan duration must be between 1 and ${MAX_DURATION_DAYS} days.`,
+        );
+      }
+
+      const loanId = new Types.ObjectId();
+
+      const holdResult = await this.operatorService.holdHASH(
+        lenderId,
+        amount,
+        HashTransactionCategory.LOAN_OFFER,
+        `Loan offer ${loanId}`,
+        loanId,
+        'hash_loan',
+      );
+
+      if (!holdResult.success) {
+        return new ApiResponse(400, `(createLoanOffer) ${holdResult.error}.`);
+      }
+
+      await this.hashLoanModel.create({
+        _id: loanId,
+        lenderId,
+        amount,
+        interestRatePct,
+        durationDays,
+      });
+
+      this.logger.log(
+        `💸 (createLoanOffer) Operator ${lenderId} offered a loan of ${amount} $HASH at ${interestRatePct}% for ${durationDays} days.`,
+      );
+
+      return new ApiResponse(
+        200,
+        `(createLoanOffer) Loan offer created successfully.`,
+        { loanId },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(createLoanOffer) Error creating loan offer: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches open loan offers, newest first.
+   */
+  async fetchOpenLoanOffers(
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<ApiResponse<{ loans: HASHLoan[] }>> {
+    try {
+      const loans = await this.hashLoanModel
+        .find({ status: HASHLoanStatus.OPEN })
+        .sort({ createdAt: -1 })
+        .skip((page - 1) * limit)
+        .limit(limit)
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(fetchOpenLoanOffers) Loan offers fetched.`,
+        { loans },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchOpenLoanOffers) Error fetching loan offers: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Cancels an open loan offer and releases the held $HASH back to the lender.
+   */
+  async cancelLoanOffer(
+    lenderId: Types.ObjectId,
+    loanId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const loan = await this.hashLoanModel.findOneAndUpdate(
+        { _id: loanId, lenderId, status: HASHLoanStatus.OPEN },
+        { $set: { status: HASHLoanStatus.CANCELLED } },
+      );
+
+      if (!loan) {
+        return new ApiResponse(
+          404,
+          `(cancelLoanOffer) Open loan offer not found.`,
+        );
+      }
+
+      await this.operatorService.releaseHold(
+        lenderId,
+        loan.amount,
+        HashTransactionCategory.LOAN_OFFER,
+        `Loan offer ${loanId} cancelled`,
+        loanId,
+        'hash_loan',
+      );
+
+      return new ApiResponse(200, `(cancelLoanOffer) Loan offer cancelled.`);
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(cancelLoanOffer) Error cancelling loan offer: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Accepts a loan offer, transferring the held $HASH from the lender to the borrower.
+   */
+  async acceptLoan(
+    borrowerId: Types.ObjectId,
+    loanId: Types.ObjectId,
+  ): Promise<ApiResponse<{ dueAt: Date }>> {
+    try {
+      const loan = await this.hashLoanModel
+        .findOne({ _id: loanId, status: HASHLoanStatus.OPEN })
+        .lean();
+
+      if (!loan) {
+        return new ApiResponse(404, `(acceptLoan) Open loan offer not found.`);
+      }
+
+      if (loan.lenderId.equals(borrowerId)) {
+        return new ApiResponse(
+          400,
+          `(acceptLoan) Operators cannot accept their own loan offers.`,
+        );
+      }
+
+      const dueAt = new Date(Date.now() + loan.durationDays * 86_400_000);
+
+      // Claim the offer first so it can only be accepted once
+      const claimed = await this.hashLoanModel.updateOne(
+        { _id: loanId, status: HASHLoanStatus.OPEN },
+        {
+          $set: { status: HASHLoanStatus.ACTIVE, borrowerId, dueAt },
+        },
+      );
+
+      if (claimed.modifiedCount === 0) {
+        return new ApiResponse(
+          400,
+          `(acceptLoan) Loan offer is no longer available.`,
+        );
+      }
+
+      const settleResult = await this.operatorService.settleHold(
+        loan.lenderId,
+        loan.amount,
+        HashTransactionCategory.LOAN_DISBURSEMENT,
+        `Loan ${loanId} disbursed to operator ${borrowerId}`,
+        loanId,
+        'hash_loan',
+      );
+
+      if (!settleResult.success) {
+        // The lender's hold can't cover the loan anymore, so cancel the offer instead of minting the loan amount
+        await this.hashLoanModel.updateOne(
+          { _id: loanId },
+          {
+            $set: {
+              status: HASHLoanStatus.CANCELLED,
+              borrowerId: null,
+              dueAt: null,
+            },
+          },
+        );
+
+        const releaseResult = await this.operatorService.releaseHold(
+          loan.lenderId,
+          loan.amount,
+          HashTransactionCategory.LOAN_OFFER,
+          `Loan offer ${loanId} cancelled (could not be disbursed)`,
+          loanId,
+          'hash_loan',
+        );
+
+        if (!releaseResult.success) {
+          this.logger.error(
+            `(acceptLoan) Failed to release the held $HASH of loan ${loanId} for operator ${loan.lenderId}: ${releaseResult.error}`,
+          );
+        }
+
+        return new ApiResponse(
+          400,
+          `(acceptLoan) Loan offer could not be disbursed: ${settleResult.error}.`,
+        );
+      }
+
+      await this.operatorService.addHASH(
+        borrowerId,
+        loan.amount,
+        HashTransactionCategory.LOAN_DISBURSEMENT,
+        `Loan ${loanId} received from operator ${loan.lenderId}`,
+        loanId,
+        'hash_loan',
+      );
+
+      this.logger.log(
+        `🤝 (acceptLoan) Operator ${borrowerId} borrowed ${loan.amount} $HASH from operator ${loan.lenderId}.`,
+      );
+
+      return new ApiResponse(200, `(acceptLoan) Loan accepted.`, { dueAt });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(acceptLoan) Error accepting loan: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Repays a loan, transferring the loan amount plus interest from the borrower back to the lender.
+   *
+   * Repaying an overdue loan clears it from the borrower's record.
+   */
+  async repayLoan(
+    borrowerId: Types.ObjectId,
+    loanId: Types.ObjectId,
+  ): Promise<ApiResponse<{ repaidAmount: number }>> {
+    try {
+      const loan = await this.hashLoanModel
+        .findOne({
+          _id: loanId,
+          borrowerId,
+          status: { $in: [HASHLoanStatus.ACTIVE, HASHLoanStatus.OVERDUE] },
+        })
+        .lean();
+
+      if (!loan) {
+        return new ApiResponse(404, `(repayLoan) Outstanding loan not found.`);
+      }
+
+      const repaidAmount = loan.amount * (1 + loan.interestRatePct / 100);
+
+      // Claim the loan first so it can only be repaid once
+      const claimed = await this.hashLoanModel.updateOne(
+        { _id: loanId, status: loan.status },
+        { $set: { status: HASHLoanStatus.REPAID, repaidAt: new Date() } },
+      );
+
+      if (claimed.modifiedCount === 0) {
+        return new ApiResponse(400, `(repayLoan) Loan was already repaid.`);
+      }
+
+      const deductResult = await this.operatorService.deductHASH(
+        borrowerId,
+        repaidAmount,
+        HashTransactionCategory.LOAN_REPAYMENT,
+        `Loan ${loanId} repaid to operator ${loan.lenderId}`,
+        loanId,
+        'hash_loan',
+      );
+
+      if (!deductResult.success) {
+        // Roll back the claim
+        await this.hashLoanModel.updateOne(
+          { _id: loanId },
+          { $set: { status: loan.status, repaidAt: null } },
+        );
+
+        return new ApiResponse(400, `(repayLoan) ${deductResult.error}.`);
+      }
+
+      await this.operatorService.addHASH(
+        loan.lenderId,
+        repaidAmount,
+        HashTransactionCategory.LOAN_REPAYMENT,
+        `Loan ${loanId} repaid by operator ${borrowerId}`,
+        loanId,
+        'hash_loan',
+      );
+
+      if (loan.status === HASHLoanStatus.OVERDUE) {
+        await this.operatorModel.updateOne(
+          { _id: borrowerId },
+          { $inc: { overdueLoans: -1 } },
+        );
+        await this.refreshTrustScore(borrowerId);
+      }
+
+      this.logger.log(
+        `✅ (repayLoan) Operator ${borrowerId} repaid ${repaidAmount} $HASH to operator ${loan.lenderId}.`,
+      );
+
+      return new ApiResponse(200, `(repayLoan) Loan repaid.`, {
+        repaidAmount,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(repayLoan) Error repaying loan: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Flags all active loans past their due date as overdue.
+   *
+   * The borrower's trust score loses its clean record component, and both the lender and borrower are notified.
+   */
+  async flagOverdueLoans(): Promise<void> {
+    const startTime = performance.now();
+
+    const overdueLoans = await this.hashLoanModel
+      .find({ status: HASHLoanStatus.ACTIVE, dueAt: { $lte: new Date() } })
+      .lean();
+
+    let flaggedCount = 0;
+
+    for (const loan of overdueLoans) {
+      const flagged = await this.hashLoanModel.updateOne(
+        { _id: loan._id, status: HASHLoanStatus.ACTIVE },
+        { $set: { status: HASHLoanStatus.OVERDUE } },
+      );
+
+      if (flagged.modifiedCount === 0) continue;
+
+      await this.operatorModel.updateOne(
+        { _id: loan.borrowerId },
+        { $inc: { overdueLoans: 1 } },
+      );
+      await this.refreshTrustScore(loan.borrowerId);
+
+      await this.drillingGatewayService.notifyLoanOverdue(loan.lenderId, {
+        loanId: loan._id,
+        borrowerId: loan.borrowerId,
+        amount: loan.amount,
+        dueAt: loan.dueAt,
+      });
+
+      await this.telegramService.notifyOperator(
+        loan.lenderId,
+        'Loan overdue',
+        `Your loan of ${loan.amount} $HASH (${loan._id}) to operator ${loan.borrowerId} was not repaid by its due date.`,
+      );
+      await this.telegramService.notifyOperator(
+        loan.borrowerId,
+        'Loan overdue',
+        `Your loan of ${loan.amount} $HASH (${loan._id}) is overdue. Repay it to restore your trust score.`,
+      );
+
+      flaggedCount++;
+    }
+
+    this.logger.log(
+      `✅ (flagOverdueLoans) Flagged ${flaggedCount} overdue loans in ${(performance.now() - startTime).toFixed(2)}ms.`,
+    );
+  }
+
+  /**
+   * Recomputes an operator's trust score right away instead of waiting for the daily update.
+   */
+  private async refreshTrustScore(operatorId: Types.ObjectId): Promise<void> {
+    const trustScore = await this.operatorService.computeTrustScore(operatorId);
+
+    await this.operatorModel.updateOne(
+      { _id: operatorId },
+      { $set: { trustScore } },
+    );
+  }
+}
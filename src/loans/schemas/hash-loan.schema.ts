@@ -0,0 +1,130 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The lifecycle of a $HASH loan.
+ */
+export enum HASHLoanStatus {
+  /** The lender's offer is waiting for a borrower. The loan amount is held from the lender's balance. */
+  OPEN = 'open',
+  /** A borrower accepted the offer and received the loan amount. */
+  ACTIVE = 'active',
+  /** The borrower didn't repay the loan by its due date. */
+  OVERDUE = 'overdue',
+  /** The borrower repaid the loan (plus interest) to the lender. */
+  REPAID = 'repaid',
+  /** The lender withdrew the offer before anyone accepted it, or the held $HASH couldn't be disbursed. */
+  CANCELLED = 'cancelled',
+}
+
+/**
+ * `HASHLoan` represents a $HASH loan between two operators.
+ *
+ * A loan starts as an offer from the lender, and is due `durationDays` after a borrower accepts it.
+ */
+@Schema({ timestamps: true, collection: 'HASHLoans', versionKey: false })
+export class HASHLoan extends Document {
+  /**
+   * The database ID of the loan.
+   */
+  @ApiProperty({
+    description: 'The database ID of the loan',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the operator lending the $HASH.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator lending the $HASH',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Operators' })
+  lenderId: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who accepted the loan (NULL while the offer is open).
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who accepted the loan',
+    example: '507f1f77bcf86cd799439013',
+    nullable: true,
+  })
+  @Prop({ type: Types.ObjectId, default: null, index: true, ref: 'Operators' })
+  borrowerId: Types.ObjectId | null;
+
+  /**
+   * The amount of $HASH lent.
+   */
+  @ApiProperty({
+    description: 'The amount of $HASH lent',
+    example: 1000,
+  })
+  @Prop({ type: Number, required: true })
+  amount: number;
+
+  /**
+   * The interest (in %) the borrower pays on top of `amount` when repaying.
+   */
+  @ApiProperty({
+    description:
+      'The interest (in %) the borrower pays on top of the amount when repaying',
+    example: 10,
+  })
+  @Prop({ type: Number, required: true })
+  interestRatePct: number;
+
+  /**
+   * How many days the borrower has to repay the loan after accepting it.
+   */
+  @ApiProperty({
+    description:
+      'How many days the borrower has to repay the loan after accepting it',
+    example: 7,
+  })
+  @Prop({ type: Number, required: true })
+  durationDays: number;
+
+  /**
+   * When the loan must be repaid by (NULL while the offer is open).
+   */
+  @ApiProperty({
+    description: 'When the loan must be repaid by',
+    example: '2025-01-08T00:00:00.000Z',
+    nullable: true,
+  })
+  @Prop({ type: Date, default: null, index: true })
+  dueAt: Date | null;
+
+  /**
+   * When the loan was repaid (NULL if not repaid yet).
+   */
+  @ApiProperty({
+    description: 'When the loan was repaid',
+    example: '2025-01-05T00:00:00.000Z',
+    nullable: true,
+  })
+  @Prop({ type: Date, default: null })
+  repaidAt: Date | null;
+
+  /**
+   * The current status of the loan.
+   */
+  @ApiProperty({
+    description: 'The current status of the loan',
+    enum: HASHLoanStatus,
+    example: HASHLoanStatus.OPEN,
+  })
+  @Prop({
+    type: String,
+    enum: HASHLoanStatus,
+    default: HASHLoanStatus.OPEN,
+    index: true,
+  })
+  status: HASHLoanStatus;
+}
+
+export const HASHLoanSchema = SchemaFactory.createForClass(HASHLoan);
@@ -0,0 +1,13 @@
+import { Module } from '@nestjs/common';
+import { MissionModule } from './mission.module';
+import { OperatorModule } from 'src/operators/operator.module';
+import { MissionRewardService } from './mission-reward.service';
+import { MissionController } from './mission.controller';
+
+@Module({
+  imports: [MissionModule, OperatorModule],
+  controllers: [MissionController], // Expose API endpoints
+  providers: [MissionRewardService], // Business logic for claiming mission rewards
+  exports: [MissionRewardService], // Allow usage in other modules
+})
+export class MissionRewardModule {}
@@ -0,0 +1,101 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { DailyMission } from './schemas/daily-mission.schema';
+import { OperatorMissionProgress } from './schemas/operator-mission-progress.schema';
+import { MissionService } from './mission.service';
+import { OperatorService } from 'src/operators/operator.service';
+import { HashTransactionCategory } from 'src/operators/schemas/hash-transaction.schema';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class MissionRewardService {
+  private readonly logger = new Logger(MissionRewardService.name);
+
+  constructor(
+    @InjectModel(DailyMission.name)
+    private dailyMissionModel: Model<DailyMission>,
+    @InjectModel(OperatorMissionProgress.name)
+    private operatorMissionProgressModel: Model<OperatorMissionProgress>,
+    private readonly missionService: MissionService,
+    private readonly operatorService: OperatorService,
+  ) {}
+
+  /**
+   * Claims a completed mission's $HASH reward for the operator.
+   */
+  async claimMissionReward(
+    operatorId: Types.ObjectId,
+    missionId: Types.ObjectId,
+  ): Promise<ApiResponse<{ rewardHASH: number } | null>> {
+    try {
+      const mission = await this.dailyMissionModel.findById(missionId).lean();
+
+      if (!mission) {
+        return new ApiResponse(404, `(claimMissionReward) Mission not found.`);
+      }
+
+      const date = this.missionService.getProgressDate(mission);
+
+      // Mark the progress as claimed atomically so that the reward can't be claimed twice
+      const progress = await this.operatorMissionProgressModel.findOneAndUpdate(
+        {
+          operatorId,
+          missionId,
+          date,
+          claimed: false,
+          progress: { $gte: mission.targetValue },
+        },
+        { $set: { claimed: true } },
+        { new: true },
+      );
+
+      if (!progress) {
+        return new ApiResponse(
+          400,
+          `(claimMissionReward) Mission not completed or reward already claimed.`,
+        );
+      }
+
+      if (mission.rewardHASH > 0) {
+        const reward = await this.operatorService.addHASH(
+          operatorId,
+          mission.rewardHASH,
+          HashTransactionCategory.MISSION_REWARD,
+          `Completed mission ${mission.name}`,
+          progress._id,
+          'OperatorMissionProgress',
+        );
+
+        if (!reward.success) {
+          await this.operatorMissionProgressModel.updateOne(
+            { _id: progress._id },
+            { $set: { claimed: false } },
+          );
+          return new ApiResponse(400, `(claimMissionReward) ${reward.error}`);
+        }
+      }
+
+      this.logger.log(
+        `🎯 (claimMissionReward) Operator ${operatorId} claimed ${mission.rewardHASH} $HASH for mission ${mission.name}.`,
+      );
+
+      return new ApiResponse(
+        200,
+        `(claimMissionReward) Mission reward claimed successfully.`,
+        { rewardHASH: mission.rewardHASH },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(claimMissionReward) Error claiming mission reward: ${err.message}`,
+        ),
+      );
+    }
+  }
+}
@@ -0,0 +1,81 @@
+import {
+  Controller,
+  Get,
+  Param,
+  Post,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { MissionService } from './mission.service';
+import { MissionRewardService } from './mission-reward.service';
+
+@ApiTags('Operator Missions')
+@Controller('operators/missions')
+export class MissionController {
+  constructor(
+    private readonly missionService: MissionService,
+    private readonly missionRewardService: MissionRewardService,
+  ) {}
+
+  @ApiOperation({
+    summary: 'Get missions',
+    description:
+      "Fetches all missions along with the authenticated operator's current progress",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched missions',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get()
+  async fetchOperatorMissions(@Request() req) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.missionService.fetchOperatorMissions(operatorId);
+  }
+
+  @ApiOperation({
+    summary: 'Claim a mission reward',
+    description:
+      "Credits a completed mission's $HASH reward to the authenticated operator",
+  })
+  @ApiParam({
+    name: 'missionId',
+    description: 'The ID of the mission to claim',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully claimed mission reward',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Mission not completed or already claimed',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Mission not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':missionId/claim')
+  async claimMissionReward(
+    @Request() req,
+    @Param('missionId') missionId: string,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.missionRewardService.claimMissionReward(
+      operatorId,
+      new Types.ObjectId(missionId),
+    );
+  }
+}
@@ -0,0 +1,30 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import {
+  DailyMission,
+  DailyMissionSchema,
+} from './schemas/daily-mission.schema';
+import {
+  OperatorMissionProgress,
+  OperatorMissionProgressSchema,
+} from './schemas/operator-mission-progress.schema';
+import { MissionService } from './mission.service';
+
+/**
+ * Tracks mission progress. Kept free of other game modules so that pools,
+ * drilling sessions and cycles can all import it.
+ */
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: DailyMission.name, schema: DailyMissionSchema },
+      {
+        name: OperatorMissionProgress.name,
+        schema: OperatorMissionProgressSchema,
+      },
+    ]),
+  ],
+  providers: [MissionService], // Business logic for mission progress
+  exports: [MongooseModule, MissionService], // Allow usage in other modules
+})
+export class MissionModule {}
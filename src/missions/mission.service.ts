@@ -0,0 +1,145 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import {
+  DailyMission,
+  MissionTargetType,
+} from './schemas/daily-mission.schema';
+import { OperatorMissionProgress } from './schemas/operator-mission-progress.schema';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class MissionService {
+  private readonly logger = new Logger(MissionService.name);
+
+  constructor(
+    @InjectModel(DailyMission.name)
+    private dailyMissionModel: Model<DailyMission>,
+    @InjectModel(OperatorMissionProgress.name)
+    private operatorMissionProgressModel: Model<OperatorMissionProgress>,
+  ) {}
+
+  /**
+   * Returns the day a mission's progress currently counts towards.
+   *
+   * Daily missions use today's UTC midnight; one-off missions always use the Unix epoch.
+   */
+  getProgressDate(mission: Pick<DailyMission, 'resetDaily'>): Date {
+    if (!mission.resetDaily) return new Date(0);
+
+    const today = new Date();
+    today.setUTCHours(0, 0, 0, 0);
+    return today;
+  }
+
+  /**
+   * Increments the operators' progress on all missions tracking `targetType`.
+   *
+   * Errors are only logged so that the calling game flow is never interrupted.
+   */
+  async incrementProgress(
+    operatorIds: Types.ObjectId[],
+    targetType: MissionTargetType,
+    amount: number = 1,
+  ): Promise<void> {
+    if (operatorIds.length === 0) return;
+
+    try {
+      const missions = await this.dailyMissionModel
+        .find({ targetType }, { resetDaily: 1 })
+        .lean();
+
+      if (missions.length === 0) return;
+
+      const bulkOps = missions.flatMap((mission) => {
+        const date = this.getProgressDate(mission);
+
+        return operatorIds.map((operatorId) => ({
+          updateOne: {
+            filter: { operatorId, missionId: mission._id, date },
+            update: {
+              $inc: { progress: amount },
+              $setOnInsert: { claimed: false },
+            },
+            upsert: true,
+          },
+        }));
+      });
+
+      await this.operatorMissionProgressModel.bulkWrite(bulkOps, {
+        ordered: false,
+      });
+    } catch (err: any) {
+      this.logger.error(
+        `❌ (incrementProgress) Error updating ${targetType} mission progress: ${err.message}`,
+      );
+    }
+  }
+
+  /**
+   * Fetches all missions along with the operator's current progress on each.
+   */
+  async fetchOperatorMissions(operatorId: Types.ObjectId): Promise<
+    ApiResponse<{
+      missions: (DailyMission & { progress: number; claimed: boolean })[];
+    }>
+  > {
+    try {
+      const missions = await this.dailyMissionModel
+        .find()
+        .sort({ rewardHASH: 1 })
+        .lean();
+
+      if (missions.length === 0) {
+        return new ApiResponse(
+          200,
+          `(fetchOperatorMissions) No missions available.`,
+          { missions: [] },
+        );
+      }
+
+      const progresses = await this.operatorMissionProgressModel
+        .find({
+          operatorId,
+          $or: missions.map((mission) => ({
+            missionId: mission._id,
+            date: this.getProgressDate(mission),
+          })),
+        })
+        .lean();
+
+      const progressByMission = new Map(
+        progresses.map((progress) => [
+          progress.missionId.toString(),
+          progress,
+        ]),
+      );
+
+      return new ApiResponse(
+        200,
+        `(fetchOperatorMissions) Missions fetched successfully.`,
+        {
+          missions: missions.map((mission) => {
+            const progress = progressByMission.get(mission._id.toString());
+            return {
+              ...mission,
+              progress: progress?.progress || 0,
+              claimed: progress?.claimed || false,
+            };
+          }) as (DailyMission & { progress: number; claimed: boolean })[],
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchOperatorMissions) Error fetching missions: ${err.message}`,
+        ),
+      );
+    }
+  }
+}
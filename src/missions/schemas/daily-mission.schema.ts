@@ -0,0 +1,97 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * Enum defining the in-game action a mission tracks
+ */
+export enum MissionTargetType {
+  /**
+   * Progresses each time one of the operator's drilling sessions ends.
+   */
+  COMPLETE_DRILLING_SESSIONS = 'complete_drilling_sessions',
+  /**
+   * Progresses each time one of the operator's drills is selected as a cycle's extractor.
+   */
+  EXTRACTION_WINS = 'extraction_wins',
+  /**
+   * Progresses when the operator joins a pool.
+   */
+  JOIN_POOL = 'join_pool',
+}
+
+/**
+ * `DailyMission` represents an objective operators can complete to earn $HASH.
+ */
+@Schema({ timestamps: true, collection: 'DailyMissions', versionKey: false })
+export class DailyMission extends Document {
+  /**
+   * The database ID of the mission.
+   */
+  @ApiProperty({
+    description: 'The database ID of the mission',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The name of the mission.
+   */
+  @ApiProperty({
+    description: 'The name of the mission',
+    example: 'Lucky Strike',
+  })
+  @Prop({ type: String, required: true, unique: true })
+  name: string;
+
+  /**
+   * The in-game action the mission tracks.
+   */
+  @ApiProperty({
+    description: 'The in-game action the mission tracks',
+    enum: MissionTargetType,
+    example: MissionTargetType.EXTRACTION_WINS,
+  })
+  @Prop({
+    type: String,
+    enum: MissionTargetType,
+    required: true,
+    index: true,
+  })
+  targetType: MissionTargetType;
+
+  /**
+   * How many times the action must be performed to complete the mission.
+   */
+  @ApiProperty({
+    description:
+      'How many times the action must be performed to complete the mission',
+    example: 1,
+  })
+  @Prop({ type: Number, required: true, min: 1 })
+  targetValue: number;
+
+  /**
+   * The amount of $HASH rewarded for completing the mission.
+   */
+  @ApiProperty({
+    description: 'The amount of $HASH rewarded for completing the mission',
+    example: 100,
+  })
+  @Prop({ type: Number, required: true, min: 0 })
+  rewardHASH: number;
+
+  /**
+   * Whether progress resets every day (UTC). If false, the mission can only be completed once.
+   */
+  @ApiProperty({
+    description:
+      'Whether progress resets every day (UTC). If false, the mission can only be completed once',
+    example: true,
+  })
+  @Prop({ type: Boolean, required: true, default: true })
+  resetDaily: boolean;
+}
+
+export const DailyMissionSchema = SchemaFactory.createForClass(DailyMission);
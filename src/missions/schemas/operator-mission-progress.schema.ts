@@ -0,0 +1,85 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `OperatorMissionProgress` tracks an operator's progress on a mission for a given day.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'OperatorMissionProgresses',
+  versionKey: false,
+})
+export class OperatorMissionProgress extends Document {
+  /**
+   * The database ID of the progress record.
+   */
+  @ApiProperty({
+    description: 'The database ID of the progress record',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the operator.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The database ID of the mission.
+   */
+  @ApiProperty({
+    description: 'The database ID of the mission',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'DailyMissions' })
+  missionId: Types.ObjectId;
+
+  /**
+   * The day (UTC midnight) the progress counts towards.
+   *
+   * For missions that don't reset daily, this is always the Unix epoch.
+   */
+  @ApiProperty({
+    description: 'The day (UTC midnight) the progress counts towards',
+    example: '2025-01-01T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  date: Date;
+
+  /**
+   * How many times the operator has performed the mission's action.
+   */
+  @ApiProperty({
+    description:
+      "How many times the operator has performed the mission's action",
+    example: 3,
+  })
+  @Prop({ type: Number, required: true, default: 0 })
+  progress: number;
+
+  /**
+   * Whether the mission's reward has been claimed.
+   */
+  @ApiProperty({
+    description: "Whether the mission's reward has been claimed",
+    example: false,
+  })
+  @Prop({ type: Boolean, required: true, default: false })
+  claimed: boolean;
+}
+
+export const OperatorMissionProgressSchema =
+  SchemaFactory.createForClass(OperatorMissionProgress);
+
+// One progress record per operator, mission and day
+OperatorMissionProgressSchema.index(
+  { operatorId: 1, missionId: 1, date: 1 },
+  { unique: true },
+);
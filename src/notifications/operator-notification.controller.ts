@@ -0,0 +1,82 @@
+import {
+  Controller,
+  Get,
+  Param,
+  Put,
+  Query,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { GetNotificationsQueryDto } from 'src/common/dto/operator-notification.dto';
+import { OperatorNotificationService } from './operator-notification.service';
+
+@ApiTags('Notifications')
+@Controller('notifications') // Base route: `/notifications`
+export class OperatorNotificationController {
+  constructor(
+    private readonly operatorNotificationService: OperatorNotificationService,
+  ) {}
+
+  @ApiOperation({
+    summary: 'Get notifications',
+    description:
+      "Fetches the authenticated operator's in-app notifications, newest first. Pass `unread=true` to only fetch unread ones",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved notifications',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get()
+  async listNotifications(
+    @Request() req,
+    @Query() query: GetNotificationsQueryDto,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.operatorNotificationService.listNotifications(
+      operatorId,
+      query.unread,
+      query.page,
+      query.limit,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Mark a notification as read',
+    description:
+      "Marks one of the authenticated operator's notifications as read",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the notification',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully marked notification as read',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Notification not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Put(':id/read')
+  async markNotificationRead(@Request() req, @Param('id') id: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.operatorNotificationService.markNotificationRead(
+      operatorId,
+      new Types.ObjectId(id),
+    );
+  }
+}
@@ -0,0 +1,20 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import {
+  OperatorNotification,
+  OperatorNotificationSchema,
+} from './schemas/operator-notification.schema';
+import { OperatorNotificationService } from './operator-notification.service';
+import { OperatorNotificationController } from './operator-notification.controller';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: OperatorNotification.name, schema: OperatorNotificationSchema },
+    ]),
+  ],
+  controllers: [OperatorNotificationController], // Expose API endpoints
+  providers: [OperatorNotificationService],
+  exports: [OperatorNotificationService],
+})
+export class OperatorNotificationModule {}
@@ -0,0 +1,117 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { OperatorNotification } from './schemas/operator-notification.schema';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class OperatorNotificationService {
+  private readonly logger = new Logger(OperatorNotificationService.name);
+
+  constructor(
+    @InjectModel(OperatorNotification.name)
+    private operatorNotificationModel: Model<OperatorNotification>,
+  ) {}
+
+  /**
+   * Stores an in-app notification for an operator.
+   */
+  async createNotification(
+    operatorId: Types.ObjectId,
+    title: string,
+    body: string,
+  ): Promise<Types.ObjectId> {
+    const notification = await this.operatorNotificationModel.create({
+      operatorId,
+      title,
+      body,
+    });
+
+    this.logger.log(
+      `📬 (createNotification) Created notification ${notification._id} for operator ${operatorId}.`,
+    );
+
+    return notification._id;
+  }
+
+  /**
+   * Marks one of an operator's notifications as read.
+   */
+  async markNotificationRead(
+    operatorId: Types.ObjectId,
+    notificationId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const result = await this.operatorNotificationModel.updateOne(
+        { _id: notificationId, operatorId },
+        { $set: { isRead: true } },
+      );
+
+      if (result.matchedCount === 0) {
+        return new ApiResponse(
+          404,
+          `(markNotificationRead) Notification not found.`,
+        );
+      }
+
+      return new ApiResponse(
+        200,
+        `(markNotificationRead) Notification marked as read.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(markNotificationRead) Error marking notification as read: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Lists an operator's notifications, newest first.
+   */
+  async listNotifications(
+    operatorId: Types.ObjectId,
+    unreadOnly: boolean = false,
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<
+    ApiResponse<{
+      notifications: OperatorNotification[];
+      unreadCount: number;
+    }>
+  > {
+    try {
+      const [notifications, unreadCount] = await Promise.all([
+        this.operatorNotificationModel
+          .find({ operatorId, ...(unreadOnly ? { isRead: false } : {}) })
+          .sort({ createdAt: -1 })
+          .skip((page - 1) * limit)
+          .limit(limit)
+          .lean(),
+        this.operatorNotificationModel.countDocuments({
+          operatorId,
+          isRead: false,
+        }),
+      ]);
+
+      return new ApiResponse(
+        200,
+        `(listNotifications) Notifications fetched.`,
+        { notifications, unreadCount },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(listNotifications) Error fetching notifications: ${err.message}`,
+        ),
+      );
+    }
+  }
+}
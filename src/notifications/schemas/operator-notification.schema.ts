@@ -0,0 +1,80 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `OperatorNotification` represents an in-app message sent to an operator.
+ *
+ * Notifications are stored for every operator, so operators without Telegram still receive them.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'OperatorNotifications',
+  versionKey: false,
+})
+export class OperatorNotification extends Document {
+  /**
+   * The database ID of the notification.
+   */
+  @ApiProperty({
+    description: 'The database ID of the notification',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the operator receiving the notification.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator receiving the notification',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The title of the notification.
+   */
+  @ApiProperty({
+    description: 'The title of the notification',
+    example: 'Loan overdue',
+  })
+  @Prop({ type: String, required: true })
+  title: string;
+
+  /**
+   * The body of the notification.
+   */
+  @ApiProperty({
+    description: 'The body of the notification',
+    example: 'Your loan of 1000 $HASH was not repaid on time.',
+  })
+  @Prop({ type: String, required: true })
+  body: string;
+
+  /**
+   * Whether the operator has read the notification.
+   */
+  @ApiProperty({
+    description: 'Whether the operator has read the notification',
+    example: false,
+  })
+  @Prop({ type: Boolean, required: true, default: false })
+  isRead: boolean;
+
+  /**
+   * When the notification was created.
+   */
+  @ApiProperty({
+    description: 'When the notification was created',
+    example: '2025-01-01T00:00:00.000Z',
+  })
+  createdAt: Date;
+}
+
+export const OperatorNotificationSchema =
+  SchemaFactory.createForClass(OperatorNotification);
+
+// Inbox queries fetch an operator's (unread) notifications, newest first
+OperatorNotificationSchema.index({ operatorId: 1, isRead: 1, createdAt: -1 });
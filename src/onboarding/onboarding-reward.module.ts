@@ -0,0 +1,30 @@
+import { Module } from '@nestjs/common';
+import { BullModule } from '@nestjs/bull';
+import { OnboardingModule } from './onboarding.module';
+import { OperatorModule } from 'src/operators/operator.module';
+import { DrillingGatewayModule } from 'src/gateway/drilling.gateway.module';
+import { TelegramModule } from 'src/telegram/telegram.module';
+import { OnboardingRewardService } from './onboarding-reward.service';
+import { OnboardingRewardQueue } from './onboarding-reward.queue';
+import { OnboardingController } from './onboarding.controller';
+
+@Module({
+  imports: [
+    OnboardingModule,
+    OperatorModule,
+    DrillingGatewayModule, // For achievement notifications
+    TelegramModule, // For Telegram and in-app notifications
+    BullModule.registerQueue({
+      name: 'onboarding-queue',
+      defaultJobOptions: {
+        attempts: 3, // Retry failed jobs 3 times
+        removeOnComplete: true, // Remove completed jobs
+        removeOnFail: false, // Keep failed jobs for debugging
+      },
+    }),
+  ],
+  controllers: [OnboardingController], // Expose API endpoints
+  providers: [OnboardingRewardService, OnboardingRewardQueue], // Business logic for onboarding bonuses
+  exports: [OnboardingRewardService], // Allow usage in other modules
+})
+export class OnboardingRewardModule {}
@@ -0,0 +1,84 @@
+import {
+  Processor,
+  Process,
+  InjectQueue,
+  OnGlobalQueueFailed,
+} from '@nestjs/bull';
+import { Queue } from 'bull';
+import { Injectable, Logger, OnModuleInit } from '@nestjs/common';
+import { OnboardingRewardService } from './onboarding-reward.service';
+
+@Injectable()
+@Processor('onboarding-queue')
+export class OnboardingRewardQueue implements OnModuleInit {
+  private readonly logger = new Logger(OnboardingRewardQueue.name);
+  private readonly oneMinuteInMs = 60 * 1000; // 1 minute
+
+  constructor(
+    private readonly onboardingRewardService: OnboardingRewardService,
+    @InjectQueue('onboarding-queue') private readonly onboardingQueue: Queue,
+  ) {}
+
+  /**
+   * Called when the module initializes.
+   */
+  async onModuleInit() {
+    // ✅ Schedule onboarding bonus payouts (Every 1 Minute)
+    await this.ensureJobScheduled(
+      'credit-onboarding-bonuses',
+      this.oneMinuteInMs,
+    );
+  }
+
+  /**
+   * Ensures a Bull job is scheduled, preventing duplicates.
+   */
+  private async ensureJobScheduled(jobName: string, intervalMs: number) {
+    const existingJobs = await this.onboardingQueue.getRepeatableJobs();
+    if (!existingJobs.some((job) => job.name === jobName)) {
+      await this.onboardingQueue.add(
+        jobName,
+        {},
+        {
+          repeat: { every: intervalMs },
+          removeOnComplete: true,
+          removeOnFail: false,
+        },
+      );
+      this.logger.log(
+        `✅ (onboardingQueue) Scheduled job: ${jobName} every ${intervalMs / 1000 / 60} minutes.`,
+      );
+    } else {
+      this.logger.log(
+        `🔄 (onboardingQueue) Job already scheduled: ${jobName}.`,
+      );
+    }
+  }
+
+  /**
+   * Credits the onboarding bonus to operators who completed onboarding (runs **every minute**).
+   */
+  @Process({
+    name: 'credit-onboarding-bonuses',
+    concurrency: 1,
+  })
+  async handleCreditOnboardingBonuses() {
+    try {
+      await this.onboardingRewardService.creditOnboardingBonuses();
+    } catch (error) {
+      this.logger.error(
+        `❌ (credit-onboarding-bonuses) Error crediting onboarding bonuses: ${error.message}`,
+      );
+    }
+  }
+
+  /**
+   * Handle failed jobs in the queue.
+   */
+  @OnGlobalQueueFailed()
+  onFailed(jobId: number, err: Error) {
+    this.logger.error(
+      `❌ Onboarding Queue job ${jobId} has failed: ${err.message}`,
+    );
+  }
+}
@@ -0,0 +1,146 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { OnboardingProgress } from './schemas/onboarding-progress.schema';
+import { OperatorService } from 'src/operators/operator.service';
+import { HashTransactionCategory } from 'src/operators/schemas/hash-transaction.schema';
+import { DrillingGatewayService } from 'src/gateway/drilling.gateway.service';
+import { TelegramService } from 'src/telegram/telegram.service';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class OnboardingRewardService {
+  private readonly logger = new Logger(OnboardingRewardService.name);
+
+  constructor(
+    @InjectModel(OnboardingProgress.name)
+    private onboardingProgressModel: Model<OnboardingProgress>,
+    private readonly operatorService: OperatorService,
+    private readonly drillingGatewayService: DrillingGatewayService,
+    private readonly telegramService: TelegramService,
+  ) {}
+
+  /**
+   * Fetches the operator's onboarding progress. Operators without any progress yet get all steps as incomplete.
+   */
+  async fetchOnboardingProgress(operatorId: Types.ObjectId): Promise<
+    ApiResponse<{
+      hasLinkedWallet: boolean;
+      hasJoinedPool: boolean;
+      hasStartedSession: boolean;
+      hasWonExtraction: boolean;
+      completedAt: Date | null;
+      bonusHASH: number;
+      bonusCredited: boolean;
+    }>
+  > {
+    try {
+      const progress = await this.onboardingProgressModel
+        .findOne({ operatorId })
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(fetchOnboardingProgress) Onboarding progress fetched.`,
+        {
+          hasLinkedWallet: progress?.hasLinkedWallet ?? false,
+          hasJoinedPool: progress?.hasJoinedPool ?? false,
+          hasStartedSession: progress?.hasStartedSession ?? false,
+          hasWonExtraction: progress?.hasWonExtraction ?? false,
+          completedAt: progress?.completedAt ?? null,
+          bonusHASH: GAME_CONSTANTS.ONBOARDING.BONUS_HASH,
+          bonusCredited: !!progress?.bonusCreditedAt,
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchOnboardingProgress) Error fetching onboarding progress: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Credits the one-time onboarding bonus to every operator who completed onboarding but hasn't received it yet,
+   * and notifies them of the unlocked achievement.
+   *
+   * Called periodically by `OnboardingRewardQueue`.
+   */
+  async creditOnboardingBonuses(): Promise<number> {
+    const completed = await this.onboardingProgressModel
+      .find(
+        { completedAt: { $ne: null }, bonusCreditedAt: null },
+        { operatorId: 1 },
+      )
+      .lean();
+
+    const bonusHASH = GAME_CONSTANTS.ONBOARDING.BONUS_HASH;
+    let creditedCount = 0;
+
+    for (const progress of completed) {
+      // Claim the bonus first so that it can never be credited twice
+      const claimed = await this.onboardingProgressModel.updateOne(
+        { _id: progress._id, bonusCreditedAt: null },
+        { $set: { bonusCreditedAt: new Date() } },
+      );
+
+      if (claimed.modifiedCount === 0) continue;
+
+      const credit = await this.operatorService.addHASH(
+        progress.operatorId,
+        bonusHASH,
+        HashTransactionCategory.ONBOARDING_BONUS,
+        `Onboarding completed`,
+        progress._id,
+        'OnboardingProgress',
+      );
+
+      if (!credit.success) {
+        // Undo the claim so the bonus is credited on the next run
+        await this.onboardingProgressModel.updateOne(
+          { _id: progress._id },
+          { $set: { bonusCreditedAt: null } },
+        );
+        continue;
+      }
+
+      creditedCount++;
+
+      try {
+        await this.drillingGatewayService.notifyAchievementUnlocked(
+          progress.operatorId,
+          {
+            name: 'onboarding-complete',
+            message: `Onboarding complete! You received ${bonusHASH} $HASH.`,
+            rewardHASH: bonusHASH,
+          },
+        );
+        await this.telegramService.notifyOperator(
+          progress.operatorId,
+          'Onboarding complete!',
+          `You completed every onboarding step and received ${bonusHASH} $HASH.`,
+        );
+      } catch (err: any) {
+        // The bonus was already credited, so failed notifications aren't fatal
+        this.logger.warn(
+          `(creditOnboardingBonuses) Error notifying operator ${progress.operatorId}: ${err.message}`,
+        );
+      }
+    }
+
+    if (creditedCount > 0) {
+      this.logger.log(
+        `🎉 (creditOnboardingBonuses) Credited onboarding bonus to ${creditedCount} operators.`,
+      );
+    }
+
+    return creditedCount;
+  }
+}
@@ -0,0 +1,35 @@
+import { Controller, Get, Request, UseGuards } from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { OnboardingRewardService } from './onboarding-reward.service';
+
+@ApiTags('Onboarding')
+@Controller('onboarding') // Base route: `/onboarding`
+export class OnboardingController {
+  constructor(
+    private readonly onboardingRewardService: OnboardingRewardService,
+  ) {}
+
+  @ApiOperation({
+    summary: 'Get onboarding progress',
+    description:
+      "Fetches which onboarding steps the authenticated operator has completed, and whether they've received the onboarding bonus",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched onboarding progress',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get()
+  async fetchOnboardingProgress(@Request() req) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.onboardingRewardService.fetchOnboardingProgress(operatorId);
+  }
+}
@@ -0,0 +1,22 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import {
+  OnboardingProgress,
+  OnboardingProgressSchema,
+} from './schemas/onboarding-progress.schema';
+import { OnboardingService } from './onboarding.service';
+
+/**
+ * Tracks onboarding progress. Kept free of other game modules so that wallets,
+ * pools, drilling sessions and cycles can all import it.
+ */
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: OnboardingProgress.name, schema: OnboardingProgressSchema },
+    ]),
+  ],
+  providers: [OnboardingService], // Business logic for onboarding progress
+  exports: [MongooseModule, OnboardingService], // Allow usage in other modules
+})
+export class OnboardingModule {}
@@ -0,0 +1,58 @@
+import { Injectable, Logger } from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import {
+  OnboardingProgress,
+  OnboardingStep,
+} from './schemas/onboarding-progress.schema';
+
+@Injectable()
+export class OnboardingService {
+  private readonly logger = new Logger(OnboardingService.name);
+
+  constructor(
+    @InjectModel(OnboardingProgress.name)
+    private onboardingProgressModel: Model<OnboardingProgress>,
+  ) {}
+
+  /**
+   * Marks an onboarding step as completed for the operators, and sets `completedAt`
+   * for operators who have now completed every step.
+   *
+   * Errors are only logged so that the calling game flow is never interrupted.
+   */
+  async completeStep(
+    operatorIds: Types.ObjectId[],
+    step: OnboardingStep,
+  ): Promise<void> {
+    if (operatorIds.length === 0) return;
+
+    try {
+      await this.onboardingProgressModel.bulkWrite(
+        operatorIds.map((operatorId) => ({
+          updateOne: {
+            filter: { operatorId },
+            update: { $set: { [step]: true } },
+            upsert: true,
+          },
+        })),
+        { ordered: false },
+      );
+
+      await this.onboardingProgressModel.updateMany(
+        {
+          operatorId: { $in: operatorIds },
+          completedAt: null,
+          ...Object.fromEntries(
+            Object.values(OnboardingStep).map((flag) => [flag, true]),
+          ),
+        },
+        { $set: { completedAt: new Date() } },
+      );
+    } catch (err: any) {
+      this.logger.error(
+        `❌ (completeStep) Error completing onboarding step ${step}: ${err.message}`,
+      );
+    }
+  }
+}
@@ -0,0 +1,128 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The steps a new operator goes through during onboarding.
+ *
+ * Each value is the name of the step's flag in `OnboardingProgress`.
+ */
+export enum OnboardingStep {
+  /** The operator connected a wallet. */
+  LINK_WALLET = 'hasLinkedWallet',
+  /** The operator joined a pool. */
+  JOIN_POOL = 'hasJoinedPool',
+  /** The operator started a drilling session. */
+  START_SESSION = 'hasStartedSession',
+  /** One of the operator's drills was selected as a cycle's extractor. */
+  WIN_EXTRACTION = 'hasWonExtraction',
+}
+
+/**
+ * `OnboardingProgress` tracks which onboarding steps an operator has completed.
+ *
+ * Once every step is completed, the operator receives a one-time onboarding bonus.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'OnboardingProgresses',
+  versionKey: false,
+})
+export class OnboardingProgress extends Document {
+  /**
+   * The database ID of the onboarding progress.
+   */
+  @ApiProperty({
+    description: 'The database ID of the onboarding progress',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the operator.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({
+    type: Types.ObjectId,
+    required: true,
+    unique: true,
+    index: true,
+    ref: 'Operators',
+  })
+  operatorId: Types.ObjectId;
+
+  /**
+   * Whether the operator has connected a wallet.
+   */
+  @ApiProperty({
+    description: 'Whether the operator has connected a wallet',
+    example: true,
+  })
+  @Prop({ type: Boolean, required: true, default: false })
+  hasLinkedWallet: boolean;
+
+  /**
+   * Whether the operator has joined a pool.
+   */
+  @ApiProperty({
+    description: 'Whether the operator has joined a pool',
+    example: true,
+  })
+  @Prop({ type: Boolean, required: true, default: false })
+  hasJoinedPool: boolean;
+
+  /**
+   * Whether the operator has started a drilling session.
+   */
+  @ApiProperty({
+    description: 'Whether the operator has started a drilling session',
+    example: true,
+  })
+  @Prop({ type: Boolean, required: true, default: false })
+  hasStartedSession: boolean;
+
+  /**
+   * Whether one of the operator's drills has been selected as a cycle's extractor.
+   */
+  @ApiProperty({
+    description:
+      "Whether one of the operator's drills has been selected as a cycle's extractor",
+    example: false,
+  })
+  @Prop({ type: Boolean, required: true, default: false })
+  hasWonExtraction: boolean;
+
+  /**
+   * When the operator completed every onboarding step (NULL if not completed yet).
+   */
+  @ApiProperty({
+    description:
+      'When the operator completed every onboarding step (null if not completed yet)',
+    example: null,
+    nullable: true,
+  })
+  @Prop({ type: Date, required: false, default: null })
+  completedAt: Date | null;
+
+  /**
+   * When the onboarding bonus was credited to the operator (NULL if not credited yet).
+   */
+  @ApiProperty({
+    description:
+      'When the onboarding bonus was credited to the operator (null if not credited yet)',
+    example: null,
+    nullable: true,
+  })
+  @Prop({ type: Date, required: false, default: null })
+  bonusCreditedAt: Date | null;
+}
+
+export const OnboardingProgressSchema =
+  SchemaFactory.createForClass(OnboardingProgress);
+
+// Used by the bonus job to find completed onboardings that haven't been rewarded yet
+OnboardingProgressSchema.index({ completedAt: 1, bonusCreditedAt: 1 });
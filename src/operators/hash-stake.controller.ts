@@ -0,0 +1,118 @@
+import {
+  Body,
+  Controller,
+  Get,
+  Param,
+  Post,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { StakeHashDto } from 'src/common/dto/hash-stake.dto';
+import { HashStakeService } from './hash-stake.service';
+
+@ApiTags('Operator Stakes')
+@Controller('operators/stakes')
+export class HashStakeController {
+  constructor(private readonly hashStakeService: HashStakeService) {}
+
+  @ApiOperation({
+    summary: 'Stake $HASH',
+    description:
+      'Locks $HASH for a fixed duration in exchange for a temporary EFF boost',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully staked $HASH',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Invalid duration, insufficient balance or boost cap reached',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post()
+  async stakeHASH(@Request() req, @Body() body: StakeHashDto) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.hashStakeService.stakeHASH(
+      operatorId,
+      body.amount,
+      body.durationDays,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Unstake $HASH',
+    description:
+      'Returns the staked $HASH to the operator once the lock period has ended',
+  })
+  @ApiParam({
+    name: 'stakeId',
+    description: 'The ID of the stake to unstake',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully unstaked $HASH',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Stake still locked or already unstaked',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Stake not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':stakeId/unstake')
+  async unstakeHASH(@Request() req, @Param('stakeId') stakeId: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.hashStakeService.unstakeHASH(
+      operatorId,
+      new Types.ObjectId(stakeId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get operator stakes',
+    description: "Fetches all of the authenticated operator's $HASH stakes",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched stakes',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get()
+  async fetchOperatorStakes(@Request() req) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.hashStakeService.fetchOperatorStakes(operatorId);
+  }
+
+  @ApiOperation({
+    summary: 'Get staking rewards',
+    description:
+      'Fetches the total $HASH staking rewards the authenticated operator has received',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched staking rewards',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get('rewards')
+  async fetchStakingRewards(@Request() req) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.hashStakeService.fetchStakingRewards(operatorId);
+  }
+}
@@ -0,0 +1,31 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { BullModule } from '@nestjs/bull';
+import { HashStake, HashStakeSchema } from './schemas/hash-stake.schema';
+import { Operator, OperatorSchema } from './schemas/operator.schema';
+import { OperatorModule } from './operator.module';
+import { HashStakeService } from './hash-stake.service';
+import { HashStakeController } from './hash-stake.controller';
+import { HashStakeQueue } from './hash-stake.queue';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: HashStake.name, schema: HashStakeSchema },
+      { name: Operator.name, schema: OperatorSchema },
+    ]),
+    BullModule.registerQueue({
+      name: 'hash-stake-queue',
+      defaultJobOptions: {
+        attempts: 3, // Retry failed jobs 3 times
+        removeOnComplete: true, // Remove completed jobs
+        removeOnFail: false, // Keep failed jobs for debugging
+      },
+    }),
+    OperatorModule,
+  ],
+  controllers: [HashStakeController], // Expose API endpoints
+  providers: [HashStakeService, HashStakeQueue], // Business logic for $HASH stakes
+  exports: [MongooseModule, HashStakeService], // Allow usage in other modules
+})
+export class HashStakeModule {}
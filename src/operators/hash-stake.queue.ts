@@ -0,0 +1,103 @@
+import {
+  Processor,
+  Process,
+  InjectQueue,
+  OnGlobalQueueFailed,
+} from '@nestjs/bull';
+import { Queue } from 'bull';
+import { Injectable, Logger, OnModuleInit } from '@nestjs/common';
+import { HashStakeService } from './hash-stake.service';
+
+@Injectable()
+@Processor('hash-stake-queue')
+export class HashStakeQueue implements OnModuleInit {
+  private readonly logger = new Logger(HashStakeQueue.name);
+  private readonly fiveMinutesInMs = 5 * 60 * 1000; // 5 minutes
+  private readonly oneHourInMs = 60 * 60 * 1000; // 1 hour
+
+  constructor(
+    private readonly hashStakeService: HashStakeService,
+    @InjectQueue('hash-stake-queue') private readonly hashStakeQueue: Queue,
+  ) {}
+
+  /**
+   * Called when the module initializes.
+   */
+  async onModuleInit() {
+    // ✅ Schedule stake expiry (Every 5 Minutes)
+    await this.ensureJobScheduled('expire-stakes', this.fiveMinutesInMs);
+
+    // ✅ Schedule staking reward payouts (Every 1 Hour)
+    await this.ensureJobScheduled(
+      'distribute-staking-rewards',
+      this.oneHourInMs,
+    );
+  }
+
+  /**
+   * Ensures a Bull job is scheduled, preventing duplicates.
+   */
+  private async ensureJobScheduled(jobName: string, intervalMs: number) {
+    const existingJobs = await this.hashStakeQueue.getRepeatableJobs();
+    if (!existingJobs.some((job) => job.name === jobName)) {
+      await this.hashStakeQueue.add(
+        jobName,
+        {},
+        {
+          repeat: { every: intervalMs },
+          removeOnComplete: true,
+          removeOnFail: false,
+        },
+      );
+      this.logger.log(
+        `✅ (hashStakeQueue) Scheduled job: ${jobName} every ${intervalMs / 1000 / 60} minutes.`,
+      );
+    } else {
+      this.logger.log(`🔄 (hashStakeQueue) Job already scheduled: ${jobName}.`);
+    }
+  }
+
+  /**
+   * Expires stakes that have reached their `unstakeAt` time and removes their EFF boost.
+   */
+  @Process({
+    name: 'expire-stakes',
+    concurrency: 1,
+  })
+  async handleExpireStakes() {
+    try {
+      await this.hashStakeService.expireStakes();
+    } catch (error) {
+      this.logger.error(
+        `❌ (expire-stakes) Error expiring stakes: ${error.message}`,
+      );
+    }
+  }
+
+  /**
+   * Pays out the yield accrued since the last payout for every stake (runs **every hour**).
+   */
+  @Process({
+    name: 'distribute-staking-rewards',
+    concurrency: 1,
+  })
+  async handleDistributeStakingRewards() {
+    try {
+      await this.hashStakeService.distributeStakingRewards();
+    } catch (error) {
+      this.logger.error(
+        `❌ (distribute-staking-rewards) Error distributing staking rewards: ${error.message}`,
+      );
+    }
+  }
+
+  /**
+   * Handle failed jobs in the queue.
+   */
+  @OnGlobalQueueFailed()
+  onFailed(jobId: number, err: Error) {
+    this.logger.error(
+      `❌ Hash Stake Queue job ${jobId} has failed: ${err.message}`,
+    );
+  }
+}
@@ -0,0 +1,465 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { HashStake, HashStakeStatus } from './schemas/hash-stake.schema';
+import { Operator } from './schemas/operator.schema';
+import { OperatorService } from './operator.service';
+import { HashTransactionCategory } from './schemas/hash-transaction.schema';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class HashStakeService {
+  private readonly logger = new Logger(HashStakeService.name);
+
+  constructor(
+    @InjectModel(HashStake.name) private hashStakeModel: Model<HashStake>,
+    @InjectModel(Operator.name) private operatorModel: Model<Operator>,
+    private readonly operatorService: OperatorService,
+  ) {}
+
+  /**
+   * Stakes $HASH for an operator, locking it for `durationDays` in exchange for an EFF boost and an hourly $HASH yield.
+   *
+   * The boost and yield are determined by the chosen duration tier (see `GAME_CONSTANTS.STAKING.DURATION_TIERS`).
+   * The boost is applied to the operator's `cumulativeEff` immediately.
+   *
+   * The boost is reserved on the operator before the $HASH is debited, in a single update that also checks `MAX_TOTAL_BOOST_PCT`,
+   * so concurrent stakes can't push the total boost past the cap.
+   */
+  async stakeHASH(
+    operatorId: Types.ObjectId,
+    amount: number,
+    durationDays: number,
+  ): Promise<ApiResponse<{ stake: HashStake | null }>> {
+    try {
+      const tier = GAME_CONSTANTS.STAKING.DURATION_TIERS.find(
+        (tier) => tier.durationDays === durationDays,
+      );
+
+      if (!tier) {
+        return new ApiResponse(
+          400,
+          `(stakeHASH) Invalid staking duration: ${durationDays} days.`,
+        );
+      }
+
+      if (amount < GAME_CONSTANTS.STAKING.MIN_STAKE_AMOUNT) {
+        return new ApiResponse(
+          400,
+          `(stakeHASH) Minimum stake amount is ${GAME_CONSTANTS.STAKING.MIN_STAKE_AMOUNT} $HASH.`,
+        );
+      }
+
+      const reservedBoost = await this.operatorModel.updateOne(
+        {
+          _id: operatorId,
+          $expr: {
+            $lte: [
+              {
+                $add: [
+                  { $ifNull: ['$stakingBoostPct', 0] },
+                  tier.multiplierPct,
+                ],
+              },
+              GAME_CONSTANTS.STAKING.MAX_TOTAL_BOOST_PCT,
+            ],
+          },
+        },
+        { $inc: { stakingBoostPct: tier.multiplierPct } },
+      );
+
+      if (reservedBoost.modifiedCount === 0) {
+        if (!(await this.operatorModel.exists({ _id: operatorId }))) {
+          return new ApiResponse(404, `(stakeHASH) Operator not found.`);
+        }
+
+        return new ApiResponse(
+          400,
+          `(stakeHASH) Total staking boost cannot exceed ${GAME_CONSTANTS.STAKING.MAX_TOTAL_BOOST_PCT}%.`,
+        );
+      }
+
+      const stakeId = new Types.ObjectId();
+
+      // Debit the operator's balance first so the stake is always backed by $HASH
+      const deduction = await this.operatorService.deductHASH(
+        operatorId,
+        amount,
+        HashTransactionCategory.HASH_STAKE,
+        `Staked ${amount} $HASH for ${durationDays} days`,
+        stakeId,
+        'HashStake',
+      );
+
+      if (!deduction.success) {
+        // Give back the reserved boost
+        await this.operatorModel.updateOne(
+          { _id: operatorId },
+          { $inc: { stakingBoostPct: -tier.multiplierPct } },
+        );
+
+        return new ApiResponse(400, `(stakeHASH) ${deduction.error}`);
+      }
+
+      const stakedAt = new Date();
+      const stake = await this.hashStakeModel.create({
+        _id: stakeId,
+        operatorId,
+        amount,
+        multiplierPct: tier.multiplierPct,
+        annualYieldPct: tier.annualYieldPct,
+        stakedAt,
+        unstakeAt: new Date(stakedAt.getTime() + durationDays * 86_400_000),
+        status: HashStakeStatus.ACTIVE,
+      });
+
+      await this.operatorService.updateCumulativeEffForSingleOperator(
+        operatorId,
+      );
+
+      this.logger.log(
+        `🔒 (stakeHASH) Operator ${operatorId} staked ${amount} $HASH for ${durationDays} days (+${tier.multiplierPct}% EFF).`,
+      );
+
+      return new ApiResponse(200, `(stakeHASH) $HASH staked successfully.`, {
+        stake,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(stakeHASH) Error staking $HASH: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Returns the staked $HASH to the operator once the stake's `unstakeAt` has passed.
+   *
+   * If the stake's boost hasn't been removed by the expiry job yet, it is removed here. Any yield accrued since the last payout
+   * is paid before the $HASH is returned, since `distributeStakingRewards` skips unstaked stakes.
+   */
+  async unstakeHASH(
+    operatorId: Types.ObjectId,
+    stakeId: Types.ObjectId,
+  ): Promise<ApiResponse<{ amount: number } | null>> {
+    try {
+      const stake = await this.hashStakeModel
+        .findOne({ _id: stakeId, operatorId })
+        .lean();
+
+      if (!stake) {
+        return new ApiResponse(404, `(unstakeHASH) Stake not found.`);
+      }
+
+      if (stake.status === HashStakeStatus.UNSTAKED) {
+        return new ApiResponse(400, `(unstakeHASH) Stake already unstaked.`);
+      }
+
+      if (stake.unstakeAt.getTime() > Date.now()) {
+        return new ApiResponse(
+          400,
+          `(unstakeHASH) Stake is locked until ${stake.unstakeAt.toISOString()}.`,
+        );
+      }
+
+      // Make sure the boost is removed before returning the $HASH
+      if (stake.status === HashStakeStatus.ACTIVE) {
+        await this.expireStake(stake);
+      }
+
+      // Pay the final yield up to `unstakeAt` while the stake can still be paid
+      if ((await this.payStakeReward(stake, stake.unstakeAt)) === null) {
+        return new ApiResponse(
+          500,
+          `(unstakeHASH) Error paying the remaining staking reward. Please try again.`,
+        );
+      }
+
+      // Atomically mark the stake as unstaked to prevent double claims
+      const claimedStake = await this.hashStakeModel.findOneAndUpdate(
+        { _id: stakeId, operatorId, status: HashStakeStatus.EXPIRED },
+        {
+          $set: { status: HashStakeStatus.UNSTAKED, unstakedAt: new Date() },
+        },
+      );
+
+      if (!claimedStake) {
+        return new ApiResponse(400, `(unstakeHASH) Stake already unstaked.`);
+      }
+
+      const credit = await this.operatorService.addHASH(
+        operatorId,
+        stake.amount,
+        HashTransactionCategory.HASH_UNSTAKE,
+        `Unstaked ${stake.amount} $HASH`,
+        stakeId,
+        'HashStake',
+      );
+
+      if (!credit.success) {
+        // Revert the status so the operator can try again
+        await this.hashStakeModel.updateOne(
+          { _id: stakeId },
+          { $set: { status: HashStakeStatus.EXPIRED, unstakedAt: null } },
+        );
+
+        return new ApiResponse(500, `(unstakeHASH) ${credit.error}`);
+      }
+
+      this.logger.log(
+        `🔓 (unstakeHASH) Operator ${operatorId} unstaked ${stake.amount} $HASH.`,
+      );
+
+      return new ApiResponse(
+        200,
+        `(unstakeHASH) $HASH unstaked successfully.`,
+        { amount: stake.amount },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(unstakeHASH) Error unstaking $HASH: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches all stakes of an operator, newest first.
+   */
+  async fetchOperatorStakes(
+    operatorId: Types.ObjectId,
+  ): Promise<ApiResponse<{ stakes: HashStake[] }>> {
+    try {
+      const stakes = await this.hashStakeModel
+        .find({ operatorId })
+        .sort({ stakedAt: -1 })
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(fetchOperatorStakes) Stakes fetched successfully.`,
+        { stakes },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchOperatorStakes) Error fetching stakes: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches the total $HASH staking rewards an operator has received across all of their stakes.
+   */
+  async fetchStakingRewards(
+    operatorId: Types.ObjectId,
+  ): Promise<ApiResponse<{ totalRewards: number }>> {
+    try {
+      const [result] = await this.hashStakeModel.aggregate<{
+        totalRewards: number;
+      }>([
+        { $match: { operatorId } },
+        { $group: { _id: null, totalRewards: { $sum: '$rewardsEarned' } } },
+      ]);
+
+      return new ApiResponse(
+        200,
+        `(fetchStakingRewards) Staking rewards fetched successfully.`,
+        { totalRewards: result?.totalRewards || 0 },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchStakingRewards) Error fetching staking rewards: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Pays out the yield every stake accrued since its last payout (or since it was staked), up to its `unstakeAt`.
+   *
+   * The yield is `amount * annualYieldPct / 100` per year of elapsed time, so delayed or skipped runs are caught up
+   * on the next run. Stakes that expired since their last payout get their final yield up to `unstakeAt`.
+   * Stakes that were already paid within the last `REWARD_MIN_INTERVAL_MS` are skipped.
+   *
+   * Called periodically by `HashStakeQueue`.
+   */
+  async distributeStakingRewards(): Promise<number> {
+    const now = new Date();
+    const cutoff = new Date(
+      now.getTime() - GAME_CONSTANTS.STAKING.REWARD_MIN_INTERVAL_MS,
+    );
+
+    const stakes = await this.hashStakeModel
+      .find(
+        {
+          status: { $in: [HashStakeStatus.ACTIVE, HashStakeStatus.EXPIRED] },
+          annualYieldPct: { $gt: 0 },
+          $or: [{ lastRewardAt: null }, { lastRewardAt: { $lte: cutoff } }],
+          // Skip expired stakes that were already paid up to `unstakeAt`
+          $expr: {
+            $lt: [{ $ifNull: ['$lastRewardAt', '$stakedAt'] }, '$unstakeAt'],
+          },
+        },
+        {
+          operatorId: 1,
+          amount: 1,
+          annualYieldPct: 1,
+          lastRewardAt: 1,
+          stakedAt: 1,
+          unstakeAt: 1,
+        },
+      )
+      .lean();
+
+    let rewardedCount = 0;
+    for (const stake of stakes) {
+      if (await this.payStakeReward(stake, now)) {
+        rewardedCount++;
+      }
+    }
+
+    if (rewardedCount > 0) {
+      this.logger.log(
+        `💰 (distributeStakingRewards) Paid staking rewards for ${rewardedCount} stakes.`,
+      );
+    }
+
+    return rewardedCount;
+  }
+
+  /**
+   * Pays out the yield a stake accrued since its last payout (or since it was staked), up to `rewardTo` or its `unstakeAt`, whichever is earlier.
+   *
+   * Returns the $HASH paid (0 if there was nothing to pay or another process paid it first), or `null` if it couldn't be credited.
+   */
+  private async payStakeReward(
+    stake: Pick<
+      HashStake,
+      | '_id'
+      | 'operatorId'
+      | 'amount'
+      | 'annualYieldPct'
+      | 'lastRewardAt'
+      | 'stakedAt'
+      | 'unstakeAt'
+    >,
+    rewardTo: Date,
+  ): Promise<number | null> {
+    const rewardFrom = stake.lastRewardAt ?? stake.stakedAt;
+    const rewardUntil = new Date(
+      Math.min(rewardTo.getTime(), stake.unstakeAt.getTime()),
+    );
+    const elapsedMs = rewardUntil.getTime() - rewardFrom.getTime();
+    if (elapsedMs <= 0) return 0;
+
+    const reward =
+      (((stake.amount * stake.annualYieldPct) / 100) * elapsedMs) /
+      (365 * 86_400_000);
+    if (reward <= 0) return 0;
+
+    // Claim the reward for the elapsed time first so that it can never be paid twice
+    const claimed = await this.hashStakeModel.updateOne(
+      {
+        _id: stake._id,
+        status: { $in: [HashStakeStatus.ACTIVE, HashStakeStatus.EXPIRED] },
+        lastRewardAt: stake.lastRewardAt ?? null,
+      },
+      { $set: { lastRewardAt: rewardUntil }, $inc: { rewardsEarned: reward } },
+    );
+
+    if (claimed.modifiedCount === 0) return 0;
+
+    const credit = await this.operatorService.addHASH(
+      stake.operatorId,
+      reward,
+      HashTransactionCategory.STAKING_REWARD,
+      `Staking reward for ${stake.amount} $HASH staked (${(elapsedMs / 3_600_000).toFixed(2)} hours)`,
+      stake._id,
+      'HashStake',
+    );
+
+    if (!credit.success) {
+      // Undo the claim so the reward is paid on the next attempt
+      await this.hashStakeModel.updateOne(
+        { _id: stake._id },
+        {
+          $set: { lastRewardAt: stake.lastRewardAt ?? null },
+          $inc: { rewardsEarned: -reward },
+        },
+      );
+      return null;
+    }
+
+    return reward;
+  }
+
+  /**
+   * Expires all active stakes whose `unstakeAt` has passed, removing their EFF boost.
+   *
+   * Called periodically by `HashStakeQueue`.
+   */
+  async expireStakes(): Promise<number> {
+    const expiredStakes = await this.hashStakeModel
+      .find({
+        status: HashStakeStatus.ACTIVE,
+        unstakeAt: { $lte: new Date() },
+      })
+      .lean();
+
+    let expiredCount = 0;
+    for (const stake of expiredStakes) {
+      if (await this.expireStake(stake)) {
+        expiredCount++;
+      }
+    }
+
+    if (expiredCount > 0) {
+      this.logger.log(`⏳ (expireStakes) Expired ${expiredCount} stakes.`);
+    }
+
+    return expiredCount;
+  }
+
+  /**
+   * Marks a single active stake as expired and removes its boost from the operator.
+   *
+   * Returns `false` if the stake was already expired by another process.
+   */
+  private async expireStake(
+    stake: Pick<HashStake, '_id' | 'operatorId' | 'multiplierPct'>,
+  ): Promise<boolean> {
+    const updated = await this.hashStakeModel.findOneAndUpdate(
+      { _id: stake._id, status: HashStakeStatus.ACTIVE },
+      { $set: { status: HashStakeStatus.EXPIRED } },
+    );
+
+    if (!updated) {
+      return false;
+    }
+
+    await this.operatorModel.updateOne(
+      { _id: stake.operatorId },
+      { $inc: { stakingBoostPct: -stake.multiplierPct } },
+    );
+
+    await this.operatorService.updateCumulativeEffForSingleOperator(
+      stake.operatorId,
+    );
+
+    return true;
+  }
+}
@@ -0,0 +1,75 @@
+import {
+  Controller,
+  Get,
+  Param,
+  Post,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { OperatorSkillService } from './operator-skill.service';
+
+@ApiTags('Operator Skills')
+@Controller('operators/skills')
+export class OperatorSkillController {
+  constructor(private readonly operatorSkillService: OperatorSkillService) {}
+
+  @ApiOperation({
+    summary: 'Get skill tree',
+    description:
+      'Fetches all skills in the skill tree and whether the authenticated operator has unlocked them',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched skill tree',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get()
+  async fetchSkillTree(@Request() req) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.operatorSkillService.fetchSkillTree(operatorId);
+  }
+
+  @ApiOperation({
+    summary: 'Unlock a skill',
+    description:
+      "Burns the skill's $HASH cost and permanently applies its bonus to the operator",
+  })
+  @ApiParam({
+    name: 'skillId',
+    description: 'The ID of the skill to unlock',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully unlocked skill',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Already unlocked, prerequisite missing or insufficient balance',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Skill not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':skillId/unlock')
+  async unlockSkill(@Request() req, @Param('skillId') skillId: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.operatorSkillService.unlockSkill(
+      operatorId,
+      new Types.ObjectId(skillId),
+    );
+  }
+}
@@ -0,0 +1,29 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import {
+  OperatorSkill,
+  OperatorSkillSchema,
+} from './schemas/operator-skill.schema';
+import {
+  OperatorSkillUnlock,
+  OperatorSkillUnlockSchema,
+} from './schemas/operator-skill-unlock.schema';
+import { Operator, OperatorSchema } from './schemas/operator.schema';
+import { OperatorModule } from './operator.module';
+import { OperatorSkillService } from './operator-skill.service';
+import { OperatorSkillController } from './operator-skill.controller';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: OperatorSkill.name, schema: OperatorSkillSchema },
+      { name: OperatorSkillUnlock.name, schema: OperatorSkillUnlockSchema },
+      { name: Operator.name, schema: OperatorSchema },
+    ]),
+    OperatorModule,
+  ],
+  controllers: [OperatorSkillController], // Expose API endpoints
+  providers: [OperatorSkillService], // Business logic for the skill tree
+  exports: [MongooseModule, OperatorSkillService], // Allow usage in other modules
+})
+export class OperatorSkillModule {}
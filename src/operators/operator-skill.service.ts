@@ -0,0 +1,191 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import {
+  OperatorSkill,
+  OperatorSkillBonusType,
+} from './schemas/operator-skill.schema';
+import { OperatorSkillUnlock } from './schemas/operator-skill-unlock.schema';
+import { Operator } from './schemas/operator.schema';
+import { OperatorService } from './operator.service';
+import { HashTransactionCategory } from './schemas/hash-transaction.schema';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class OperatorSkillService {
+  private readonly logger = new Logger(OperatorSkillService.name);
+
+  constructor(
+    @InjectModel(OperatorSkill.name)
+    private operatorSkillModel: Model<OperatorSkill>,
+    @InjectModel(OperatorSkillUnlock.name)
+    private operatorSkillUnlockModel: Model<OperatorSkillUnlock>,
+    @InjectModel(Operator.name) private operatorModel: Model<Operator>,
+    private readonly operatorService: OperatorService,
+  ) {}
+
+  /**
+   * Fetches the full skill tree, flagging which skills the operator has already unlocked.
+   */
+  async fetchSkillTree(operatorId: Types.ObjectId): Promise<
+    ApiResponse<{
+      skills: (OperatorSkill & { unlocked: boolean })[];
+    }>
+  > {
+    try {
+      const [skills, unlocks] = await Promise.all([
+        this.operatorSkillModel.find().sort({ unlockCostHASH: 1 }).lean(),
+        this.operatorSkillUnlockModel
+          .find({ operatorId }, { skillId: 1 })
+          .lean(),
+      ]);
+
+      const unlockedSkillIds = new Set(
+        unlocks.map((unlock) => unlock.skillId.toString()),
+      );
+
+      return new ApiResponse(
+        200,
+        `(fetchSkillTree) Skill tree fetched successfully.`,
+        {
+          skills: skills.map((skill) => ({
+            ...skill,
+            unlocked: unlockedSkillIds.has(skill._id.toString()),
+          })) as (OperatorSkill & { unlocked: boolean })[],
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchSkillTree) Error fetching skill tree: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Unlocks a skill for an operator, burning its `unlockCostHASH` and applying its bonus.
+   *
+   * The skill's prerequisite (if any) must already be unlocked.
+   */
+  async unlockSkill(
+    operatorId: Types.ObjectId,
+    skillId: Types.ObjectId,
+  ): Promise<ApiResponse<{ unlock: OperatorSkillUnlock } | null>> {
+    try {
+      const skill = await this.operatorSkillModel.findById(skillId).lean();
+
+      if (!skill) {
+        return new ApiResponse(404, `(unlockSkill) Skill not found.`);
+      }
+
+      if (
+        skill.prerequisiteSkillId &&
+        !(await this.operatorSkillUnlockModel.exists({
+          operatorId,
+          skillId: skill.prerequisiteSkillId,
+        }))
+      ) {
+        return new ApiResponse(
+          400,
+          `(unlockSkill) Prerequisite skill must be unlocked first.`,
+        );
+      }
+
+      // Insert the unlock first; the unique index rejects duplicate unlocks,
+      // including concurrent requests for the same skill
+      let unlock: OperatorSkillUnlock;
+      try {
+        unlock = await this.operatorSkillUnlockModel.create({
+          operatorId,
+          skillId,
+          unlockedAt: new Date(),
+        });
+      } catch (err: any) {
+        if (err.code === 11000) {
+          return new ApiResponse(400, `(unlockSkill) Skill already unlocked.`);
+        }
+        throw err;
+      }
+
+      if (skill.unlockCostHASH > 0) {
+        const deduction = await this.operatorService.deductHASH(
+          operatorId,
+          skill.unlockCostHASH,
+          HashTransactionCategory.SKILL_UNLOCK,
+          `Unlocked skill ${skill.name}`,
+          unlock._id,
+          'OperatorSkillUnlock',
+        );
+
+        if (!deduction.success) {
+          await this.operatorSkillUnlockModel.deleteOne({ _id: unlock._id });
+          return new ApiResponse(400, `(unlockSkill) ${deduction.error}`);
+        }
+      }
+
+      await this.applySkillBonus(operatorId, skill);
+
+      this.logger.log(
+        `🧠 (unlockSkill) Operator ${operatorId} unlocked skill ${skill.name} for ${skill.unlockCostHASH} $HASH.`,
+      );
+
+      return new ApiResponse(200, `(unlockSkill) Skill unlocked successfully.`, {
+        unlock,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(unlockSkill) Error unlocking skill: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Adds a skill's bonus to the operator's aggregated skill bonuses.
+   */
+  private async applySkillBonus(
+    operatorId: Types.ObjectId,
+    skill: Pick<OperatorSkill, 'bonusType' | 'bonusValue'>,
+  ): Promise<void> {
+    switch (skill.bonusType) {
+      case OperatorSkillBonusType.EFF_MULTIPLIER:
+        await this.operatorModel.updateOne(
+          { _id: operatorId },
+          { $inc: { skillEffBonusPct: skill.bonusValue } },
+        );
+        await this.operatorService.updateCumulativeEffForSingleOperator(
+          operatorId,
+        );
+        break;
+      case OperatorSkillBonusType.FUEL_CONSUMPTION_REDUCTION:
+        // Capped so that fuel consumption can never drop to zero
+        await this.operatorModel.updateOne({ _id: operatorId }, [
+          {
+            $set: {
+              skillFuelReductionPct: {
+                $min: [
+                  {
+                    $add: [
+                      { $ifNull: ['$skillFuelReductionPct', 0] },
+                      skill.bonusValue,
+                    ],
+                  },
+                  GAME_CONSTANTS.SKILLS.MAX_FUEL_CONSUMPTION_REDUCTION_PCT,
+                ],
+              },
+            },
+          },
+        ]);
+        break;
+    }
+  }
+}
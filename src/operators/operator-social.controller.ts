@@ -0,0 +1,167 @@
+import {
+  Controller,
+  Delete,
+  Get,
+  Param,
+  Post,
+  Query,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { GetLeaderboardQueryDto } from 'src/common/dto/leaderboard.dto';
+import { OperatorSocialService } from './operator-social.service';
+
+@ApiTags('Operator Social')
+@Controller('operators')
+export class OperatorSocialController {
+  constructor(private readonly operatorSocialService: OperatorSocialService) {}
+
+  @ApiOperation({
+    summary: 'Get activity feed',
+    description:
+      'Fetches the activity (e.g. extraction wins, pool joins) of the operators the authenticated operator follows, newest first',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched activity feed',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get('feed')
+  async fetchActivityFeed(
+    @Request() req,
+    @Query() query: GetLeaderboardQueryDto,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.operatorSocialService.fetchActivityFeed(
+      operatorId,
+      query.page,
+      query.limit,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Follow an operator',
+    description:
+      "Makes the authenticated operator follow another operator, adding their activity to the authenticated operator's feed",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the operator to follow',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully followed operator',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Already following or following self',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Operator not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/follow')
+  async followOperator(@Request() req, @Param('id') id: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.operatorSocialService.followOperator(
+      operatorId,
+      new Types.ObjectId(id),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Unfollow an operator',
+    description:
+      'Makes the authenticated operator stop following another operator',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the operator to unfollow',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully unfollowed operator',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Operator is not being followed',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Delete(':id/unfollow')
+  async unfollowOperator(@Request() req, @Param('id') id: string) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.operatorSocialService.unfollowOperator(
+      operatorId,
+      new Types.ObjectId(id),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get followers',
+    description: 'Fetches the operators following an operator',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched followers',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id/followers')
+  async fetchFollowers(
+    @Param('id') id: string,
+    @Query() query: GetLeaderboardQueryDto,
+  ) {
+    return this.operatorSocialService.fetchFollowers(
+      new Types.ObjectId(id),
+      query.page,
+      query.limit,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get followed operators',
+    description: 'Fetches the operators an operator follows',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched followed operators',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id/following')
+  async fetchFollowing(
+    @Param('id') id: string,
+    @Query() query: GetLeaderboardQueryDto,
+  ) {
+    return this.operatorSocialService.fetchFollowing(
+      new Types.ObjectId(id),
+      query.page,
+      query.limit,
+    );
+  }
+}
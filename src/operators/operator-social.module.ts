@@ -0,0 +1,27 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { Operator, OperatorSchema } from './schemas/operator.schema';
+import {
+  OperatorFollow,
+  OperatorFollowSchema,
+} from './schemas/operator-follow.schema';
+import {
+  ActivityEvent,
+  ActivityEventSchema,
+} from './schemas/activity-event.schema';
+import { OperatorSocialService } from './operator-social.service';
+import { OperatorSocialController } from './operator-social.controller';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: Operator.name, schema: OperatorSchema },
+      { name: OperatorFollow.name, schema: OperatorFollowSchema },
+      { name: ActivityEvent.name, schema: ActivityEventSchema },
+    ]),
+  ],
+  controllers: [OperatorSocialController], // Expose API endpoints
+  providers: [OperatorSocialService], // Business logic for follows and the activity feed
+  exports: [OperatorSocialService],
+})
+export class OperatorSocialModule {}
@@ -0,0 +1,285 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { Operator } from './schemas/operator.schema';
+import { OperatorFollow } from './schemas/operator-follow.schema';
+import { ActivityEvent } from './schemas/activity-event.schema';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class OperatorSocialService {
+  private readonly logger = new Logger(OperatorSocialService.name);
+
+  constructor(
+    @InjectModel(Operator.name) private operatorModel: Model<Operator>,
+    @InjectModel(OperatorFollow.name)
+    private operatorFollowModel: Model<OperatorFollow>,
+    @InjectModel(ActivityEvent.name)
+    private activityEventModel: Model<ActivityEvent>,
+  ) {}
+
+  /**
+   * Makes an operator follow another operator.
+   */
+  async followOperator(
+    followerId: Types.ObjectId,
+    followingId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      if (followerId.equals(followingId)) {
+        return new ApiResponse<null>(
+          400,
+          `(followOperator) Operators can't follow themselves.`,
+        );
+      }
+
+      const operatorExists = await this.operatorModel.exists({
+        _id: followingId,
+      });
+
+      if (!operatorExists) {
+        return new ApiResponse<null>(
+          404,
+          `(followOperator) Operator not found.`,
+        );
+      }
+
+      // Upsert so that concurrent follow requests can't create duplicate follows
+      const result = await this.operatorFollowModel.updateOne(
+        { followerId, followingId },
+        { $setOnInsert: { followerId, followingId } },
+        { upsert: true },
+      );
+
+      if (result.upsertedCount === 0) {
+        return new ApiResponse<null>(
+          400,
+          `(followOperator) Operator is already being followed.`,
+        );
+      }
+
+      return new ApiResponse<null>(
+        200,
+        `(followOperator) Successfully followed operator.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(followOperator) Error following operator: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Makes an operator stop following another operator.
+   */
+  async unfollowOperator(
+    followerId: Types.ObjectId,
+    followingId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const result = await this.operatorFollowModel.deleteOne({
+        followerId,
+        followingId,
+      });
+
+      if (result.deletedCount === 0) {
+        return new ApiResponse<null>(
+          404,
+          `(unfollowOperator) Operator is not being followed.`,
+        );
+      }
+
+      return new ApiResponse<null>(
+        200,
+        `(unfollowOperator) Successfully unfollowed operator.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(unfollowOperator) Error unfollowing operator: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches the operators following an operator (newest followers first), with pagination.
+   */
+  async fetchFollowers(
+    operatorId: Types.ObjectId,
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<
+    ApiResponse<{
+      followers: { operatorId: Types.ObjectId; username: string | null }[];
+      total: number;
+    }>
+  > {
+    try {
+      const skip = (page - 1) * limit;
+      const [follows, total] = await Promise.all([
+        this.operatorFollowModel
+          .find({ followingId: operatorId }, { followerId: 1 })
+          .sort({ createdAt: -1 })
+          .skip(skip)
+          .limit(limit)
+          .lean(),
+        this.operatorFollowModel.countDocuments({ followingId: operatorId }),
+      ]);
+
+      const followers = await this.withUsernames(
+        follows.map((follow) => follow.followerId),
+      );
+
+      return new ApiResponse(
+        200,
+        `(fetchFollowers) Followers fetched successfully.`,
+        { followers, total },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchFollowers) Error fetching followers: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches the operators an operator follows (most recently followed first), with pagination.
+   */
+  async fetchFollowing(
+    operatorId: Types.ObjectId,
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<
+    ApiResponse<{
+      following: { operatorId: Types.ObjectId; username: string | null }[];
+      total: number;
+    }>
+  > {
+    try {
+      const skip = (page - 1) * limit;
+      const [follows, total] = await Promise.all([
+        this.operatorFollowModel
+          .find({ followerId: operatorId }, { followingId: 1 })
+          .sort({ createdAt: -1 })
+          .skip(skip)
+          .limit(limit)
+          .lean(),
+        this.operatorFollowModel.countDocuments({ followerId: operatorId }),
+      ]);
+
+      const following = await this.withUsernames(
+        follows.map((follow) => follow.followingId),
+      );
+
+      return new ApiResponse(
+        200,
+        `(fetchFollowing) Followed operators fetched successfully.`,
+        { following, total },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchFollowing) Error fetching followed operators: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches the activity of the operators an operator follows (newest first), with pagination.
+   */
+  async fetchActivityFeed(
+    operatorId: Types.ObjectId,
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<
+    ApiResponse<{
+      feed: (ActivityEvent & { username: string | null })[];
+    }>
+  > {
+    try {
+      const followedOperatorIds: Types.ObjectId[] =
+        await this.operatorFollowModel.distinct('followingId', {
+          followerId: operatorId,
+        });
+
+      if (followedOperatorIds.length === 0) {
+        return new ApiResponse(
+          200,
+          `(fetchActivityFeed) Activity feed fetched successfully.`,
+          { feed: [] },
+        );
+      }
+
+      const events = await this.activityEventModel
+        .find({ operatorId: { $in: followedOperatorIds } })
+        .sort({ occurredAt: -1 })
+        .skip((page - 1) * limit)
+        .limit(limit)
+        .lean();
+
+      const operators = await this.withUsernames(
+        events.map((event) => event.operatorId),
+      );
+      const usernameMap = new Map(
+        operators.map((operator) => [
+          operator.operatorId.toString(),
+          operator.username,
+        ]),
+      );
+
+      return new ApiResponse(
+        200,
+        `(fetchActivityFeed) Activity feed fetched successfully.`,
+        {
+          feed: events.map((event) => ({
+            ...event,
+            username: usernameMap.get(event.operatorId.toString()) ?? null,
+          })) as (ActivityEvent & { username: string | null })[],
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchActivityFeed) Error fetching activity feed: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Pairs each operator ID with the operator's username (NULL if the operator no longer exists), keeping the order.
+   */
+  private async withUsernames(
+    operatorIds: Types.ObjectId[],
+  ): Promise<{ operatorId: Types.ObjectId; username: string | null }[]> {
+    const operators = await this.operatorModel
+      .find({ _id: { $in: operatorIds } }, { 'usernameData.username': 1 })
+      .lean();
+    const usernameMap = new Map(
+      operators.map((operator) => [
+        operator._id.toString(),
+        operator.usernameData.username,
+      ]),
+    );
+
+    return operatorIds.map((operatorId) => ({
+      operatorId,
+      username: usernameMap.get(operatorId.toString()) ?? null,
+    }));
+  }
+}
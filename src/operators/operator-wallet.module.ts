@@ -14,6 +14,7 @@ import { AlchemyModule } from 'src/alchemy/alchemy.module';
 import { MixpanelModule } from 'src/mixpanel/mixpanel.module';
 import { JwtTonProofService } from 'src/common/services/jwt-ton-proof.service';
 import { OperatorModule } from './operator.module';
+import { OnboardingModule } from 'src/onboarding/onboarding.module';
 
 @Module({
   imports: [
@@ -27,6 +28,7 @@ import { OperatorModule } from './operator.module';
     AlchemyModule,
     MixpanelModule,
     OperatorModule,
+    OnboardingModule,
   ],
   controllers: [OperatorWalletController], // Expose API endpoints
   providers: [OperatorWalletService, JwtTonProofService], // Business logic for Operators
@@ -31,8 +31,13 @@ import { ConfigService } from '@nestjs/config';
 import { JwtTonProofService } from 'src/common/services/jwt-ton-proof.service';
 import { Cell, contractAddress, loadStateInit } from '@ton/core';
 import { sha256 } from '@ton/crypto';
-import { tryParsePublicKey } from 'src/common/utils/wallets-data';
+import {
+  canonicalTonAddress,
+  tryParsePublicKey,
+} from 'src/common/utils/wallets-data';
 import { OperatorService } from './operator.service';
+import { OnboardingService } from 'src/onboarding/onboarding.service';
+import { OnboardingStep } from 'src/onboarding/schemas/onboarding-progress.schema';
 
 @Injectable()
 export class OperatorWalletService {
@@ -53,7 +58,8 @@ export class OperatorWalletService {
     private readonly mixpanelService: MixpanelService,
     private readonly configService: ConfigService,
     private readonly operatorService: OperatorService,
-    private readonly jwtTonProofService?: JwtTonProofService,
+    private readonly onboardingService: OnboardingService,
+    private readonly jwtTonProofService: JwtTonProofService,
   ) {
     // Initialize TON client with TON4 endpoint
     const isMainnet =
@@ -107,16 +113,18 @@ export class OperatorWalletService {
         this.logger.log(
           `⚠️ (updateAssetEquityForOperator) Operator ${operatorId} has no wallets.`,
         );
-        return;
       }
 
-      // ✅ Step 2: Fetch total balance in USD (handles multiple chains)
-      const newEquity = await this.fetchTotalBalanceForWallets(
-        walletDocuments.map((wallet) => ({
-          address: wallet.address,
-          chain: wallet.chain as AllowedChain, // Explicitly cast to AllowedChain
-        })),
-      );
+      // ✅ Step 2: Fetch total balance in USD (handles multiple chains). Operators without wallets have no equity.
+      const newEquity =
+        walletDocuments.length === 0
+          ? 0
+          : await this.fetchTotalBalanceForWallets(
+              walletDocuments.map((wallet) => ({
+                address: wallet.address,
+                chain: wallet.chain as AllowedChain, // Explicitly cast to AllowedChain
+              })),
+            );
 
       this.logger.debug(
         `(updateAssetEquityForOperator) New equity: ${newEquity}`,
@@ -148,13 +156,15 @@ export class OperatorWalletService {
         { new: false, projection: { actualEff: 1 } }, // Return the old `actualEff` (with `new: false`)
       );
 
-      // ✅ Step 6: Update `assetEquity` and `effMultiplier` in Operator document
+      // ✅ Step 6: Update `assetEquity`, `effMultiplier` and `verified` in Operator document
       await this.operatorModel.updateOne(
         { _id: operatorId },
         {
           $set: {
             assetEquity: newEquity,
             effMultiplier: newEffMultiplier,
+            verified:
+              newEquity >= GAME_CONSTANTS.OPERATORS.VERIFICATION_THRESHOLD_USD,
           },
         },
       );
@@ -421,34 +431,28 @@ export class OperatorWalletService {
         );
       }
 
+      // TON addresses are stored in their raw form, so every form of the same wallet matches the same link
       const normalizedAddress =
         walletData.chain === AllowedChain.TON
-          ? walletData.address
+          ? canonicalTonAddress(walletData.address)
           : walletData.address.toLowerCase();
 
-      let existingWallet: OperatorWallet | null;
+      if (!normalizedAddress) {
+        throw new HttpException('(connectWallet) Invalid TON address.', 400);
+      }
 
       // Check if the wallet is already connected to another operator
-      if (walletData.chain === AllowedChain.TON) {
-        existingWallet = await this.operatorWalletModel.findOne({
-          chain: walletData.chain,
-          address: {
-            $in: [walletData.address, walletData.address.toLowerCase()],
-          },
-        });
-      } else {
-        existingWallet = await this.operatorWalletModel.findOne({
-          chain: walletData.chain,
-          address: walletData.address.toLowerCase(),
-        });
-      }
+      const existingWallet = await this.operatorWalletModel.findOne({
+        chain: walletData.chain,
+        address: normalizedAddress,
+      });
 
-      if (
-        existingWallet &&
-        existingWallet.operatorId.toString() !== operatorId.toString()
-      ) {
+      // A wallet can only ever be linked once, even to the same operator
+      if (existingWallet) {
         throw new HttpException(
-          '(connectWallet) Wallet already connected to another operator',
+          existingWallet.operatorId.equals(operatorId)
+            ? '(connectWallet) Wallet already connected to this operator'
+            : '(connectWallet) Wallet already connected to another operator',
           400,
         );
       }
@@ -477,20 +481,19 @@ export class OperatorWalletService {
       let isValid = false;
 
       if (walletData.chain === AllowedChain.TON) {
-        // if (walletData.tonProof) {
-        //   isValid = await this.validateTonProof(
-        //     walletData.tonProof,
-        //     walletData.address,
-        //   );
-        // } else {
-        //   isValid = await this.validateTonSignature(
-        //     walletData.signature,
-        //     walletData.signatureMessage,
-        //     walletData.address,
-        //   );
-        // }
-
-        isValid = true;
+        // Prefer the TON Connect `ton_proof`, falling back to a signed message
+        if (walletData.tonProof) {
+          isValid = await this.validateTonProof(
+            walletData.tonProof,
+            walletData.address,
+          );
+        } else {
+          isValid = await this.validateTonSignature(
+            walletData.signature,
+            walletData.signatureMessage,
+            walletData.address,
+          );
+        }
       } else if (walletData.chain === AllowedChain.BERA) {
         isValid = await this.validateEVMSignature(
           walletData.signatureMessage,
@@ -515,7 +518,18 @@ export class OperatorWalletService {
         signatureMessage: walletData.signatureMessage,
       });
 
-      await newWallet.save();
+      try {
+        await newWallet.save();
+      } catch (err: any) {
+        // Another request linked the same wallet in the meantime
+        if (err.code === 11000) {
+          throw new HttpException(
+            '(connectWallet) Wallet already connected to an operator',
+            400,
+          );
+        }
+        throw err;
+      }
 
       // Update asset equity for operator now that a new wallet is connected
       await this.updateAssetEquityForOperator(operatorId).catch((err: any) => {
@@ -533,6 +547,11 @@ export class OperatorWalletService {
           );
         });
 
+      await this.onboardingService.completeStep(
+        [operatorId],
+        OnboardingStep.LINK_WALLET,
+      );
+
       this.mixpanelService.track(EVENT_CONSTANTS.WALLET_CONNECT, {
         distinct_id: operatorId,
         wallet: newWallet,
@@ -607,6 +626,22 @@ export class OperatorWalletService {
         );
       }
 
+      // Update asset equity for operator now that the wallet is disconnected (this also resets `verified` if needed)
+      await this.updateAssetEquityForOperator(operatorId).catch((err: any) => {
+        this.logger.error(
+          `(disconnectWallet) Error updating asset equity for operator: ${err.message}`,
+        );
+      });
+
+      // Update cumulative EFF data for operator
+      await this.operatorService
+        .updateCumulativeEffForSingleOperator(operatorId)
+        .catch((err: any) => {
+          this.logger.error(
+            `(disconnectWallet) Error updating cumulative EFF for operator: ${err.message}`,
+          );
+        });
+
       return wallet;
     } catch (error) {
       this.logger.error(
@@ -634,10 +669,6 @@ export class OperatorWalletService {
    * @returns Payload token string
    */
   generateTonProofPayload(context?: Record<string, any>): string {
-    if (!this.jwtTonProofService) {
-      throw new Error('JwtTonProofService is not available');
-    }
-
     const payload = this.jwtTonProofService.generatePayload();
     return this.jwtTonProofService.createPayloadToken(payload, context);
   }
@@ -683,15 +714,13 @@ export class OperatorWalletService {
       // Parse the TON address
       const parsedAddress = Address.parse(address);
 
-      // Verify the payload token if JWT service is available
-      if (this.jwtTonProofService) {
-        const payloadVerified = this.jwtTonProofService.verifyPayloadToken(
-          tonProofDto.proof.payload,
-        );
-        if (!payloadVerified) {
-          this.logger.error('Invalid payload token');
-          return false;
-        }
+      // Verify the payload token, so a proof can only be made for a payload this server issued
+      const payloadVerified = this.jwtTonProofService.verifyPayloadToken(
+        tonProofDto.proof.payload,
+      );
+      if (!payloadVerified) {
+        this.logger.error('Invalid payload token');
+        return false;
       }
 
       try {
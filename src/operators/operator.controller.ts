@@ -1,15 +1,26 @@
 import {
+  BadRequestException,
   Body,
   Controller,
+  ForbiddenException,
   Get,
+  Headers,
+  HttpException,
+  HttpStatus,
+  Param,
   Post,
+  Put,
   Query,
   Request,
+  StreamableFile,
+  UnauthorizedException,
   UseGuards,
 } from '@nestjs/common';
+import { ConfigService } from '@nestjs/config';
 import {
   ApiBearerAuth,
   ApiOperation,
+  ApiParam,
   ApiQuery,
   ApiResponse,
   ApiTags,
@@ -18,15 +29,27 @@ import { ApiResponse as AppApiResponse } from 'src/common/dto/response.dto';
 import { OperatorService } from './operator.service';
 import { Operator } from './schemas/operator.schema';
 import { Types } from 'mongoose';
-import { GetOperatorResponseDto } from 'src/common/dto/operator.dto';
+import { Readable } from 'stream';
+import {
+  GetOperatorResponseDto,
+  GetSessionHistoryQueryDto,
+  UpdateProfileMediaDto,
+} from 'src/common/dto/operator.dto';
 import { OperatorWallet } from './schemas/operator-wallet.schema';
 import { Drill } from 'src/drills/schemas/drill.schema';
 import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { PoolService } from 'src/pools/pool.service';
+import { TaxReportService } from './tax-report.service';
 
 @ApiTags('Operators')
 @Controller('operators')
 export class OperatorController {
-  constructor(private readonly operatorService: OperatorService) {}
+  constructor(
+    private readonly operatorService: OperatorService,
+    private readonly poolService: PoolService,
+    private readonly configService: ConfigService,
+    private readonly taxReportService: TaxReportService,
+  ) {}
 
   @ApiOperation({
     summary: 'Rename operator',
@@ -55,6 +78,144 @@ export class OperatorController {
     await this.operatorService.renameUsername(operatorId, newUsername);
   }
 
+  @ApiOperation({
+    summary: 'Export drilling session history',
+    description:
+      "Streams the operator's drilling session history as a CSV file. Can only be exported once every 24 hours",
+  })
+  @ApiQuery({
+    name: 'format',
+    description: 'The export format (only `csv` is supported)',
+    required: false,
+    type: String,
+    example: 'csv',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'CSV file with the drilling session history',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Unsupported format',
+  })
+  @ApiResponse({
+    status: 429,
+    description: 'Too Many Requests - Already exported in the last 24 hours',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get('sessions/export')
+  async exportSessionHistory(
+    @Request() req,
+    @Query('format') format: string = 'csv',
+  ): Promise<StreamableFile> {
+    if (format !== 'csv') {
+      throw new BadRequestException(
+        new AppApiResponse(
+          400,
+          `(exportSessionHistory) Unsupported export format: ${format}`,
+        ),
+      );
+    }
+
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    if (!(await this.operatorService.acquireSessionExportGate(operatorId))) {
+      throw new HttpException(
+        new AppApiResponse(
+          429,
+          `(exportSessionHistory) Session history can only be exported once every 24 hours.`,
+        ),
+        HttpStatus.TOO_MANY_REQUESTS,
+      );
+    }
+
+    return new StreamableFile(
+      Readable.from(this.operatorService.streamSessionHistoryCSV(operatorId)),
+      {
+        type: 'text/csv',
+        disposition: 'attachment; filename="drilling-sessions.csv"',
+      },
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Update profile media',
+    description:
+      "Updates the operator's avatar and/or banner URLs. Images must be uploaded to the CDN beforehand",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully updated profile media',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid or disallowed URL',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Put('profile-media')
+  async updateProfileMedia(
+    @Request() req,
+    @Body() body: UpdateProfileMediaDto,
+  ): Promise<AppApiResponse<null>> {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.operatorService.updateProfileMedia(
+      operatorId,
+      body.avatarURL,
+      body.bannerURL,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Toggle multi-session mode',
+    description:
+      'Enables or disables multi-session mode (one drilling session per active drill). Enabling requires a minimum trust score.',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully updated multi-session mode',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Trust score too low',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Operator not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post('multi-session-mode')
+  async setMultiSessionMode(
+    @Request() req,
+    @Body('enabled') enabled: boolean,
+  ): Promise<AppApiResponse<null>> {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.operatorService.setMultiSessionMode(operatorId, !!enabled);
+  }
+
+  /**
+   * * Refuels an operator by the given amount, capped at their max fuel. Admin-only.
+   */
+  @Post('admin-refuel')
+  async refuelOperatorAdmin(
+    @Body('password') adminPassword: string,
+    @Body('operatorId') operatorId: string,
+    @Body('amount') amount: number,
+  ) {
+    if (adminPassword !== this.configService.get('ADMIN_PASSWORD')) {
+      throw new UnauthorizedException(
+        `(refuelOperatorAdmin) Invalid admin password.`,
+      );
+    }
+
+    return this.operatorService.refuelOperator(
+      new Types.ObjectId(operatorId),
+      Number(amount),
+    );
+  }
+
   @ApiOperation({
     summary: 'Get operator data',
     description:
@@ -113,6 +274,81 @@ export class OperatorController {
     );
   }
 
+  @ApiOperation({
+    summary: 'Get operator stats',
+    description:
+      "Fetches the authenticated operator's lifetime stats, such as total $HASH earned, total TON spent and their daily drilling streaks",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved operator stats',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Operator not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get('stats')
+  async getOperatorStats(@Request() req) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.operatorService.fetchOperatorStats(operatorId);
+  }
+
+  @ApiOperation({
+    summary: 'Get operator stats by ID',
+    description:
+      "Fetches an operator's lifetime stats, such as total $HASH earned, drilling sessions, extractor wins and current pool",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved operator stats',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Operator not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id/stats')
+  async getOperatorStatsById(@Param('id') operatorId: string) {
+    return this.operatorService.fetchOperatorStats(
+      new Types.ObjectId(operatorId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get recommended drilling session times',
+    description:
+      "Analyzes the operator's completed drilling sessions and returns the top 3 start hours (UTC) with the highest average $HASH earned",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved recommended session times',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get('recommended-session-time')
+  async getRecommendedSessionTime(@Request() req): Promise<
+    AppApiResponse<{
+      recommendedHours: Array<{
+        hour: number;
+        avgEarnedHASH: number;
+        sessionCount: number;
+      }>;
+    }>
+  > {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.operatorService.fetchRecommendedSessionTimes(operatorId);
+  }
+
   @ApiOperation({
     summary: 'Get operator overview data',
     description:
@@ -146,4 +382,228 @@ export class OperatorController {
       new Types.ObjectId(operatorId),
     );
   }
+
+  @ApiOperation({
+    summary: 'Get $HASH position',
+    description:
+      "Fetches the authenticated operator's $HASH earned, spent, staked, lent and withdrawn, derived from their $HASH transactions. Includes a data integrity warning if the derived net balance doesn't match the current balance",
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved $HASH position',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Operator not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get('hash-position')
+  async getHASHPosition(@Request() req) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.operatorService.fetchHASHPosition(operatorId);
+  }
+
+  @ApiOperation({
+    summary: 'Get $HASH spending breakdown',
+    description:
+      "Fetches where the authenticated operator's $HASH went, grouped by transaction category with each category's share of the total spending",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the authenticated operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved $HASH spending breakdown',
+  })
+  @ApiResponse({
+    status: 403,
+    description: "Forbidden - Cannot view another operator's spending",
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Operator not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id/hash-spending-breakdown')
+  async getHASHSpendingBreakdown(@Request() req, @Param('id') id: string) {
+    if (id !== req.user.operatorId) {
+      throw new ForbiddenException(
+        new AppApiResponse(
+          403,
+          `(getHASHSpendingBreakdown) Operators can only view their own $HASH spending.`,
+        ),
+      );
+    }
+
+    return this.operatorService.fetchHASHSpendingBreakdown(
+      new Types.ObjectId(id),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get drilling session history',
+    description:
+      "Fetches a paginated list of the authenticated operator's completed drilling sessions, newest first, optionally filtered by start time",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the authenticated operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved drilling session history',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - `from` is not before `to`',
+  })
+  @ApiResponse({
+    status: 403,
+    description: "Forbidden - Cannot view another operator's sessions",
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id/sessions')
+  async getSessionHistory(
+    @Request() req,
+    @Param('id') id: string,
+    @Query() query: GetSessionHistoryQueryDto,
+  ) {
+    if (id !== req.user.operatorId) {
+      throw new ForbiddenException(
+        new AppApiResponse(
+          403,
+          `(getSessionHistory) Operators can only view their own sessions.`,
+        ),
+      );
+    }
+
+    return this.operatorService.fetchSessionHistory(
+      new Types.ObjectId(id),
+      query.from ? new Date(query.from) : undefined,
+      query.to ? new Date(query.to) : undefined,
+      query.page,
+      query.limit,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get tax report',
+    description:
+      "Fetches the authenticated operator's completed drilling sessions and $HASH credits for a calendar year (UTC), each valued in USD at that day's TON/USD rate. Returns CSV instead of JSON if the `Accept` header is `text/csv`",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the authenticated operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiQuery({
+    name: 'year',
+    description: 'The calendar year to report on',
+    required: true,
+    type: Number,
+    example: 2024,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved tax report',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid year',
+  })
+  @ApiResponse({
+    status: 403,
+    description: "Forbidden - Cannot view another operator's tax report",
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id/tax-report')
+  async getTaxReport(
+    @Request() req,
+    @Param('id') id: string,
+    @Query('year') year: string,
+    @Headers('accept') accept?: string,
+  ) {
+    if (id !== req.user.operatorId) {
+      throw new ForbiddenException(
+        new AppApiResponse(
+          403,
+          `(getTaxReport) Operators can only view their own tax report.`,
+        ),
+      );
+    }
+
+    const response = await this.taxReportService.fetchTaxReport(
+      new Types.ObjectId(id),
+      Number(year),
+    );
+
+    if (response.status !== 200 || !accept?.includes('text/csv')) {
+      return response;
+    }
+
+    return new StreamableFile(
+      Buffer.from(this.taxReportService.taxReportToCSV(response.data)),
+      {
+        type: 'text/csv',
+        disposition: `attachment; filename="tax-report-${year}.csv"`,
+      },
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get cross-pool stats',
+    description:
+      'Fetches the $HASH earned and extraction wins of an operator in each pool they have been a member of, newest membership first',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved cross-pool stats',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id/cross-pool-stats')
+  async getCrossPoolStats(@Param('id') operatorId: string) {
+    return this.poolService.fetchCrossPoolContributions(
+      new Types.ObjectId(operatorId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get operator by ID',
+    description:
+      "Fetches an operator by their ID. The operator's Telegram, wallet and referral data is not included",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved operator',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Operator not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id')
+  async getOperatorById(@Param('id') operatorId: string) {
+    return this.operatorService.fetchOperatorById(
+      new Types.ObjectId(operatorId),
+    );
+  }
 }
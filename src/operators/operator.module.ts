@@ -8,6 +8,7 @@ import { ConfigModule } from '@nestjs/config';
 import { DrillModule } from 'src/drills/drill.module';
 import { BullModule } from '@nestjs/bull';
 import { OperatorQueue } from './operator.queue';
+import { TaxReportService } from './tax-report.service';
 import { Drill, DrillSchema } from 'src/drills/schemas/drill.schema';
 import {
   OperatorWallet,
@@ -23,6 +24,15 @@ import {
   HashReserveSchema,
 } from 'src/hash-reserve/schemas/hash-reserve.schema';
 import { ReferralModule } from 'src/referral/referral.module';
+import {
+  DrillingSession,
+  DrillingSessionSchema,
+} from 'src/drills/schemas/drilling-session.schema';
+import { TonUsdRate, TonUsdRateSchema } from './schemas/ton-usd-rate.schema';
+import {
+  DrillingCycle,
+  DrillingCycleSchema,
+} from 'src/drills/schemas/drilling-cycle.schema';
 
 @Module({
   imports: [
@@ -33,6 +43,9 @@ import { ReferralModule } from 'src/referral/referral.module';
       { name: OperatorWallet.name, schema: OperatorWalletSchema },
       { name: HashTransaction.name, schema: HashTransactionSchema },
       { name: HASHReserve.name, schema: HashReserveSchema },
+      { name: DrillingSession.name, schema: DrillingSessionSchema },
+      { name: TonUsdRate.name, schema: TonUsdRateSchema },
+      { name: DrillingCycle.name, schema: DrillingCycleSchema },
     ]),
     BullModule.registerQueue({
       name: 'operator-queue',
@@ -52,7 +65,7 @@ import { ReferralModule } from 'src/referral/referral.module';
     ReferralModule,
   ],
   controllers: [OperatorController], // Expose API endpoints
-  providers: [OperatorService, OperatorQueue], // Business logic for Operators
+  providers: [OperatorService, OperatorQueue, TaxReportService], // Business logic for Operators
   exports: [MongooseModule, OperatorService], // Allow usage in other modules
 })
 export class OperatorModule {}
@@ -8,6 +8,7 @@ import {
 import { Queue } from 'bull';
 import { Injectable, Logger, OnModuleInit } from '@nestjs/common';
 import { OperatorService } from './operator.service';
+import { TaxReportService } from './tax-report.service';
 
 @Injectable()
 @Processor('operator-queue')
@@ -17,6 +18,7 @@ export class OperatorQueue implements OnModuleInit {
 
   constructor(
     private readonly operatorService: OperatorService,
+    private readonly taxReportService: TaxReportService,
     @InjectQueue('operator-queue') private readonly operatorQueue: Queue, // ✅ Inject Bull Queue
   ) {}
 
@@ -26,6 +28,18 @@ export class OperatorQueue implements OnModuleInit {
   async onModuleInit() {
     // ✅ Schedule Cumulative Eff Update (Every 1 Hour)
     await this.ensureJobScheduled('update-cumulative-eff', this.oneHourInMs);
+
+    // ✅ Schedule Trust Score Update (Every 24 Hours)
+    await this.ensureJobScheduled('update-trust-scores', 24 * this.oneHourInMs);
+
+    // ✅ Schedule Missed Drilling Streak Reset (Every 24 Hours)
+    await this.ensureJobScheduled(
+      'reset-drilling-streaks',
+      24 * this.oneHourInMs,
+    );
+
+    // ✅ Schedule TON/USD Rate Recording (Every 6 Hours)
+    await this.ensureJobScheduled('record-ton-usd-rates', 6 * this.oneHourInMs);
   }
 
   /**
@@ -75,6 +89,51 @@ export class OperatorQueue implements OnModuleInit {
     }
   }
 
+  /**
+   * Recomputes every operator's trust score (runs **every day**).
+   */
+  @Process({
+    name: 'update-trust-scores',
+    concurrency: 1, // Limit to one concurrent job at a time
+  })
+  async handleTrustScoreUpdate() {
+    try {
+      await this.operatorService.updateTrustScores();
+    } catch (error) {
+      this.logger.error(
+        `❌ (update-trust-scores) Error updating trust scores: ${error.message}`,
+      );
+    }
+  }
+
+  /**
+   * Resets the drilling streaks of operators who missed a day (runs **every day**).
+   */
+  @Process({
+    name: 'reset-drilling-streaks',
+    concurrency: 1, // Limit to one concurrent job at a time
+  })
+  async handleDrillingStreakReset() {
+    try {
+      await this.operatorService.resetMissedDrillingStreaks();
+    } catch (error) {
+      this.logger.error(
+        `❌ (reset-drilling-streaks) Error resetting drilling streaks: ${error.message}`,
+      );
+    }
+  }
+
+  /**
+   * Records the latest daily TON/USD rates used by tax reports (runs **every 6 hours**).
+   */
+  @Process({
+    name: 'record-ton-usd-rates',
+    concurrency: 1, // Limit to one concurrent job at a time
+  })
+  async handleTonUsdRateRecording() {
+    await this.taxReportService.recordTonUsdRates();
+  }
+
   /**
    * Handle stalled jobs in the queue.
    * This is a critical error that indicates something is wrong with the job processing.
@@ -23,11 +23,56 @@ import {
   HashTransactionStatus,
 } from './schemas/hash-transaction.schema';
 import { PoolOperator } from 'src/pools/schemas/pool-operator.schema';
+import { Pool } from 'src/pools/schemas/pool.schema';
 import { ApiResponse } from 'src/common/dto/response.dto';
 import { HASHReserve } from 'src/hash-reserve/schemas/hash-reserve.schema';
 import { randomBytes } from 'crypto';
 import { AllowedChain } from 'src/common/enums/chain.enum';
 import { ReferralService } from 'src/referral/referral.service';
+import { DrillingSession } from 'src/drills/schemas/drilling-session.schema';
+import { DrillingCycle } from 'src/drills/schemas/drilling-cycle.schema';
+
+/**
+ * The $HASH position bucket each transaction category counts towards.
+ *
+ * There are no $HASH withdrawals yet, so no category counts towards `withdrawn`.
+ */
+const HASH_POSITION_BUCKETS: Record<
+  HashTransactionCategory,
+  'earned' | 'spent' | 'staked' | 'lent' | 'withdrawn'
+> = {
+  [HashTransactionCategory.SYSTEM_REWARD]: 'earned',
+  [HashTransactionCategory.MANUAL_ADJUSTMENT]: 'earned',
+  [HashTransactionCategory.MINING_REWARD]: 'earned',
+  [HashTransactionCategory.REFERRAL_BONUS]: 'earned',
+  [HashTransactionCategory.STAKING_REWARD]: 'earned',
+  [HashTransactionCategory.DRILL_INSURANCE_PAYOUT]: 'earned',
+  [HashTransactionCategory.MISSION_REWARD]: 'earned',
+  [HashTransactionCategory.TOURNAMENT_PRIZE]: 'earned',
+  [HashTransactionCategory.ONBOARDING_BONUS]: 'earned',
+  [HashTransactionCategory.POOL_TREASURY_WITHDRAWAL]: 'earned',
+  [HashTransactionCategory.DRILL_BATTLE_WIN]: 'earned',
+  [HashTransactionCategory.POOL_EFFICIENCY_GOAL_REWARD]: 'earned',
+  // A season reset forfeits the $HASH earned during the season
+  [HashTransactionCategory.SEASON_RESET]: 'earned',
+  [HashTransactionCategory.WHITELIST_PAYMENT]: 'spent',
+  [HashTransactionCategory.BID_HOLD]: 'spent',
+  [HashTransactionCategory.BID_REFUND]: 'spent',
+  [HashTransactionCategory.AUCTION_WIN]: 'spent',
+  [HashTransactionCategory.SKILL_UNLOCK]: 'spent',
+  [HashTransactionCategory.DRILL_BATTLE_STAKE]: 'spent',
+  [HashTransactionCategory.HASH_STAKE]: 'staked',
+  [HashTransactionCategory.HASH_UNSTAKE]: 'staked',
+  [HashTransactionCategory.LOAN_OFFER]: 'lent',
+  [HashTransactionCategory.LOAN_DISBURSEMENT]: 'lent',
+  [HashTransactionCategory.LOAN_REPAYMENT]: 'lent',
+};
+
+/**
+ * The largest difference (in $HASH) between an operator's ledger and their balance that is still
+ * considered a floating point rounding error rather than a data integrity issue.
+ */
+const HASH_POSITION_TOLERANCE = 1e-6;
 
 @Injectable()
 export class OperatorService {
@@ -42,16 +87,22 @@ export class OperatorService {
     @InjectModel(Drill.name) private drillModel: Model<Drill>,
     @InjectModel(PoolOperator.name)
     private poolOperatorModel: Model<PoolOperator>,
+    @InjectModel(Pool.name) private poolModel: Model<Pool>,
     private readonly poolOperatorService: PoolOperatorService,
     private readonly poolService: PoolService,
     private readonly drillService: DrillService,
     private readonly redisService: RedisService,
     private readonly referralService: ReferralService,
     @InjectModel(HASHReserve.name) private hashReserveModel: Model<HASHReserve>,
+    @InjectModel(DrillingSession.name)
+    private drillingSessionModel: Model<DrillingSession>,
+    @InjectModel(DrillingCycle.name)
+    private drillingCycleModel: Model<DrillingCycle>,
   ) {}
 
   async adminBatchCreateOperators(operatorCount: number, batchSize = 10000) {
     try {
+      const testPoolId = new Types.ObjectId('67c59119e13cd025d70558f8');
       let totalCreated = 0;
 
       while (totalCreated < operatorCount) {
@@ -115,7 +166,7 @@ export class OperatorService {
 
           poolOperators.push({
             operator: _id,
-            pool: new Types.ObjectId('67c59119e13cd025d70558f8'),
+            pool: testPoolId,
             totalRewards: 0,
           });
         }
@@ -124,6 +175,10 @@ export class OperatorService {
         await this.operatorWalletModel.insertMany(operatorWallets);
         await this.drillModel.insertMany(drills);
         await this.poolOperatorModel.insertMany(poolOperators);
+        await this.poolModel.updateOne(
+          { _id: testPoolId },
+          { $inc: { operatorCount: poolOperators.length } },
+        );
 
         this.logger.log(
           `Created ${totalCreated + currentBatchSize} / ${operatorCount}`,
@@ -232,6 +287,292 @@ export class OperatorService {
     }
   }
 
+  /**
+   * Gets the Redis key that gates drilling session history exports for an operator.
+   *
+   * Shared by all export formats so that an operator can only export once per `SESSION_EXPORT_COOLDOWN`.
+   */
+  getSessionExportGateKey(operatorId: Types.ObjectId): string {
+    return `operator:session-export:${operatorId.toString()}`;
+  }
+
+  /**
+   * Tries to start a drilling session history export for an operator.
+   *
+   * Returns `false` if the operator already exported their history within the last `SESSION_EXPORT_COOLDOWN` seconds.
+   */
+  async acquireSessionExportGate(
+    operatorId: Types.ObjectId,
+  ): Promise<boolean> {
+    return this.redisService.setIfNotExists(
+      this.getSessionExportGateKey(operatorId),
+      new Date().toISOString(),
+      GAME_CONSTANTS.OPERATORS.SESSION_EXPORT_COOLDOWN,
+    );
+  }
+
+  /**
+   * Streams an operator's drilling session history as CSV, oldest session first.
+   *
+   * Sessions are read with a cursor and yielded in chunks, so large histories are never fully loaded into memory.
+   */
+  async *streamSessionHistoryCSV(
+    operatorId: Types.ObjectId,
+  ): AsyncGenerator<string> {
+    const chunkSize = 500;
+
+    yield 'session_id,start_time,end_time,earned_hash,duration_seconds\n';
+
+    const cursor = this.drillingSessionModel
+      .find({ operatorId }, { _id: 1, startTime: 1, endTime: 1, earnedHASH: 1 })
+      .sort({ startTime: 1 })
+      .lean()
+      .cursor({ batchSize: chunkSize });
+
+    let rows: string[] = [];
+
+    for await (const session of cursor) {
+      const startTime = new Date(session.startTime);
+      const endTime = session.endTime ? new Date(session.endTime) : null;
+      const durationSeconds = endTime
+        ? Math.round((endTime.getTime() - startTime.getTime()) / 1000)
+        : '';
+
+      rows.push(
+        [
+          session._id.toString(),
+          startTime.toISOString(),
+          endTime ? endTime.toISOString() : '',
+          session.earnedHASH,
+          durationSeconds,
+        ].join(','),
+      );
+
+      if (rows.length >= chunkSize) {
+        yield rows.join('\n') + '\n';
+        rows = [];
+      }
+    }
+
+    if (rows.length > 0) {
+      yield rows.join('\n') + '\n';
+    }
+  }
+
+  /**
+   * Fetches a page of an operator's completed drilling sessions, newest first.
+   *
+   * Sessions can optionally be filtered to those started within `[from, to)`.
+   */
+  async fetchSessionHistory(
+    operatorId: Types.ObjectId,
+    from?: Date,
+    to?: Date,
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<
+    ApiResponse<{
+      sessions: Array<{
+        _id: Types.ObjectId;
+        startTime: Date;
+        endTime: Date;
+        earnedHASH: number;
+        durationSeconds: number;
+      }>;
+    }>
+  > {
+    try {
+      if (from && to && from >= to) {
+        return new ApiResponse(
+          400,
+          `(fetchSessionHistory) \`from\` must be before \`to\`.`,
+        );
+      }
+
+      const startTime: Record<string, Date> = {};
+      if (from) startTime.$gte = from;
+      if (to) startTime.$lt = to;
+
+      const sessions = await this.drillingSessionModel.aggregate([
+        {
+          $match: {
+            operatorId,
+            endTime: { $ne: null },
+            ...(from || to ? { startTime } : {}),
+          },
+        },
+        { $sort: { startTime: -1 } },
+        { $skip: (page - 1) * limit },
+        { $limit: limit },
+        {
+          $project: {
+            startTime: 1,
+            endTime: 1,
+            earnedHASH: 1,
+            durationSeconds: {
+              $round: [
+                {
+                  $divide: [{ $subtract: ['$endTime', '$startTime'] }, 1000],
+                },
+              ],
+            },
+          },
+        },
+      ]);
+
+      return new ApiResponse(
+        200,
+        `(fetchSessionHistory) Session history fetched.`,
+        { sessions },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchSessionHistory) Error fetching session history: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Updates an operator's avatar and/or banner URLs.
+   *
+   * Clients upload the images to the CDN themselves, so only the URLs are stored here.
+   * URLs must be HTTPS, at most `PROFILE_MEDIA_MAX_URL_LENGTH` characters long and hosted on an allowed CDN host.
+   * Passing `null` removes the image; leaving a field `undefined` keeps it unchanged.
+   */
+  async updateProfileMedia(
+    operatorId: Types.ObjectId,
+    avatarURL?: string | null,
+    bannerURL?: string | null,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const updates: Record<string, string | null> = {};
+
+      for (const [field, url] of [
+        ['avatarURL', avatarURL],
+        ['bannerURL', bannerURL],
+      ] as const) {
+        if (url === undefined) continue;
+
+        if (url !== null && !this.isValidProfileMediaURL(url)) {
+          return new ApiResponse<null>(
+            400,
+            `(updateProfileMedia) Invalid ${field}. URLs must use HTTPS, be at most ${GAME_CONSTANTS.OPERATORS.PROFILE_MEDIA_MAX_URL_LENGTH} characters long and point to an allowed CDN host.`,
+          );
+        }
+
+        updates[field] = url;
+      }
+
+      if (Object.keys(updates).length === 0) {
+        return new ApiResponse<null>(
+          400,
+          `(updateProfileMedia) No avatar or banner URL provided.`,
+        );
+      }
+
+      const result = await this.operatorModel.updateOne(
+        { _id: operatorId },
+        { $set: updates },
+      );
+
+      if (result.matchedCount === 0) {
+        return new ApiResponse<null>(
+          404,
+          `(updateProfileMedia) Operator not found.`,
+        );
+      }
+
+      return new ApiResponse<null>(
+        200,
+        `(updateProfileMedia) Profile media updated.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(updateProfileMedia) Error updating profile media: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Checks that a profile media URL is HTTPS, not too long and hosted on an allowed CDN host.
+   */
+  private isValidProfileMediaURL(url: string): boolean {
+    if (url.length > GAME_CONSTANTS.OPERATORS.PROFILE_MEDIA_MAX_URL_LENGTH) {
+      return false;
+    }
+
+    try {
+      const { protocol, hostname } = new URL(url);
+
+      return (
+        protocol === 'https:' &&
+        GAME_CONSTANTS.OPERATORS.PROFILE_MEDIA_ALLOWED_HOST_PATTERN.test(
+          hostname,
+        )
+      );
+    } catch {
+      return false;
+    }
+  }
+
+  /**
+   * Enables or disables multi-session mode for an operator.
+   *
+   * In multi-session mode, starting a drilling session creates one session per active drill instead of one aggregate session.
+   * Enabling it requires the operator's trust score to be at least `GAME_CONSTANTS.DRILLS.MULTI_SESSION_MIN_TRUST_SCORE`.
+   */
+  async setMultiSessionMode(
+    operatorId: Types.ObjectId,
+    enabled: boolean,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const operator = await this.operatorModel
+        .findById(operatorId, { trustScore: 1 })
+        .lean();
+
+      if (!operator) {
+        return new ApiResponse<null>(
+          404,
+          `(setMultiSessionMode) Operator not found.`,
+        );
+      }
+
+      if (
+        enabled &&
+        (operator.trustScore ?? 0) <
+          GAME_CONSTANTS.DRILLS.MULTI_SESSION_MIN_TRUST_SCORE
+      ) {
+        return new ApiResponse<null>(
+          403,
+          `(setMultiSessionMode) Trust score too low. Required: ${GAME_CONSTANTS.DRILLS.MULTI_SESSION_MIN_TRUST_SCORE}, current: ${operator.trustScore ?? 0}.`,
+        );
+      }
+
+      await this.operatorModel.updateOne(
+        { _id: operatorId },
+        { $set: { multiSessionMode: enabled } },
+      );
+
+      return new ApiResponse<null>(
+        200,
+        `(setMultiSessionMode) Multi-session mode ${enabled ? 'enabled' : 'disabled'}.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(setMultiSessionMode) Error updating multi-session mode: ${err.message}`,
+        ),
+      );
+    }
+  }
+
   /**
    * Fetches the overview data for all operators in Hashland.
    * Includes:
@@ -358,6 +699,395 @@ export class OperatorService {
     }
   }
 
+  /**
+   * Fetches any operator by their ID.
+   *
+   * Their Telegram, wallet and referral data is left out, as it's private to the operator.
+   */
+  async fetchOperatorById(
+    operatorId: Types.ObjectId,
+  ): Promise<ApiResponse<{ operator: Operator } | null>> {
+    try {
+      const operator = await this.operatorModel
+        .findOne(
+          { _id: operatorId },
+          { tgProfile: 0, walletProfile: 0, referralData: 0 },
+        )
+        .lean();
+
+      if (!operator) {
+        return new ApiResponse(404, `(fetchOperatorById) Operator not found.`);
+      }
+
+      return new ApiResponse(200, `(fetchOperatorById) Operator fetched.`, {
+        operator,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchOperatorById) Error fetching operator: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Computes an operator's trust score (0 to 1) as a weighted sum of:
+   * - account age (maxed out at `fullAccountAgeDays`)
+   * - a clean record (0 while the operator has overdue $HASH loans, 1 otherwise)
+   * - referral quality (the share of referred operators who have earned $HASH)
+   * - session consistency (the share of the last `sessionConsistencyWindowDays` days with a drilling session)
+   */
+  async computeTrustScore(operatorId: Types.ObjectId): Promise<number> {
+    const {
+      weights,
+      fullAccountAgeDays,
+      sessionConsistencyWindowDays,
+      noReferralsQuality,
+    } = GAME_CONSTANTS.TRUST_SCORE;
+
+    const operator = await this.operatorModel
+      .findById(operatorId, { createdAt: 1, overdueLoans: 1 })
+      .lean();
+
+    if (!operator) {
+      throw new NotFoundException('(computeTrustScore) Operator not found');
+    }
+
+    const windowStart = new Date(
+      Date.now() - sessionConsistencyWindowDays * 86_400_000,
+    );
+
+    const [referrals, activeDays] = await Promise.all([
+      this.operatorModel.aggregate<{ total: number; earning: number }>([
+        { $match: { 'referralData.referredBy': operatorId } },
+        {
+          $group: {
+            _id: null,
+            total: { $sum: 1 },
+            earning: {
+              $sum: { $cond: [{ $gt: ['$totalEarnedHASH', 0] }, 1, 0] },
+            },
+          },
+        },
+      ]),
+      this.drillingSessionModel.aggregate<{ _id: string }>([
+        { $match: { operatorId, startTime: { $gte: windowStart } } },
+        {
+          $group: {
+            _id: {
+              $dateToString: { format: '%Y-%m-%d', date: '$startTime' },
+            },
+          },
+        },
+      ]),
+    ]);
+
+    const accountAgeDays =
+      (Date.now() - new Date(operator.createdAt).getTime()) / 86_400_000;
+    const accountAge = Math.min(1, accountAgeDays / fullAccountAgeDays);
+    const cleanRecord = operator.overdueLoans > 0 ? 0 : 1;
+    const referralQuality = referrals[0]?.total
+      ? referrals[0].earning / referrals[0].total
+      : noReferralsQuality;
+    const sessionConsistency = Math.min(
+      1,
+      activeDays.length / sessionConsistencyWindowDays,
+    );
+
+    return (
+      accountAge * weights.accountAge +
+      cleanRecord * weights.cleanRecord +
+      referralQuality * weights.referralQuality +
+      sessionConsistency * weights.sessionConsistency
+    );
+  }
+
+  /**
+   * Recomputes and stores the trust score of every operator.
+   */
+  async updateTrustScores(): Promise<void> {
+    const startTime = performance.now();
+    const cursor = this.operatorModel.find({}, { _id: 1 }).lean().cursor();
+
+    const batchSize = 500;
+    let bulkUpdates = [];
+    let updatedCount = 0;
+
+    for await (const operator of cursor) {
+      const trustScore = await this.computeTrustScore(operator._id);
+      bulkUpdates.push({
+        updateOne: {
+          filter: { _id: operator._id },
+          update: { $set: { trustScore } },
+        },
+      });
+
+      if (bulkUpdates.length >= batchSize) {
+        await this.operatorModel.bulkWrite(bulkUpdates);
+        updatedCount += bulkUpdates.length;
+        bulkUpdates = [];
+      }
+    }
+
+    if (bulkUpdates.length > 0) {
+      await this.operatorModel.bulkWrite(bulkUpdates);
+      updatedCount += bulkUpdates.length;
+    }
+
+    this.logger.log(
+      `✅ (updateTrustScores) Updated trust scores for ${updatedCount} operators in ${(performance.now() - startTime).toFixed(2)}ms.`,
+    );
+  }
+
+  /**
+   * Updates the drilling streaks of operators who just completed a drilling session.
+   *
+   * Only an operator's first completed session of the day (UTC) counts: it extends their streak if their last session
+   * was yesterday, and starts a new streak otherwise.
+   *
+   * Returns the operators who just reached one of the `DRILLING_STREAK_MILESTONES`.
+   */
+  async updateDrillingStreaks(
+    operatorIds: Types.ObjectId[],
+  ): Promise<Array<{ operatorId: Types.ObjectId; streak: number }>> {
+    const today = new Date();
+    today.setUTCHours(0, 0, 0, 0);
+    const yesterday = new Date(today.getTime() - 86_400_000);
+
+    // Operators whose streak is about to be extended into a milestone
+    const milestones = GAME_CONSTANTS.OPERATORS.DRILLING_STREAK_MILESTONES;
+    const reachingMilestone = await this.operatorModel
+      .find(
+        {
+          _id: { $in: operatorIds },
+          lastSessionDate: yesterday,
+          currentStreak: { $in: milestones.map((streak) => streak - 1) },
+        },
+        { _id: 1, currentStreak: 1 },
+      )
+      .lean();
+
+    // Extend the streaks of operators who drilled yesterday
+    await this.operatorModel.updateMany(
+      { _id: { $in: operatorIds }, lastSessionDate: yesterday },
+      [
+        {
+          $set: {
+            currentStreak: { $add: ['$currentStreak', 1] },
+            lastSessionDate: today,
+          },
+        },
+        {
+          $set: {
+            longestStreak: { $max: ['$longestStreak', '$currentStreak'] },
+          },
+        },
+      ],
+    );
+
+    // Start a new streak for everyone else who hasn't drilled today yet
+    await this.operatorModel.updateMany(
+      {
+        _id: { $in: operatorIds },
+        $or: [{ lastSessionDate: null }, { lastSessionDate: { $lt: today } }],
+      },
+      [
+        { $set: { currentStreak: 1, lastSessionDate: today } },
+        { $set: { longestStreak: { $max: ['$longestStreak', 1] } } },
+      ],
+    );
+
+    return reachingMilestone.map((operator) => ({
+      operatorId: operator._id,
+      streak: operator.currentStreak + 1,
+    }));
+  }
+
+  /**
+   * Resets the drilling streak of every operator who didn't complete a drilling session yesterday (UTC).
+   */
+  async resetMissedDrillingStreaks(): Promise<void> {
+    const yesterday = new Date();
+    yesterday.setUTCHours(0, 0, 0, 0);
+    yesterday.setUTCDate(yesterday.getUTCDate() - 1);
+
+    const result = await this.operatorModel.updateMany(
+      { currentStreak: { $gt: 0 }, lastSessionDate: { $lt: yesterday } },
+      { $set: { currentStreak: 0 } },
+    );
+
+    this.logger.log(
+      `✅ (resetMissedDrillingStreaks) Reset the drilling streaks of ${result.modifiedCount} operators.`,
+    );
+  }
+
+  /**
+   * Fetches an operator's lifetime stats, such as their $HASH earned, drilling sessions and extractor wins.
+   *
+   * Stats are cached for `STATS_CACHE_TTL` seconds.
+   */
+  async fetchOperatorStats(operatorId: Types.ObjectId): Promise<
+    ApiResponse<{
+      totalEarnedHASH: number;
+      totalTONSpent: number;
+      cumulativeEff: number;
+      currentFuel: number;
+      currentStreak: number;
+      longestStreak: number;
+      lastSessionDate: Date | null;
+      totalSessions: number;
+      avgHASHPerSession: number;
+      extractorWins: number;
+      currentPoolId: Types.ObjectId | null;
+    } | null>
+  > {
+    try {
+      const cacheKey = `operator:stats:${operatorId}`;
+      const cached = await this.redisService.get(cacheKey);
+
+      if (cached) {
+        return new ApiResponse(
+          200,
+          `(fetchOperatorStats) Operator stats fetched successfully`,
+          JSON.parse(cached),
+        );
+      }
+
+      const [operator, [sessionStats], extractorWins, poolOperator] =
+        await Promise.all([
+          this.operatorModel
+            .findById(operatorId, {
+              _id: 0,
+              totalEarnedHASH: 1,
+              totalTONSpent: 1,
+              cumulativeEff: 1,
+              currentFuel: 1,
+              currentStreak: 1,
+              longestStreak: 1,
+              lastSessionDate: 1,
+            })
+            .lean(),
+          this.drillingSessionModel.aggregate<{
+            totalSessions: number;
+            totalSessionHASH: number;
+          }>([
+            // Only completed sessions have a final `earnedHASH` value
+            { $match: { operatorId, endTime: { $ne: null } } },
+            {
+              $group: {
+                _id: null,
+                totalSessions: { $sum: 1 },
+                totalSessionHASH: { $sum: '$earnedHASH' },
+              },
+            },
+          ]),
+          this.drillingCycleModel.countDocuments({
+            extractorOperatorId: operatorId,
+          }),
+          this.poolOperatorModel
+            .findOne({ operator: operatorId }, { pool: 1 })
+            .lean(),
+        ]);
+
+      if (!operator) {
+        return new ApiResponse(
+          404,
+          `(fetchOperatorStats) Operator not found.`,
+        );
+      }
+
+      const totalSessions = sessionStats?.totalSessions ?? 0;
+      const stats = {
+        totalEarnedHASH: operator.totalEarnedHASH || 0,
+        totalTONSpent: operator.totalTONSpent || 0,
+        cumulativeEff: operator.cumulativeEff || 0,
+        currentFuel: operator.currentFuel || 0,
+        currentStreak: operator.currentStreak || 0,
+        longestStreak: operator.longestStreak || 0,
+        lastSessionDate: operator.lastSessionDate ?? null,
+        totalSessions,
+        avgHASHPerSession:
+          totalSessions > 0 ? sessionStats.totalSessionHASH / totalSessions : 0,
+        extractorWins,
+        currentPoolId: (poolOperator?.pool as Types.ObjectId) ?? null,
+      };
+
+      await this.redisService.set(
+        cacheKey,
+        JSON.stringify(stats),
+        GAME_CONSTANTS.OPERATORS.STATS_CACHE_TTL,
+      );
+
+      return new ApiResponse(
+        200,
+        `(fetchOperatorStats) Operator stats fetched successfully`,
+        stats,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchOperatorStats) Error fetching operator stats: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Recommends the best hours (in UTC) for an operator to start a drilling session.
+   *
+   * Groups the operator's completed sessions by their start hour and returns the top 3 hours
+   * with the highest average $HASH earned per session.
+   */
+  async fetchRecommendedSessionTimes(operatorId: Types.ObjectId): Promise<
+    ApiResponse<{
+      recommendedHours: Array<{
+        hour: number;
+        avgEarnedHASH: number;
+        sessionCount: number;
+      }>;
+    }>
+  > {
+    try {
+      const recommendedHours = await this.drillingSessionModel.aggregate([
+        // Only completed sessions have a final `earnedHASH` value
+        { $match: { operatorId, endTime: { $ne: null } } },
+        {
+          $group: {
+            _id: { $hour: { date: '$startTime', timezone: 'UTC' } },
+            avgEarnedHASH: { $avg: '$earnedHASH' },
+            sessionCount: { $sum: 1 },
+          },
+        },
+        { $sort: { avgEarnedHASH: -1, sessionCount: -1 } },
+        { $limit: 3 },
+        {
+          $project: {
+            _id: 0,
+            hour: '$_id',
+            avgEarnedHASH: 1,
+            sessionCount: 1,
+          },
+        },
+      ]);
+
+      return new ApiResponse(
+        200,
+        `(fetchRecommendedSessionTimes) Recommended session times fetched successfully`,
+        { recommendedHours },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchRecommendedSessionTimes) Error fetching recommended session times: ${err.message}`,
+        ),
+      );
+    }
+  }
+
   /**
    * Updates cumulativeEff for all operators by summing their drills' actualEff values
    * and applying luck factor, effMultiplier and effCredits.
@@ -389,17 +1119,32 @@ export class OperatorService {
     // ✅ Step 2: Get operator data (effMultiplier and effCredits)
     const operatorIds = drillEffs.map((d) => d._id);
     const operators = await this.operatorModel
-      .find({ _id: { $in: operatorIds } }, { effMultiplier: 1, effCredits: 1 })
+      .find(
+        { _id: { $in: operatorIds } },
+        {
+          effMultiplier: 1,
+          effCredits: 1,
+          stakingBoostPct: 1,
+          skillEffBonusPct: 1,
+        },
+      )
       .lean();
 
     const operatorMap = new Map<
       string,
-      { effMultiplier: number; effCredits: number }
+      {
+        effMultiplier: number;
+        effCredits: number;
+        stakingBoostPct: number;
+        skillEffBonusPct: number;
+      }
     >();
     for (const op of operators) {
       operatorMap.set(op._id.toString(), {
         effMultiplier: op.effMultiplier || 1,
         effCredits: op.effCredits || 0,
+        stakingBoostPct: op.stakingBoostPct || 0,
+        skillEffBonusPct: op.skillEffBonusPct || 0,
       });
     }
 
@@ -416,7 +1161,11 @@ export class OperatorService {
               GAME_CONSTANTS.LUCK.MIN_LUCK_MULTIPLIER);
 
         const cumulativeEff =
-          totalDrillEff * operatorData.effMultiplier * luckFactor +
+          totalDrillEff *
+            operatorData.effMultiplier *
+            (1 + operatorData.stakingBoostPct / 100) *
+            (1 + operatorData.skillEffBonusPct / 100) *
+            luckFactor +
           operatorData.effCredits;
 
         return {
@@ -504,9 +1253,14 @@ export class OperatorService {
 
     const totalDrillEff = drillAgg[0].totalDrillEff;
 
-    // Step 2: Get effMultiplier and effCredits for the operator
+    // Step 2: Get effMultiplier, effCredits, staking and skill boosts for the operator
     const operator = await this.operatorModel
-      .findById(operatorId, { effMultiplier: 1, effCredits: 1 })
+      .findById(operatorId, {
+        effMultiplier: 1,
+        effCredits: 1,
+        stakingBoostPct: 1,
+        skillEffBonusPct: 1,
+      })
       .lean();
 
     if (!operator) {
@@ -518,6 +1272,8 @@ export class OperatorService {
 
     const effMultiplier = operator.effMultiplier || 1;
     const effCredits = operator.effCredits || 0;
+    const stakingBoost = 1 + (operator.stakingBoostPct || 0) / 100;
+    const skillBoost = 1 + (operator.skillEffBonusPct || 0) / 100;
 
     // Step 3: Apply luck factor
     const luckFactor =
@@ -527,7 +1283,8 @@ export class OperatorService {
           GAME_CONSTANTS.LUCK.MIN_LUCK_MULTIPLIER);
 
     const cumulativeEff =
-      totalDrillEff * effMultiplier * luckFactor + effCredits;
+      totalDrillEff * effMultiplier * stakingBoost * skillBoost * luckFactor +
+      effCredits;
 
     // Step 4: Update operator
     await this.operatorModel.updateOne(
@@ -658,6 +1415,7 @@ export class OperatorService {
     authData: {
       id: string;
       username?: string;
+      language?: string;
       walletAddress?: string;
       walletChain?: string;
     },
@@ -709,10 +1467,18 @@ export class OperatorService {
         `🔍 (findOrCreateOperator) Searching for operator with Telegram ID: ${authData.id}`,
       );
 
+      const tgProfileUpdates: Record<string, string> = {};
+      if (authData.username) {
+        tgProfileUpdates['tgProfile.tgUsername'] = authData.username;
+      }
+      if (authData.language) {
+        tgProfileUpdates['tgProfile.language'] = authData.language;
+      }
+
       const operator = await this.operatorModel.findOneAndUpdate(
         { 'tgProfile.tgId': authData.id },
-        authData.username
-          ? { $set: { 'tgProfile.tgUsername': authData.username } }
+        Object.keys(tgProfileUpdates).length > 0
+          ? { $set: tgProfileUpdates }
           : {},
         { new: true, projection },
       );
@@ -751,11 +1517,16 @@ export class OperatorService {
       cumulativeEff: 0,
       effMultiplier: 1,
       effCredits: 0,
+      stakingBoostPct: 0,
+      skillEffBonusPct: 0,
+      skillFuelReductionPct: 0,
+      trustScore: 0,
       maxFuel: GAME_CONSTANTS.FUEL.OPERATOR_STARTING_FUEL,
       currentFuel: GAME_CONSTANTS.FUEL.OPERATOR_STARTING_FUEL,
       maxActiveDrillsAllowed:
         GAME_CONSTANTS.DRILLS.INITIAL_ACTIVE_DRILLS_ALLOWED,
       totalEarnedHASH: 0,
+      totalTONSpent: 0,
       lastJoinedPool: null,
       referralData: {
         referralCode: null,
@@ -780,6 +1551,7 @@ export class OperatorService {
       operatorData.tgProfile = {
         tgId: authData.id,
         tgUsername: authData.username || `user_${authData.id}`,
+        language: authData.language || 'en',
       };
       operatorData.walletProfile = null;
     }
@@ -1004,6 +1776,23 @@ export class OperatorService {
       maxFuel: number;
     }[] = [];
 
+    // Fetch fuel consumption reductions from unlocked skills (only operators that have one)
+    const skillReductions = await this.operatorModel
+      .find(
+        {
+          _id: { $in: Array.from(activeOperatorIds) },
+          skillFuelReductionPct: { $gt: 0 },
+        },
+        { skillFuelReductionPct: 1 },
+      )
+      .lean();
+    const fuelReductionPctMap = new Map<string, number>(
+      skillReductions.map((op) => [
+        op._id.toString(),
+        op.skillFuelReductionPct,
+      ]),
+    );
+
     // Process each operator
     for (const operatorId of activeOperatorIds) {
       // Try to get cached fuel values first
@@ -1025,8 +1814,13 @@ export class OperatorService {
         };
       }
 
-      // Calculate new fuel value
-      const newFuel = Math.max(0, cachedFuel.currentFuel - fuelUsed);
+      // Calculate new fuel value, applying the operator's skill reduction (if any)
+      const fuelReductionPct =
+        fuelReductionPctMap.get(operatorId.toString()) || 0;
+      const newFuel = Math.max(
+        0,
+        cachedFuel.currentFuel - fuelUsed * (1 - fuelReductionPct / 100),
+      );
 
       // Add to fuel data for batch caching
       operatorFuelData.push({
@@ -1275,6 +2069,68 @@ export class OperatorService {
     }
   }
 
+  /**
+   * Refuels an operator by `amount`, capped at their `maxFuel`. Admin-only.
+   *
+   * Regular refuelling goes through the `REPLENISH_FUEL` shop item and task rewards instead.
+   */
+  async refuelOperator(
+    operatorId: Types.ObjectId,
+    amount: number,
+  ): Promise<ApiResponse<{ currentFuel: number; maxFuel: number }>> {
+    try {
+      if (!Number.isFinite(amount) || amount <= 0) {
+        return new ApiResponse(
+          400,
+          `(refuelOperator) Fuel amount must be a positive number.`,
+        );
+      }
+
+      // Cap at `maxFuel` within the update itself so concurrent fuel changes aren't overwritten
+      const operator = await this.operatorModel
+        .findOneAndUpdate(
+          { _id: operatorId },
+          [
+            {
+              $set: {
+                currentFuel: {
+                  $min: ['$maxFuel', { $add: ['$currentFuel', amount] }],
+                },
+              },
+            },
+          ],
+          { new: true, projection: { currentFuel: 1, maxFuel: 1 } },
+        )
+        .lean();
+
+      if (!operator) {
+        return new ApiResponse(404, `(refuelOperator) Operator not found.`);
+      }
+
+      await this.cacheFuelValues(
+        operatorId,
+        operator.currentFuel,
+        operator.maxFuel,
+      );
+
+      this.logger.log(
+        `⛽ (refuelOperator) Refuelled operator ${operatorId} by up to ${amount} units (${operator.currentFuel}/${operator.maxFuel}).`,
+      );
+
+      return new ApiResponse(200, `(refuelOperator) Operator refuelled.`, {
+        currentFuel: operator.currentFuel,
+        maxFuel: operator.maxFuel,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(refuelOperator) Error refuelling operator: ${err.message}`,
+        ),
+      );
+    }
+  }
+
   /**
    * Check if a referral code exists in the database
    * @param referralCode The code to check
@@ -1865,6 +2721,268 @@ export class OperatorService {
     }
   }
 
+  /**
+   * Settle held HASH amount (remove it from the hold balance for good, e.g. when winning an auction)
+   */
+  async settleHold(
+    operatorId: Types.ObjectId,
+    amount: number,
+    category: HashTransactionCategory,
+    description: string,
+    relatedEntityId?: Types.ObjectId,
+    relatedEntityType?: string,
+    metadata?: any,
+  ): Promise<{
+    success: boolean;
+    transaction?: HashTransaction;
+    error?: string;
+  }> {
+    if (amount <= 0) {
+      return { success: false, error: 'Amount must be greater than 0' };
+    }
+
+    try {
+      // Only settle if the operator has enough held HASH
+      const operator = await this.operatorModel.findOneAndUpdate(
+        { _id: operatorId, holdHASH: { $gte: amount } },
+        { $inc: { holdHASH: -amount } },
+      );
+
+      if (!operator) {
+        return {
+          success: false,
+          error: 'Operator not found or insufficient held HASH balance',
+        };
+      }
+
+      // The current balance was already reduced when the HASH was held
+      const transaction = new this.hashTransactionModel({
+        operatorId,
+        transactionType: HashTransactionType.DEBIT,
+        amount,
+        category,
+        description,
+        relatedEntityId,
+        relatedEntityType,
+        balanceBefore: operator.currentHASH,
+        balanceAfter: operator.currentHASH,
+        status: HashTransactionStatus.COMPLETED,
+        metadata,
+      });
+
+      await transaction.save();
+
+      this.logger.log(
+        `Settled ${amount} held HASH for operator ${operatorId}. Hold balance: ${operator.holdHASH} -> ${operator.holdHASH - amount}`,
+      );
+
+      return { success: true, transaction };
+    } catch (error) {
+      this.logger.error(
+        `(settleHold) Error settling held HASH for operator ${operatorId}: ${error.message}`,
+        error.stack,
+      );
+      return { success: false, error: 'Failed to settle held HASH' };
+    }
+  }
+
+  /**
+   * Fetches an operator's net $HASH position, derived from their $HASH transactions.
+   *
+   * `earnedTotal` is the net $HASH received from rewards, while `spentTotal`, `stakedTotal`, `lentTotal` and `withdrawnTotal`
+   * are the net $HASH that left the operator's balance for each purpose (e.g. `lentTotal` is negative for a borrower).
+   * Settled holds are skipped, as the held $HASH already left the balance when it was held.
+   *
+   * `netBalance` should always equal the operator's `currentHASH`; if it doesn't, `integrityWarning` explains the discrepancy.
+   */
+  async fetchHASHPosition(operatorId: Types.ObjectId): Promise<
+    ApiResponse<{
+      earnedTotal: number;
+      spentTotal: number;
+      stakedTotal: number;
+      lentTotal: number;
+      withdrawnTotal: number;
+      netBalance: number;
+      currentHASH: number;
+      integrityWarning: string | null;
+    } | null>
+  > {
+    try {
+      const operator = await this.operatorModel
+        .findById(operatorId, { currentHASH: 1 })
+        .lean();
+
+      if (!operator) {
+        return new ApiResponse(404, `(fetchHASHPosition) Operator not found.`);
+      }
+
+      const categoryTotals = await this.hashTransactionModel.aggregate<{
+        _id: HashTransactionCategory;
+        total: number;
+      }>([
+        {
+          $match: {
+            operatorId,
+            status: HashTransactionStatus.COMPLETED,
+            // Settled holds don't change the balance
+            $expr: { $ne: ['$balanceBefore', '$balanceAfter'] },
+          },
+        },
+        {
+          $group: {
+            _id: '$category',
+            total: {
+              $sum: {
+                $cond: [
+                  { $eq: ['$transactionType', HashTransactionType.CREDIT] },
+                  '$amount',
+                  { $multiply: ['$amount', -1] },
+                ],
+              },
+            },
+          },
+        },
+      ]);
+
+      // The net $HASH each bucket added to the operator's balance
+      const bucketTotals = {
+        earned: 0,
+        spent: 0,
+        staked: 0,
+        lent: 0,
+        withdrawn: 0,
+      };
+      for (const { _id: category, total } of categoryTotals) {
+        bucketTotals[HASH_POSITION_BUCKETS[category] ?? 'earned'] += total;
+      }
+
+      const netBalance =
+        bucketTotals.earned +
+        bucketTotals.spent +
+        bucketTotals.staked +
+        bucketTotals.lent +
+        bucketTotals.withdrawn;
+      const discrepancy = operator.currentHASH - netBalance;
+
+      const integrityWarning =
+        Math.abs(discrepancy) > HASH_POSITION_TOLERANCE
+          ? `Balance of ${operator.currentHASH} $HASH does not match the ${netBalance} $HASH derived from transactions (off by ${discrepancy} $HASH).`
+          : null;
+
+      if (integrityWarning) {
+        this.logger.warn(
+          `⚠️ (fetchHASHPosition) Operator ${operatorId}: ${integrityWarning}`,
+        );
+      }
+
+      return new ApiResponse(
+        200,
+        `(fetchHASHPosition) HASH position fetched.`,
+        {
+          earnedTotal: bucketTotals.earned,
+          spentTotal: -bucketTotals.spent,
+          stakedTotal: -bucketTotals.staked,
+          lentTotal: -bucketTotals.lent,
+          withdrawnTotal: -bucketTotals.withdrawn,
+          netBalance,
+          currentHASH: operator.currentHASH,
+          integrityWarning,
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchHASHPosition) Error fetching HASH position: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches where an operator's $HASH went, grouped by transaction category (highest spending first).
+   *
+   * Only debits count as spending. Settled holds are skipped, as the held $HASH was already counted when it was held.
+   * Holds that were later released (e.g. cancelled loan offers) are still counted as spent.
+   */
+  async fetchHASHSpendingBreakdown(operatorId: Types.ObjectId): Promise<
+    ApiResponse<{
+      totalSpent: number;
+      categories: {
+        category: HashTransactionCategory;
+        totalSpent: number;
+        transactionCount: number;
+        lastSpentAt: Date;
+        percentage: number;
+      }[];
+    } | null>
+  > {
+    try {
+      const operatorExists = await this.operatorModel.exists({
+        _id: operatorId,
+      });
+
+      if (!operatorExists) {
+        return new ApiResponse(
+          404,
+          `(fetchHASHSpendingBreakdown) Operator not found.`,
+        );
+      }
+
+      const categoryTotals = await this.hashTransactionModel.aggregate<{
+        _id: HashTransactionCategory;
+        totalSpent: number;
+        transactionCount: number;
+        lastSpentAt: Date;
+      }>([
+        {
+          $match: {
+            operatorId,
+            transactionType: HashTransactionType.DEBIT,
+            status: HashTransactionStatus.COMPLETED,
+            // Settled holds don't change the balance
+            $expr: { $ne: ['$balanceBefore', '$balanceAfter'] },
+          },
+        },
+        {
+          $group: {
+            _id: '$category',
+            totalSpent: { $sum: '$amount' },
+            transactionCount: { $sum: 1 },
+            lastSpentAt: { $max: '$createdAt' },
+          },
+        },
+        { $sort: { totalSpent: -1 } },
+      ]);
+
+      const totalSpent = categoryTotals.reduce(
+        (sum, category) => sum + category.totalSpent,
+        0,
+      );
+
+      return new ApiResponse(
+        200,
+        `(fetchHASHSpendingBreakdown) $HASH spending breakdown fetched.`,
+        {
+          totalSpent,
+          categories: categoryTotals.map(({ _id, ...totals }) => ({
+            category: _id,
+            ...totals,
+            percentage:
+              totalSpent > 0 ? (totals.totalSpent / totalSpent) * 100 : 0,
+          })),
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchHASHSpendingBreakdown) Error fetching $HASH spending breakdown: ${err.message}`,
+        ),
+      );
+    }
+  }
+
   /**
    * Get operator's HASH transaction history
    */
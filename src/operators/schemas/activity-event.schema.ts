@@ -0,0 +1,91 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+/**
+ * The types of operator activity shown in the activity feed.
+ */
+export enum ActivityEventType {
+  /**
+   * One of the operator's drills was selected as a cycle's extractor.
+   */
+  EXTRACTION_WIN = 'EXTRACTION_WIN',
+  /**
+   * The operator joined a pool.
+   */
+  POOL_JOINED = 'POOL_JOINED',
+}
+
+/**
+ * `ActivityEvent` represents a notable action of an operator, shown in their followers' activity feeds.
+ *
+ * Events are removed by MongoDB after `ACTIVITY_EVENT_RETENTION_DAYS` days.
+ */
+@Schema({ collection: 'ActivityEvents', versionKey: false })
+export class ActivityEvent extends Document {
+  /**
+   * The database ID of the activity event.
+   */
+  @ApiProperty({
+    description: 'The database ID of the activity event',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the operator the activity belongs to.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator the activity belongs to',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The type of the activity.
+   */
+  @ApiProperty({
+    description: 'The type of the activity',
+    enum: ActivityEventType,
+    example: ActivityEventType.EXTRACTION_WIN,
+  })
+  @Prop({ type: String, enum: ActivityEventType, required: true })
+  type: ActivityEventType;
+
+  /**
+   * Type-specific details of the activity (e.g. the cycle number for `EXTRACTION_WIN`, the pool ID for `POOL_JOINED`).
+   */
+  @ApiProperty({
+    description: 'Type-specific details of the activity',
+    example: { cycleNumber: 1024, drillId: '507f1f77bcf86cd799439013' },
+  })
+  @Prop({ type: Object, default: {} })
+  data: Record<string, any>;
+
+  /**
+   * When the activity occurred.
+   */
+  @ApiProperty({
+    description: 'When the activity occurred',
+    example: '2025-03-01T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true, default: () => new Date() })
+  occurredAt: Date;
+}
+
+export const ActivityEventSchema = SchemaFactory.createForClass(ActivityEvent);
+
+// Index for fetching the activity of followed operators, newest first
+ActivityEventSchema.index({ operatorId: 1, occurredAt: -1 });
+
+// Remove old events automatically, since one is recorded every cycle
+ActivityEventSchema.index(
+  { occurredAt: 1 },
+  {
+    expireAfterSeconds:
+      GAME_CONSTANTS.OPERATORS.ACTIVITY_EVENT_RETENTION_DAYS * 86_400,
+  },
+);
@@ -0,0 +1,154 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * Enum defining the status of a $HASH stake
+ */
+export enum HashStakeStatus {
+  /**
+   * The stake is locked and its EFF boost is applied.
+   */
+  ACTIVE = 'active',
+  /**
+   * The stake's lock period has ended and its EFF boost was removed, but the $HASH hasn't been claimed yet.
+   */
+  EXPIRED = 'expired',
+  /**
+   * The staked $HASH has been returned to the operator.
+   */
+  UNSTAKED = 'unstaked',
+}
+
+/**
+ * `HashStake` represents an amount of $HASH locked by an operator in exchange for a temporary EFF boost.
+ */
+@Schema({ timestamps: true, collection: 'HashStakes', versionKey: false })
+export class HashStake extends Document {
+  /**
+   * The database ID of the stake.
+   */
+  @ApiProperty({
+    description: 'The database ID of the stake',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who staked the $HASH.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who staked the $HASH',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The amount of $HASH staked.
+   */
+  @ApiProperty({
+    description: 'The amount of $HASH staked',
+    example: 1000,
+  })
+  @Prop({ type: Number, required: true, min: 0 })
+  amount: number;
+
+  /**
+   * The EFF boost (in %) granted while the stake is active.
+   */
+  @ApiProperty({
+    description: 'The EFF boost (in %) granted while the stake is active',
+    example: 5,
+  })
+  @Prop({ type: Number, required: true, min: 0 })
+  multiplierPct: number;
+
+  /**
+   * The annual $HASH yield (in %) on the staked amount, accrued until the stake unlocks and paid out hourly.
+   */
+  @ApiProperty({
+    description:
+      'The annual $HASH yield (in %) on the staked amount, accrued until the stake unlocks and paid out hourly',
+    example: 5,
+  })
+  @Prop({ type: Number, required: true, default: 0, min: 0 })
+  annualYieldPct: number;
+
+  /**
+   * The total $HASH rewards paid out for this stake so far.
+   */
+  @ApiProperty({
+    description: 'The total $HASH rewards paid out for this stake so far',
+    example: 12.5,
+  })
+  @Prop({ type: Number, required: true, default: 0 })
+  rewardsEarned: number;
+
+  /**
+   * The time up to which staking rewards have been paid out for this stake.
+   */
+  @ApiProperty({
+    description:
+      'The time up to which staking rewards have been paid out for this stake',
+    example: '2025-01-01T01:00:00.000Z',
+    required: false,
+  })
+  @Prop({ type: Date, default: null })
+  lastRewardAt?: Date | null;
+
+  /**
+   * When the $HASH was staked.
+   */
+  @ApiProperty({
+    description: 'When the $HASH was staked',
+    example: '2025-01-01T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true, default: Date.now })
+  stakedAt: Date;
+
+  /**
+   * When the stake unlocks and the $HASH can be returned to the operator.
+   */
+  @ApiProperty({
+    description:
+      'When the stake unlocks and the $HASH can be returned to the operator',
+    example: '2025-01-31T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true, index: true })
+  unstakeAt: Date;
+
+  /**
+   * The current status of the stake.
+   */
+  @ApiProperty({
+    description: 'The current status of the stake',
+    enum: HashStakeStatus,
+    example: HashStakeStatus.ACTIVE,
+  })
+  @Prop({
+    type: String,
+    enum: HashStakeStatus,
+    required: true,
+    default: HashStakeStatus.ACTIVE,
+    index: true,
+  })
+  status: HashStakeStatus;
+
+  /**
+   * When the staked $HASH was returned to the operator.
+   */
+  @ApiProperty({
+    description: 'When the staked $HASH was returned to the operator',
+    example: '2025-02-01T00:00:00.000Z',
+    required: false,
+  })
+  @Prop({ type: Date, default: null })
+  unstakedAt?: Date | null;
+}
+
+export const HashStakeSchema = SchemaFactory.createForClass(HashStake);
+
+// Used by the expiry job to find stakes that have reached their unlock time
+HashStakeSchema.index({ status: 1, unstakeAt: 1 });
@@ -22,6 +22,22 @@ export enum HashTransactionCategory {
   MANUAL_ADJUSTMENT = 'manual_adjustment',
   MINING_REWARD = 'mining_reward',
   REFERRAL_BONUS = 'referral_bonus',
+  HASH_STAKE = 'hash_stake',
+  HASH_UNSTAKE = 'hash_unstake',
+  STAKING_REWARD = 'staking_reward',
+  DRILL_INSURANCE_PAYOUT = 'drill_insurance_payout',
+  SKILL_UNLOCK = 'skill_unlock',
+  MISSION_REWARD = 'mission_reward',
+  LOAN_OFFER = 'loan_offer',
+  LOAN_DISBURSEMENT = 'loan_disbursement',
+  LOAN_REPAYMENT = 'loan_repayment',
+  TOURNAMENT_PRIZE = 'tournament_prize',
+  ONBOARDING_BONUS = 'onboarding_bonus',
+  POOL_TREASURY_WITHDRAWAL = 'pool_treasury_withdrawal',
+  DRILL_BATTLE_STAKE = 'drill_battle_stake',
+  DRILL_BATTLE_WIN = 'drill_battle_win',
+  POOL_EFFICIENCY_GOAL_REWARD = 'pool_efficiency_goal_reward',
+  SEASON_RESET = 'season_reset',
 }
 
 /**
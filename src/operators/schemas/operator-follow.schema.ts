@@ -0,0 +1,59 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `OperatorFollow` represents an operator following another operator.
+ *
+ * Followed operators' activity shows up in the follower's activity feed.
+ */
+@Schema({ timestamps: true, collection: 'OperatorFollows', versionKey: false })
+export class OperatorFollow extends Document {
+  /**
+   * The database ID of the follow.
+   */
+  @ApiProperty({
+    description: 'The database ID of the follow',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who follows.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who follows',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  followerId: Types.ObjectId;
+
+  /**
+   * The database ID of the operator being followed.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator being followed',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  followingId: Types.ObjectId;
+
+  /**
+   * The timestamp when the operator started following.
+   */
+  @ApiProperty({
+    description: 'The timestamp when the operator started following',
+    example: '2025-03-01T00:00:00.000Z',
+  })
+  createdAt: Date;
+}
+
+export const OperatorFollowSchema =
+  SchemaFactory.createForClass(OperatorFollow);
+
+// An operator can only follow another operator once (also used for fetching who an operator follows)
+OperatorFollowSchema.index({ followerId: 1, followingId: 1 }, { unique: true });
+
+// Index for fetching an operator's followers, newest first
+OperatorFollowSchema.index({ followingId: 1, createdAt: -1 });
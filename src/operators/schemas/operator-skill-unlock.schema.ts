@@ -0,0 +1,62 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `OperatorSkillUnlock` records that an operator has unlocked a skill from the skill tree.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'OperatorSkillUnlocks',
+  versionKey: false,
+})
+export class OperatorSkillUnlock extends Document {
+  /**
+   * The database ID of the unlock.
+   */
+  @ApiProperty({
+    description: 'The database ID of the unlock',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who unlocked the skill.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who unlocked the skill',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The database ID of the unlocked skill.
+   */
+  @ApiProperty({
+    description: 'The database ID of the unlocked skill',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'OperatorSkills' })
+  skillId: Types.ObjectId;
+
+  /**
+   * When the skill was unlocked.
+   */
+  @ApiProperty({
+    description: 'When the skill was unlocked',
+    example: '2025-01-01T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true, default: Date.now })
+  unlockedAt: Date;
+}
+
+export const OperatorSkillUnlockSchema =
+  SchemaFactory.createForClass(OperatorSkillUnlock);
+
+// A skill can only be unlocked once per operator
+OperatorSkillUnlockSchema.index(
+  { operatorId: 1, skillId: 1 },
+  { unique: true },
+);
@@ -0,0 +1,97 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * Enum defining the kind of bonus an operator skill grants
+ */
+export enum OperatorSkillBonusType {
+  /**
+   * Reduces the fuel the operator uses per drilling cycle by `bonusValue` %.
+   */
+  FUEL_CONSUMPTION_REDUCTION = 'fuel_consumption_reduction',
+  /**
+   * Boosts the operator's `cumulativeEff` by `bonusValue` %.
+   */
+  EFF_MULTIPLIER = 'eff_multiplier',
+}
+
+/**
+ * `OperatorSkill` represents a node in the skill tree that operators can unlock with $HASH.
+ */
+@Schema({ timestamps: true, collection: 'OperatorSkills', versionKey: false })
+export class OperatorSkill extends Document {
+  /**
+   * The database ID of the skill.
+   */
+  @ApiProperty({
+    description: 'The database ID of the skill',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The name of the skill.
+   */
+  @ApiProperty({
+    description: 'The name of the skill',
+    example: 'Efficient Combustion I',
+  })
+  @Prop({ type: String, required: true, unique: true })
+  name: string;
+
+  /**
+   * A short description of what the skill does.
+   */
+  @ApiProperty({
+    description: 'A short description of what the skill does',
+    example: 'Reduces fuel consumption by 5%',
+  })
+  @Prop({ type: String, required: true })
+  description: string;
+
+  /**
+   * The kind of bonus the skill grants.
+   */
+  @ApiProperty({
+    description: 'The kind of bonus the skill grants',
+    enum: OperatorSkillBonusType,
+    example: OperatorSkillBonusType.FUEL_CONSUMPTION_REDUCTION,
+  })
+  @Prop({ type: String, enum: OperatorSkillBonusType, required: true })
+  bonusType: OperatorSkillBonusType;
+
+  /**
+   * The size of the bonus (in %).
+   */
+  @ApiProperty({
+    description: 'The size of the bonus (in %)',
+    example: 5,
+  })
+  @Prop({ type: Number, required: true, min: 0 })
+  bonusValue: number;
+
+  /**
+   * The skill that must be unlocked before this one, if any.
+   */
+  @ApiProperty({
+    description: 'The skill that must be unlocked before this one, if any',
+    example: '507f1f77bcf86cd799439012',
+    required: false,
+  })
+  @Prop({ type: Types.ObjectId, ref: 'OperatorSkills', default: null })
+  prerequisiteSkillId?: Types.ObjectId | null;
+
+  /**
+   * The amount of $HASH burned to unlock the skill.
+   */
+  @ApiProperty({
+    description: 'The amount of $HASH burned to unlock the skill',
+    example: 500,
+  })
+  @Prop({ type: Number, required: true, min: 0 })
+  unlockCostHASH: number;
+}
+
+export const OperatorSkillSchema = SchemaFactory.createForClass(OperatorSkill);
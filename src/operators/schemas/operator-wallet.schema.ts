@@ -100,3 +100,6 @@ export class OperatorWallet extends Document {
  */
 export const OperatorWalletSchema =
   SchemaFactory.createForClass(OperatorWallet);
+
+// A wallet address can only be linked to one operator per chain (existing duplicates are removed by `run-dedupe-operator-wallets.ts`)
+OperatorWalletSchema.index({ address: 1, chain: 1 }, { unique: true });
@@ -59,6 +59,20 @@ export class Operator extends Document {
   @Prop({ required: true, default: 0 })
   assetEquity: number;
 
+  /**
+   * Whether the operator is verified, i.e. their linked wallets hold at least
+   * `GAME_CONSTANTS.OPERATORS.VERIFICATION_THRESHOLD_USD` in asset equity.
+   *
+   * Updated whenever the operator's asset equity is recalculated.
+   */
+  @ApiProperty({
+    description:
+      "Whether the operator is verified via their linked wallets' asset equity",
+    example: false,
+  })
+  @Prop({ type: Boolean, default: false })
+  verified: boolean;
+
   /**
    * The total cumulative EFF from all drills owned by the operator.
    *
@@ -97,6 +111,153 @@ export class Operator extends Document {
   @Prop({ required: true, default: 0 })
   effCredits: number;
 
+  /**
+   * The total EFF boost (in %) the operator currently receives from their active $HASH stakes.
+   *
+   * Applied on top of `effMultiplier` when calculating `cumulativeEff`.
+   */
+  @ApiProperty({
+    description:
+      'The total EFF boost (in %) the operator currently receives from their active $HASH stakes',
+    example: 5,
+  })
+  @Prop({ required: true, default: 0 })
+  stakingBoostPct: number;
+
+  /**
+   * The total EFF boost (in %) the operator receives from their unlocked skills.
+   *
+   * Applied on top of `effMultiplier` when calculating `cumulativeEff`.
+   */
+  @ApiProperty({
+    description:
+      'The total EFF boost (in %) the operator receives from their unlocked skills',
+    example: 5,
+  })
+  @Prop({ required: true, default: 0 })
+  skillEffBonusPct: number;
+
+  /**
+   * The total fuel consumption reduction (in %) the operator receives from their unlocked skills.
+   */
+  @ApiProperty({
+    description:
+      'The total fuel consumption reduction (in %) the operator receives from their unlocked skills',
+    example: 10,
+  })
+  @Prop({ required: true, default: 0 })
+  skillFuelReductionPct: number;
+
+  /**
+   * How trustworthy the operator is (0 to 1), recomputed daily.
+   *
+   * See `GAME_CONSTANTS.TRUST_SCORE` for how it's calculated.
+   */
+  @ApiProperty({
+    description: 'How trustworthy the operator is (0 to 1), recomputed daily',
+    example: 0.85,
+  })
+  @Prop({ type: Number, default: 0, index: true })
+  trustScore: number;
+
+  /**
+   * The number of $HASH loans the operator has borrowed and not repaid by their due date.
+   *
+   * Any overdue loan voids the clean record component of the operator's trust score.
+   */
+  @ApiProperty({
+    description:
+      'The number of $HASH loans the operator has not repaid by their due date',
+    example: 0,
+  })
+  @Prop({ type: Number, default: 0 })
+  overdueLoans: number;
+
+  /**
+   * The number of consecutive days (UTC) the operator completed at least one drilling session.
+   *
+   * Reset to 0 daily for operators who didn't complete a session the day before.
+   */
+  @ApiProperty({
+    description:
+      'The number of consecutive days the operator completed a drilling session',
+    example: 5,
+  })
+  @Prop({ type: Number, default: 0 })
+  currentStreak: number;
+
+  /**
+   * The longest drilling streak (in days) the operator ever reached.
+   */
+  @ApiProperty({
+    description: 'The longest drilling streak (in days) the operator reached',
+    example: 12,
+  })
+  @Prop({ type: Number, default: 0 })
+  longestStreak: number;
+
+  /**
+   * The day (UTC midnight) the operator last completed a drilling session (null if they never did).
+   */
+  @ApiProperty({
+    description:
+      'The day (UTC midnight) the operator last completed a drilling session',
+    example: '2025-01-01T00:00:00.000Z',
+    nullable: true,
+  })
+  @Prop({ type: Date, default: null })
+  lastSessionDate: Date | null;
+
+  /**
+   * The timestamp when the operator was banned by an admin (null if the operator isn't banned).
+   */
+  @ApiProperty({
+    description:
+      'The timestamp when the operator was banned (null if not banned)',
+    example: null,
+    nullable: true,
+  })
+  @Prop({ type: Date, required: false, default: null })
+  bannedAt: Date | null;
+
+  /**
+   * The URL of the operator's avatar image (hosted on the CDN).
+   */
+  @ApiProperty({
+    description: "The URL of the operator's avatar image",
+    example: 'https://cdn.hashland.com/avatars/507f1f77bcf86cd799439011.png',
+    required: false,
+    nullable: true,
+  })
+  @Prop({ type: String, default: null })
+  avatarURL?: string | null;
+
+  /**
+   * The URL of the operator's profile banner image (hosted on the CDN).
+   */
+  @ApiProperty({
+    description: "The URL of the operator's profile banner image",
+    example: 'https://cdn.hashland.com/banners/507f1f77bcf86cd799439011.png',
+    required: false,
+    nullable: true,
+  })
+  @Prop({ type: String, default: null })
+  bannerURL?: string | null;
+
+  /**
+   * Whether the operator drills in multi-session mode, i.e. one drilling session per active drill
+   * instead of one aggregate session.
+   *
+   * Can only be enabled once the operator's trust score reaches `GAME_CONSTANTS.DRILLS.MULTI_SESSION_MIN_TRUST_SCORE`.
+   */
+  @ApiProperty({
+    description:
+      'Whether the operator drills in multi-session mode (one session per active drill)',
+    example: false,
+  })
+  @Prop({ type: Boolean, default: false })
+  multiSessionMode: boolean;
+
   /**
    * The maximum fuel capacity of the operator's drills.
    */
@@ -146,6 +307,16 @@ export class Operator extends Document {
   @Prop({ required: true, default: 0 })
   totalEarnedHASH: number;
 
+  /**
+   * The total amount of TON the operator has spent on verified TON payments (e.g. shop purchases).
+   */
+  @ApiProperty({
+    description: 'The total amount of TON spent by the operator',
+    example: 12.5,
+  })
+  @Prop({ type: Number, default: 0, index: true })
+  totalTONSpent: number;
+
   /**
    * The current available $HASH balance of the operator.
    */
@@ -188,12 +359,14 @@ export class Operator extends Document {
     example: {
       tgId: '123456789',
       tgUsername: 'username',
+      language: 'en',
     },
   })
   @Prop({
     type: {
       tgId: { type: String, required: true, index: true },
       tgUsername: { type: String, required: true },
+      language: { type: String, required: false, default: 'en' },
     },
     required: false,
     default: null,
@@ -201,6 +374,10 @@ export class Operator extends Document {
   tgProfile?: {
     tgId: string;
     tgUsername: string;
+    /**
+     * The operator's preferred language code for Telegram notifications (e.g. `en`, `ru`, `zh`).
+     */
+    language?: string;
   } | null;
 
   /**
@@ -246,7 +423,12 @@ export class Operator extends Document {
   @Prop({
     type: {
       referralCode: { type: String, required: false, index: true },
-      referredBy: { type: Types.ObjectId, ref: 'Operators', required: false },
+      referredBy: {
+        type: Types.ObjectId,
+        ref: 'Operators',
+        required: false,
+        index: true,
+      },
       totalReferrals: { type: Number, default: 0 },
       referralRewards: {
         effCredits: { type: Number, default: 0 },
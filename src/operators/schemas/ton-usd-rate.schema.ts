@@ -0,0 +1,45 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `TonUsdRate` stores the TON/USD closing price of a single UTC day, used to value $HASH income in tax reports.
+ */
+@Schema({ timestamps: true, collection: 'TonUsdRates', versionKey: false })
+export class TonUsdRate extends Document {
+  /**
+   * The database ID of the rate.
+   */
+  @ApiProperty({
+    description: 'The database ID of the rate',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({
+    type: Types.ObjectId,
+    default: () => new Types.ObjectId(),
+  })
+  _id: Types.ObjectId;
+
+  /**
+   * The UTC day the rate applies to, formatted as `YYYY-MM-DD`.
+   */
+  @ApiProperty({
+    description: 'The UTC day the rate applies to (YYYY-MM-DD)',
+    example: '2025-01-31',
+  })
+  @Prop({ type: String, required: true, unique: true })
+  date: string;
+
+  /**
+   * The TON/USD price at the end of the day (or the latest price for the current day).
+   */
+  @ApiProperty({
+    description:
+      'The TON/USD price at the end of the day (or the latest price for the current day)',
+    example: 5.12,
+  })
+  @Prop({ type: Number, required: true })
+  rate: number;
+}
+
+export const TonUsdRateSchema = SchemaFactory.createForClass(TonUsdRate);
@@ -0,0 +1,250 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { ConfigService } from '@nestjs/config';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import axios from 'axios';
+import {
+  HashTransaction,
+  HashTransactionCategory,
+  HashTransactionStatus,
+  HashTransactionType,
+} from './schemas/hash-transaction.schema';
+import { TonUsdRate } from './schemas/ton-usd-rate.schema';
+import { DrillingSession } from 'src/drills/schemas/drilling-session.schema';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+/**
+ * A single row of an operator's tax report.
+ */
+export interface TaxReportEntry {
+  type: 'session' | 'transaction';
+  id: Types.ObjectId;
+  timestamp: Date;
+  category: HashTransactionCategory | 'drilling_session';
+  amountHASH: number;
+  tonUsdRate: number | null;
+  usdValue: number | null;
+}
+
+/**
+ * An operator's $HASH income over a calendar year.
+ */
+export interface TaxReport {
+  year: number;
+  hashTonPrice: number | null;
+  sessions: TaxReportEntry[];
+  transactions: TaxReportEntry[];
+  totalHASHCredited: number;
+  totalUSDValue: number | null;
+}
+
+@Injectable()
+export class TaxReportService {
+  private readonly logger = new Logger(TaxReportService.name);
+
+  constructor(
+    @InjectModel(HashTransaction.name)
+    private hashTransactionModel: Model<HashTransaction>,
+    @InjectModel(DrillingSession.name)
+    private drillingSessionModel: Model<DrillingSession>,
+    @InjectModel(TonUsdRate.name) private tonUsdRateModel: Model<TonUsdRate>,
+    private readonly configService: ConfigService,
+  ) {}
+
+  /**
+   * Stores the TON/USD closing price of the last 7 UTC days.
+   *
+   * Re-fetching a whole week on every run fills in any days missed while the job wasn't running.
+   * The current day's rate is overwritten with the latest price until the day is over.
+   */
+  async recordTonUsdRates(): Promise<void> {
+    try {
+      const response = await axios.get(
+        'https://data-api.binance.vision/api/v3/klines?symbol=TONUSDT&interval=1d&limit=7',
+      );
+
+      // Each kline is `[openTime, open, high, low, close, ...]`
+      const klines: any[][] = response.data || [];
+
+      if (klines.length === 0) {
+        this.logger.warn(
+          `⚠️ (recordTonUsdRates) No TON/USD prices returned.`,
+        );
+        return;
+      }
+
+      await this.tonUsdRateModel.bulkWrite(
+        klines.map((kline) => ({
+          updateOne: {
+            filter: { date: new Date(kline[0]).toISOString().slice(0, 10) },
+            update: { $set: { rate: parseFloat(kline[4]) } },
+            upsert: true,
+          },
+        })),
+      );
+
+      this.logger.log(
+        `✅ (recordTonUsdRates) Recorded ${klines.length} daily TON/USD rates.`,
+      );
+    } catch (err: any) {
+      this.logger.error(
+        `❌ (recordTonUsdRates) Failed to record TON/USD rates: ${err.message}`,
+      );
+    }
+  }
+
+  /**
+   * Builds an operator's tax report for a calendar year (UTC): their completed drilling sessions
+   * and completed $HASH credits, each valued in USD at the TON/USD rate of the day it happened.
+   *
+   * $HASH isn't traded, so the $HASH/TON price comes from the `HASH_TON_PRICE` config value.
+   * USD values are `null` if it isn't set or if no TON/USD rate was stored for that day.
+   */
+  async fetchTaxReport(
+    operatorId: Types.ObjectId,
+    year: number,
+  ): Promise<ApiResponse<TaxReport>> {
+    try {
+      if (
+        !Number.isInteger(year) ||
+        year < 2000 ||
+        year > new Date().getUTCFullYear()
+      ) {
+        return new ApiResponse(400, `(fetchTaxReport) Invalid year: ${year}.`);
+      }
+
+      const from = new Date(Date.UTC(year, 0, 1));
+      const to = new Date(Date.UTC(year + 1, 0, 1));
+
+      const [sessions, transactions, rates] = await Promise.all([
+        this.drillingSessionModel
+          .find(
+            { operatorId, endTime: { $gte: from, $lt: to } },
+            { endTime: 1, earnedHASH: 1 },
+          )
+          .sort({ endTime: 1 })
+          .lean(),
+        this.hashTransactionModel
+          .find(
+            {
+              operatorId,
+              transactionType: HashTransactionType.CREDIT,
+              status: HashTransactionStatus.COMPLETED,
+              createdAt: { $gte: from, $lt: to },
+            },
+            { createdAt: 1, category: 1, amount: 1 },
+          )
+          .sort({ createdAt: 1 })
+          .lean(),
+        this.tonUsdRateModel
+          .find({ date: { $gte: `${year}-01-01`, $lte: `${year}-12-31` } })
+          .lean(),
+      ]);
+
+      const hashTonPrice = this.getHASHTonPrice();
+      const ratesByDate = new Map(rates.map((rate) => [rate.date, rate.rate]));
+
+      const toEntry = (
+        type: TaxReportEntry['type'],
+        id: Types.ObjectId,
+        timestamp: Date,
+        category: TaxReportEntry['category'],
+        amountHASH: number,
+      ): TaxReportEntry => {
+        const tonUsdRate =
+          ratesByDate.get(new Date(timestamp).toISOString().slice(0, 10)) ??
+          null;
+
+        return {
+          type,
+          id,
+          timestamp,
+          category,
+          amountHASH,
+          tonUsdRate,
+          usdValue:
+            hashTonPrice !== null && tonUsdRate !== null
+              ? amountHASH * hashTonPrice * tonUsdRate
+              : null,
+        };
+      };
+
+      const transactionEntries = transactions.map((transaction) =>
+        toEntry(
+          'transaction',
+          transaction._id,
+          (transaction as any).createdAt,
+          transaction.category,
+          transaction.amount,
+        ),
+      );
+
+      const totalUSDValue = transactionEntries.some(
+        (entry) => entry.usdValue === null,
+      )
+        ? null
+        : transactionEntries.reduce((sum, entry) => sum + entry.usdValue, 0);
+
+      return new ApiResponse(200, `(fetchTaxReport) Tax report fetched.`, {
+        year,
+        hashTonPrice,
+        sessions: sessions.map((session) =>
+          toEntry(
+            'session',
+            session._id,
+            session.endTime,
+            'drilling_session',
+            session.earnedHASH,
+          ),
+        ),
+        transactions: transactionEntries,
+        totalHASHCredited: transactionEntries.reduce(
+          (sum, entry) => sum + entry.amountHASH,
+          0,
+        ),
+        totalUSDValue,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchTaxReport) Error fetching tax report: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Formats a tax report as CSV, with the sessions followed by the $HASH credits.
+   */
+  taxReportToCSV(report: TaxReport): string {
+    const rows = [...report.sessions, ...report.transactions].map((entry) =>
+      [
+        entry.type,
+        entry.id.toString(),
+        new Date(entry.timestamp).toISOString(),
+        entry.category,
+        entry.amountHASH,
+        entry.tonUsdRate ?? '',
+        entry.usdValue ?? '',
+      ].join(','),
+    );
+
+    return (
+      'type,id,timestamp_utc,category,amount_hash,ton_usd_rate,usd_value\n' +
+      rows.map((row) => row + '\n').join('')
+    );
+  }
+
+  /**
+   * Gets the configured $HASH/TON price, or `null` if it isn't set.
+   */
+  private getHASHTonPrice(): number | null {
+    const price = parseFloat(this.configService.get('HASH_TON_PRICE'));
+    return Number.isFinite(price) && price > 0 ? price : null;
+  }
+}
@@ -0,0 +1,225 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { Announcement, AnnouncementType } from './schemas/announcement.schema';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class AnnouncementService {
+  private readonly logger = new Logger(AnnouncementService.name);
+
+  constructor(
+    @InjectModel(Announcement.name)
+    private announcementModel: Model<Announcement>,
+  ) {}
+
+  /**
+   * Creates a new announcement (admin only).
+   */
+  async createAnnouncement(
+    title: string,
+    body: string,
+    type: AnnouncementType,
+    startsAt: Date,
+    endsAt: Date | null,
+    createdByAdmin: string,
+  ): Promise<ApiResponse<{ announcement: Announcement } | null>> {
+    try {
+      if (endsAt && endsAt <= startsAt) {
+        return new ApiResponse(
+          400,
+          `(createAnnouncement) The announcement must end after it starts.`,
+        );
+      }
+
+      const announcement = await this.announcementModel.create({
+        title,
+        body,
+        type,
+        startsAt,
+        endsAt,
+        createdByAdmin,
+      });
+
+      this.logger.log(
+        `📢 (createAnnouncement) ${createdByAdmin} created ${type} announcement "${title}".`,
+      );
+
+      return new ApiResponse(
+        200,
+        `(createAnnouncement) Announcement created.`,
+        { announcement },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(createAnnouncement) Error creating announcement: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Updates an announcement (admin only). Fields left undefined are kept; an `endsAt` of null shows the announcement
+   * until it's removed.
+   */
+  async updateAnnouncement(
+    announcementId: Types.ObjectId,
+    update: {
+      title?: string;
+      body?: string;
+      type?: AnnouncementType;
+      startsAt?: Date;
+      endsAt?: Date | null;
+    },
+  ): Promise<ApiResponse<{ announcement: Announcement } | null>> {
+    try {
+      const existing = await this.announcementModel
+        .findById(announcementId, { startsAt: 1, endsAt: 1 })
+        .lean();
+
+      if (!existing) {
+        return new ApiResponse(
+          404,
+          `(updateAnnouncement) Announcement not found.`,
+        );
+      }
+
+      const startsAt = update.startsAt ?? existing.startsAt;
+      const endsAt =
+        update.endsAt !== undefined ? update.endsAt : existing.endsAt;
+
+      if (endsAt && endsAt <= startsAt) {
+        return new ApiResponse(
+          400,
+          `(updateAnnouncement) The announcement must end after it starts.`,
+        );
+      }
+
+      // Only set the fields that were provided
+      const $set = Object.fromEntries(
+        Object.entries(update).filter(([, value]) => value !== undefined),
+      );
+
+      const announcement = await this.announcementModel
+        .findByIdAndUpdate(announcementId, { $set }, { new: true })
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(updateAnnouncement) Announcement updated.`,
+        { announcement },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(updateAnnouncement) Error updating announcement: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Deletes an announcement (admin only).
+   */
+  async deleteAnnouncement(
+    announcementId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const deleted = await this.announcementModel.deleteOne({
+        _id: announcementId,
+      });
+
+      if (deleted.deletedCount === 0) {
+        return new ApiResponse<null>(
+          404,
+          `(deleteAnnouncement) Announcement not found.`,
+        );
+      }
+
+      return new ApiResponse<null>(
+        200,
+        `(deleteAnnouncement) Announcement deleted.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(deleteAnnouncement) Error deleting announcement: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches all announcements, including past and upcoming ones, newest first (admin only).
+   */
+  async fetchAnnouncements(): Promise<
+    ApiResponse<{ announcements: Announcement[] } | null>
+  > {
+    try {
+      const announcements = await this.announcementModel
+        .find()
+        .sort({ startsAt: -1 })
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(fetchAnnouncements) Announcements fetched.`,
+        { announcements },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchAnnouncements) Error fetching announcements: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches the announcements that are currently shown, newest first.
+   */
+  async fetchActiveAnnouncements(): Promise<
+    ApiResponse<{ announcements: Announcement[] } | null>
+  > {
+    try {
+      const announcements = await this.findActiveAnnouncements();
+
+      return new ApiResponse(
+        200,
+        `(fetchActiveAnnouncements) Active announcements fetched.`,
+        { announcements },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchActiveAnnouncements) Error fetching active announcements: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Finds the announcements that are currently shown, i.e. that have started and haven't ended yet.
+   */
+  async findActiveAnnouncements(): Promise<Announcement[]> {
+    const now = new Date();
+
+    return this.announcementModel
+      .find({
+        startsAt: { $lte: now },
+        $or: [{ endsAt: null }, { endsAt: { $gt: now } }],
+      })
+      .sort({ startsAt: -1 })
+      .lean();
+  }
+}
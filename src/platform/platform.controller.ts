@@ -0,0 +1,41 @@
+import { Controller, Get } from '@nestjs/common';
+import { ApiOperation, ApiResponse, ApiTags } from '@nestjs/swagger';
+import { AnnouncementService } from './announcement.service';
+import { PlatformService } from './platform.service';
+
+@ApiTags('Platform')
+@Controller('platform')
+export class PlatformController {
+  constructor(
+    private readonly platformService: PlatformService,
+    private readonly announcementService: AnnouncementService,
+  ) {}
+
+  @ApiOperation({
+    summary: 'Get active announcements',
+    description:
+      'Fetches the platform-wide announcements that are currently shown, newest first',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved active announcements',
+  })
+  @Get('announcements')
+  async getActiveAnnouncements() {
+    return this.announcementService.fetchActiveAnnouncements();
+  }
+
+  @ApiOperation({
+    summary: 'Get platform config',
+    description:
+      'Fetches the platform-wide data clients load on startup, including the active announcements',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved platform config',
+  })
+  @Get('config')
+  async getPlatformConfig() {
+    return this.platformService.fetchPlatformConfig();
+  }
+}
@@ -0,0 +1,21 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import {
+  Announcement,
+  AnnouncementSchema,
+} from './schemas/announcement.schema';
+import { AnnouncementService } from './announcement.service';
+import { PlatformService } from './platform.service';
+import { PlatformController } from './platform.controller';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: Announcement.name, schema: AnnouncementSchema },
+    ]),
+  ],
+  controllers: [PlatformController], // Expose API endpoints
+  providers: [AnnouncementService, PlatformService], // Business logic for platform-wide data
+  exports: [AnnouncementService], // Allow usage in other modules (e.g. admin announcements)
+})
+export class PlatformModule {}
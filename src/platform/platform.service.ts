@@ -0,0 +1,36 @@
+import { Injectable, InternalServerErrorException } from '@nestjs/common';
+import { ApiResponse } from 'src/common/dto/response.dto';
+import { AnnouncementService } from './announcement.service';
+import { Announcement } from './schemas/announcement.schema';
+
+@Injectable()
+export class PlatformService {
+  constructor(private readonly announcementService: AnnouncementService) {}
+
+  /**
+   * Fetches the platform-wide data clients load on startup.
+   *
+   * Currently only includes the active announcements.
+   */
+  async fetchPlatformConfig(): Promise<
+    ApiResponse<{ announcements: Announcement[] } | null>
+  > {
+    try {
+      const announcements =
+        await this.announcementService.findActiveAnnouncements();
+
+      return new ApiResponse(
+        200,
+        `(fetchPlatformConfig) Platform config fetched.`,
+        { announcements },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchPlatformConfig) Error fetching platform config: ${err.message}`,
+        ),
+      );
+    }
+  }
+}
@@ -0,0 +1,117 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The kind of notice an announcement is, so clients can style its banner.
+ */
+export enum AnnouncementType {
+  INFO = 'INFO',
+  EVENT = 'EVENT',
+  MAINTENANCE = 'MAINTENANCE',
+  WARNING = 'WARNING',
+}
+
+/**
+ * `Announcement` represents a platform-wide notice shown to all operators between `startsAt` and `endsAt`.
+ */
+@Schema({ timestamps: true, collection: 'Announcements', versionKey: false })
+export class Announcement extends Document {
+  /**
+   * The database ID of the announcement.
+   */
+  @ApiProperty({
+    description: 'The database ID of the announcement',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({
+    type: Types.ObjectId,
+    default: () => new Types.ObjectId(),
+  })
+  _id: Types.ObjectId;
+
+  /**
+   * The title of the announcement.
+   */
+  @ApiProperty({
+    description: 'The title of the announcement',
+    example: 'Scheduled maintenance',
+  })
+  @Prop({ type: String, required: true })
+  title: string;
+
+  /**
+   * The body of the announcement.
+   */
+  @ApiProperty({
+    description: 'The body of the announcement',
+    example: 'Drilling will be paused for 30 minutes at 12:00 UTC.',
+  })
+  @Prop({ type: String, required: true })
+  body: string;
+
+  /**
+   * The kind of notice the announcement is.
+   */
+  @ApiProperty({
+    description: 'The kind of notice the announcement is',
+    enum: AnnouncementType,
+    example: AnnouncementType.MAINTENANCE,
+  })
+  @Prop({
+    type: String,
+    required: true,
+    enum: AnnouncementType,
+    default: AnnouncementType.INFO,
+  })
+  type: AnnouncementType;
+
+  /**
+   * When the announcement starts being shown.
+   */
+  @ApiProperty({
+    description: 'When the announcement starts being shown',
+    example: '2025-01-01T12:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  startsAt: Date;
+
+  /**
+   * When the announcement stops being shown (null if it's shown until removed).
+   */
+  @ApiProperty({
+    description:
+      'When the announcement stops being shown (null if it is shown until removed)',
+    example: '2025-01-02T12:00:00.000Z',
+    nullable: true,
+  })
+  @Prop({ type: Date, default: null })
+  endsAt: Date | null;
+
+  /**
+   * The name of the admin who created the announcement.
+   */
+  @ApiProperty({
+    description: 'The name of the admin who created the announcement',
+    example: 'hashland_admin',
+  })
+  @Prop({ type: String, required: true })
+  createdByAdmin: string;
+
+  /**
+   * The timestamp when the announcement was created.
+   */
+  @ApiProperty({
+    description: 'The timestamp when the announcement was created',
+    example: '2024-03-19T12:00:00.000Z',
+  })
+  createdAt: Date;
+}
+
+/**
+ * Generate the Mongoose schema for Announcement.
+ */
+export const AnnouncementSchema = SchemaFactory.createForClass(Announcement);
+
+// Index for fetching the currently active announcements
+AnnouncementSchema.index({ startsAt: 1, endsAt: 1 });
@@ -0,0 +1,99 @@
+import { Controller, Param, Post, Request, UseGuards } from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { RateLimit } from 'src/common/decorators/rate-limit.decorator';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { PoolAnnouncementService } from './pool-announcement.service';
+
+@ApiTags('Pools')
+@Controller('pools')
+export class PoolAnnouncementController {
+  constructor(
+    private readonly poolAnnouncementService: PoolAnnouncementService,
+  ) {}
+
+  @ApiOperation({
+    summary: 'Announce a pool',
+    description: `Posts a recruitment message with a join link to the pool's Telegram channel. Leader only, requires open slots and can only be called once every ${GAME_CONSTANTS.POOLS.ANNOUNCEMENT_COOLDOWN / 60 / 60} hours per pool. Returns the Telegram message ID.`,
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully announced pool',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Pool has no Telegram channel or no open slots',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only the pool leader can announce the pool',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @ApiResponse({
+    status: 429,
+    description: 'Too Many Requests - Pool was announced recently',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/announce')
+  async announcePool(@Param('id') poolId: string, @Request() req) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.poolAnnouncementService.announcePool(
+      operatorId,
+      new Types.ObjectId(poolId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Join a pool with an invitation',
+    description:
+      "Adds the authenticated operator to the pool of an invitation token from a recruitment announcement's join link. Each token can only be used once before it expires, and the pool's open slots and join prerequisites still apply.",
+  })
+  @ApiParam({
+    name: 'token',
+    description: 'The invitation token from the join link',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully joined pool',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Operator is already in a pool or pool is full',
+  })
+  @ApiResponse({
+    status: 403,
+    description: "Forbidden - Pool's join prerequisites are not met",
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Invitation not found, expired or already used',
+  })
+  @ApiBearerAuth()
+  @RateLimit(5)
+  @UseGuards(JwtAuthGuard)
+  @Post('invitations/:token/join')
+  async redeemInvitationToken(@Param('token') token: string, @Request() req) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.poolAnnouncementService.redeemInvitationToken(
+      operatorId,
+      token,
+    );
+  }
+}
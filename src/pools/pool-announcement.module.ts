@@ -0,0 +1,32 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { Pool, PoolSchema } from './schemas/pool.schema';
+import {
+  PoolOperator,
+  PoolOperatorSchema,
+} from './schemas/pool-operator.schema';
+import {
+  PoolInvitationToken,
+  PoolInvitationTokenSchema,
+} from './schemas/pool-invitation-token.schema';
+import { TelegramModule } from 'src/telegram/telegram.module';
+import { PoolModule } from './pool.module';
+import { PoolAnnouncementService } from './pool-announcement.service';
+import { PoolAnnouncementController } from './pool-announcement.controller';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: Pool.name, schema: PoolSchema },
+      { name: PoolOperator.name, schema: PoolOperatorSchema },
+      { name: PoolInvitationToken.name, schema: PoolInvitationTokenSchema },
+    ]),
+    // Not part of `PoolModule`, since `TelegramModule` depends on it via `OperatorModule`
+    TelegramModule,
+    PoolModule,
+  ],
+  controllers: [PoolAnnouncementController], // Expose API endpoints
+  providers: [PoolAnnouncementService], // Business logic for pool announcements
+  exports: [PoolAnnouncementService],
+})
+export class PoolAnnouncementModule {}
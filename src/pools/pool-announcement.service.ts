@@ -0,0 +1,233 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { ConfigService } from '@nestjs/config';
+import { Model, Types } from 'mongoose';
+import * as crypto from 'crypto';
+import { Pool } from './schemas/pool.schema';
+import { PoolOperator } from './schemas/pool-operator.schema';
+import { PoolInvitationToken } from './schemas/pool-invitation-token.schema';
+import { RedisService } from 'src/common/redis.service';
+import { TelegramService } from 'src/telegram/telegram.service';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { ApiResponse } from 'src/common/dto/response.dto';
+import { PoolService } from './pool.service';
+
+@Injectable()
+export class PoolAnnouncementService {
+  private readonly logger = new Logger(PoolAnnouncementService.name);
+
+  constructor(
+    @InjectModel(Pool.name) private poolModel: Model<Pool>,
+    @InjectModel(PoolOperator.name)
+    private poolOperatorModel: Model<PoolOperator>,
+    @InjectModel(PoolInvitationToken.name)
+    private poolInvitationTokenModel: Model<PoolInvitationToken>,
+    private readonly configService: ConfigService,
+    private readonly redisService: RedisService,
+    private readonly telegramService: TelegramService,
+    private readonly poolService: PoolService,
+  ) {}
+
+  /**
+   * Broadcasts a recruitment message with a join link to the pool's Telegram channel. Only callable by the pool's leader.
+   *
+   * The join link carries a freshly generated `PoolInvitationToken` (see `redeemInvitationToken`). A pool can only be announced once every
+   * `ANNOUNCEMENT_COOLDOWN` seconds, and only while it has open slots.
+   */
+  async announcePool(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+  ): Promise<ApiResponse<{ messageId: number; token: string } | null>> {
+    try {
+      const pool = await this.poolModel
+        .findById(poolId, {
+          leaderId: 1,
+          name: 1,
+          maxOperators: 1,
+          joinPrerequisites: 1,
+        })
+        .lean();
+
+      if (!pool) {
+        return new ApiResponse(404, `(announcePool) Pool not found.`);
+      }
+
+      if (!pool.leaderId || !pool.leaderId.equals(leaderId)) {
+        return new ApiResponse(
+          403,
+          `(announcePool) Only the pool leader can announce the pool.`,
+        );
+      }
+
+      const channelId = pool.joinPrerequisites?.tgChannelId;
+      if (!channelId) {
+        return new ApiResponse(
+          400,
+          `(announcePool) Pool has no Telegram channel to announce to.`,
+        );
+      }
+
+      // `null` means the pool has no operator limit
+      let openSlots: number | null = null;
+      if (pool.maxOperators) {
+        const memberCount = await this.poolOperatorModel.countDocuments({
+          pool: poolId,
+        });
+        openSlots = pool.maxOperators - memberCount;
+
+        if (openSlots <= 0) {
+          return new ApiResponse(
+            400,
+            `(announcePool) Pool has no open slots to announce.`,
+          );
+        }
+      }
+
+      const cooldownKey = `pool:${poolId.toString()}:announcement`;
+      const acquired = await this.redisService.setIfNotExists(
+        cooldownKey,
+        new Date().toISOString(),
+        GAME_CONSTANTS.POOLS.ANNOUNCEMENT_COOLDOWN,
+      );
+
+      if (!acquired) {
+        return new ApiResponse(
+          429,
+          `(announcePool) A pool can only be announced once every ${GAME_CONSTANTS.POOLS.ANNOUNCEMENT_COOLDOWN / 60 / 60} hours.`,
+        );
+      }
+
+      const invitation = await this.poolInvitationTokenModel.create({
+        poolId,
+        token: crypto.randomBytes(16).toString('hex'),
+        createdBy: leaderId,
+        expiresAt: new Date(
+          Date.now() +
+            GAME_CONSTANTS.POOLS.INVITATION_TOKEN_EXPIRY_DAYS * 86_400_000,
+        ),
+      });
+
+      const joinLink = `${this.configService.get<string>('HASHLAND_URL')}?pool=${invitation.token}`;
+      // Pool names may contain underscores, which Telegram's Markdown treats as italics
+      const poolName = pool.name.replace(/_/g, '\\_');
+      const slotsText =
+        openSlots === null ? 'open slots' : `${openSlots} open slots`;
+
+      let messageId: number;
+      try {
+        const response = await this.telegramService.sendTelegramMessage(
+          channelId,
+          `Join our pool! ${poolName} has ${slotsText} — [Join](${joinLink})`,
+          { parse_mode: 'Markdown' },
+        );
+        messageId = response.result.message_id;
+      } catch (err: any) {
+        // Release the cooldown and drop the unused token so the leader can retry
+        await Promise.all([
+          this.redisService.del(cooldownKey),
+          this.poolInvitationTokenModel.deleteOne({ _id: invitation._id }),
+        ]);
+        throw err;
+      }
+
+      await this.poolInvitationTokenModel.updateOne(
+        { _id: invitation._id },
+        { $set: { tgMessageId: messageId } },
+      );
+
+      this.logger.log(
+        `📣 (announcePool) Pool ${poolId} announced in Telegram channel ${channelId} (message ${messageId}).`,
+      );
+
+      return new ApiResponse(200, `(announcePool) Pool announced.`, {
+        messageId,
+        token: invitation.token,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(announcePool) Error announcing pool: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Joins the pool of an invitation token from a recruitment announcement's join link.
+   *
+   * Each token can only be redeemed once and only before it expires. The operator joins through `PoolService.joinPool`,
+   * so the pool's capacity, join cooldown and prerequisites still apply; if joining fails, the token can be used again.
+   * Since the leader handed out the invitation, pools that require approval can be joined this way too.
+   */
+  async redeemInvitationToken(
+    operatorId: Types.ObjectId,
+    token: string,
+  ): Promise<ApiResponse<{ poolId: Types.ObjectId } | null>> {
+    try {
+      // Claim the token first so it can only be redeemed once
+      const invitation = await this.poolInvitationTokenModel
+        .findOneAndUpdate(
+          { token, expiresAt: { $gt: new Date() }, redeemedBy: null },
+          { $set: { redeemedBy: operatorId, redeemedAt: new Date() } },
+          { new: true, projection: { poolId: 1 } },
+        )
+        .lean();
+
+      if (!invitation) {
+        return new ApiResponse(
+          404,
+          `(redeemInvitationToken) Invitation not found, expired or already used.`,
+        );
+      }
+
+      const joinResponse = await this.poolService
+        .joinPool(operatorId, invitation.poolId, true)
+        .catch(async (err: any) => {
+          await this.releaseInvitationToken(invitation._id, operatorId);
+          throw err;
+        });
+
+      if (joinResponse.status !== 200) {
+        await this.releaseInvitationToken(invitation._id, operatorId);
+
+        return new ApiResponse(
+          joinResponse.status,
+          `(redeemInvitationToken) ${joinResponse.message}`,
+        );
+      }
+
+      this.logger.log(
+        `🎟️ (redeemInvitationToken) Operator ${operatorId} joined pool ${invitation.poolId} with an invitation.`,
+      );
+
+      return new ApiResponse(200, `(redeemInvitationToken) Joined pool.`, {
+        poolId: invitation.poolId,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(redeemInvitationToken) Error redeeming invitation: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Makes a claimed invitation token redeemable again, e.g. after its operator failed to join the pool.
+   */
+  private async releaseInvitationToken(
+    invitationId: Types.ObjectId,
+    operatorId: Types.ObjectId,
+  ): Promise<void> {
+    await this.poolInvitationTokenModel.updateOne(
+      { _id: invitationId, redeemedBy: operatorId },
+      { $set: { redeemedBy: null, redeemedAt: null } },
+    );
+  }
+}
@@ -0,0 +1,261 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import {
+  PoolChallenge,
+  PoolChallengeStatus,
+} from './schemas/pool-challenge.schema';
+import { Pool } from './schemas/pool.schema';
+import { DrillingCycle } from 'src/drills/schemas/drilling-cycle.schema';
+import { ApiResponse } from 'src/common/dto/response.dto';
+import { PoolService } from './pool.service';
+
+@Injectable()
+export class PoolChallengeService {
+  private readonly logger = new Logger(PoolChallengeService.name);
+
+  constructor(
+    @InjectModel(PoolChallenge.name)
+    private poolChallengeModel: Model<PoolChallenge>,
+    @InjectModel(Pool.name) private poolModel: Model<Pool>,
+    @InjectModel(DrillingCycle.name)
+    private drillingCycleModel: Model<DrillingCycle>,
+    private readonly poolService: PoolService,
+  ) {}
+
+  /**
+   * (Admin only) Creates a challenge between two pools.
+   *
+   * If `startsAtCycle` is omitted, the challenge starts at the next drilling cycle.
+   */
+  async createChallenge(
+    challengingPoolId: Types.ObjectId,
+    challengedPoolId: Types.ObjectId,
+    cycleCount: number,
+    rewardHASH: number,
+    startsAtCycle?: number,
+  ): Promise<ApiResponse<{ challenge: PoolChallenge } | null>> {
+    try {
+      if (challengingPoolId.equals(challengedPoolId)) {
+        return new ApiResponse(
+          400,
+          `(createChallenge) A pool cannot challenge itself.`,
+        );
+      }
+
+      const poolCount = await this.poolModel.countDocuments({
+        _id: { $in: [challengingPoolId, challengedPoolId] },
+      });
+
+      if (poolCount !== 2) {
+        return new ApiResponse(404, `(createChallenge) Pool not found.`);
+      }
+
+      const latestCycle = await this.drillingCycleModel
+        .findOne({}, { cycleNumber: 1 })
+        .sort({ cycleNumber: -1 })
+        .lean();
+      const nextCycleNumber = (latestCycle?.cycleNumber || 0) + 1;
+
+      if (startsAtCycle !== undefined && startsAtCycle < nextCycleNumber) {
+        return new ApiResponse(
+          400,
+          `(createChallenge) Challenge must start at cycle ${nextCycleNumber} or later.`,
+        );
+      }
+
+      const challenge = await this.poolChallengeModel.create({
+        challengingPoolId,
+        challengedPoolId,
+        cycleCount,
+        startsAtCycle: startsAtCycle ?? nextCycleNumber,
+        rewardHASH,
+        status: PoolChallengeStatus.ACTIVE,
+      });
+
+      this.logger.log(
+        `⚔️ (createChallenge) Pool ${challengingPoolId} challenged pool ${challengedPoolId} for ${cycleCount} cycles starting at cycle #${challenge.startsAtCycle}.`,
+      );
+
+      return new ApiResponse(
+        200,
+        `(createChallenge) Challenge created successfully.`,
+        { challenge },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(createChallenge) Error creating challenge: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches all pool challenges, most recent first.
+   */
+  async fetchChallenges(
+    status?: PoolChallengeStatus,
+  ): Promise<ApiResponse<{ challenges: PoolChallenge[] }>> {
+    try {
+      const challenges = await this.poolChallengeModel
+        .find(status ? { status } : {})
+        .sort({ createdAt: -1 })
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(fetchChallenges) Challenges fetched successfully.`,
+        { challenges },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchChallenges) Error fetching challenges: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Resolves all active challenges whose last cycle is `cycleNumber` or earlier.
+   *
+   * Called at the end of every drilling cycle.
+   */
+  async resolveEndedChallenges(cycleNumber: number): Promise<void> {
+    const dueChallenges = await this.poolChallengeModel
+      .find({
+        status: PoolChallengeStatus.ACTIVE,
+        $expr: {
+          $lte: [
+            { $add: ['$startsAtCycle', '$cycleCount', -1] },
+            cycleNumber,
+          ],
+        },
+      })
+      .lean();
+
+    for (const challenge of dueChallenges) {
+      try {
+        await this.resolveChallenge(challenge);
+      } catch (err: any) {
+        this.logger.error(
+          `❌ (resolveEndedChallenges) Error resolving challenge ${challenge._id}: ${err.message}`,
+        );
+      }
+    }
+  }
+
+  /**
+   * Compares the $HASH extracted by each pool's operators during the challenge
+   * and transfers `rewardHASH` from the loser's treasury to the winner's.
+   */
+  private async resolveChallenge(
+    challenge: Pick<
+      PoolChallenge,
+      | '_id'
+      | 'challengingPoolId'
+      | 'challengedPoolId'
+      | 'cycleCount'
+      | 'startsAtCycle'
+      | 'rewardHASH'
+    >,
+  ): Promise<void> {
+    // Claim the challenge first so that it can never be resolved (and paid out) twice
+    const claim = await this.poolChallengeModel.updateOne(
+      { _id: challenge._id, status: PoolChallengeStatus.ACTIVE },
+      {
+        $set: { status: PoolChallengeStatus.RESOLVED, resolvedAt: new Date() },
+      },
+    );
+
+    if (claim.modifiedCount === 0) return;
+
+    const extracted = await this.drillingCycleModel.aggregate<{
+      _id: Types.ObjectId;
+      extractedHASH: number;
+    }>([
+      {
+        $match: {
+          cycleNumber: {
+            $gte: challenge.startsAtCycle,
+            $lt: challenge.startsAtCycle + challenge.cycleCount,
+          },
+          extractorOperatorId: { $ne: null },
+        },
+      },
+      {
+        $lookup: {
+          from: 'PoolOperators',
+          localField: 'extractorOperatorId',
+          foreignField: 'operator',
+          as: 'poolOperator',
+        },
+      },
+      { $unwind: '$poolOperator' },
+      {
+        $match: {
+          'poolOperator.pool': {
+            $in: [challenge.challengingPoolId, challenge.challengedPoolId],
+          },
+        },
+      },
+      {
+        $group: {
+          _id: '$poolOperator.pool',
+          extractedHASH: { $sum: '$issuedHASH' },
+        },
+      },
+    ]);
+
+    const extractedByPool = new Map(
+      extracted.map((entry) => [entry._id.toString(), entry.extractedHASH]),
+    );
+    const challengingPoolExtractedHASH =
+      extractedByPool.get(challenge.challengingPoolId.toString()) || 0;
+    const challengedPoolExtractedHASH =
+      extractedByPool.get(challenge.challengedPoolId.toString()) || 0;
+
+    let winnerPoolId: Types.ObjectId | null = null;
+    let loserPoolId: Types.ObjectId | null = null;
+    if (challengingPoolExtractedHASH > challengedPoolExtractedHASH) {
+      winnerPoolId = challenge.challengingPoolId;
+      loserPoolId = challenge.challengedPoolId;
+    } else if (challengedPoolExtractedHASH > challengingPoolExtractedHASH) {
+      winnerPoolId = challenge.challengedPoolId;
+      loserPoolId = challenge.challengingPoolId;
+    }
+
+    // The reward is moved between the pools' treasuries, capped at what the losing pool's treasury holds
+    let transferredHASH = 0;
+    if (winnerPoolId && loserPoolId && challenge.rewardHASH > 0) {
+      transferredHASH = await this.poolService.transferTreasuryHASH(
+        loserPoolId,
+        winnerPoolId,
+        () => challenge.rewardHASH,
+      );
+    }
+
+    await this.poolChallengeModel.updateOne(
+      { _id: challenge._id },
+      {
+        $set: {
+          winnerPoolId,
+          challengingPoolExtractedHASH,
+          challengedPoolExtractedHASH,
+          transferredHASH,
+        },
+      },
+    );
+
+    this.logger.log(
+      `🏆 (resolveChallenge) Challenge ${challenge._id} resolved. ${winnerPoolId ? `Pool ${winnerPoolId} won ${transferredHASH} $HASH.` : 'Ended in a tie.'}`,
+    );
+  }
+}
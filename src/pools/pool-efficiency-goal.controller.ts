@@ -0,0 +1,101 @@
+import {
+  Body,
+  Controller,
+  Get,
+  Param,
+  Post,
+  Query,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { GetLeaderboardQueryDto } from 'src/common/dto/leaderboard.dto';
+import { CreatePoolEfficiencyGoalDto } from 'src/common/dto/pools/pool-efficiency-goal.dto';
+import { PoolEfficiencyGoalService } from './pool-efficiency-goal.service';
+
+@ApiTags('Pools')
+@Controller('pools')
+export class PoolEfficiencyGoalController {
+  constructor(
+    private readonly poolEfficiencyGoalService: PoolEfficiencyGoalService,
+  ) {}
+
+  @ApiOperation({
+    summary: 'Set a pool efficiency goal',
+    description: `Sets an EFF target for the pool. Leader only. If the pool reaches the target before the deadline (at most ${GAME_CONSTANTS.POOLS.EFFICIENCY_GOAL_MAX_DURATION_DAYS} days away), every member receives the reward from the pool's treasury.`,
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully created the goal',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      "Bad Request - Invalid target or deadline, too many active goals, or the treasury can't cover the rewards",
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only the pool leader can set efficiency goals',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/goals')
+  async createGoal(
+    @Param('id') poolId: string,
+    @Body() body: CreatePoolEfficiencyGoalDto,
+    @Request() req,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolEfficiencyGoalService.createGoal(
+      operatorId,
+      new Types.ObjectId(poolId),
+      body.targetEff,
+      new Date(body.deadline),
+      body.rewardHASHPerMember,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get pool efficiency goals',
+    description: "Fetches the pool's efficiency goals, newest first",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched the goals',
+  })
+  @Get(':id/goals')
+  async fetchGoals(
+    @Param('id') poolId: string,
+    @Query() query: GetLeaderboardQueryDto,
+  ) {
+    return this.poolEfficiencyGoalService.fetchGoals(
+      new Types.ObjectId(poolId),
+      query.page,
+      query.limit,
+    );
+  }
+}
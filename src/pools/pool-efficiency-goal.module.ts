@@ -0,0 +1,40 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { BullModule } from '@nestjs/bull';
+import { Pool, PoolSchema } from './schemas/pool.schema';
+import {
+  PoolOperator,
+  PoolOperatorSchema,
+} from './schemas/pool-operator.schema';
+import {
+  PoolEfficiencyGoal,
+  PoolEfficiencyGoalSchema,
+} from './schemas/pool-efficiency-goal.schema';
+import { OperatorModule } from 'src/operators/operator.module';
+import { PoolEfficiencyGoalService } from './pool-efficiency-goal.service';
+import { PoolEfficiencyGoalController } from './pool-efficiency-goal.controller';
+import { PoolEfficiencyGoalQueue } from './pool-efficiency-goal.queue';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: Pool.name, schema: PoolSchema },
+      { name: PoolOperator.name, schema: PoolOperatorSchema },
+      { name: PoolEfficiencyGoal.name, schema: PoolEfficiencyGoalSchema },
+    ]),
+    BullModule.registerQueue({
+      name: 'pool-efficiency-goal-queue',
+      defaultJobOptions: {
+        attempts: 3, // Retry failed jobs 3 times
+        removeOnComplete: true, // Remove completed jobs
+        removeOnFail: false, // Keep failed jobs for debugging
+      },
+    }),
+    // Not part of `PoolModule`, since `OperatorModule` depends on it
+    OperatorModule,
+  ],
+  controllers: [PoolEfficiencyGoalController], // Expose API endpoints
+  providers: [PoolEfficiencyGoalService, PoolEfficiencyGoalQueue],
+  exports: [PoolEfficiencyGoalService],
+})
+export class PoolEfficiencyGoalModule {}
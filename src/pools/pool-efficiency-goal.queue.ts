@@ -0,0 +1,85 @@
+import {
+  Processor,
+  Process,
+  InjectQueue,
+  OnGlobalQueueFailed,
+} from '@nestjs/bull';
+import { Queue } from 'bull';
+import { Injectable, Logger, OnModuleInit } from '@nestjs/common';
+import { PoolEfficiencyGoalService } from './pool-efficiency-goal.service';
+
+@Injectable()
+@Processor('pool-efficiency-goal-queue')
+export class PoolEfficiencyGoalQueue implements OnModuleInit {
+  private readonly logger = new Logger(PoolEfficiencyGoalQueue.name);
+  private readonly fiveMinutesInMs = 5 * 60 * 1000; // 5 minutes
+
+  constructor(
+    private readonly poolEfficiencyGoalService: PoolEfficiencyGoalService,
+    @InjectQueue('pool-efficiency-goal-queue')
+    private readonly poolEfficiencyGoalQueue: Queue,
+  ) {}
+
+  /**
+   * Called when the module initializes.
+   */
+  async onModuleInit() {
+    // ✅ Schedule Pool Efficiency Goal Checks (Every 5 Minutes)
+    await this.ensureJobScheduled(
+      'check-efficiency-goals',
+      this.fiveMinutesInMs,
+    );
+  }
+
+  /**
+   * Ensures a Bull job is scheduled, preventing duplicates.
+   */
+  private async ensureJobScheduled(jobName: string, intervalMs: number) {
+    const existingJobs = await this.poolEfficiencyGoalQueue.getRepeatableJobs();
+    if (!existingJobs.some((job) => job.name === jobName)) {
+      await this.poolEfficiencyGoalQueue.add(
+        jobName,
+        {},
+        {
+          repeat: { every: intervalMs },
+          removeOnComplete: true,
+          removeOnFail: false,
+        },
+      );
+      this.logger.log(
+        `✅ (poolEfficiencyGoalQueue) Scheduled job: ${jobName} every ${intervalMs / 1000 / 60} minutes.`,
+      );
+    } else {
+      this.logger.log(
+        `🔄 (poolEfficiencyGoalQueue) Job already scheduled: ${jobName}.`,
+      );
+    }
+  }
+
+  /**
+   * Rewards achieved pool efficiency goals and expires overdue ones (runs **every 5 minutes**).
+   */
+  @Process({
+    name: 'check-efficiency-goals',
+    concurrency: 1, // Limit to one concurrent job at a time
+  })
+  async handleCheckEfficiencyGoals() {
+    try {
+      await this.poolEfficiencyGoalService.checkGoals();
+    } catch (error) {
+      this.logger.error(
+        `❌ (check-efficiency-goals) Error checking pool efficiency goals: ${error.message}`,
+      );
+    }
+  }
+
+  /**
+   * Handle failed jobs in the queue.
+   */
+  @OnGlobalQueueFailed()
+  onFailed(jobId: number, err: Error) {
+    this.logger.error(
+      `❌ Pool Efficiency Goal Queue job ${jobId} has failed: ${err.message}`,
+    );
+  }
+}
@@ -0,0 +1,300 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { performance } from 'perf_hooks';
+import { Pool } from './schemas/pool.schema';
+import { PoolOperator } from './schemas/pool-operator.schema';
+import {
+  PoolEfficiencyGoal,
+  PoolEfficiencyGoalStatus,
+} from './schemas/pool-efficiency-goal.schema';
+import { OperatorService } from 'src/operators/operator.service';
+import { HashTransactionCategory } from 'src/operators/schemas/hash-transaction.schema';
+import { ApiResponse } from 'src/common/dto/response.dto';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+@Injectable()
+export class PoolEfficiencyGoalService {
+  private readonly logger = new Logger(PoolEfficiencyGoalService.name);
+
+  constructor(
+    @InjectModel(Pool.name) private poolModel: Model<Pool>,
+    @InjectModel(PoolOperator.name)
+    private poolOperatorModel: Model<PoolOperator>,
+    @InjectModel(PoolEfficiencyGoal.name)
+    private goalModel: Model<PoolEfficiencyGoal>,
+    private readonly operatorService: OperatorService,
+  ) {}
+
+  /**
+   * Sets an efficiency goal for a pool. Leader only.
+   *
+   * The goal's rewards are paid out from the pool treasury, so the treasury must be able to cover
+   * `rewardHASHPerMember` for every current member when the goal is set.
+   */
+  async createGoal(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    targetEff: number,
+    deadline: Date,
+    rewardHASHPerMember: number,
+  ): Promise<ApiResponse<{ goalId: Types.ObjectId }>> {
+    try {
+      const { EFFICIENCY_GOAL_MAX_DURATION_DAYS, MAX_ACTIVE_EFFICIENCY_GOALS } =
+        GAME_CONSTANTS.POOLS;
+
+      const pool = await this.poolModel
+        .findById(poolId, { leaderId: 1, estimatedEff: 1, treasuryHASH: 1 })
+        .lean();
+
+      if (!pool) {
+        return new ApiResponse(404, `(createGoal) Pool not found.`);
+      }
+
+      if (!pool.leaderId || !pool.leaderId.equals(leaderId)) {
+        return new ApiResponse(
+          403,
+          `(createGoal) Only the pool leader can set efficiency goals.`,
+        );
+      }
+
+      const msUntilDeadline = deadline.getTime() - Date.now();
+      if (
+        msUntilDeadline <= 0 ||
+        msUntilDeadline > EFFICIENCY_GOAL_MAX_DURATION_DAYS * 86_400_000
+      ) {
+        return new ApiResponse(
+          400,
+          `(createGoal) Deadline must be within the next ${EFFICIENCY_GOAL_MAX_DURATION_DAYS} days.`,
+        );
+      }
+
+      if (targetEff <= (pool.estimatedEff || 0)) {
+        return new ApiResponse(
+          400,
+          `(createGoal) Target EFF must be above the pool's current EFF of ${pool.estimatedEff || 0}.`,
+        );
+      }
+
+      const [activeGoalCount, memberCount] = await Promise.all([
+        this.goalModel.countDocuments({
+          poolId,
+          status: PoolEfficiencyGoalStatus.ACTIVE,
+        }),
+        this.poolOperatorModel.countDocuments({ pool: poolId }),
+      ]);
+
+      if (activeGoalCount >= MAX_ACTIVE_EFFICIENCY_GOALS) {
+        return new ApiResponse(
+          400,
+          `(createGoal) Pool already has ${MAX_ACTIVE_EFFICIENCY_GOALS} active efficiency goals.`,
+        );
+      }
+
+      const totalReward = rewardHASHPerMember * memberCount;
+      if (totalReward > (pool.treasuryHASH || 0)) {
+        return new ApiResponse(
+          400,
+          `(createGoal) The pool treasury (${pool.treasuryHASH || 0} $HASH) can't cover the ${totalReward} $HASH reward for ${memberCount} members.`,
+        );
+      }
+
+      const goal = await this.goalModel.create({
+        poolId,
+        createdBy: leaderId,
+        targetEff,
+        deadline,
+        rewardHASHPerMember,
+      });
+
+      this.logger.log(
+        `🎯 (createGoal) Leader ${leaderId} set a ${targetEff} EFF goal for pool ${poolId} (${rewardHASHPerMember} $HASH per member).`,
+      );
+
+      return new ApiResponse(200, `(createGoal) Efficiency goal created.`, {
+        goalId: goal._id,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(createGoal) Error creating efficiency goal: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches a pool's efficiency goals, newest first.
+   */
+  async fetchGoals(
+    poolId: Types.ObjectId,
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<ApiResponse<{ goals: PoolEfficiencyGoal[] }>> {
+    try {
+      const goals = await this.goalModel
+        .find({ poolId })
+        .sort({ createdAt: -1 })
+        .skip((page - 1) * limit)
+        .limit(limit)
+        .lean();
+
+      return new ApiResponse(200, `(fetchGoals) Efficiency goals fetched.`, {
+        goals,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchGoals) Error fetching efficiency goals: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Checks all active efficiency goals:
+   * - Goals whose pool's `estimatedEff` reached `targetEff` are achieved, and every member is rewarded from the pool treasury.
+   * - Goals whose deadline passed expire.
+   */
+  async checkGoals(): Promise<void> {
+    const startTime = performance.now();
+
+    const activeGoals = await this.goalModel
+      .find({ status: PoolEfficiencyGoalStatus.ACTIVE })
+      .lean();
+
+    let achievedCount = 0;
+    let expiredCount = 0;
+
+    for (const goal of activeGoals) {
+      try {
+        const pool = await this.poolModel
+          .findById(goal.poolId, { estimatedEff: 1 })
+          .lean();
+
+        if (goal.deadline <= new Date()) {
+          const expired = await this.goalModel.updateOne(
+            { _id: goal._id, status: PoolEfficiencyGoalStatus.ACTIVE },
+            { $set: { status: PoolEfficiencyGoalStatus.EXPIRED } },
+          );
+          expiredCount += expired.modifiedCount;
+        } else if (pool && pool.estimatedEff >= goal.targetEff) {
+          if (await this.rewardGoal(goal)) {
+            achievedCount++;
+          }
+        }
+      } catch (err: any) {
+        this.logger.error(
+          `❌ (checkGoals) Error checking efficiency goal ${goal._id}: ${err.message}`,
+        );
+      }
+    }
+
+    this.logger.log(
+      `✅ (checkGoals) Achieved ${achievedCount} and expired ${expiredCount} pool efficiency goals in ${(performance.now() - startTime).toFixed(2)}ms.`,
+    );
+  }
+
+  /**
+   * Marks a goal as achieved and pays out `rewardHASHPerMember` from the pool treasury to every current member of the pool.
+   *
+   * The goal is marked as unfunded instead if the treasury can no longer cover the rewards.
+   * Returns whether the members were rewarded.
+   */
+  private async rewardGoal(
+    goal: Pick<
+      PoolEfficiencyGoal,
+      '_id' | 'poolId' | 'targetEff' | 'rewardHASHPerMember'
+    >,
+  ): Promise<boolean> {
+    // Claim the goal first so it can't be paid out twice
+    const claimed = await this.goalModel.updateOne(
+      { _id: goal._id, status: PoolEfficiencyGoalStatus.ACTIVE },
+      {
+        $set: {
+          status: PoolEfficiencyGoalStatus.ACHIEVED,
+          achieved: true,
+          achievedAt: new Date(),
+        },
+      },
+    );
+
+    if (claimed.modifiedCount === 0) return false;
+
+    const members = await this.poolOperatorModel
+      .find({ pool: goal.poolId }, { operator: 1 })
+      .lean();
+    const totalReward = goal.rewardHASHPerMember * members.length;
+
+    // Only deduct the rewards if the treasury can still cover them
+    const pool = await this.poolModel.findOneAndUpdate(
+      { _id: goal.poolId, treasuryHASH: { $gte: totalReward } },
+      { $inc: { treasuryHASH: -totalReward } },
+      { projection: { _id: 1 } },
+    );
+
+    if (!pool) {
+      await this.goalModel.updateOne(
+        { _id: goal._id },
+        {
+          $set: {
+            status: PoolEfficiencyGoalStatus.UNFUNDED,
+            achieved: false,
+          },
+        },
+      );
+
+      this.logger.warn(
+        `⚠️ (rewardGoal) Pool ${goal.poolId} reached its ${goal.targetEff} EFF goal ${goal._id}, but its treasury can't cover the ${totalReward} $HASH reward.`,
+      );
+      return false;
+    }
+
+    let rewardedMemberCount = 0;
+    for (const member of members) {
+      const result = await this.operatorService.addHASH(
+        member.operator as Types.ObjectId,
+        goal.rewardHASHPerMember,
+        HashTransactionCategory.POOL_EFFICIENCY_GOAL_REWARD,
+        `Pool efficiency goal ${goal._id} reward`,
+        goal._id,
+        'pool_efficiency_goal',
+      );
+
+      if (result.success) {
+        rewardedMemberCount++;
+      } else {
+        this.logger.error(
+          `❌ (rewardGoal) Failed to credit ${goal.rewardHASHPerMember} $HASH to operator ${member.operator} for goal ${goal._id}: ${result.error}`,
+        );
+      }
+    }
+
+    // Put the rewards that couldn't be credited back into the treasury
+    const uncreditedReward =
+      goal.rewardHASHPerMember * (members.length - rewardedMemberCount);
+    if (uncreditedReward > 0) {
+      await this.poolModel.updateOne(
+        { _id: goal.poolId },
+        { $inc: { treasuryHASH: uncreditedReward } },
+      );
+    }
+
+    await this.goalModel.updateOne(
+      { _id: goal._id },
+      { $set: { rewardedMemberCount } },
+    );
+
+    this.logger.log(
+      `🏁 (rewardGoal) Pool ${goal.poolId} achieved efficiency goal ${goal._id}. Rewarded ${rewardedMemberCount} members with ${goal.rewardHASHPerMember} $HASH each.`,
+    );
+
+    return true;
+  }
+}
@@ -0,0 +1,250 @@
+import {
+  Body,
+  Controller,
+  Get,
+  Param,
+  Post,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import {
+  InviteJoinRequestVotersDto,
+  PoolJoinRequestDecisionDto,
+} from 'src/common/dto/pools/pool-join-request.dto';
+import { PoolJoinRequestService } from './pool-join-request.service';
+
+@ApiTags('Pools')
+@Controller('pools')
+export class PoolJoinRequestController {
+  constructor(
+    private readonly poolJoinRequestService: PoolJoinRequestService,
+  ) {}
+
+  @ApiOperation({
+    summary: 'Request to join a pool',
+    description:
+      'Creates a request to join a pool that requires approval. The request is approved by the pool leader or by pool members the leader invited to vote on it.',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully created the join request',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      "Bad Request - Pool doesn't require approval, operator is already in a pool or already has a pending request",
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/join-requests')
+  async createJoinRequest(@Param('id') poolId: string, @Request() req) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolJoinRequestService.createJoinRequest(
+      operatorId,
+      new Types.ObjectId(poolId),
+    );
+  }
+
+  @ApiOperation({
+    summary: "Fetch a pool's pending join requests",
+    description: "Fetches the pool's pending join requests. Leader only.",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully fetched the join requests',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only the pool leader can fetch join requests',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id/join-requests')
+  async fetchJoinRequests(@Param('id') poolId: string, @Request() req) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolJoinRequestService.fetchJoinRequests(
+      operatorId,
+      new Types.ObjectId(poolId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Invite pool members to vote on a join request',
+    description: `Invites pool members to vote on a pending join request. Leader only. The request is approved without the leader once ${GAME_CONSTANTS.POOLS.JOIN_REQUEST_APPROVAL_QUORUM} invited members approved it.`,
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiParam({
+    name: 'requestId',
+    description: 'The ID of the join request',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully invited the voters',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Only pool members can be invited to vote',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only the pool leader can invite voters',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool or pending join request not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/join-requests/:requestId/invite-voters')
+  async inviteVoters(
+    @Param('id') poolId: string,
+    @Param('requestId') requestId: string,
+    @Body() body: InviteJoinRequestVotersDto,
+    @Request() req,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolJoinRequestService.inviteVoters(
+      operatorId,
+      new Types.ObjectId(poolId),
+      new Types.ObjectId(requestId),
+      body.operatorIds.map((id) => new Types.ObjectId(id)),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Vote on a join request',
+    description: `Votes to approve or reject a pending join request. Only pool members invited by the leader can vote, and each member can only vote once. The request is approved once it has ${GAME_CONSTANTS.POOLS.JOIN_REQUEST_APPROVAL_QUORUM} approvals.`,
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiParam({
+    name: 'requestId',
+    description: 'The ID of the join request',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully recorded the vote',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Join request is no longer open for voting or operator already voted',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only invited pool members can vote',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Join request not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/join-requests/:requestId/vote')
+  async voteOnJoinRequest(
+    @Param('id') poolId: string,
+    @Param('requestId') requestId: string,
+    @Body() body: PoolJoinRequestDecisionDto,
+    @Request() req,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolJoinRequestService.voteOnJoinRequest(
+      operatorId,
+      new Types.ObjectId(poolId),
+      new Types.ObjectId(requestId),
+      body.approve,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Approve or reject a join request',
+    description:
+      'Approves or rejects a pending join request directly. Leader only. Approving adds the operator to the pool.',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiParam({
+    name: 'requestId',
+    description: 'The ID of the join request',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully reviewed the join request',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Join request was already resolved or the operator can no longer join the pool',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only the pool leader can review join requests',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool or pending join request not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/join-requests/:requestId/review')
+  async reviewJoinRequest(
+    @Param('id') poolId: string,
+    @Param('requestId') requestId: string,
+    @Body() body: PoolJoinRequestDecisionDto,
+    @Request() req,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolJoinRequestService.reviewJoinRequest(
+      operatorId,
+      new Types.ObjectId(poolId),
+      new Types.ObjectId(requestId),
+      body.approve,
+    );
+  }
+}
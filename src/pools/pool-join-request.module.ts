@@ -0,0 +1,34 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { Pool, PoolSchema } from './schemas/pool.schema';
+import {
+  PoolOperator,
+  PoolOperatorSchema,
+} from './schemas/pool-operator.schema';
+import {
+  PoolJoinRequest,
+  PoolJoinRequestSchema,
+} from './schemas/pool-join-request.schema';
+import {
+  PoolJoinRequestVote,
+  PoolJoinRequestVoteSchema,
+} from './schemas/pool-join-request-vote.schema';
+import { PoolModule } from './pool.module';
+import { PoolJoinRequestService } from './pool-join-request.service';
+import { PoolJoinRequestController } from './pool-join-request.controller';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: Pool.name, schema: PoolSchema },
+      { name: PoolOperator.name, schema: PoolOperatorSchema },
+      { name: PoolJoinRequest.name, schema: PoolJoinRequestSchema },
+      { name: PoolJoinRequestVote.name, schema: PoolJoinRequestVoteSchema },
+    ]),
+    PoolModule,
+  ],
+  controllers: [PoolJoinRequestController], // Expose API endpoints
+  providers: [PoolJoinRequestService], // Business logic for pool join requests
+  exports: [PoolJoinRequestService],
+})
+export class PoolJoinRequestModule {}
@@ -0,0 +1,463 @@
+import { Injectable, InternalServerErrorException } from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { Pool } from './schemas/pool.schema';
+import { PoolOperator } from './schemas/pool-operator.schema';
+import {
+  PoolJoinRequest,
+  PoolJoinRequestStatus,
+} from './schemas/pool-join-request.schema';
+import { PoolJoinRequestVote } from './schemas/pool-join-request-vote.schema';
+import { PoolService } from './pool.service';
+import { ApiResponse } from 'src/common/dto/response.dto';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+@Injectable()
+export class PoolJoinRequestService {
+  constructor(
+    @InjectModel(Pool.name) private poolModel: Model<Pool>,
+    @InjectModel(PoolOperator.name)
+    private poolOperatorModel: Model<PoolOperator>,
+    @InjectModel(PoolJoinRequest.name)
+    private joinRequestModel: Model<PoolJoinRequest>,
+    @InjectModel(PoolJoinRequestVote.name)
+    private joinRequestVoteModel: Model<PoolJoinRequestVote>,
+    private readonly poolService: PoolService,
+  ) {}
+
+  /**
+   * Creates a request for an operator to join a pool that requires approval.
+   *
+   * An operator can only have one pending request per pool, and can't request to join while already in a pool.
+   */
+  async createJoinRequest(
+    operatorId: Types.ObjectId,
+    poolId: Types.ObjectId,
+  ): Promise<ApiResponse<{ requestId: Types.ObjectId }>> {
+    try {
+      const [operatorInPool, pool] = await Promise.all([
+        this.poolOperatorModel.exists({ operator: operatorId }),
+        this.poolModel.findById(poolId, { requiresApproval: 1 }).lean(),
+      ]);
+
+      if (!pool) {
+        return new ApiResponse(404, `(createJoinRequest) Pool not found.`);
+      }
+
+      if (!pool.requiresApproval) {
+        return new ApiResponse(
+          400,
+          `(createJoinRequest) Pool doesn't require approval. Join it directly instead.`,
+        );
+      }
+
+      if (operatorInPool) {
+        return new ApiResponse(
+          400,
+          `(createJoinRequest) Operator is already in a pool.`,
+        );
+      }
+
+      const request = await this.joinRequestModel.create({
+        poolId,
+        operatorId,
+      });
+
+      return new ApiResponse(200, `(createJoinRequest) Join request created.`, {
+        requestId: request._id,
+      });
+    } catch (err: any) {
+      // Unique index on pending requests per operator and pool
+      if (err.code === 11000) {
+        return new ApiResponse(
+          400,
+          `(createJoinRequest) Operator already has a pending join request for this pool.`,
+        );
+      }
+
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(createJoinRequest) Error creating join request: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches a pool's pending join requests. Leader only.
+   */
+  async fetchJoinRequests(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+  ): Promise<ApiResponse<{ requests: PoolJoinRequest[] }>> {
+    try {
+      const leaderCheck = await this.checkPoolLeader(
+        leaderId,
+        poolId,
+        'fetchJoinRequests',
+      );
+      if (leaderCheck) return leaderCheck;
+
+      const requests = await this.joinRequestModel
+        .find({ poolId, status: PoolJoinRequestStatus.PENDING })
+        .sort({ createdAt: 1 })
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(fetchJoinRequests) Join requests fetched.`,
+        { requests },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchJoinRequests) Error fetching join requests: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Invites pool members to vote on a pending join request. Leader only.
+   *
+   * Once `JOIN_REQUEST_APPROVAL_QUORUM` invited members approved the request, it's approved without the leader.
+   */
+  async inviteVoters(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    requestId: Types.ObjectId,
+    operatorIds: Types.ObjectId[],
+  ): Promise<ApiResponse<{ invitedVoterIds: Types.ObjectId[] }>> {
+    try {
+      const leaderCheck = await this.checkPoolLeader(
+        leaderId,
+        poolId,
+        'inviteVoters',
+      );
+      if (leaderCheck) return leaderCheck;
+
+      const memberCount = await this.poolOperatorModel.countDocuments({
+        pool: poolId,
+        operator: { $in: operatorIds },
+      });
+
+      if (memberCount !== new Set(operatorIds.map(String)).size) {
+        return new ApiResponse(
+          400,
+          `(inviteVoters) Only pool members can be invited to vote.`,
+        );
+      }
+
+      const request = await this.joinRequestModel
+        .findOneAndUpdate(
+          { _id: requestId, poolId, status: PoolJoinRequestStatus.PENDING },
+          { $addToSet: { invitedVoterIds: { $each: operatorIds } } },
+          { new: true, projection: { invitedVoterIds: 1 } },
+        )
+        .lean();
+
+      if (!request) {
+        return new ApiResponse(
+          404,
+          `(inviteVoters) Pending join request not found.`,
+        );
+      }
+
+      return new ApiResponse(200, `(inviteVoters) Voters invited.`, {
+        invitedVoterIds: request.invitedVoterIds,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(inviteVoters) Error inviting voters: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Records an invited pool member's vote on a pending join request. Each member can only vote once.
+   *
+   * The request is approved, and the operator joins the pool, once it has `JOIN_REQUEST_APPROVAL_QUORUM` approvals.
+   */
+  async voteOnJoinRequest(
+    operatorId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    requestId: Types.ObjectId,
+    approve: boolean,
+  ): Promise<
+    ApiResponse<{ approvals: number; rejections: number; approved: boolean }>
+  > {
+    try {
+      const [isMember, request] = await Promise.all([
+        this.poolOperatorModel.exists({ operator: operatorId, pool: poolId }),
+        this.joinRequestModel
+          .findOne(
+            { _id: requestId, poolId },
+            { status: 1, invitedVoterIds: 1 },
+          )
+          .lean(),
+      ]);
+
+      if (!request) {
+        return new ApiResponse(
+          404,
+          `(voteOnJoinRequest) Join request not found.`,
+        );
+      }
+
+      if (
+        !isMember ||
+        !request.invitedVoterIds.some((id) => id.equals(operatorId))
+      ) {
+        return new ApiResponse(
+          403,
+          `(voteOnJoinRequest) Only pool members invited by the leader can vote on this join request.`,
+        );
+      }
+
+      if (request.status !== PoolJoinRequestStatus.PENDING) {
+        return new ApiResponse(
+          400,
+          `(voteOnJoinRequest) Join request is no longer open for voting.`,
+        );
+      }
+
+      // Unique index on (requestId, operatorId) prevents double votes
+      try {
+        await this.joinRequestVoteModel.create({
+          requestId,
+          operatorId,
+          approve,
+        });
+      } catch (err: any) {
+        if (err.code === 11000) {
+          return new ApiResponse(
+            400,
+            `(voteOnJoinRequest) Operator already voted on this join request.`,
+          );
+        }
+        throw err;
+      }
+
+      const updatedRequest = await this.joinRequestModel
+        .findOneAndUpdate(
+          { _id: requestId, status: PoolJoinRequestStatus.PENDING },
+          { $inc: approve ? { approvals: 1 } : { rejections: 1 } },
+          { new: true, projection: { approvals: 1, rejections: 1 } },
+        )
+        .lean();
+
+      if (!updatedRequest) {
+        // Resolved by the leader or another vote in the meantime
+        await this.joinRequestVoteModel.deleteOne({ requestId, operatorId });
+
+        return new ApiResponse(
+          400,
+          `(voteOnJoinRequest) Join request is no longer open for voting.`,
+        );
+      }
+
+      let approved = false;
+
+      if (
+        updatedRequest.approvals >=
+        GAME_CONSTANTS.POOLS.JOIN_REQUEST_APPROVAL_QUORUM
+      ) {
+        const approval = await this.approveJoinRequest(requestId);
+        approved = approval.status === 200;
+      }
+
+      return new ApiResponse(200, `(voteOnJoinRequest) Vote recorded.`, {
+        approvals: updatedRequest.approvals,
+        rejections: updatedRequest.rejections,
+        approved,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(voteOnJoinRequest) Error voting on join request: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Approves or rejects a pending join request directly. Leader only.
+   */
+  async reviewJoinRequest(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    requestId: Types.ObjectId,
+    approve: boolean,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const leaderCheck = await this.checkPoolLeader(
+        leaderId,
+        poolId,
+        'reviewJoinRequest',
+      );
+      if (leaderCheck) return leaderCheck;
+
+      const requestExists = await this.joinRequestModel.exists({
+        _id: requestId,
+        poolId,
+        status: PoolJoinRequestStatus.PENDING,
+      });
+
+      if (!requestExists) {
+        return new ApiResponse(
+          404,
+          `(reviewJoinRequest) Pending join request not found.`,
+        );
+      }
+
+      if (approve) {
+        return this.approveJoinRequest(requestId);
+      }
+
+      const rejected = await this.joinRequestModel.updateOne(
+        { _id: requestId, status: PoolJoinRequestStatus.PENDING },
+        {
+          $set: {
+            status: PoolJoinRequestStatus.REJECTED,
+            resolvedAt: new Date(),
+          },
+        },
+      );
+
+      if (rejected.modifiedCount === 0) {
+        return new ApiResponse(
+          400,
+          `(reviewJoinRequest) Join request was already resolved.`,
+        );
+      }
+
+      return new ApiResponse(200, `(reviewJoinRequest) Join request rejected.`);
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(reviewJoinRequest) Error reviewing join request: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Approves a pending join request and adds its operator to the pool.
+   *
+   * If the operator can't join, the `joinPool` response is returned. The request is only rejected if the operator
+   * can never join through it (they're already in a pool or the pool no longer exists); otherwise (e.g. the pool
+   * is full for now or the operator is on join cooldown), it goes back to pending so it can be approved again later.
+   */
+  private async approveJoinRequest(
+    requestId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    // Claim the request first so it can't be approved twice
+    const request = await this.joinRequestModel
+      .findOneAndUpdate(
+        { _id: requestId, status: PoolJoinRequestStatus.PENDING },
+        {
+          $set: {
+            status: PoolJoinRequestStatus.APPROVED,
+            resolvedAt: new Date(),
+          },
+        },
+        { projection: { poolId: 1, operatorId: 1 } },
+      )
+      .lean();
+
+    if (!request) {
+      return new ApiResponse(
+        400,
+        `(approveJoinRequest) Join request was already resolved.`,
+      );
+    }
+
+    let joinResponse: ApiResponse<null>;
+    try {
+      joinResponse = await this.poolService.joinPool(
+        request.operatorId,
+        request.poolId,
+        true,
+      );
+    } catch (err: any) {
+      // Undo the claim so the request can be approved again
+      await this.reopenJoinRequest(requestId);
+      throw err;
+    }
+
+    if (joinResponse.status !== 200) {
+      const [operatorInPool, poolExists] = await Promise.all([
+        this.poolOperatorModel.exists({ operator: request.operatorId }),
+        this.poolModel.exists({ _id: request.poolId }),
+      ]);
+
+      if (operatorInPool || !poolExists) {
+        await this.joinRequestModel.updateOne(
+          { _id: requestId, status: PoolJoinRequestStatus.APPROVED },
+          { $set: { status: PoolJoinRequestStatus.REJECTED } },
+        );
+      } else {
+        await this.reopenJoinRequest(requestId);
+      }
+
+      return joinResponse;
+    }
+
+    return new ApiResponse(
+      200,
+      `(approveJoinRequest) Join request approved. Operator joined the pool.`,
+    );
+  }
+
+  /**
+   * Puts an approved join request back to pending, so it can be approved again once its operator can join.
+   *
+   * If the operator sent another pending request to the same pool in the meantime, this one is rejected instead.
+   */
+  private async reopenJoinRequest(requestId: Types.ObjectId): Promise<void> {
+    try {
+      await this.joinRequestModel.updateOne(
+        { _id: requestId, status: PoolJoinRequestStatus.APPROVED },
+        { $set: { status: PoolJoinRequestStatus.PENDING, resolvedAt: null } },
+      );
+    } catch (err: any) {
+      if (err.code !== 11000) throw err;
+
+      await this.joinRequestModel.updateOne(
+        { _id: requestId, status: PoolJoinRequestStatus.APPROVED },
+        { $set: { status: PoolJoinRequestStatus.REJECTED } },
+      );
+    }
+  }
+
+  /**
+   * Returns an error response if the pool doesn't exist or `leaderId` isn't its leader, otherwise `null`.
+   */
+  private async checkPoolLeader(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    method: string,
+  ): Promise<ApiResponse<null> | null> {
+    const pool = await this.poolModel.findById(poolId, { leaderId: 1 }).lean();
+
+    if (!pool) {
+      return new ApiResponse(404, `(${method}) Pool not found.`);
+    }
+
+    if (!pool.leaderId || !pool.leaderId.equals(leaderId)) {
+      return new ApiResponse(
+        403,
+        `(${method}) Only the pool leader can manage join requests.`,
+      );
+    }
+
+    return null;
+  }
+}
@@ -0,0 +1,134 @@
+import {
+  Body,
+  Controller,
+  Get,
+  Param,
+  Post,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { CreatePoolMergeRequestDto } from 'src/common/dto/pools/pool-merge.dto';
+import { PoolMergeService } from './pool-merge.service';
+
+@ApiTags('Pools')
+@Controller('pools')
+export class PoolMergeController {
+  constructor(private readonly poolMergeService: PoolMergeService) {}
+
+  @ApiOperation({
+    summary: 'Request a pool merge',
+    description:
+      "Requests to merge the pool with another pool. Leader only. The merge happens once the other pool's leader approves it: the smaller pool's members and treasury move to the larger pool, and the smaller pool is dissolved.",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool requesting the merge',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully created the merge request',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Merging a pool with itself, or a merge between the pools is already pending',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only the pool leader can request a merge',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/merge-requests')
+  async createMergeRequest(
+    @Param('id') poolId: string,
+    @Body() body: CreatePoolMergeRequestDto,
+    @Request() req,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolMergeService.createMergeRequest(
+      operatorId,
+      new Types.ObjectId(poolId),
+      new Types.ObjectId(body.targetPoolId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get pending pool merge requests',
+    description:
+      'Fetches the pending merge requests the pool is part of, newest first',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved the merge requests',
+  })
+  @Get(':id/merge-requests')
+  async fetchPendingMergeRequests(@Param('id') poolId: string) {
+    return this.poolMergeService.fetchPendingMergeRequests(
+      new Types.ObjectId(poolId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Approve a pool merge request',
+    description:
+      "Approves a merge request on behalf of one of the two pools' leaders. Once both leaders approved, the smaller pool is merged into the larger one, as long as the merged member count fits within the larger pool's operator limit.",
+  })
+  @ApiParam({
+    name: 'requestId',
+    description: 'The ID of the merge request',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully approved the merge request',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Merge request no longer pending, or the merged pool would exceed its operator limit',
+  })
+  @ApiResponse({
+    status: 403,
+    description:
+      'Forbidden - Only the leaders of the merging pools can approve the merge',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Merge request or pool not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post('merge-requests/:requestId/approve')
+  async approveMergeRequest(
+    @Param('requestId') requestId: string,
+    @Request() req,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolMergeService.approveMergeRequest(
+      operatorId,
+      new Types.ObjectId(requestId),
+    );
+  }
+}
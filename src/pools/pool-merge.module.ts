@@ -0,0 +1,31 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { Pool, PoolSchema } from './schemas/pool.schema';
+import {
+  PoolOperator,
+  PoolOperatorSchema,
+} from './schemas/pool-operator.schema';
+import { PoolLinks, PoolLinksSchema } from './schemas/pool-links.schema';
+import {
+  PoolMergeRequest,
+  PoolMergeRequestSchema,
+} from './schemas/pool-merge-request.schema';
+import { PoolModule } from './pool.module';
+import { PoolMergeService } from './pool-merge.service';
+import { PoolMergeController } from './pool-merge.controller';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: Pool.name, schema: PoolSchema },
+      { name: PoolOperator.name, schema: PoolOperatorSchema },
+      { name: PoolLinks.name, schema: PoolLinksSchema },
+      { name: PoolMergeRequest.name, schema: PoolMergeRequestSchema },
+    ]),
+    PoolModule,
+  ],
+  controllers: [PoolMergeController], // Expose API endpoints
+  providers: [PoolMergeService],
+  exports: [PoolMergeService],
+})
+export class PoolMergeModule {}
@@ -0,0 +1,333 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { Pool } from './schemas/pool.schema';
+import { PoolOperator } from './schemas/pool-operator.schema';
+import { PoolLinks } from './schemas/pool-links.schema';
+import {
+  PoolMergeRequest,
+  PoolMergeRequestStatus,
+} from './schemas/pool-merge-request.schema';
+import { PoolService } from './pool.service';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class PoolMergeService {
+  private readonly logger = new Logger(PoolMergeService.name);
+
+  constructor(
+    @InjectModel(Pool.name) private poolModel: Model<Pool>,
+    @InjectModel(PoolOperator.name)
+    private poolOperatorModel: Model<PoolOperator>,
+    @InjectModel(PoolLinks.name) private poolLinksModel: Model<PoolLinks>,
+    @InjectModel(PoolMergeRequest.name)
+    private mergeRequestModel: Model<PoolMergeRequest>,
+    private readonly poolService: PoolService,
+  ) {}
+
+  /**
+   * Requests to merge a pool with another pool. Leader only.
+   *
+   * The request counts as approved by the requesting leader; the merge happens once the target pool's leader approves it too.
+   */
+  async createMergeRequest(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    targetPoolId: Types.ObjectId,
+  ): Promise<ApiResponse<{ requestId: Types.ObjectId } | null>> {
+    try {
+      if (poolId.equals(targetPoolId)) {
+        return new ApiResponse(
+          400,
+          `(createMergeRequest) A pool can't be merged with itself.`,
+        );
+      }
+
+      const [pool, targetPool] = await Promise.all([
+        this.poolModel.findById(poolId, { leaderId: 1 }).lean(),
+        this.poolModel.findById(targetPoolId, { _id: 1 }).lean(),
+      ]);
+
+      if (!pool || !targetPool) {
+        return new ApiResponse(404, `(createMergeRequest) Pool not found.`);
+      }
+
+      if (!pool.leaderId || !pool.leaderId.equals(leaderId)) {
+        return new ApiResponse(
+          403,
+          `(createMergeRequest) Only the pool leader can request a merge.`,
+        );
+      }
+
+      const pendingRequest = await this.mergeRequestModel.exists({
+        status: PoolMergeRequestStatus.PENDING,
+        $or: [
+          { initiatingPoolId: poolId, targetPoolId },
+          { initiatingPoolId: targetPoolId, targetPoolId: poolId },
+        ],
+      });
+
+      if (pendingRequest) {
+        return new ApiResponse(
+          400,
+          `(createMergeRequest) A merge between these pools is already pending.`,
+        );
+      }
+
+      const request = await this.mergeRequestModel.create({
+        initiatingPoolId: poolId,
+        targetPoolId,
+      });
+
+      this.logger.log(
+        `🤝 (createMergeRequest) Leader ${leaderId} requested to merge pool ${poolId} with pool ${targetPoolId}.`,
+      );
+
+      return new ApiResponse(
+        200,
+        `(createMergeRequest) Merge request created.`,
+        { requestId: request._id },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(createMergeRequest) Error creating merge request: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches the pending merge requests a pool is part of, newest first.
+   */
+  async fetchPendingMergeRequests(
+    poolId: Types.ObjectId,
+  ): Promise<ApiResponse<{ requests: PoolMergeRequest[] }>> {
+    try {
+      const requests = await this.mergeRequestModel
+        .find({
+          status: PoolMergeRequestStatus.PENDING,
+          $or: [{ initiatingPoolId: poolId }, { targetPoolId: poolId }],
+        })
+        .sort({ createdAt: -1 })
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(fetchPendingMergeRequests) Merge requests fetched.`,
+        { requests: requests as PoolMergeRequest[] },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchPendingMergeRequests) Error fetching merge requests: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Approves a merge request on behalf of the leader of one of its pools.
+   *
+   * Once both leaders approved, the smaller pool (by member count; ties merge into the target pool)
+   * is merged into the larger one, as long as the merged member count fits within the larger pool's `maxOperators`.
+   */
+  async approveMergeRequest(
+    leaderId: Types.ObjectId,
+    requestId: Types.ObjectId,
+  ): Promise<
+    ApiResponse<{
+      merged: boolean;
+      mergeToPoolId: Types.ObjectId | null;
+    } | null>
+  > {
+    try {
+      const request = await this.mergeRequestModel.findById(requestId).lean();
+
+      if (!request) {
+        return new ApiResponse(
+          404,
+          `(approveMergeRequest) Merge request not found.`,
+        );
+      }
+
+      if (request.status !== PoolMergeRequestStatus.PENDING) {
+        return new ApiResponse(
+          400,
+          `(approveMergeRequest) Merge request is no longer pending.`,
+        );
+      }
+
+      const [initiatingPool, targetPool] = await Promise.all([
+        this.poolModel
+          .findById(request.initiatingPoolId, {
+            leaderId: 1,
+            maxOperators: 1,
+          })
+          .lean(),
+        this.poolModel
+          .findById(request.targetPoolId, { leaderId: 1, maxOperators: 1 })
+          .lean(),
+      ]);
+
+      if (!initiatingPool || !targetPool) {
+        return new ApiResponse(404, `(approveMergeRequest) Pool not found.`);
+      }
+
+      let approvalField: 'initiatorApproved' | 'targetApproved';
+      if (initiatingPool.leaderId?.equals(leaderId)) {
+        approvalField = 'initiatorApproved';
+      } else if (targetPool.leaderId?.equals(leaderId)) {
+        approvalField = 'targetApproved';
+      } else {
+        return new ApiResponse(
+          403,
+          `(approveMergeRequest) Only the leaders of the merging pools can approve the merge.`,
+        );
+      }
+
+      const approved = await this.mergeRequestModel
+        .findOneAndUpdate(
+          { _id: requestId, status: PoolMergeRequestStatus.PENDING },
+          { $set: { [approvalField]: true } },
+          { new: true },
+        )
+        .lean();
+
+      if (!approved) {
+        return new ApiResponse(
+          400,
+          `(approveMergeRequest) Merge request is no longer pending.`,
+        );
+      }
+
+      if (!approved.initiatorApproved || !approved.targetApproved) {
+        return new ApiResponse(
+          200,
+          `(approveMergeRequest) Merge approved. Waiting for the other pool's leader.`,
+          { merged: false, mergeToPoolId: null },
+        );
+      }
+
+      const [initiatingCount, targetCount] = await Promise.all([
+        this.poolOperatorModel.countDocuments({ pool: initiatingPool._id }),
+        this.poolOperatorModel.countDocuments({ pool: targetPool._id }),
+      ]);
+
+      const [largerPool, smallerPool] =
+        initiatingCount > targetCount
+          ? [initiatingPool, targetPool]
+          : [targetPool, initiatingPool];
+      const mergedCount = initiatingCount + targetCount;
+
+      if (
+        largerPool.maxOperators != null &&
+        mergedCount > largerPool.maxOperators
+      ) {
+        return new ApiResponse(
+          400,
+          `(approveMergeRequest) The merged pool would have ${mergedCount} operators, above the limit of ${largerPool.maxOperators}.`,
+        );
+      }
+
+      // Claim the request first so the pools can't be merged twice
+      const claimed = await this.mergeRequestModel.updateOne(
+        { _id: requestId, status: PoolMergeRequestStatus.PENDING },
+        {
+          $set: {
+            status: PoolMergeRequestStatus.MERGED,
+            mergeToPoolId: largerPool._id,
+          },
+        },
+      );
+
+      if (claimed.modifiedCount === 0) {
+        return new ApiResponse(
+          400,
+          `(approveMergeRequest) Merge request is no longer pending.`,
+        );
+      }
+
+      await this.mergePools(smallerPool._id, largerPool._id);
+
+      return new ApiResponse(200, `(approveMergeRequest) Pools merged.`, {
+        merged: true,
+        mergeToPoolId: largerPool._id,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(approveMergeRequest) Error approving merge request: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Moves all members, treasury $HASH and lifetime rewards of `fromPoolId` into `toPoolId`, then dissolves `fromPoolId`.
+   */
+  private async mergePools(
+    fromPoolId: Types.ObjectId,
+    toPoolId: Types.ObjectId,
+  ): Promise<void> {
+    // Delete the merged pool first, so nothing can be credited to its treasury or join it while its members are moved
+    const fromPool = await this.poolModel
+      .findOneAndDelete(
+        { _id: fromPoolId },
+        { projection: { treasuryHASH: 1, totalRewards: 1 } },
+      )
+      .lean();
+
+    const movedMembers = await this.poolOperatorModel
+      .find({ pool: fromPoolId }, { operator: 1 })
+      .lean();
+    const movedIds = movedMembers.map(
+      (member) => member.operator as Types.ObjectId,
+    );
+
+    const moved = await this.poolOperatorModel.updateMany(
+      { pool: fromPoolId },
+      { $set: { pool: toPoolId } },
+    );
+
+    await this.poolModel.updateOne(
+      { _id: toPoolId },
+      {
+        $inc: {
+          treasuryHASH: fromPool?.treasuryHASH || 0,
+          totalRewards: fromPool?.totalRewards || 0,
+          operatorCount: moved.modifiedCount,
+        },
+      },
+    );
+
+    // Clean up the rest of the merged pool
+    await Promise.all([
+      this.poolLinksModel.deleteOne({ poolId: fromPoolId }),
+      this.poolModel.updateMany(
+        { siblingPoolId: fromPoolId },
+        { $set: { siblingPoolId: null } },
+      ),
+      this.mergeRequestModel.deleteMany({
+        status: PoolMergeRequestStatus.PENDING,
+        $or: [{ initiatingPoolId: fromPoolId }, { targetPoolId: fromPoolId }],
+      }),
+    ]);
+
+    await this.poolService.recordMembershipEnd(movedIds, fromPoolId);
+    await this.poolService.recordMembershipStart(movedIds, toPoolId);
+    await this.poolService.invalidatePoolSynergyMultipliers();
+    await this.poolService.updatePoolEstimatedEff(toPoolId);
+
+    this.logger.log(
+      `🔗 (mergePools) Merged pool ${fromPoolId} into pool ${toPoolId} with ${moved.modifiedCount} operators.`,
+    );
+  }
+}
@@ -1,9 +1,26 @@
-import { Body, Controller, Delete, Param, Post } from '@nestjs/common';
-import { ApiOperation, ApiResponse, ApiTags, ApiParam } from '@nestjs/swagger';
+import {
+  Body,
+  Controller,
+  Delete,
+  ForbiddenException,
+  Param,
+  Post,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiResponse,
+  ApiTags,
+  ApiParam,
+} from '@nestjs/swagger';
 import { ApiResponse as AppApiResponse } from 'src/common/dto/response.dto';
 import { PoolOperatorService } from './pool-operator.service';
 import { Types } from 'mongoose';
 import { CreatePoolOperatorDto } from 'src/common/dto/pools/pool-operator.dto';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { RateLimit } from 'src/common/decorators/rate-limit.decorator';
 
 @ApiTags('Pool Operators')
 @Controller('pool-operators') // Base route: `/pool-controllers`
@@ -12,7 +29,8 @@ export class PoolOperatorController {
 
   @ApiOperation({
     summary: 'Create a pool operator',
-    description: 'Creates a new pool operator by linking an operator to a pool',
+    description:
+      'Creates a new pool operator by linking the authenticated operator to a pool. Same checks as joining a pool via `POST /pools/:id/join`',
   })
   @ApiResponse({
     status: 200,
@@ -20,16 +38,28 @@ export class PoolOperatorController {
   })
   @ApiResponse({
     status: 400,
-    description: 'Bad request - Operator is already in a pool or pool is full',
+    description:
+      'Bad request - Operator is already in a pool, pool is full or operator is on join cooldown',
+  })
+  @ApiResponse({
+    status: 403,
+    description:
+      "Forbidden - Cannot add another operator to a pool, or the pool's prerequisites aren't met",
   })
   @ApiResponse({
     status: 404,
     description: 'Pool not found',
   })
+  @ApiBearerAuth()
+  @RateLimit(5)
+  @UseGuards(JwtAuthGuard)
   @Post('/create')
   async createPoolOperator(
+    @Request() req,
     @Body() createPoolOperatorDto: CreatePoolOperatorDto,
   ): Promise<AppApiResponse<null>> {
+    this.assertOwnOperator(req, createPoolOperatorDto.operatorId);
+
     return this.poolOperatorService.createPoolOperator(
       new Types.ObjectId(createPoolOperatorDto.operatorId),
       new Types.ObjectId(createPoolOperatorDto.poolId),
@@ -38,7 +68,8 @@ export class PoolOperatorController {
 
   @ApiOperation({
     summary: 'Delete a pool operator',
-    description: 'Removes an operator from their current pool',
+    description:
+      'Removes the authenticated operator from their current pool. Same checks as leaving a pool via `DELETE /pools/:id/leave`',
   })
   @ApiParam({
     name: 'operatorId',
@@ -49,16 +80,44 @@ export class PoolOperatorController {
     status: 200,
     description: 'Successfully removed operator from pool',
   })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad request - Operator has an active drilling session',
+  })
+  @ApiResponse({
+    status: 403,
+    description:
+      'Forbidden - Cannot remove another operator from their pool, or the operator is the pool leader',
+  })
   @ApiResponse({
     status: 404,
     description: 'Pool operator not found',
   })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
   @Delete('/:operatorId')
   async deletePoolOperator(
+    @Request() req,
     @Param('operatorId') operatorId: string,
   ): Promise<AppApiResponse<null>> {
+    this.assertOwnOperator(req, operatorId);
+
     return this.poolOperatorService.removePoolOperator(
       new Types.ObjectId(operatorId),
     );
   }
+
+  /**
+   * Ensures the given operator ID is the authenticated operator, since operators can only join or leave pools themselves.
+   */
+  private assertOwnOperator(req, operatorId: string) {
+    if (operatorId !== req.user.operatorId) {
+      throw new ForbiddenException(
+        new AppApiResponse(
+          403,
+          `(poolOperators) Operators can only join or leave pools themselves.`,
+        ),
+      );
+    }
+  }
 }
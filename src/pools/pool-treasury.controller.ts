@@ -0,0 +1,124 @@
+import {
+  Body,
+  Controller,
+  Param,
+  Post,
+  Request,
+  UseGuards,
+} from '@nestjs/common';
+import {
+  ApiBearerAuth,
+  ApiOperation,
+  ApiParam,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import {
+  ProposeTreasuryWithdrawalDto,
+  VoteTreasuryWithdrawalDto,
+} from 'src/common/dto/pools/pool-treasury.dto';
+import { PoolTreasuryService } from './pool-treasury.service';
+
+@ApiTags('Pools')
+@Controller('pools')
+export class PoolTreasuryController {
+  constructor(private readonly poolTreasuryService: PoolTreasuryService) {}
+
+  @ApiOperation({
+    summary: 'Propose a treasury withdrawal',
+    description: `Proposes withdrawing $HASH from the pool's treasury to the pool leader. Leader only. Pool members have ${GAME_CONSTANTS.POOLS.TREASURY_PROPOSAL_DURATION_DAYS} days to vote, and the withdrawal is paid out once ${GAME_CONSTANTS.POOLS.TREASURY_PROPOSAL_QUORUM * 100}% of them voted in favour.`,
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully created the proposal',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Amount exceeds the treasury balance or pool already has a pending proposal',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only the pool leader can propose a withdrawal',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/treasury/propose-withdrawal')
+  async proposeWithdrawal(
+    @Param('id') poolId: string,
+    @Body() body: ProposeTreasuryWithdrawalDto,
+    @Request() req,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolTreasuryService.proposeWithdrawal(
+      operatorId,
+      new Types.ObjectId(poolId),
+      body.amount,
+      body.reason,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Vote on a treasury withdrawal',
+    description:
+      "Votes in favour of or against one of the pool's pending treasury withdrawal proposals. Pool members only, and each member can only vote once.",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiParam({
+    name: 'proposalId',
+    description: 'The ID of the treasury withdrawal proposal',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully recorded the vote',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Proposal is no longer open for voting or operator already voted',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only pool members can vote',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Proposal not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/treasury/proposals/:proposalId/vote')
+  async voteOnWithdrawal(
+    @Param('id') poolId: string,
+    @Param('proposalId') proposalId: string,
+    @Body() body: VoteTreasuryWithdrawalDto,
+    @Request() req,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolTreasuryService.voteOnWithdrawal(
+      operatorId,
+      new Types.ObjectId(poolId),
+      new Types.ObjectId(proposalId),
+      body.inFavour,
+    );
+  }
+}
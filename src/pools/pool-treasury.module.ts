@@ -0,0 +1,45 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { BullModule } from '@nestjs/bull';
+import { Pool, PoolSchema } from './schemas/pool.schema';
+import {
+  PoolOperator,
+  PoolOperatorSchema,
+} from './schemas/pool-operator.schema';
+import { PoolEvent, PoolEventSchema } from './schemas/pool-event.schema';
+import {
+  TreasuryWithdrawalProposal,
+  TreasuryWithdrawalProposalSchema,
+} from './schemas/treasury-withdrawal-proposal.schema';
+import { OperatorModule } from 'src/operators/operator.module';
+import { PoolTreasuryService } from './pool-treasury.service';
+import { PoolTreasuryController } from './pool-treasury.controller';
+import { PoolTreasuryQueue } from './pool-treasury.queue';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: Pool.name, schema: PoolSchema },
+      { name: PoolOperator.name, schema: PoolOperatorSchema },
+      { name: PoolEvent.name, schema: PoolEventSchema },
+      {
+        name: TreasuryWithdrawalProposal.name,
+        schema: TreasuryWithdrawalProposalSchema,
+      },
+    ]),
+    BullModule.registerQueue({
+      name: 'pool-treasury-queue',
+      defaultJobOptions: {
+        attempts: 3, // Retry failed jobs 3 times
+        removeOnComplete: true, // Remove completed jobs
+        removeOnFail: false, // Keep failed jobs for debugging
+      },
+    }),
+    // Not part of `PoolModule`, since `OperatorModule` depends on it
+    OperatorModule,
+  ],
+  controllers: [PoolTreasuryController], // Expose API endpoints
+  providers: [PoolTreasuryService, PoolTreasuryQueue],
+  exports: [PoolTreasuryService],
+})
+export class PoolTreasuryModule {}
@@ -0,0 +1,85 @@
+import {
+  Processor,
+  Process,
+  InjectQueue,
+  OnGlobalQueueFailed,
+} from '@nestjs/bull';
+import { Queue } from 'bull';
+import { Injectable, Logger, OnModuleInit } from '@nestjs/common';
+import { PoolTreasuryService } from './pool-treasury.service';
+
+@Injectable()
+@Processor('pool-treasury-queue')
+export class PoolTreasuryQueue implements OnModuleInit {
+  private readonly logger = new Logger(PoolTreasuryQueue.name);
+  private readonly fiveMinutesInMs = 5 * 60 * 1000; // 5 minutes
+
+  constructor(
+    private readonly poolTreasuryService: PoolTreasuryService,
+    @InjectQueue('pool-treasury-queue')
+    private readonly poolTreasuryQueue: Queue,
+  ) {}
+
+  /**
+   * Called when the module initializes.
+   */
+  async onModuleInit() {
+    // ✅ Schedule Treasury Withdrawal Proposal Resolution (Every 5 Minutes)
+    await this.ensureJobScheduled(
+      'resolve-withdrawal-proposals',
+      this.fiveMinutesInMs,
+    );
+  }
+
+  /**
+   * Ensures a Bull job is scheduled, preventing duplicates.
+   */
+  private async ensureJobScheduled(jobName: string, intervalMs: number) {
+    const existingJobs = await this.poolTreasuryQueue.getRepeatableJobs();
+    if (!existingJobs.some((job) => job.name === jobName)) {
+      await this.poolTreasuryQueue.add(
+        jobName,
+        {},
+        {
+          repeat: { every: intervalMs },
+          removeOnComplete: true,
+          removeOnFail: false,
+        },
+      );
+      this.logger.log(
+        `✅ (poolTreasuryQueue) Scheduled job: ${jobName} every ${intervalMs / 1000 / 60} minutes.`,
+      );
+    } else {
+      this.logger.log(
+        `🔄 (poolTreasuryQueue) Job already scheduled: ${jobName}.`,
+      );
+    }
+  }
+
+  /**
+   * Approves, rejects or expires pending treasury withdrawal proposals (runs **every 5 minutes**).
+   */
+  @Process({
+    name: 'resolve-withdrawal-proposals',
+    concurrency: 1, // Limit to one concurrent job at a time
+  })
+  async handleResolveWithdrawalProposals() {
+    try {
+      await this.poolTreasuryService.resolveWithdrawalProposals();
+    } catch (error) {
+      this.logger.error(
+        `❌ (resolve-withdrawal-proposals) Error resolving treasury withdrawal proposals: ${error.message}`,
+      );
+    }
+  }
+
+  /**
+   * Handle failed jobs in the queue.
+   */
+  @OnGlobalQueueFailed()
+  onFailed(jobId: number, err: Error) {
+    this.logger.error(
+      `❌ Pool Treasury Queue job ${jobId} has failed: ${err.message}`,
+    );
+  }
+}
@@ -0,0 +1,379 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { performance } from 'perf_hooks';
+import { Pool } from './schemas/pool.schema';
+import { PoolOperator } from './schemas/pool-operator.schema';
+import { PoolEvent, PoolEventType } from './schemas/pool-event.schema';
+import {
+  TreasuryWithdrawalProposal,
+  TreasuryWithdrawalProposalStatus,
+} from './schemas/treasury-withdrawal-proposal.schema';
+import { OperatorService } from 'src/operators/operator.service';
+import { HashTransactionCategory } from 'src/operators/schemas/hash-transaction.schema';
+import { ApiResponse } from 'src/common/dto/response.dto';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+@Injectable()
+export class PoolTreasuryService {
+  private readonly logger = new Logger(PoolTreasuryService.name);
+
+  constructor(
+    @InjectModel(Pool.name) private poolModel: Model<Pool>,
+    @InjectModel(PoolOperator.name)
+    private poolOperatorModel: Model<PoolOperator>,
+    @InjectModel(PoolEvent.name) private poolEventModel: Model<PoolEvent>,
+    @InjectModel(TreasuryWithdrawalProposal.name)
+    private proposalModel: Model<TreasuryWithdrawalProposal>,
+    private readonly operatorService: OperatorService,
+  ) {}
+
+  /**
+   * Creates a proposal to withdraw $HASH from a pool's treasury to its leader. Leader only.
+   *
+   * A pool can only have one pending proposal at a time, and the amount can't exceed the treasury's current balance.
+   * The pool's current members are snapshotted as the proposal's eligible voters.
+   */
+  async proposeWithdrawal(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    amount: number,
+    reason: string,
+  ): Promise<ApiResponse<{ proposalId: Types.ObjectId; expiresAt: Date }>> {
+    try {
+      const pool = await this.poolModel
+        .findById(poolId, { leaderId: 1, treasuryHASH: 1 })
+        .lean();
+
+      if (!pool) {
+        return new ApiResponse(404, `(proposeWithdrawal) Pool not found.`);
+      }
+
+      if (!pool.leaderId || !pool.leaderId.equals(leaderId)) {
+        return new ApiResponse(
+          403,
+          `(proposeWithdrawal) Only the pool leader can propose a treasury withdrawal.`,
+        );
+      }
+
+      if (amount > (pool.treasuryHASH || 0)) {
+        return new ApiResponse(
+          400,
+          `(proposeWithdrawal) Amount exceeds the pool treasury balance of ${pool.treasuryHASH || 0} $HASH.`,
+        );
+      }
+
+      const hasPendingProposal = await this.proposalModel.exists({
+        poolId,
+        status: TreasuryWithdrawalProposalStatus.PENDING,
+      });
+
+      if (hasPendingProposal) {
+        return new ApiResponse(
+          400,
+          `(proposeWithdrawal) Pool already has a pending treasury withdrawal proposal.`,
+        );
+      }
+
+      const expiresAt = new Date(
+        Date.now() +
+          GAME_CONSTANTS.POOLS.TREASURY_PROPOSAL_DURATION_DAYS * 86_400_000,
+      );
+
+      const members = await this.poolOperatorModel
+        .find({ pool: poolId }, { operator: 1 })
+        .lean();
+
+      const proposal = await this.proposalModel.create({
+        poolId,
+        proposedBy: leaderId,
+        amount,
+        reason,
+        eligibleVoterIds: members.map(({ operator }) => operator),
+        expiresAt,
+      });
+
+      this.logger.log(
+        `🗳️ (proposeWithdrawal) Leader ${leaderId} proposed withdrawing ${amount} $HASH from the treasury of pool ${poolId}.`,
+      );
+
+      return new ApiResponse(
+        200,
+        `(proposeWithdrawal) Treasury withdrawal proposal created.`,
+        { proposalId: proposal._id, expiresAt },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(proposeWithdrawal) Error proposing treasury withdrawal: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Records a pool member's vote on one of their pool's pending treasury withdrawal proposals.
+   *
+   * Only members who were in the pool when the proposal was created can vote, and each of them only once.
+   * The proposal itself is resolved by `resolveWithdrawalProposals`.
+   */
+  async voteOnWithdrawal(
+    operatorId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    proposalId: Types.ObjectId,
+    inFavour: boolean,
+  ): Promise<ApiResponse<{ votesFor: number; votesAgainst: number }>> {
+    try {
+      const isMember = await this.poolOperatorModel.exists({
+        operator: operatorId,
+        pool: poolId,
+      });
+
+      if (!isMember) {
+        return new ApiResponse(
+          403,
+          `(voteOnWithdrawal) Only pool members can vote on treasury withdrawals.`,
+        );
+      }
+
+      const proposal = await this.proposalModel
+        .findOne(
+          { _id: proposalId, poolId },
+          { status: 1, expiresAt: 1, eligibleVoterIds: 1 },
+        )
+        .lean();
+
+      if (!proposal) {
+        return new ApiResponse(404, `(voteOnWithdrawal) Proposal not found.`);
+      }
+
+      if (
+        proposal.status !== TreasuryWithdrawalProposalStatus.PENDING ||
+        proposal.expiresAt <= new Date()
+      ) {
+        return new ApiResponse(
+          400,
+          `(voteOnWithdrawal) Proposal is no longer open for voting.`,
+        );
+      }
+
+      if (!proposal.eligibleVoterIds.some((id) => id.equals(operatorId))) {
+        return new ApiResponse(
+          403,
+          `(voteOnWithdrawal) Only members who were in the pool when the proposal was created can vote on it.`,
+        );
+      }
+
+      // Only count the vote if the operator hasn't voted yet (atomically, to prevent double votes)
+      const updatedProposal = await this.proposalModel
+        .findOneAndUpdate(
+          {
+            _id: proposalId,
+            status: TreasuryWithdrawalProposalStatus.PENDING,
+            voterIds: { $ne: operatorId },
+          },
+          {
+            $push: inFavour
+              ? { voterIds: operatorId, forVoterIds: operatorId }
+              : { voterIds: operatorId, againstVoterIds: operatorId },
+            $inc: inFavour ? { votesFor: 1 } : { votesAgainst: 1 },
+          },
+          { new: true, projection: { votesFor: 1, votesAgainst: 1 } },
+        )
+        .lean();
+
+      if (!updatedProposal) {
+        return new ApiResponse(
+          400,
+          `(voteOnWithdrawal) Operator already voted on this proposal.`,
+        );
+      }
+
+      return new ApiResponse(200, `(voteOnWithdrawal) Vote recorded.`, {
+        votesFor: updatedProposal.votesFor,
+        votesAgainst: updatedProposal.votesAgainst,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(voteOnWithdrawal) Error voting on treasury withdrawal: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Resolves all pending treasury withdrawal proposals. Called periodically by `PoolTreasuryQueue`.
+   *
+   * - Proposals where at least `TREASURY_PROPOSAL_QUORUM` of the eligible voters (the pool's members when the proposal was created)
+   * voted in favour are approved, and the amount is moved from the pool treasury to the pool leader's balance.
+   * Only votes from eligible voters who are still in the pool are counted.
+   * - Proposals that can no longer reach quorum are rejected.
+   * - Proposals that didn't reach quorum before `expiresAt` expire.
+   */
+  async resolveWithdrawalProposals(): Promise<void> {
+    const startTime = performance.now();
+
+    const pendingProposals = await this.proposalModel
+      .find({ status: TreasuryWithdrawalProposalStatus.PENDING })
+      .lean();
+
+    let approvedCount = 0;
+    let rejectedCount = 0;
+    let expiredCount = 0;
+
+    for (const proposal of pendingProposals) {
+      try {
+        // Eligible voters who have since left the pool (or were kicked) no longer count
+        const remainingVoters = await this.poolOperatorModel
+          .find(
+            {
+              pool: proposal.poolId,
+              operator: { $in: proposal.eligibleVoterIds },
+            },
+            { operator: 1 },
+          )
+          .lean();
+        const remainingVoterIds = new Set(
+          remainingVoters.map(({ operator }) => operator.toString()),
+        );
+        const countRemaining = (voterIds: Types.ObjectId[]) =>
+          voterIds.filter((id) => remainingVoterIds.has(id.toString()))
+            .length;
+
+        const votesFor = countRemaining(proposal.forVoterIds);
+        const votesAgainst = countRemaining(proposal.againstVoterIds);
+        const requiredVotes = Math.max(
+          1,
+          Math.ceil(
+            proposal.eligibleVoterIds.length *
+              GAME_CONSTANTS.POOLS.TREASURY_PROPOSAL_QUORUM,
+          ),
+        );
+
+        if (votesFor >= requiredVotes) {
+          if (await this.approveWithdrawal(proposal)) {
+            approvedCount++;
+          } else {
+            rejectedCount++;
+          }
+        } else if (remainingVoterIds.size - votesAgainst < requiredVotes) {
+          // Not enough eligible voters left who could still vote in favour
+          const rejected = await this.proposalModel.updateOne(
+            {
+              _id: proposal._id,
+              status: TreasuryWithdrawalProposalStatus.PENDING,
+            },
+            { $set: { status: TreasuryWithdrawalProposalStatus.REJECTED } },
+          );
+          rejectedCount += rejected.modifiedCount;
+        } else if (proposal.expiresAt <= new Date()) {
+          const expired = await this.proposalModel.updateOne(
+            {
+              _id: proposal._id,
+              status: TreasuryWithdrawalProposalStatus.PENDING,
+            },
+            { $set: { status: TreasuryWithdrawalProposalStatus.EXPIRED } },
+          );
+          expiredCount += expired.modifiedCount;
+        }
+      } catch (err: any) {
+        this.logger.error(
+          `❌ (resolveWithdrawalProposals) Error resolving proposal ${proposal._id}: ${err.message}`,
+        );
+      }
+    }
+
+    this.logger.log(
+      `✅ (resolveWithdrawalProposals) Approved ${approvedCount}, rejected ${rejectedCount} and expired ${expiredCount} treasury withdrawal proposals in ${(performance.now() - startTime).toFixed(2)}ms.`,
+    );
+  }
+
+  /**
+   * Approves a proposal that reached quorum and pays out its amount from the pool treasury to the pool's current leader.
+   *
+   * The proposal is rejected instead if the treasury can no longer cover the amount or the pool has no leader.
+   * Returns whether the withdrawal was paid out.
+   */
+  private async approveWithdrawal(
+    proposal: Pick<TreasuryWithdrawalProposal, '_id' | 'poolId' | 'amount'>,
+  ): Promise<boolean> {
+    // Claim the proposal first so it can't be paid out twice
+    const claimed = await this.proposalModel.updateOne(
+      { _id: proposal._id, status: TreasuryWithdrawalProposalStatus.PENDING },
+      { $set: { status: TreasuryWithdrawalProposalStatus.APPROVED } },
+    );
+
+    if (claimed.modifiedCount === 0) return false;
+
+    // Only deduct the amount if the treasury can still cover it
+    const pool = await this.poolModel
+      .findOneAndUpdate(
+        {
+          _id: proposal.poolId,
+          leaderId: { $ne: null },
+          treasuryHASH: { $gte: proposal.amount },
+        },
+        { $inc: { treasuryHASH: -proposal.amount } },
+        { projection: { leaderId: 1 } },
+      )
+      .lean();
+
+    if (!pool) {
+      await this.proposalModel.updateOne(
+        { _id: proposal._id },
+        { $set: { status: TreasuryWithdrawalProposalStatus.REJECTED } },
+      );
+
+      this.logger.warn(
+        `⚠️ (approveWithdrawal) Rejected proposal ${proposal._id}: pool ${proposal.poolId} has no leader or not enough $HASH in its treasury.`,
+      );
+      return false;
+    }
+
+    const result = await this.operatorService.addHASH(
+      pool.leaderId,
+      proposal.amount,
+      HashTransactionCategory.POOL_TREASURY_WITHDRAWAL,
+      `Pool treasury withdrawal ${proposal._id}`,
+      proposal._id,
+      'treasury_withdrawal_proposal',
+    );
+
+    if (!result.success) {
+      // Put the $HASH back into the treasury so the pool doesn't lose it
+      await this.poolModel.updateOne(
+        { _id: proposal.poolId },
+        { $inc: { treasuryHASH: proposal.amount } },
+      );
+      await this.proposalModel.updateOne(
+        { _id: proposal._id },
+        { $set: { status: TreasuryWithdrawalProposalStatus.REJECTED } },
+      );
+
+      this.logger.error(
+        `❌ (approveWithdrawal) Failed to credit ${proposal.amount} $HASH to leader ${pool.leaderId} for proposal ${proposal._id}: ${result.error}`,
+      );
+      return false;
+    }
+
+    await this.poolEventModel.create({
+      poolId: proposal.poolId,
+      type: PoolEventType.TREASURY_WITHDRAWAL,
+      operatorId: pool.leaderId,
+      amount: proposal.amount,
+    });
+
+    this.logger.log(
+      `💰 (approveWithdrawal) Paid out ${proposal.amount} $HASH from the treasury of pool ${proposal.poolId} to leader ${pool.leaderId}.`,
+    );
+
+    return true;
+  }
+}
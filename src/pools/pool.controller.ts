@@ -1,7 +1,12 @@
 import {
+  Body,
   Controller,
+  Delete,
+  ForbiddenException,
   Get,
   Param,
+  Post,
+  Put,
   Query,
   UseGuards,
   Request,
@@ -17,15 +22,34 @@ import {
 import { PoolService } from './pool.service';
 import { Pool } from './schemas/pool.schema';
 import { ApiResponse as AppApiResponse } from 'src/common/dto/response.dto';
-import { GetAllPoolsResponseDto } from 'src/common/dto/pools/pool.dto';
 import {
+  GetAllPoolsQueryDto,
+  GetAllPoolsResponseDto,
+  GetPoolActivityHeatmapQueryDto,
+  GetPoolActivityHeatmapResponseDto,
+  PoolJoinEligibilityDto,
+  PoolRecommendationDto,
+  RewardPresetDto,
+  SplitPoolDto,
+  TransferPoolLeadershipDto,
+  UpdatePoolLinksDto,
+  UpdatePoolSettingsDto,
+} from 'src/common/dto/pools/pool.dto';
+import {
+  GetPoolMembersQueryDto,
+  GetPoolMembersResponseDto,
   GetPoolOperatorsQueryDto,
   GetPoolOperatorsResponseDto,
   GetPoolOperatorResponseDto,
 } from 'src/common/dto/pools/pool-operator.dto';
 import { PoolOperator } from './schemas/pool-operator.schema';
+import { PoolMemberEffTier } from 'src/common/utils/pool';
+import { PoolLinks } from './schemas/pool-links.schema';
 import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
+import { RateLimit } from 'src/common/decorators/rate-limit.decorator';
 import { Types } from 'mongoose';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import { GetLeaderboardQueryDto } from 'src/common/dto/leaderboard.dto';
 
 @ApiTags('Pools')
 @Controller('pools') // Base route: `/pools`
@@ -34,7 +58,8 @@ export class PoolController {
 
   @ApiOperation({
     summary: 'Get all pools',
-    description: 'Fetches all pools with optional field projection',
+    description:
+      'Fetches all pools with their current operator count, with optional field projection, leader filter and pagination',
   })
   @ApiQuery({
     name: 'projection',
@@ -55,7 +80,10 @@ export class PoolController {
     type: GetAllPoolsResponseDto,
   })
   @Get()
-  async getAllPools(@Query('projection') projection?: string) {
+  async getAllPools(
+    @Query() query: GetAllPoolsQueryDto,
+    @Query('projection') projection?: string,
+  ) {
     // Convert query string to Mongoose projection object
     const projectionObj = projection
       ? projection
@@ -63,13 +91,55 @@ export class PoolController {
           .reduce((acc, field) => ({ ...acc, [field]: 1 }), {})
       : undefined;
 
-    return this.poolService.getAllPools(projectionObj);
+    return this.poolService.getAllPools(projectionObj, {
+      page: query.page,
+      limit: query.limit,
+      leaderId: query.leaderId,
+    });
+  }
+
+  @ApiOperation({
+    summary: 'Get pool reward presets',
+    description:
+      'Fetches the named reward system templates that can be applied when creating a pool',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved reward presets',
+    type: [RewardPresetDto],
+  })
+  @Get('reward-presets')
+  getRewardPresets(): AppApiResponse<{ presets: RewardPresetDto[] }> {
+    return this.poolService.getRewardPresets();
+  }
+
+  @ApiOperation({
+    summary: 'Get pool recommendations',
+    description: `Recommends up to ${GAME_CONSTANTS.POOLS.RECOMMENDATIONS.count} pools the authenticated operator can join, ranked by member reputation, recent $HASH earnings and free slots. Only available to operators without a pool.`,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved pool recommendations',
+    type: [PoolRecommendationDto],
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Operator is already in a pool',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get('recommend')
+  async recommendPools(
+    @Request() req,
+  ): Promise<AppApiResponse<{ pools: PoolRecommendationDto[] } | null>> {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+    return this.poolService.recommendPools(operatorId);
   }
 
   @ApiOperation({
     summary: 'Get a pool by ID',
     description:
-      'Fetches a specific pool by its ID with optional field projection',
+      "Fetches a specific pool by its ID with optional field projection, along with its current operator count and the EFF boost its drills get from the pool's size",
   })
   @ApiParam({
     name: 'id',
@@ -97,7 +167,14 @@ export class PoolController {
   async getPoolById(
     @Param('id') id: string,
     @Query('projection') projection?: string,
-  ): Promise<AppApiResponse<{ pool: Pool | null }>> {
+  ): Promise<
+    AppApiResponse<{
+      pool: Pool | null;
+      links: PoolLinks | null;
+      currentOperatorCount: number;
+      synergyEffBoost: number;
+    }>
+  > {
     // Convert query string to Mongoose projection object
     const projectionObj = projection
       ? projection
@@ -108,6 +185,59 @@ export class PoolController {
     return this.poolService.getPoolById(id, projectionObj);
   }
 
+  @ApiOperation({
+    summary: 'Get pool reward history',
+    description:
+      "Fetches a paginated list of the pool's past reward distributions (how each cycle's issued $HASH was split when one of its operators was the extractor), newest first",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved pool reward history',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @Get(':id/reward-history')
+  async fetchPoolRewardHistory(
+    @Param('id') id: string,
+    @Query() query: GetLeaderboardQueryDto,
+  ) {
+    return this.poolService.fetchPoolRewardHistory(
+      new Types.ObjectId(id),
+      query.page,
+      query.limit,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Get pool stats',
+    description:
+      "Fetches a pool's member count, total EFF, the number of cycles its operators won and the total $HASH it has been rewarded",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved pool stats',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @Get(':id/stats')
+  async fetchPoolStats(@Param('id') id: string) {
+    return this.poolService.fetchPoolStats(new Types.ObjectId(id));
+  }
+
   @ApiOperation({
     summary: 'Get operators for a specific pool',
     description:
@@ -137,7 +267,9 @@ export class PoolController {
     @Query() query: GetPoolOperatorsQueryDto,
   ): Promise<
     AppApiResponse<{
-      operators: Partial<PoolOperator[]>;
+      operators: (Partial<PoolOperator> & {
+        effTier: PoolMemberEffTier | null;
+      })[];
       total: number;
       page: number;
       limit: number;
@@ -160,6 +292,67 @@ export class PoolController {
     );
   }
 
+  @ApiOperation({
+    summary: 'Get members of a specific pool',
+    description:
+      'Fetches the members of a pool ordered by join time, using cursor-based pagination',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved pool members',
+    type: GetPoolMembersResponseDto,
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid cursor or limit',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @Get(':id/members')
+  async getPoolMembers(
+    @Param('id') id: string,
+    @Query() query: GetPoolMembersQueryDto,
+  ): Promise<AppApiResponse<GetPoolMembersResponseDto>> {
+    return this.poolService.getPoolMembers(id, query.cursor, query.limit || 50);
+  }
+
+  @ApiOperation({
+    summary: 'Get pool activity heatmap',
+    description:
+      "Fetches when the pool is most active as a 7x24 matrix (day of the week x hour, UTC), computed from the drilling sessions of the pool's current members over the past weeks",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved pool activity heatmap',
+    type: GetPoolActivityHeatmapResponseDto,
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @Get(':id/activity-heatmap')
+  async getPoolActivityHeatmap(
+    @Param('id') id: string,
+    @Query() query: GetPoolActivityHeatmapQueryDto,
+  ): Promise<AppApiResponse<GetPoolActivityHeatmapResponseDto>> {
+    return this.poolService.fetchPoolActivityHeatmap(
+      new Types.ObjectId(id),
+      query.weeks || 4,
+    );
+  }
+
   @ApiOperation({
     summary: 'Get current user pool operator details',
     description:
@@ -213,4 +406,409 @@ export class PoolController {
       projectionObj,
     );
   }
+
+  @ApiOperation({
+    summary: 'Check pool join eligibility',
+    description:
+      "Checks whether the operator meets every prerequisite to join the pool (not already in a pool, an open slot, the pool's minimum trust score and Telegram channel membership) without attempting to join. Returns every prerequisite that is not met. Operators can only check their own eligibility.",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiParam({
+    name: 'operatorId',
+    description: 'The ID of the operator',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully checked pool join eligibility',
+    type: PoolJoinEligibilityDto,
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Operators can only check their own eligibility',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool or operator not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id/eligibility/:operatorId')
+  async checkPoolJoinEligibility(
+    @Param('id') poolId: string,
+    @Param('operatorId') operatorId: string,
+    @Request() req,
+  ): Promise<AppApiResponse<PoolJoinEligibilityDto | null>> {
+    if (operatorId !== req.user.operatorId) {
+      throw new ForbiddenException(
+        new AppApiResponse(
+          403,
+          `(checkPoolJoinEligibility) Operators can only check their own eligibility.`,
+        ),
+      );
+    }
+
+    return this.poolService.checkPoolJoinEligibility(
+      new Types.ObjectId(operatorId),
+      new Types.ObjectId(poolId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Join a pool',
+    description:
+      "Adds the authenticated operator to the pool. Requires an open slot and the pool's join prerequisites (minimum trust score, Telegram channel membership) to be met.",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully joined pool',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Operator is already in a pool or pool is full',
+  })
+  @ApiResponse({
+    status: 403,
+    description: "Forbidden - Pool's join prerequisites are not met",
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @ApiBearerAuth()
+  @RateLimit(5)
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/join')
+  async joinPool(
+    @Param('id') poolId: string,
+    @Request() req,
+  ): Promise<AppApiResponse<null>> {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolService.joinPool(operatorId, new Types.ObjectId(poolId));
+  }
+
+  @ApiOperation({
+    summary: 'Leave a pool',
+    description:
+      'Removes the authenticated operator from the pool. The pool leader must transfer leadership first, and operators with an active drilling session cannot leave.',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully left pool',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Operator has an active drilling session',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - The pool leader cannot leave the pool',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found or operator is not a member',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Delete(':id/leave')
+  async leavePool(
+    @Param('id') poolId: string,
+    @Request() req,
+  ): Promise<AppApiResponse<null>> {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolService.leavePool(operatorId, new Types.ObjectId(poolId));
+  }
+
+  @ApiOperation({
+    summary: 'Transfer pool leadership',
+    description:
+      'Hands off the leadership of the pool to another member of the pool. Leader only.',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully transferred pool leadership',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Operator is already the pool leader',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only the pool leader can transfer leadership',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @ApiResponse({
+    status: 409,
+    description:
+      'Conflict - The new leader is not a member of the pool, or the leadership was changed in the meantime',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Put(':id/transfer-leadership')
+  async transferPoolLeadership(
+    @Param('id') poolId: string,
+    @Request() req,
+    @Body() body: TransferPoolLeadershipDto,
+  ): Promise<AppApiResponse<null>> {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolService.transferPoolLeadership(
+      operatorId,
+      new Types.ObjectId(poolId),
+      new Types.ObjectId(body.newLeaderId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Update pool settings',
+    description:
+      "Updates the pool's max operators and/or join prerequisites. Only the provided settings are changed. Leader only.",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully updated pool settings',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - Invalid or no settings provided',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only the pool leader can update the settings',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @ApiResponse({
+    status: 422,
+    description:
+      'Unprocessable Entity - Max operators is lower than the current member count',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Put(':id/settings')
+  async updatePoolSettings(
+    @Param('id') poolId: string,
+    @Request() req,
+    @Body() body: UpdatePoolSettingsDto,
+  ): Promise<AppApiResponse<null>> {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolService.updatePoolSettings(
+      operatorId,
+      new Types.ObjectId(poolId),
+      {
+        maxOperators: body.maxOperators,
+        joinPrerequisites: body.joinPrerequisites,
+        requiresApproval: body.requiresApproval,
+      },
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Kick a pool operator',
+    description:
+      'Removes a member from the pool. Leader only, and members with an active drilling session cannot be kicked.',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiParam({
+    name: 'operatorId',
+    description: 'The ID of the operator to kick',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully kicked pool operator',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Operator has an active drilling session or is the leader',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only the pool leader can kick pool operators',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found or operator is not a member',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Delete(':id/operators/:operatorId')
+  async kickPoolOperator(
+    @Param('id') poolId: string,
+    @Param('operatorId') targetOperatorId: string,
+    @Request() req,
+  ): Promise<AppApiResponse<null>> {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolService.kickPoolOperator(
+      operatorId,
+      new Types.ObjectId(poolId),
+      new Types.ObjectId(targetOperatorId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Reclaim dormant slots',
+    description: `Kicks pool operators who haven't had a drilling session in the last ${GAME_CONSTANTS.POOLS.DORMANT_OPERATOR_DAYS} days. Leader only, and can only be called once every 24 hours per pool.`,
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully reclaimed dormant slots',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only the pool leader can reclaim dormant slots',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @ApiResponse({
+    status: 429,
+    description: 'Too Many Requests - Dormant slots were reclaimed recently',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/reclaim-dormant-slots')
+  async reclaimDormantSlots(
+    @Param('id') poolId: string,
+    @Request() req,
+  ): Promise<AppApiResponse<{ reclaimedCount: number; freedSlots: number }>> {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolService.reclaimDormantSlots(
+      operatorId,
+      new Types.ObjectId(poolId),
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Split pool',
+    description: `Splits a pool with more than ${GAME_CONSTANTS.POOLS.SPLIT_SOFT_CAP} operators into two sibling pools. The new pool is led by the given member, receives the given members and a proportional share of the pool treasury. Leader only.`,
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully split the pool',
+  })
+  @ApiResponse({
+    status: 400,
+    description:
+      'Bad Request - Pool below the split soft cap, invalid members or duplicate pool name',
+  })
+  @ApiResponse({
+    status: 403,
+    description: 'Forbidden - Only the pool leader can split the pool',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Post(':id/split')
+  async splitPool(
+    @Param('id') poolId: string,
+    @Body() body: SplitPoolDto,
+    @Request() req,
+  ) {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolService.splitPool(
+      operatorId,
+      new Types.ObjectId(poolId),
+      new Types.ObjectId(body.newLeaderId),
+      body.memberIdsForNewPool.map((id) => new Types.ObjectId(id)),
+      body.name,
+    );
+  }
+
+  @ApiOperation({
+    summary: 'Update pool links',
+    description:
+      "Sets the pool's external links (website, Discord, Twitter and Telegram). URLs must use HTTPS. Leader only.",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the pool',
+    type: String,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully updated the pool links',
+  })
+  @ApiResponse({
+    status: 400,
+    description: 'Bad Request - No links provided or invalid URL',
+  })
+  @ApiResponse({
+    status: 403,
+    description: "Forbidden - Only the pool leader can update the pool's links",
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Pool not found',
+  })
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Put(':id/links')
+  async updatePoolLinks(
+    @Param('id') poolId: string,
+    @Body() body: UpdatePoolLinksDto,
+    @Request() req,
+  ): Promise<AppApiResponse<{ links: PoolLinks | null }>> {
+    const operatorId = new Types.ObjectId(req.user.operatorId);
+
+    return this.poolService.updatePoolLinks(
+      operatorId,
+      new Types.ObjectId(poolId),
+      body,
+    );
+  }
 }
@@ -2,21 +2,81 @@ import { Module } from '@nestjs/common';
 import { MongooseModule } from '@nestjs/mongoose';
 import { PoolService } from './pool.service';
 import { Pool, PoolSchema } from './schemas/pool.schema';
+import { PoolLinks, PoolLinksSchema } from './schemas/pool-links.schema';
+import { PoolEvent, PoolEventSchema } from './schemas/pool-event.schema';
+import {
+  PoolRewardDistribution,
+  PoolRewardDistributionSchema,
+} from './schemas/pool-reward-distribution.schema';
+import {
+  PoolMembershipHistory,
+  PoolMembershipHistorySchema,
+} from './schemas/pool-membership-history.schema';
+import {
+  DrillingCycleRewardShare,
+  DrillingCycleRewardShareSchema,
+} from 'src/drills/schemas/drilling-crs.schema';
 import { PoolController } from './pool.controller';
 import {
   PoolOperator,
   PoolOperatorSchema,
 } from './schemas/pool-operator.schema';
+import {
+  DrillingSession,
+  DrillingSessionSchema,
+} from 'src/drills/schemas/drilling-session.schema';
+import {
+  PoolChallenge,
+  PoolChallengeSchema,
+} from './schemas/pool-challenge.schema';
+import {
+  DrillingCycle,
+  DrillingCycleSchema,
+} from 'src/drills/schemas/drilling-cycle.schema';
+import { PoolChallengeService } from './pool-challenge.service';
+import { MissionModule } from 'src/missions/mission.module';
+import { OnboardingModule } from 'src/onboarding/onboarding.module';
+import {
+  Operator,
+  OperatorSchema,
+} from 'src/operators/schemas/operator.schema';
+import {
+  ActivityEvent,
+  ActivityEventSchema,
+} from 'src/operators/schemas/activity-event.schema';
+import { Drill, DrillSchema } from 'src/drills/schemas/drill.schema';
 
 @Module({
   imports: [
     MongooseModule.forFeature([
       { name: Pool.name, schema: PoolSchema },
       { name: PoolOperator.name, schema: PoolOperatorSchema },
+      { name: DrillingSession.name, schema: DrillingSessionSchema },
+      { name: PoolChallenge.name, schema: PoolChallengeSchema },
+      { name: DrillingCycle.name, schema: DrillingCycleSchema },
+      { name: Operator.name, schema: OperatorSchema },
+      { name: PoolLinks.name, schema: PoolLinksSchema },
+      { name: PoolEvent.name, schema: PoolEventSchema },
+      { name: ActivityEvent.name, schema: ActivityEventSchema },
+      { name: Drill.name, schema: DrillSchema },
+      {
+        name: PoolRewardDistribution.name,
+        schema: PoolRewardDistributionSchema,
+      },
+      {
+        name: PoolMembershipHistory.name,
+        schema: PoolMembershipHistorySchema,
+      },
+      {
+        name: DrillingCycleRewardShare.name,
+        schema: DrillingCycleRewardShareSchema,
+      },
     ]),
+    MissionModule,
+    OnboardingModule,
   ],
   controllers: [PoolController], // Expose API endpoints
-  providers: [PoolService], // Business logic for pools
-  exports: [MongooseModule, PoolService], // Allow usage in other modules
+  providers: [PoolService, PoolChallengeService], // Business logic for pools
+  exports: [MongooseModule, PoolService, PoolChallengeService], // Allow usage in other modules
 })
 export class PoolModule {}
@@ -1,4 +1,5 @@
 import {
+  BadRequestException,
   Injectable,
   InternalServerErrorException,
   NotFoundException,
@@ -6,10 +7,50 @@ import {
 } from '@nestjs/common';
 import { InjectModel } from '@nestjs/mongoose';
 import { Model, Types } from 'mongoose';
+import { ConfigService } from '@nestjs/config';
 import { Pool } from './schemas/pool.schema';
+import { PoolLinks } from './schemas/pool-links.schema';
 import { ApiResponse } from 'src/common/dto/response.dto';
 import { PoolOperator } from './schemas/pool-operator.schema';
+import { Operator } from 'src/operators/schemas/operator.schema';
 import { performance } from 'perf_hooks';
+import { DrillingSession } from 'src/drills/schemas/drilling-session.schema';
+import { Drill } from 'src/drills/schemas/drill.schema';
+import { RedisService } from 'src/common/redis.service';
+import { MissionService } from 'src/missions/mission.service';
+import { MissionTargetType } from 'src/missions/schemas/daily-mission.schema';
+import { OnboardingService } from 'src/onboarding/onboarding.service';
+import { OnboardingStep } from 'src/onboarding/schemas/onboarding-progress.schema';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+import {
+  GetPoolActivityHeatmapResponseDto,
+  PoolJoinEligibilityDto,
+  PoolJoinPrerequisiteFailureDto,
+  PoolRecommendationDto,
+  PoolRewardSystemDto,
+  RewardPresetDto,
+} from 'src/common/dto/pools/pool.dto';
+import { isTelegramChatMember } from 'src/common/utils/telegram';
+import {
+  PoolJoinPrerequisite,
+  PoolMemberEffTier,
+  poolMemberEffTier,
+  isPoolFull,
+  poolJoinCooldownLeft,
+  poolSynergyMultiplier,
+  validatePoolPrerequisites,
+  validatePoolRewardSystem,
+} from 'src/common/utils/pool';
+import { PoolPrerequisites } from 'src/common/schemas/pool-prerequisites.schema';
+import { PoolRewardDistribution } from './schemas/pool-reward-distribution.schema';
+import { PoolMembershipHistory } from './schemas/pool-membership-history.schema';
+import { DrillingCycle } from 'src/drills/schemas/drilling-cycle.schema';
+import { DrillingCycleRewardShare } from 'src/drills/schemas/drilling-crs.schema';
+import {
+  ActivityEvent,
+  ActivityEventType,
+} from 'src/operators/schemas/activity-event.schema';
+import { PoolMemberDto } from 'src/common/dto/pools/pool-operator.dto';
 
 @Injectable()
 export class PoolService {
@@ -20,22 +61,197 @@ export class PoolService {
     private poolModel: Model<Pool>,
     @InjectModel(PoolOperator.name)
     private poolOperatorModel: Model<PoolOperator>,
+    @InjectModel(DrillingSession.name)
+    private drillingSessionModel: Model<DrillingSession>,
+    @InjectModel(Operator.name) private operatorModel: Model<Operator>,
+    @InjectModel(Drill.name) private drillModel: Model<Drill>,
+    @InjectModel(PoolLinks.name) private poolLinksModel: Model<PoolLinks>,
+    @InjectModel(ActivityEvent.name)
+    private activityEventModel: Model<ActivityEvent>,
+    @InjectModel(PoolRewardDistribution.name)
+    private poolRewardDistributionModel: Model<PoolRewardDistribution>,
+    @InjectModel(PoolMembershipHistory.name)
+    private poolMembershipHistoryModel: Model<PoolMembershipHistory>,
+    @InjectModel(DrillingCycle.name)
+    private drillingCycleModel: Model<DrillingCycle>,
+    @InjectModel(DrillingCycleRewardShare.name)
+    private drillingCycleRewardShareModel: Model<DrillingCycleRewardShare>,
+    private readonly redisService: RedisService,
+    private readonly missionService: MissionService,
+    private readonly onboardingService: OnboardingService,
+    private readonly configService: ConfigService,
   ) {}
 
+  /**
+   * Checks whether an operator can join a pool without attempting to join it.
+   *
+   * Evaluates every prerequisite checked by `joinPool` and returns all of the ones the operator doesn't meet,
+   * so clients can show actionable reasons up front.
+   */
+  async checkPoolJoinEligibility(
+    operatorId: Types.ObjectId,
+    poolId: Types.ObjectId,
+  ): Promise<ApiResponse<PoolJoinEligibilityDto | null>> {
+    try {
+      const [operatorInPool, pool, operator] = await Promise.all([
+        this.poolOperatorModel.exists({ operator: operatorId }),
+        this.poolModel
+          .findOne(
+            { _id: poolId },
+            {
+              maxOperators: 1,
+              operatorCount: 1,
+              joinPrerequisites: 1,
+              requiresApproval: 1,
+            },
+          )
+          .lean(),
+        this.operatorModel
+          .findById(operatorId, {
+            trustScore: 1,
+            lastJoinedPool: 1,
+            'tgProfile.tgId': 1,
+          })
+          .lean(),
+      ]);
+
+      if (!pool) {
+        return new ApiResponse<null>(
+          404,
+          `(checkPoolJoinEligibility) Pool not found.`,
+        );
+      }
+
+      if (!operator) {
+        return new ApiResponse<null>(
+          404,
+          `(checkPoolJoinEligibility) Operator not found.`,
+        );
+      }
+
+      const failingPrerequisites: PoolJoinPrerequisiteFailureDto[] = [];
+
+      if (operatorInPool) {
+        failingPrerequisites.push({
+          prerequisite: PoolJoinPrerequisite.NOT_IN_POOL,
+          reason: 'The operator is already in a pool.',
+        });
+      }
+
+      if (pool.requiresApproval) {
+        failingPrerequisites.push({
+          prerequisite: PoolJoinPrerequisite.APPROVAL_REQUIRED,
+          reason:
+            'The pool requires approval to join. Send a join request instead.',
+        });
+      }
+
+      if (isPoolFull(pool)) {
+        failingPrerequisites.push({
+          prerequisite: PoolJoinPrerequisite.CAPACITY,
+          reason: `The pool is full (max operators: ${pool.maxOperators}).`,
+        });
+      }
+
+      const joinCooldownLeft = poolJoinCooldownLeft(operator.lastJoinedPool);
+      if (joinCooldownLeft > 0) {
+        failingPrerequisites.push({
+          prerequisite: PoolJoinPrerequisite.JOIN_COOLDOWN,
+          reason: `The operator joined a pool recently. Cooldown left: ${joinCooldownLeft} seconds.`,
+        });
+      }
+
+      const minTrustScore = pool.joinPrerequisites?.minTrustScore;
+      if (
+        minTrustScore !== null &&
+        minTrustScore !== undefined &&
+        (operator.trustScore || 0) < minTrustScore
+      ) {
+        failingPrerequisites.push({
+          prerequisite: PoolJoinPrerequisite.MIN_TRUST_SCORE,
+          reason: `The operator's trust score is below the pool's minimum of ${minTrustScore}.`,
+        });
+      }
+
+      const tgChannelId = pool.joinPrerequisites?.tgChannelId;
+      if (tgChannelId) {
+        if (!operator.tgProfile?.tgId) {
+          failingPrerequisites.push({
+            prerequisite: PoolJoinPrerequisite.TG_CHANNEL,
+            reason:
+              'The pool requires a Telegram channel membership, but the operator has no linked Telegram account.',
+          });
+        } else {
+          const isChannelMember = await isTelegramChatMember(
+            this.configService.get<string>('TELEGRAM_BOT_TOKEN'),
+            tgChannelId,
+            operator.tgProfile.tgId,
+          ).catch((err: any) => {
+            this.logger.warn(
+              `⚠️ (checkPoolJoinEligibility) Error checking Telegram channel membership: ${err.message}`,
+            );
+            return null;
+          });
+
+          if (!isChannelMember) {
+            failingPrerequisites.push({
+              prerequisite: PoolJoinPrerequisite.TG_CHANNEL,
+              reason:
+                isChannelMember === null
+                  ? "The operator's Telegram channel membership couldn't be verified."
+                  : "The operator is not a member of the pool's Telegram channel.",
+            });
+          }
+        }
+      }
+
+      return new ApiResponse<PoolJoinEligibilityDto>(
+        200,
+        `(checkPoolJoinEligibility) Pool join eligibility checked.`,
+        {
+          eligible: failingPrerequisites.length === 0,
+          failingPrerequisites,
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(checkPoolJoinEligibility) Error checking pool join eligibility: ${err.message}`,
+        ),
+      );
+    }
+  }
+
   /**
    * Join a pool. Ensures:
    * - An operator can only be in one pool.
    * - The pool is not full.
+   * - The operator isn't on cooldown for joining a pool.
+   * - The operator meets the pool's minimum trust score (if any).
+   * - The operator is a member of the pool's Telegram channel (if any).
+   * - The pool doesn't require approval, unless the join was `approved` (e.g. via a join request or an invitation).
    */
   async joinPool(
     operatorId: Types.ObjectId,
     poolId: Types.ObjectId,
+    approved: boolean = false,
   ): Promise<ApiResponse<null>> {
     try {
       // ✅ Step 1: Fetch pool details + check if operator is already in a pool
       const [operatorInPool, pool] = await Promise.all([
         this.poolOperatorModel.exists({ operator: operatorId }),
-        this.poolModel.findOne({ _id: poolId }, { maxOperators: 1 }).lean(),
+        this.poolModel
+          .findOne(
+            { _id: poolId },
+            {
+              maxOperators: 1,
+              operatorCount: 1,
+              joinPrerequisites: 1,
+              requiresApproval: 1,
+            },
+          )
+          .lean(),
       ]);
 
       if (operatorInPool) {
@@ -49,31 +265,156 @@ export class PoolService {
         return new ApiResponse<null>(404, `(joinPool) Pool not found.`);
       }
 
-      // ✅ Step 2: Check if the pool is full
-      const poolOperatorCount = await this.poolOperatorModel.countDocuments({
-        pool: poolId,
-      });
-      if (poolOperatorCount >= pool.maxOperators) {
+      if (pool.requiresApproval && !approved) {
+        return new ApiResponse<null>(
+          403,
+          `(joinPool) Pool requires approval to join. Send a join request instead.`,
+        );
+      }
+
+      // ✅ Step 2: Check if the pool is full (the slot itself is only reserved in step 3)
+      if (isPoolFull(pool)) {
         return new ApiResponse<null>(400, `(joinPool) Pool is full.`);
       }
 
-      // TO DO IN THE FUTURE:
-      // Ensure that the pool prerequisites are met before joining.
+      // ✅ Step 2.1: Check that the operator isn't on cooldown for joining a pool
+      const operator = await this.operatorModel
+        .findById(operatorId, {
+          trustScore: 1,
+          lastJoinedPool: 1,
+          'tgProfile.tgId': 1,
+        })
+        .lean();
+
+      if (!operator) {
+        return new ApiResponse<null>(404, `(joinPool) Operator not found.`);
+      }
+
+      const joinCooldownLeft = poolJoinCooldownLeft(operator.lastJoinedPool);
+      if (joinCooldownLeft > 0) {
+        return new ApiResponse<null>(
+          400,
+          `(joinPool) Operator is on cooldown for joining a pool. Cooldown left: ${joinCooldownLeft} seconds.`,
+        );
+      }
+
+      // ✅ Step 2.2: Check the pool's join prerequisites (if any)
+      const minTrustScore = pool.joinPrerequisites?.minTrustScore;
+      const tgChannelId = pool.joinPrerequisites?.tgChannelId;
+      const hasMinTrustScore =
+        minTrustScore !== null && minTrustScore !== undefined;
+
+      if (hasMinTrustScore || tgChannelId) {
+        if (hasMinTrustScore && (operator?.trustScore || 0) < minTrustScore) {
+          return new ApiResponse<null>(
+            403,
+            `(joinPool) Operator's trust score is below the pool's minimum of ${minTrustScore}.`,
+          );
+        }
+
+        if (tgChannelId) {
+          if (!operator?.tgProfile?.tgId) {
+            return new ApiResponse<null>(
+              403,
+              `(joinPool) Pool requires a Telegram channel membership, but the operator has no linked Telegram account.`,
+            );
+          }
+
+          const isChannelMember = await isTelegramChatMember(
+            this.configService.get<string>('TELEGRAM_BOT_TOKEN'),
+            tgChannelId,
+            operator.tgProfile.tgId,
+          );
+
+          if (!isChannelMember) {
+            return new ApiResponse<null>(
+              403,
+              `(joinPool) Operator is not a member of the pool's Telegram channel.`,
+            );
+          }
+        }
+      }
 
-      // ✅ Step 3: Insert operator into the pool **atomically** (prevent race conditions)
-      const result = await this.poolOperatorModel.updateOne(
-        { operator: operatorId }, // Ensure operatorId is unique
-        { $setOnInsert: { operator: operatorId, pool: poolId } }, // Insert only if it doesn't exist
-        { upsert: true }, // Insert if not exists
+      // ✅ Step 3: Reserve a slot **atomically**, only while the pool is below `maxOperators` (prevent race conditions)
+      const reserved = await this.poolModel.updateOne(
+        {
+          _id: poolId,
+          $or: [
+            { maxOperators: null },
+            {
+              $expr: {
+                $lt: [{ $ifNull: ['$operatorCount', 0] }, '$maxOperators'],
+              },
+            },
+          ],
+        },
+        { $inc: { operatorCount: 1 } },
       );
 
+      if (reserved.modifiedCount === 0) {
+        return new ApiResponse<null>(400, `(joinPool) Pool is full.`);
+      }
+
+      // ✅ Step 3.1: Insert operator into the pool **atomically**, giving the slot back if they're already in one
+      const result = await this.poolOperatorModel
+        .updateOne(
+          { operator: operatorId }, // Ensure operatorId is unique
+          { $setOnInsert: { operator: operatorId, pool: poolId } }, // Insert only if it doesn't exist
+          { upsert: true }, // Insert if not exists
+        )
+        .catch(async (err: any) => {
+          await this.releasePoolSlots(poolId, 1);
+          throw err;
+        });
+
       if (result.upsertedCount === 0) {
+        await this.releasePoolSlots(poolId, 1);
+
         return new ApiResponse<null>(
           400,
           `(joinPool) Operator already joined this pool.`,
         );
       }
 
+      await Promise.all([
+        this.recordMembershipStart([operatorId], poolId),
+        this.invalidatePoolSynergyMultipliers(),
+        this.operatorModel.updateOne(
+          { _id: operatorId },
+          { lastJoinedPool: new Date() },
+        ),
+      ]);
+
+      await this.updatePoolEstimatedEff(poolId).catch((err: any) => {
+        // Log but don't fail the join if the efficiency update fails
+        this.logger.warn(
+          `⚠️ (joinPool) Error updating pool ${poolId} efficiency: ${err.message}`,
+        );
+      });
+
+      await this.missionService.incrementProgress(
+        [operatorId],
+        MissionTargetType.JOIN_POOL,
+      );
+
+      await this.onboardingService.completeStep(
+        [operatorId],
+        OnboardingStep.JOIN_POOL,
+      );
+
+      // The operator already joined, so a failed activity feed write is only logged
+      await this.activityEventModel
+        .create({
+          operatorId,
+          type: ActivityEventType.POOL_JOINED,
+          data: { poolId },
+        })
+        .catch((err: any) => {
+          this.logger.error(
+            `❌ (joinPool) Error recording pool join activity for operator ${operatorId}: ${err.message}`,
+          );
+        });
+
       return new ApiResponse<null>(
         200,
         `(joinPool) Operator successfully joined pool.`,
@@ -88,8 +429,227 @@ export class PoolService {
     }
   }
 
+  /**
+   * Recommends up to `RECOMMENDATIONS.count` pools to an operator without a pool, best match first.
+   *
+   * Only pools the operator can join directly are recommended (not full, not requiring approval, with their trust score and Telegram channel prerequisites met),
+   * ranked as described in `POOLS.RECOMMENDATIONS`. Recommendations are cached per operator for `RECOMMENDATIONS.cacheTTL` seconds.
+   */
+  async recommendPools(
+    operatorId: Types.ObjectId,
+  ): Promise<ApiResponse<{ pools: PoolRecommendationDto[] } | null>> {
+    try {
+      const { count, cacheTTL, reputationWeight, hashWeight, memberFitWeight } =
+        GAME_CONSTANTS.POOLS.RECOMMENDATIONS;
+
+      const [operatorInPool, operator] = await Promise.all([
+        this.poolOperatorModel.exists({ operator: operatorId }),
+        this.operatorModel
+          .findById(operatorId, { trustScore: 1, 'tgProfile.tgId': 1 })
+          .lean(),
+      ]);
+
+      if (!operator) {
+        return new ApiResponse(404, `(recommendPools) Operator not found.`);
+      }
+
+      if (operatorInPool) {
+        return new ApiResponse(
+          400,
+          `(recommendPools) Operator is already in a pool.`,
+        );
+      }
+
+      const cacheKey = `pool:recommendations:${operatorId.toString()}`;
+      const cachedPools = await this.redisService.get(cacheKey);
+      if (cachedPools) {
+        return new ApiResponse(200, `(recommendPools) Pools recommended.`, {
+          pools: JSON.parse(cachedPools),
+        });
+      }
+
+      const since = new Date(Date.now() - 7 * 86_400_000);
+      const [pools, memberStats, hashTotals] = await Promise.all([
+        this.poolModel
+          .find(
+            {},
+            {
+              name: 1,
+              maxOperators: 1,
+              operatorCount: 1,
+              joinPrerequisites: 1,
+              requiresApproval: 1,
+            },
+          )
+          .lean(),
+        this.poolOperatorModel.aggregate<{
+          _id: Types.ObjectId;
+          memberCount: number;
+          reputationScore: number;
+        }>([
+          {
+            $lookup: {
+              from: 'Operators',
+              localField: 'operator',
+              foreignField: '_id',
+              as: 'operatorData',
+            },
+          },
+          {
+            $group: {
+              _id: '$pool',
+              memberCount: { $sum: 1 },
+              reputationScore: {
+                $avg: {
+                  $ifNull: [
+                    { $arrayElemAt: ['$operatorData.trustScore', 0] },
+                    0,
+                  ],
+                },
+              },
+            },
+          },
+        ]),
+        this.poolRewardDistributionModel.aggregate<{
+          _id: Types.ObjectId;
+          hashTotal7d: number;
+        }>([
+          { $match: { createdAt: { $gte: since } } },
+          { $group: { _id: '$poolId', hashTotal7d: { $sum: '$issuedHASH' } } },
+        ]),
+      ]);
+
+      const memberStatsMap = new Map(
+        memberStats.map((stats) => [stats._id.toString(), stats]),
+      );
+      const hashTotalMap = new Map(
+        hashTotals.map((total) => [total._id.toString(), total.hashTotal7d]),
+      );
+      const maxHashTotal = Math.max(
+        0,
+        ...hashTotals.map((total) => total.hashTotal7d),
+      );
+
+      const candidates = pools
+        .map((pool) => {
+          const stats = memberStatsMap.get(pool._id.toString());
+          const memberCount = stats?.memberCount || 0;
+          const maxOperators = pool.maxOperators ?? null;
+          const reputationScore = stats?.reputationScore || 0;
+          const hashTotal7d = hashTotalMap.get(pool._id.toString()) || 0;
+
+          // Pools without an operator limit always have free slots
+          const freeSlotShare =
+            maxOperators === null
+              ? 1
+              : maxOperators > 0
+                ? Math.max(0, maxOperators - memberCount) / maxOperators
+                : 0;
+          const score =
+            reputationScore * reputationWeight +
+            (maxHashTotal > 0 ? hashTotal7d / maxHashTotal : 0) * hashWeight +
+            freeSlotShare * memberFitWeight;
+
+          return {
+            pool,
+            recommendation: {
+              poolId: pool._id.toString(),
+              name: pool.name,
+              memberCount,
+              maxOperators,
+              reputationScore,
+              hashTotal7d,
+              score,
+            },
+          };
+        })
+        .filter(({ pool }) => {
+          const minTrustScore = pool.joinPrerequisites?.minTrustScore;
+
+          if (isPoolFull(pool)) {
+            return false;
+          }
+
+          // Pools requiring approval can't be joined directly (only via a join request)
+          if (pool.requiresApproval) {
+            return false;
+          }
+
+          if (
+            minTrustScore !== null &&
+            minTrustScore !== undefined &&
+            (operator.trustScore || 0) < minTrustScore
+          ) {
+            return false;
+          }
+
+          return (
+            !pool.joinPrerequisites?.tgChannelId || !!operator.tgProfile?.tgId
+          );
+        })
+        .sort((a, b) => b.recommendation.score - a.recommendation.score);
+
+      // Telegram channel membership needs an API call per pool, so it's only checked for the best-ranked pools
+      const recommendations: PoolRecommendationDto[] = [];
+      for (const { pool, recommendation } of candidates) {
+        if (recommendations.length >= count) {
+          break;
+        }
+
+        const tgChannelId = pool.joinPrerequisites?.tgChannelId;
+        if (tgChannelId) {
+          // Pools whose channel membership can't be verified are skipped rather than failing the recommendations
+          const isChannelMember = await isTelegramChatMember(
+            this.configService.get<string>('TELEGRAM_BOT_TOKEN'),
+            tgChannelId,
+            operator.tgProfile.tgId,
+          ).catch((err: any) => {
+            this.logger.warn(
+              `⚠️ (recommendPools) Error checking Telegram channel membership: ${err.message}`,
+            );
+            return null;
+          });
+
+          if (!isChannelMember) {
+            continue;
+          }
+        }
+
+        recommendations.push(recommendation);
+      }
+
+      await this.redisService.set(
+        cacheKey,
+        JSON.stringify(recommendations),
+        cacheTTL,
+      );
+
+      return new ApiResponse(200, `(recommendPools) Pools recommended.`, {
+        pools: recommendations,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(recommendPools) Error recommending pools: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches the reward presets that can be applied when creating a pool.
+   */
+  getRewardPresets(): ApiResponse<{ presets: RewardPresetDto[] }> {
+    return new ApiResponse(200, `(getRewardPresets) Reward presets fetched.`, {
+      presets: GAME_CONSTANTS.POOLS.REWARD_PRESETS,
+    });
+  }
+
   /**
    * Creates a new pool. Bypasses prerequisites and costs. Admin only.
+   *
+   * The pool's reward system is either `rewardSystem` (if provided) or the reward system of the preset with `presetId`.
    */
   async createPoolAdmin(
     // the operator's database ID
@@ -98,138 +658,1336 @@ export class PoolService {
     name: string,
     // the maximum number of operators allowed in the pool
     maxOperators?: number | null,
+    // a custom reward system or a reward preset to apply
+    rewardOptions?: {
+      rewardSystem?: PoolRewardSystemDto;
+      presetId?: number;
+    },
   ): Promise<
     ApiResponse<{
       poolId: string;
     }>
   > {
     try {
+      let rewardSystem: PoolRewardSystemDto | undefined =
+        rewardOptions?.rewardSystem;
+
+      if (!rewardSystem && rewardOptions?.presetId !== undefined) {
+        const preset = GAME_CONSTANTS.POOLS.REWARD_PRESETS.find(
+          (preset) => preset.presetId === rewardOptions.presetId,
+        );
+
+        if (!preset) {
+          return new ApiResponse(
+            404,
+            `(createPoolAdmin) Reward preset ${rewardOptions.presetId} not found.`,
+          );
+        }
+
+        rewardSystem = { ...preset.rewardSystem };
+      }
+
+      // default (balanced) reward system unless a custom one or preset is given
+      rewardSystem ??= {
+        ...GAME_CONSTANTS.POOLS.REWARD_PRESETS[0].rewardSystem,
+      };
+
+      const rewardSystemError = validatePoolRewardSystem(rewardSystem);
+      if (rewardSystemError) {
+        return new ApiResponse(400, `(createPoolAdmin) ${rewardSystemError}`);
+      }
+
       const pool = await this.poolModel.create({
         leaderId: leaderId ? new Types.ObjectId(leaderId) : null,
         name,
         maxOperators,
-        // default reward system
-        rewardSystem: {
-          extractorOperator: 48.0,
-          leader: 4.0,
-          activePoolOperators: 48.0,
-        },
+        rewardSystem,
         // anyone can join
         joinPrerequisites: null,
       });
 
       return new ApiResponse<{ poolId: string }>(
         200,
-        `(createPoolAdmin) Pool created.`,
-        {
-          poolId: pool._id.toString(),
-        },
+        `(createPoolAdmin) Pool created.`,
+        {
+          poolId: pool._id.toString(),
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(createPoolAdmin) Error creating pool: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetch all pools with up-to-date operator counts.
+   *
+   * Optionally filtered by leader. Results are only paginated if `page` or
+   * `limit` is given; otherwise all matching pools are returned.
+   */
+  async getAllPools(
+    projection?: string | Record<string, 1 | 0>,
+    options: { page?: number; limit?: number; leaderId?: string } = {},
+  ): Promise<ApiResponse<{ pools: any[]; total: number }>> {
+    try {
+      const filter = options.leaderId
+        ? { leaderId: new Types.ObjectId(options.leaderId) }
+        : {};
+      const paginate =
+        options.page !== undefined || options.limit !== undefined;
+      const page = options.page ?? 1;
+      const limit = options.limit ?? 20;
+
+      // 1) Fetch the pools with optional projection (and pagination)
+      const poolsQuery = this.poolModel.find(filter).select(projection);
+      if (paginate) {
+        poolsQuery
+          .sort({ _id: 1 })
+          .skip((page - 1) * limit)
+          .limit(limit);
+      }
+
+      const [pools, total] = await Promise.all([
+        poolsQuery.lean(),
+        this.poolModel.countDocuments(filter),
+      ]);
+      const poolIds = pools.map((pool) => pool._id);
+
+      // 2) Aggregate operator counts by pool in one go
+      const counts = await this.poolOperatorModel.aggregate<{
+        _id: any;
+        count: number;
+      }>([
+        { $match: { pool: { $in: poolIds } } },
+        { $group: { _id: '$pool', count: { $sum: 1 } } },
+      ]);
+
+      // 3) Build a lookup map: poolId → operator count
+      const countMap = counts.reduce<Record<string, number>>(
+        (map, { _id, count }) => {
+          map[_id.toString()] = count;
+          return map;
+        },
+        {},
+      );
+
+      // 4) Build a lookup map: poolId → external links
+      const poolLinks = await this.poolLinksModel
+        .find(
+          { poolId: { $in: poolIds } },
+          { _id: 0, createdAt: 0, updatedAt: 0 },
+        )
+        .lean();
+      const linksMap = new Map(
+        poolLinks.map(({ poolId, ...links }) => [poolId.toString(), links]),
+      );
+
+      // 5) Merge in the counts (defaulting to 0 if no operators) and links
+      const poolsWithCounts = pools.map((pool) => ({
+        ...pool,
+        currentOperatorCount: countMap[pool._id.toString()] || 0,
+        links: linksMap.get(pool._id.toString()) ?? null,
+      }));
+
+      return new ApiResponse(200, '(getAllPools) Fetched all pools.', {
+        pools: poolsWithCounts,
+        total,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(getAllPools) Error fetching pools: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Get a pool by its ID.
+   * Ensures the pool's efficiency value is up-to-date.
+   */
+  async getPoolById(
+    poolId: string,
+    projection?: string | Record<string, 1 | 0>,
+  ): Promise<
+    ApiResponse<{
+      pool: Pool | null;
+      links: PoolLinks | null;
+      currentOperatorCount: number;
+      synergyEffBoost: number;
+    }>
+  > {
+    try {
+      // First check if the pool exists and get its last update time
+      const poolWithTimestamp = await this.poolModel
+        .findById(poolId, { lastEffUpdate: 1 })
+        .lean();
+
+      if (!poolWithTimestamp) {
+        return new ApiResponse(
+          404,
+          `(getPoolById) Pool with ID ${poolId} not found`,
+        );
+      }
+
+      // Now fetch the pool with the updated efficiency and requested projection
+      const [pool, links, currentOperatorCount] = await Promise.all([
+        this.poolModel.findById(poolId).select(projection).lean(),
+        this.poolLinksModel
+          .findOne(
+            { poolId: new Types.ObjectId(poolId) },
+            { _id: 0, poolId: 0, createdAt: 0, updatedAt: 0 },
+          )
+          .lean(),
+        this.poolOperatorModel.countDocuments({
+          pool: new Types.ObjectId(poolId),
+        }),
+      ]);
+
+      return new ApiResponse(
+        200,
+        `(getPoolById) Fetched pool with ID ${poolId}.`,
+        {
+          pool,
+          links,
+          currentOperatorCount,
+          // The extra EFF share the pool's drills get from its size (e.g. 0.2 = +20%)
+          synergyEffBoost: poolSynergyMultiplier(currentOperatorCount) - 1,
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(getPoolById) Error fetching pool: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches the past reward distributions of a pool (i.e. the cycles its operators extracted in), newest first.
+   */
+  async fetchPoolRewardHistory(
+    poolId: Types.ObjectId,
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<
+    ApiResponse<{ distributions: PoolRewardDistribution[]; total: number }>
+  > {
+    try {
+      const poolExists = await this.poolModel.exists({ _id: poolId });
+      if (!poolExists) {
+        return new ApiResponse(
+          404,
+          `(fetchPoolRewardHistory) Pool not found.`,
+        );
+      }
+
+      const [distributions, total] = await Promise.all([
+        this.poolRewardDistributionModel
+          .find({ poolId })
+          .sort({ createdAt: -1 })
+          .skip((page - 1) * limit)
+          .limit(limit)
+          .lean(),
+        this.poolRewardDistributionModel.countDocuments({ poolId }),
+      ]);
+
+      return new ApiResponse(
+        200,
+        `(fetchPoolRewardHistory) Pool reward history fetched successfully.`,
+        { distributions: distributions as PoolRewardDistribution[], total },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchPoolRewardHistory) Error fetching pool reward history: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches a pool's aggregate stats: its member count, total EFF, the cycles its operators won
+   * and the $HASH it has been rewarded throughout its lifetime.
+   *
+   * Stats are cached for `STATS_CACHE_TTL` seconds.
+   */
+  async fetchPoolStats(poolId: Types.ObjectId): Promise<
+    ApiResponse<{
+      totalMembers: number;
+      totalEff: number;
+      cyclesWon: number;
+      totalHASHDistributed: number;
+    } | null>
+  > {
+    try {
+      const cacheKey = `pool:stats:${poolId}`;
+      const cached = await this.redisService.get(cacheKey);
+
+      if (cached) {
+        return new ApiResponse(
+          200,
+          `(fetchPoolStats) Pool stats fetched.`,
+          JSON.parse(cached),
+        );
+      }
+
+      const [pool, totalMembers, cyclesWon] = await Promise.all([
+        this.poolModel
+          .findById(poolId, { estimatedEff: 1, totalRewards: 1 })
+          .lean(),
+        this.poolOperatorModel.countDocuments({ pool: poolId }),
+        // A reward distribution is recorded for each cycle a member extracted in
+        this.poolRewardDistributionModel.countDocuments({ poolId }),
+      ]);
+
+      if (!pool) {
+        return new ApiResponse(404, `(fetchPoolStats) Pool not found.`);
+      }
+
+      const stats = {
+        totalMembers,
+        totalEff: pool.estimatedEff || 0,
+        cyclesWon,
+        totalHASHDistributed: pool.totalRewards || 0,
+      };
+
+      await this.redisService.set(
+        cacheKey,
+        JSON.stringify(stats),
+        GAME_CONSTANTS.POOLS.STATS_CACHE_TTL,
+      );
+
+      return new ApiResponse(
+        200,
+        `(fetchPoolStats) Pool stats fetched.`,
+        stats,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchPoolStats) Error fetching pool stats: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Updates a pool's external links (website and socials). Only callable by the pool's leader.
+   *
+   * URLs must use HTTPS and be at most `LINK_MAX_URL_LENGTH` characters long.
+   * Passing `null` removes the link; leaving a field `undefined` keeps it unchanged.
+   */
+  async updatePoolLinks(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    links: Partial<
+      Record<'website' | 'discord' | 'twitter' | 'telegram', string | null>
+    >,
+  ): Promise<ApiResponse<{ links: PoolLinks | null }>> {
+    try {
+      const pool = await this.poolModel
+        .findById(poolId, { leaderId: 1 })
+        .lean();
+
+      if (!pool) {
+        return new ApiResponse(404, `(updatePoolLinks) Pool not found.`);
+      }
+
+      if (!pool.leaderId || !pool.leaderId.equals(leaderId)) {
+        return new ApiResponse(
+          403,
+          `(updatePoolLinks) Only the pool leader can update the pool's links.`,
+        );
+      }
+
+      const updates: Record<string, string | null> = {};
+
+      for (const field of [
+        'website',
+        'discord',
+        'twitter',
+        'telegram',
+      ] as const) {
+        const url = links[field];
+        if (url === undefined) continue;
+
+        if (url !== null && !this.isValidPoolLinkURL(url)) {
+          return new ApiResponse(
+            400,
+            `(updatePoolLinks) Invalid ${field} URL. URLs must use HTTPS and be at most ${GAME_CONSTANTS.POOLS.LINK_MAX_URL_LENGTH} characters long.`,
+          );
+        }
+
+        updates[field] = url;
+      }
+
+      if (Object.keys(updates).length === 0) {
+        return new ApiResponse(400, `(updatePoolLinks) No links provided.`);
+      }
+
+      const updatedLinks = await this.poolLinksModel
+        .findOneAndUpdate(
+          { poolId },
+          { $set: updates },
+          {
+            new: true,
+            upsert: true,
+            projection: { _id: 0, poolId: 0, createdAt: 0, updatedAt: 0 },
+          },
+        )
+        .lean();
+
+      return new ApiResponse(200, `(updatePoolLinks) Pool links updated.`, {
+        links: updatedLinks,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(updatePoolLinks) Error updating pool links: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Checks that a pool link URL is HTTPS and not too long.
+   */
+  private isValidPoolLinkURL(url: string): boolean {
+    if (url.length > GAME_CONSTANTS.POOLS.LINK_MAX_URL_LENGTH) {
+      return false;
+    }
+
+    try {
+      return new URL(url).protocol === 'https:';
+    } catch {
+      return false;
+    }
+  }
+
+  /**
+   * Update pool settings (e.g., maxOperators, joinPrerequisites).
+   *
+   * Throws a `BadRequestException` if `updates` contains an invalid reward system.
+   */
+  async updatePool(poolId: string, updates: Partial<Pool>) {
+    if (updates.rewardSystem) {
+      const rewardSystemError = validatePoolRewardSystem(updates.rewardSystem);
+      if (rewardSystemError) {
+        throw new BadRequestException(`(updatePool) ${rewardSystemError}`);
+      }
+    }
+
+    return this.poolModel.findByIdAndUpdate(poolId, updates, { new: true });
+  }
+
+  /**
+   * Updates a pool's `maxOperators`, `joinPrerequisites` and/or `requiresApproval`. Only callable by the pool's leader.
+   *
+   * Only the settings that are provided are updated. `maxOperators` can't be lowered below the pool's current member count.
+   */
+  async updatePoolSettings(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    settings: {
+      maxOperators?: number;
+      joinPrerequisites?: PoolPrerequisites | null;
+      requiresApproval?: boolean;
+    },
+  ): Promise<ApiResponse<null>> {
+    try {
+      const pool = await this.poolModel
+        .findById(poolId, { leaderId: 1, maxOperators: 1 })
+        .lean();
+
+      if (!pool) {
+        return new ApiResponse<null>(
+          404,
+          `(updatePoolSettings) Pool not found.`,
+        );
+      }
+
+      if (!pool.leaderId || !pool.leaderId.equals(leaderId)) {
+        return new ApiResponse<null>(
+          403,
+          `(updatePoolSettings) Only the pool leader can update the pool settings.`,
+        );
+      }
+
+      const updates: Record<string, unknown> = {};
+
+      if (settings.maxOperators !== undefined) {
+        if (
+          !Number.isInteger(settings.maxOperators) ||
+          settings.maxOperators < 1
+        ) {
+          return new ApiResponse<null>(
+            400,
+            `(updatePoolSettings) Max operators must be a positive integer.`,
+          );
+        }
+
+        // Only a shrink can drop below the current member count
+        if (
+          pool.maxOperators == null ||
+          settings.maxOperators < pool.maxOperators
+        ) {
+          const memberCount = await this.poolOperatorModel.countDocuments({
+            pool: poolId,
+          });
+
+          if (settings.maxOperators < memberCount) {
+            return new ApiResponse<null>(
+              422,
+              `(updatePoolSettings) Max operators cannot be lower than the current member count (${memberCount}).`,
+            );
+          }
+        }
+
+        updates.maxOperators = settings.maxOperators;
+      }
+
+      if (settings.joinPrerequisites !== undefined) {
+        if (settings.joinPrerequisites) {
+          const prerequisitesError = validatePoolPrerequisites(
+            settings.joinPrerequisites,
+          );
+          if (prerequisitesError) {
+            return new ApiResponse<null>(
+              400,
+              `(updatePoolSettings) ${prerequisitesError}`,
+            );
+          }
+        }
+
+        updates.joinPrerequisites = settings.joinPrerequisites
+          ? {
+              tgChannelId: settings.joinPrerequisites.tgChannelId ?? null,
+              minTrustScore: settings.joinPrerequisites.minTrustScore ?? null,
+            }
+          : null;
+      }
+
+      if (settings.requiresApproval !== undefined) {
+        updates.requiresApproval = settings.requiresApproval;
+      }
+
+      if (Object.keys(updates).length === 0) {
+        return new ApiResponse<null>(
+          400,
+          `(updatePoolSettings) No settings to update.`,
+        );
+      }
+
+      await this.poolModel.updateOne({ _id: poolId }, { $set: updates });
+
+      this.logger.log(
+        `⚙️ (updatePoolSettings) Leader ${leaderId} updated the settings of pool ${poolId}: ${Object.keys(updates).join(', ')}.`,
+      );
+
+      return new ApiResponse<null>(
+        200,
+        `(updatePoolSettings) Pool settings updated.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(updatePoolSettings) Error updating pool settings: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Reclaims the slots of dormant operators in a pool. Only callable by the pool's leader.
+   *
+   * An operator is dormant if they haven't had a drilling session in the last `DORMANT_OPERATOR_DAYS` days.
+   * Dormant operators are kicked from the pool, and this can only be done once every `DORMANT_SLOT_RECLAIM_COOLDOWN` seconds per pool.
+   */
+  async reclaimDormantSlots(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+  ): Promise<ApiResponse<{ reclaimedCount: number; freedSlots: number }>> {
+    try {
+      const pool = await this.poolModel
+        .findById(poolId, { leaderId: 1, maxOperators: 1 })
+        .lean();
+
+      if (!pool) {
+        return new ApiResponse(404, `(reclaimDormantSlots) Pool not found.`);
+      }
+
+      if (!pool.leaderId || !pool.leaderId.equals(leaderId)) {
+        return new ApiResponse(
+          403,
+          `(reclaimDormantSlots) Only the pool leader can reclaim dormant slots.`,
+        );
+      }
+
+      const cooldownKey = `pool:${poolId.toString()}:reclaim-dormant-slots`;
+      if (await this.redisService.get(cooldownKey)) {
+        return new ApiResponse(
+          429,
+          `(reclaimDormantSlots) Dormant slots can only be reclaimed once every ${GAME_CONSTANTS.POOLS.DORMANT_SLOT_RECLAIM_COOLDOWN / 60 / 60} hours.`,
+        );
+      }
+
+      const cutoff = new Date(
+        Date.now() - GAME_CONSTANTS.POOLS.DORMANT_OPERATOR_DAYS * 86_400_000,
+      );
+
+      // Only operators that have been in the pool for longer than the dormancy window can be dormant
+      const members = await this.poolOperatorModel
+        .find(
+          {
+            pool: poolId,
+            operator: { $ne: leaderId },
+            createdAt: { $lt: cutoff },
+          },
+          { operator: 1 },
+        )
+        .lean();
+
+      const memberIds = members.map(
+        (member) => member.operator as Types.ObjectId,
+      );
+
+      // Operators with an ongoing session or a session that ended after the cutoff are still active
+      const activeMemberIds: Types.ObjectId[] =
+        await this.drillingSessionModel.distinct('operatorId', {
+          operatorId: { $in: memberIds },
+          $or: [{ endTime: null }, { endTime: { $gte: cutoff } }],
+        });
+
+      const activeMemberSet = new Set(
+        activeMemberIds.map((id) => id.toString()),
+      );
+      const dormantMemberIds = memberIds.filter(
+        (id) => !activeMemberSet.has(id.toString()),
+      );
+
+      // Dormant operators are removed one by one like any other member, skipping those that started a session in the meantime
+      let reclaimedCount = 0;
+      for (const dormantMemberId of dormantMemberIds) {
+        if (await this.hasActiveDrillingSession(dormantMemberId)) {
+          continue;
+        }
+
+        if (await this.removePoolOperator(dormantMemberId, poolId)) {
+          reclaimedCount++;
+        }
+      }
+
+      if (reclaimedCount > 0) {
+        this.logger.log(
+          `🧹 (reclaimDormantSlots) Kicked ${reclaimedCount} operators from pool ${poolId} (reason: dormant).`,
+        );
+
+        await this.updatePoolEstimatedEff(poolId);
+      }
+
+      await this.redisService.set(
+        cooldownKey,
+        Date.now().toString(),
+        GAME_CONSTANTS.POOLS.DORMANT_SLOT_RECLAIM_COOLDOWN,
+      );
+
+      const remainingMembers = await this.poolOperatorModel.countDocuments({
+        pool: poolId,
+      });
+      const freedSlots =
+        pool.maxOperators == null
+          ? reclaimedCount
+          : Math.max(0, pool.maxOperators - remainingMembers);
+
+      return new ApiResponse(
+        200,
+        `(reclaimDormantSlots) Reclaimed ${reclaimedCount} dormant slots.`,
+        { reclaimedCount, freedSlots },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(reclaimDormantSlots) Error reclaiming dormant slots: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Removes an operator from a pool they are a member of.
+   *
+   * The pool leader can't leave (leadership must be transferred first), and operators can't leave
+   * while they have an active drilling session.
+   */
+  async leavePool(
+    operatorId: Types.ObjectId,
+    poolId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const pool = await this.poolModel
+        .findById(poolId, { leaderId: 1 })
+        .lean();
+
+      if (!pool) {
+        return new ApiResponse<null>(404, `(leavePool) Pool not found.`);
+      }
+
+      if (pool.leaderId && pool.leaderId.equals(operatorId)) {
+        return new ApiResponse<null>(
+          403,
+          `(leavePool) The pool leader must transfer leadership before leaving the pool.`,
+        );
+      }
+
+      const isMember = await this.poolOperatorModel.exists({
+        operator: operatorId,
+        pool: poolId,
+      });
+
+      if (!isMember) {
+        return new ApiResponse<null>(
+          404,
+          `(leavePool) Operator is not a member of this pool.`,
+        );
+      }
+
+      if (await this.hasActiveDrillingSession(operatorId)) {
+        return new ApiResponse<null>(
+          400,
+          `(leavePool) Operator can't leave the pool during an active drilling session.`,
+        );
+      }
+
+      await this.removePoolOperator(operatorId, poolId);
+      await this.updatePoolEstimatedEff(poolId);
+
+      this.logger.log(
+        `👋 (leavePool) Operator ${operatorId} left pool ${poolId}.`,
+      );
+
+      return new ApiResponse<null>(
+        200,
+        `(leavePool) Operator successfully left pool.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(leavePool) Error leaving pool: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Hands off a pool's leadership to another member of the pool. Only callable by the pool's current leader.
+   *
+   * The leader is only replaced if it's still `currentLeaderId` at the time of the update, so concurrent transfers can't both succeed.
+   */
+  async transferPoolLeadership(
+    currentLeaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    newLeaderId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const pool = await this.poolModel
+        .findById(poolId, { leaderId: 1 })
+        .lean();
+
+      if (!pool) {
+        return new ApiResponse<null>(
+          404,
+          `(transferPoolLeadership) Pool not found.`,
+        );
+      }
+
+      if (!pool.leaderId || !pool.leaderId.equals(currentLeaderId)) {
+        return new ApiResponse<null>(
+          403,
+          `(transferPoolLeadership) Only the pool leader can transfer leadership.`,
+        );
+      }
+
+      if (newLeaderId.equals(currentLeaderId)) {
+        return new ApiResponse<null>(
+          400,
+          `(transferPoolLeadership) Operator is already the pool leader.`,
+        );
+      }
+
+      const isMember = await this.poolOperatorModel.exists({
+        operator: newLeaderId,
+        pool: poolId,
+      });
+
+      if (!isMember) {
+        return new ApiResponse<null>(
+          409,
+          `(transferPoolLeadership) The new leader must be a member of the pool.`,
+        );
+      }
+
+      const result = await this.poolModel.updateOne(
+        { _id: poolId, leaderId: currentLeaderId },
+        { $set: { leaderId: newLeaderId } },
+      );
+
+      if (result.modifiedCount === 0) {
+        return new ApiResponse<null>(
+          409,
+          `(transferPoolLeadership) Pool leadership was changed in the meantime.`,
+        );
+      }
+
+      this.logger.log(
+        `👑 (transferPoolLeadership) Transferred leadership of pool ${poolId} from ${currentLeaderId} to ${newLeaderId}.`,
+      );
+
+      return new ApiResponse<null>(
+        200,
+        `(transferPoolLeadership) Pool leadership transferred.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(transferPoolLeadership) Error transferring pool leadership: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Kicks a member out of a pool. Only callable by the pool's leader.
+   *
+   * Members can't be kicked while they have an active drilling session.
+   */
+  async kickPoolOperator(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    targetOperatorId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const pool = await this.poolModel
+        .findById(poolId, { leaderId: 1 })
+        .lean();
+
+      if (!pool) {
+        return new ApiResponse<null>(404, `(kickPoolOperator) Pool not found.`);
+      }
+
+      if (!pool.leaderId || !pool.leaderId.equals(leaderId)) {
+        return new ApiResponse<null>(
+          403,
+          `(kickPoolOperator) Only the pool leader can kick pool operators.`,
+        );
+      }
+
+      if (targetOperatorId.equals(leaderId)) {
+        return new ApiResponse<null>(
+          400,
+          `(kickPoolOperator) The pool leader can't kick themselves.`,
+        );
+      }
+
+      const isMember = await this.poolOperatorModel.exists({
+        operator: targetOperatorId,
+        pool: poolId,
+      });
+
+      if (!isMember) {
+        return new ApiResponse<null>(
+          404,
+          `(kickPoolOperator) Operator is not a member of this pool.`,
+        );
+      }
+
+      if (await this.hasActiveDrillingSession(targetOperatorId)) {
+        return new ApiResponse<null>(
+          400,
+          `(kickPoolOperator) Operator can't be kicked during an active drilling session.`,
+        );
+      }
+
+      await this.removePoolOperator(targetOperatorId, poolId);
+      await this.updatePoolEstimatedEff(poolId);
+
+      this.logger.log(
+        `🧹 (kickPoolOperator) Kicked operator ${targetOperatorId} from pool ${poolId} (reason: kicked by leader).`,
+      );
+
+      return new ApiResponse<null>(
+        200,
+        `(kickPoolOperator) Operator successfully kicked from pool.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(kickPoolOperator) Error kicking pool operator: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Opens a membership history record for each operator that joined (or was moved into) a pool.
+   *
+   * Failures are only logged, since the history should never block membership changes.
+   */
+  async recordMembershipStart(
+    operatorIds: Types.ObjectId[],
+    poolId: Types.ObjectId,
+  ): Promise<void> {
+    try {
+      const memberFrom = new Date();
+      await this.poolMembershipHistoryModel.insertMany(
+        operatorIds.map((operatorId) => ({ operatorId, poolId, memberFrom })),
+      );
+    } catch (err: any) {
+      this.logger.error(
+        `❌ (recordMembershipStart) Error recording pool ${poolId} membership start: ${err.message}`,
+      );
+    }
+  }
+
+  /**
+   * Closes the open membership history records of operators that left (or were kicked or moved out of) a pool.
+   *
+   * Like `recordMembershipStart`, failures are only logged.
+   */
+  async recordMembershipEnd(
+    operatorIds: Types.ObjectId[],
+    poolId: Types.ObjectId,
+  ): Promise<void> {
+    try {
+      await this.poolMembershipHistoryModel.updateMany(
+        { operatorId: { $in: operatorIds }, poolId, memberTo: null },
+        { $set: { memberTo: new Date() } },
+      );
+    } catch (err: any) {
+      this.logger.error(
+        `❌ (recordMembershipEnd) Error recording pool ${poolId} membership end: ${err.message}`,
+      );
+    }
+  }
+
+  /**
+   * Invalidates the pool synergy multipliers cached by the drilling cycle. Must be called whenever pool memberships change.
+   *
+   * Failures are only logged; the cycle refetches the multipliers after `SYNERGY_MULTIPLIER_CACHE_TTL` seconds at the latest.
+   */
+  async invalidatePoolSynergyMultipliers(): Promise<void> {
+    try {
+      await this.redisService.increment('pool:synergy-multipliers:version');
+    } catch (err: any) {
+      this.logger.error(
+        `❌ (invalidatePoolSynergyMultipliers) Error invalidating pool synergy multipliers: ${err.message}`,
+      );
+    }
+  }
+
+  /**
+   * Fetches what an operator contributed to each pool they've been a member of, newest membership first.
+   *
+   * The $HASH earned and extraction wins are taken from the drilling cycles that ended while the operator was a member.
+   */
+  async fetchCrossPoolContributions(operatorId: Types.ObjectId): Promise<
+    ApiResponse<{
+      contributions: {
+        poolId: Types.ObjectId;
+        poolName: string | null;
+        memberFrom: Date;
+        memberTo: Date | null;
+        hashEarnedWhileMember: number;
+        extractionWinsWhileMember: number;
+      }[];
+    }>
+  > {
+    try {
+      const memberships = await this.poolMembershipHistoryModel
+        .find({ operatorId })
+        .sort({ memberFrom: -1 })
+        .lean();
+
+      const pools = await this.poolModel
+        .find(
+          { _id: { $in: memberships.map((membership) => membership.poolId) } },
+          { name: 1 },
+        )
+        .lean();
+      const poolNameMap = new Map(
+        pools.map((pool) => [pool._id.toString(), pool.name]),
+      );
+
+      const contributions = await Promise.all(
+        memberships.map(async (membership) => {
+          const cycleWindow = {
+            endTime: {
+              $gte: membership.memberFrom,
+              $lte: membership.memberTo ?? new Date(),
+            },
+          };
+
+          const [firstCycle, lastCycle, extractionWinsWhileMember] =
+            await Promise.all([
+              this.drillingCycleModel
+                .findOne(cycleWindow, { cycleNumber: 1 })
+                .sort({ endTime: 1 })
+                .lean(),
+              this.drillingCycleModel
+                .findOne(cycleWindow, { cycleNumber: 1 })
+                .sort({ endTime: -1 })
+                .lean(),
+              this.drillingCycleModel.countDocuments({
+                ...cycleWindow,
+                extractorOperatorId: operatorId,
+              }),
+            ]);
+
+          let hashEarnedWhileMember = 0;
+          if (firstCycle && lastCycle) {
+            const [earned] =
+              await this.drillingCycleRewardShareModel.aggregate<{
+                total: number;
+              }>([
+                {
+                  $match: {
+                    operatorId,
+                    cycleNumber: {
+                      $gte: firstCycle.cycleNumber,
+                      $lte: lastCycle.cycleNumber,
+                    },
+                  },
+                },
+                { $group: { _id: null, total: { $sum: '$amount' } } },
+              ]);
+            hashEarnedWhileMember = earned?.total ?? 0;
+          }
+
+          return {
+            poolId: membership.poolId,
+            poolName: poolNameMap.get(membership.poolId.toString()) ?? null,
+            memberFrom: membership.memberFrom,
+            memberTo: membership.memberTo,
+            hashEarnedWhileMember,
+            extractionWinsWhileMember,
+          };
+        }),
+      );
+
+      return new ApiResponse(
+        200,
+        `(fetchCrossPoolContributions) Cross-pool contributions fetched successfully.`,
+        { contributions },
       );
     } catch (err: any) {
       throw new InternalServerErrorException(
-        new ApiResponse<null>(
+        new ApiResponse(
           500,
-          `(createPoolAdmin) Error creating pool: ${err.message}`,
+          `(fetchCrossPoolContributions) Error fetching cross-pool contributions: ${err.message}`,
         ),
       );
     }
   }
 
   /**
-   * Fetch all pools with up-to-date operator counts.
+   * Moves $HASH from one pool's treasury to another's and returns the amount moved.
+   *
+   * `amountFor` gets the source treasury's current balance and returns how much of it to move. The source is only debited
+   * while it still holds that amount (a conditional `$inc`), and re-read up to 3 times if it changed in the meantime.
    */
-  async getAllPools(
-    projection?: string | Record<string, 1 | 0>,
-  ): Promise<ApiResponse<{ pools: any[] }>> {
-    try {
-      // 1) Aggregate operator counts by pool in one go
-      const counts = await this.poolOperatorModel.aggregate<{
-        _id: any;
-        count: number;
-      }>([{ $group: { _id: '$pool', count: { $sum: 1 } } }]);
+  async transferTreasuryHASH(
+    fromPoolId: Types.ObjectId,
+    toPoolId: Types.ObjectId,
+    amountFor: (treasuryHASH: number) => number,
+  ): Promise<number> {
+    const maxRetries = 3;
 
-      // 2) Build a lookup map: poolId → operator count
-      const countMap = counts.reduce<Record<string, number>>(
-        (map, { _id, count }) => {
-          map[_id.toString()] = count;
-          return map;
-        },
-        {},
+    for (let attempt = 1; attempt <= maxRetries; attempt++) {
+      const fromPool = await this.poolModel
+        .findById(fromPoolId, { treasuryHASH: 1 })
+        .lean();
+      const treasuryHASH = Math.max(0, fromPool?.treasuryHASH || 0);
+      const amount = Math.min(treasuryHASH, amountFor(treasuryHASH));
+
+      if (!(amount > 0)) {
+        return 0;
+      }
+
+      const debit = await this.poolModel.updateOne(
+        { _id: fromPoolId, treasuryHASH: { $gte: amount } },
+        { $inc: { treasuryHASH: -amount } },
       );
 
-      // 3) Fetch all pools with optional projection
-      const pools = await this.poolModel.find().select(projection).lean();
+      // The treasury was spent from in the meantime, so re-read it
+      if (debit.modifiedCount === 0) {
+        continue;
+      }
 
-      // 4) Merge in the counts (defaulting to 0 if no operators)
-      const poolsWithCounts = pools.map((pool) => ({
-        ...pool,
-        currentOperatorCount: countMap[pool._id.toString()] || 0,
-      }));
+      try {
+        await this.poolModel.updateOne(
+          { _id: toPoolId },
+          { $inc: { treasuryHASH: amount } },
+        );
+      } catch (err: any) {
+        // Refund the source pool so the $HASH isn't lost
+        await this.poolModel.updateOne(
+          { _id: fromPoolId },
+          { $inc: { treasuryHASH: amount } },
+        );
+        throw err;
+      }
 
-      return new ApiResponse(200, '(getAllPools) Fetched all pools.', {
-        pools: poolsWithCounts,
-      });
-    } catch (err: any) {
-      throw new InternalServerErrorException(
-        new ApiResponse<null>(
-          500,
-          `(getAllPools) Error fetching pools: ${err.message}`,
-        ),
-      );
+      return amount;
+    }
+
+    this.logger.warn(
+      `⚠️ (transferTreasuryHASH) Pool ${fromPoolId}'s treasury kept changing, nothing was moved to pool ${toPoolId}.`,
+    );
+
+    return 0;
+  }
+
+  /**
+   * Removes an operator from a pool: deletes their membership, frees their slot and closes their membership history record.
+   *
+   * Returns `false` if the operator was no longer a member. Permission checks and updating the pool's estimated EFF are up to the caller.
+   */
+  private async removePoolOperator(
+    operatorId: Types.ObjectId,
+    poolId: Types.ObjectId,
+  ): Promise<boolean> {
+    const { deletedCount } = await this.poolOperatorModel.deleteOne({
+      operator: operatorId,
+      pool: poolId,
+    });
+
+    if (deletedCount === 0) {
+      return false;
     }
+
+    await this.releasePoolSlots(poolId, deletedCount);
+    await this.recordMembershipEnd([operatorId], poolId);
+    await this.invalidatePoolSynergyMultipliers();
+
+    return true;
   }
 
   /**
-   * Get a pool by its ID.
-   * Ensures the pool's efficiency value is up-to-date.
+   * Frees `count` of a pool's reserved operator slots, e.g. after operators left the pool or a join was aborted.
    */
-  async getPoolById(
-    poolId: string,
-    projection?: string | Record<string, 1 | 0>,
-  ): Promise<ApiResponse<{ pool: Pool | null }>> {
+  private async releasePoolSlots(
+    poolId: Types.ObjectId,
+    count: number,
+  ): Promise<void> {
+    if (count <= 0) {
+      return;
+    }
+
+    await this.poolModel.updateOne(
+      { _id: poolId },
+      { $inc: { operatorCount: -count } },
+    );
+  }
+
+  /**
+   * Checks whether an operator currently has an active (not yet ended) drilling session.
+   */
+  private async hasActiveDrillingSession(
+    operatorId: Types.ObjectId,
+  ): Promise<boolean> {
+    const activeSession = await this.drillingSessionModel.exists({
+      operatorId,
+      endTime: null,
+    });
+
+    return !!activeSession;
+  }
+
+  /**
+   * Splits a pool into two sibling pools. Only callable by the pool's leader once the pool has grown beyond `SPLIT_SOFT_CAP` operators.
+   *
+   * The new pool is led by `newLeaderId` and inherits the original pool's settings. The original pool's treasury is distributed
+   * proportionally to the rewards earned by the operators on each side. `totalRewards` stays with the original pool, since it only
+   * records what that pool has earned.
+   */
+  async splitPool(
+    leaderId: Types.ObjectId,
+    poolId: Types.ObjectId,
+    newLeaderId: Types.ObjectId,
+    memberIdsForNewPool: Types.ObjectId[],
+    name?: string,
+  ): Promise<
+    ApiResponse<{
+      newPoolId: string;
+      movedOperatorCount: number;
+      transferredTreasuryHASH: number;
+    } | null>
+  > {
     try {
-      // First check if the pool exists and get its last update time
-      const poolWithTimestamp = await this.poolModel
-        .findById(poolId, { lastEffUpdate: 1 })
+      const pool = await this.poolModel
+        .findById(poolId, {
+          leaderId: 1,
+          name: 1,
+          maxOperators: 1,
+          rewardSystem: 1,
+          joinPrerequisites: 1,
+        })
         .lean();
 
-      if (!poolWithTimestamp) {
-        throw new NotFoundException(
-          new ApiResponse<null>(
-            404,
-            `(getPoolById) Pool with ID ${poolId} not found`,
-          ),
+      if (!pool) {
+        return new ApiResponse(404, `(splitPool) Pool not found.`);
+      }
+
+      if (!pool.leaderId || !pool.leaderId.equals(leaderId)) {
+        return new ApiResponse(
+          403,
+          `(splitPool) Only the pool leader can split the pool.`,
         );
       }
 
-      // Now fetch the pool with the updated efficiency and requested projection
-      const pool = await this.poolModel
-        .findById(poolId)
-        .select(projection)
+      const memberCount = await this.poolOperatorModel.countDocuments({
+        pool: poolId,
+      });
+
+      if (memberCount <= GAME_CONSTANTS.POOLS.SPLIT_SOFT_CAP) {
+        return new ApiResponse(
+          400,
+          `(splitPool) Pool needs more than ${GAME_CONSTANTS.POOLS.SPLIT_SOFT_CAP} operators to be split.`,
+        );
+      }
+
+      // The new leader always moves to the new pool
+      const movedIds = [
+        ...new Map(
+          [newLeaderId, ...memberIdsForNewPool].map((id) => [
+            id.toString(),
+            id,
+          ]),
+        ).values(),
+      ];
+
+      if (movedIds.some((id) => id.equals(leaderId))) {
+        return new ApiResponse(
+          400,
+          `(splitPool) The current leader cannot be moved to the new pool.`,
+        );
+      }
+
+      const movedMembers = await this.poolOperatorModel
+        .find(
+          { pool: poolId, operator: { $in: movedIds } },
+          { operator: 1, totalRewards: 1 },
+        )
         .lean();
 
-      return new ApiResponse<{ pool: Pool | null }>(
-        200,
-        `(getPoolById) Fetched pool with ID ${poolId}.`,
-        { pool },
+      if (movedMembers.length !== movedIds.length) {
+        return new ApiResponse(
+          400,
+          `(splitPool) All moved operators (including the new leader) must be members of the pool.`,
+        );
+      }
+
+      // Share of the pool's rewards earned by the moved operators (falls back to headcount if nothing was earned yet)
+      const [rewardsAgg] = await this.poolOperatorModel.aggregate<{
+        totalRewards: number;
+      }>([
+        { $match: { pool: poolId } },
+        { $group: { _id: null, totalRewards: { $sum: '$totalRewards' } } },
+      ]);
+
+      const poolMemberRewards = rewardsAgg?.totalRewards || 0;
+      const movedMemberRewards = movedMembers.reduce(
+        (sum, member) => sum + (member.totalRewards || 0),
+        0,
+      );
+      const movedShare =
+        poolMemberRewards > 0
+          ? movedMemberRewards / poolMemberRewards
+          : movedMembers.length / memberCount;
+
+      const newPool = await this.poolModel.create({
+        leaderId: newLeaderId,
+        name:
+          name ||
+          `${pool.name.slice(0, 11)}-${Math.random().toString(36).slice(2, 6)}`,
+        maxOperators: pool.maxOperators,
+        rewardSystem: pool.rewardSystem,
+        joinPrerequisites: pool.joinPrerequisites,
+        operatorCount: movedIds.length,
+        siblingPoolId: poolId,
+      });
+
+      await Promise.all([
+        this.poolOperatorModel.updateMany(
+          { pool: poolId, operator: { $in: movedIds } },
+          { $set: { pool: newPool._id } },
+        ),
+        this.poolModel.updateOne(
+          { _id: poolId },
+          {
+            $set: { siblingPoolId: newPool._id },
+            $inc: { operatorCount: -movedIds.length },
+          },
+        ),
+      ]);
+
+      await this.recordMembershipEnd(movedIds, poolId);
+      await this.recordMembershipStart(movedIds, newPool._id);
+      await this.invalidatePoolSynergyMultipliers();
+
+      const transferredTreasuryHASH = await this.transferTreasuryHASH(
+        poolId,
+        newPool._id,
+        (treasuryHASH) => treasuryHASH * movedShare,
       );
+
+      await Promise.all([
+        this.updatePoolEstimatedEff(poolId),
+        this.updatePoolEstimatedEff(newPool._id),
+      ]);
+
+      this.logger.log(
+        `🪓 (splitPool) Split pool ${poolId} into sibling pool ${newPool._id} led by ${newLeaderId} with ${movedIds.length} operators.`,
+      );
+
+      return new ApiResponse(200, `(splitPool) Pool split successfully.`, {
+        newPoolId: newPool._id.toString(),
+        movedOperatorCount: movedIds.length,
+        transferredTreasuryHASH,
+      });
     } catch (err: any) {
+      // Duplicate key error on the pool name
+      if (err.code === 11000) {
+        return new ApiResponse(
+          400,
+          `(splitPool) A pool with this name already exists.`,
+        );
+      }
+
       throw new InternalServerErrorException(
-        new ApiResponse<null>(
+        new ApiResponse(
           500,
-          `(getPoolById) Error fetching pool: ${err.message}`,
+          `(splitPool) Error splitting pool: ${err.message}`,
         ),
       );
     }
   }
 
-  /**
-   * Update pool settings (e.g., maxOperators, joinPrerequisites).
-   */
-  async updatePool(poolId: string, updates: Partial<Pool>) {
-    return this.poolModel.findByIdAndUpdate(poolId, updates, { new: true });
-  }
-
   /**
    * Fetches a random public pool ID that still has available slots.
    */
@@ -295,7 +2053,8 @@ export class PoolService {
 
   /**
    * Updates the estimated efficiency (estimatedEff) for a specific pool.
-   * Calculates the sum of weightedEff (cumulativeEff * effMultiplier) for all operators in the pool.
+   * Calculates the sum of weightedEff (cumulativeEff * effMultiplier) for all operators in the pool,
+   * multiplied by the pool's synergy multiplier.
    * Uses a more efficient aggregation pipeline for performance.
    *
    * @param poolId The ID of the pool to update
@@ -368,6 +2127,9 @@ export class PoolService {
         operatorCount = aggregationResult[0].count || 0;
       }
 
+      // Apply the pool's synergy multiplier based on its member count
+      totalWeightedEff *= poolSynergyMultiplier(operatorCount);
+
       // Update pool record
       await this.poolModel.findByIdAndUpdate(poolId, {
         estimatedEff: totalWeightedEff,
@@ -450,7 +2212,9 @@ export class PoolService {
     populate: boolean = true,
   ): Promise<
     ApiResponse<{
-      operators: Partial<PoolOperator[]>;
+      operators: (Partial<PoolOperator> & {
+        effTier: PoolMemberEffTier | null;
+      })[];
       total: number;
       page: number;
       limit: number;
@@ -501,7 +2265,7 @@ export class PoolService {
       }
 
       // Execute count and find in parallel for efficiency
-      const [totalCount, operators] = await Promise.all([
+      const [totalCount, operators, memberEffs] = await Promise.all([
         // Count total documents for pagination
         this.poolOperatorModel.countDocuments({
           pool: new Types.ObjectId(poolId),
@@ -512,16 +2276,70 @@ export class PoolService {
           .skip((page - 1) * limit)
           .limit(limit)
           .lean(),
+
+        // Get the EFF of every member, as efficiency tiers are relative to the whole pool's average
+        this.poolOperatorModel.aggregate<{
+          _id: Types.ObjectId;
+          cumulativeEff: number;
+        }>([
+          { $match: { pool: new Types.ObjectId(poolId) } },
+          {
+            $lookup: {
+              from: 'Operators',
+              localField: 'operator',
+              foreignField: '_id',
+              as: 'operatorData',
+            },
+          },
+          {
+            $project: {
+              _id: '$operator',
+              cumulativeEff: {
+                $ifNull: [
+                  { $arrayElemAt: ['$operatorData.cumulativeEff', 0] },
+                  0,
+                ],
+              },
+            },
+          },
+        ]),
       ]);
 
       // Calculate total pages
       const totalPages = Math.ceil(totalCount / limit);
 
+      // Compute each member's efficiency tier (null if the operator was left out by the projection)
+      const averageEff =
+        memberEffs.length > 0
+          ? memberEffs.reduce((sum, member) => sum + member.cumulativeEff, 0) /
+            memberEffs.length
+          : 0;
+      const memberEffMap = new Map(
+        memberEffs.map((member) => [
+          member._id.toString(),
+          member.cumulativeEff,
+        ]),
+      );
+      const operatorsWithEffTiers = operators.map((poolOperator) => {
+        const operator = poolOperator.operator as Types.ObjectId | Operator;
+        const operatorId =
+          operator instanceof Types.ObjectId ? operator : operator?._id;
+        const eff = operatorId
+          ? memberEffMap.get(operatorId.toString())
+          : undefined;
+
+        return {
+          ...poolOperator,
+          effTier:
+            eff === undefined ? null : poolMemberEffTier(eff, averageEff),
+        };
+      });
+
       return new ApiResponse(
         200,
         `(getPoolOperators) Successfully fetched operators for pool ${poolId}`,
         {
-          operators,
+          operators: operatorsWithEffTiers,
           total: totalCount,
           page,
           limit,
@@ -538,6 +2356,233 @@ export class PoolService {
     }
   }
 
+  /**
+   * Fetches when a pool is most active as a 7x24 matrix, indexed by day of the week (0 = Sunday) and hour (UTC).
+   *
+   * Each cell is computed from the drilling sessions the pool's current members started in that hour
+   * over the past `weeks` weeks, averaged per week.
+   */
+  async fetchPoolActivityHeatmap(
+    poolId: Types.ObjectId,
+    weeks: number = 4,
+  ): Promise<ApiResponse<GetPoolActivityHeatmapResponseDto>> {
+    try {
+      const poolExists = await this.poolModel.exists({ _id: poolId });
+      if (!poolExists) {
+        return new ApiResponse(
+          404,
+          `(fetchPoolActivityHeatmap) Pool not found.`,
+        );
+      }
+
+      const memberIds = await this.poolOperatorModel.distinct('operator', {
+        pool: poolId,
+      });
+      const since = new Date(Date.now() - weeks * 7 * 86_400_000);
+
+      const slots: Array<{
+        day: number;
+        hour: number;
+        memberHours: number;
+        earnedHASH: number;
+      }> = await this.drillingSessionModel.aggregate([
+        {
+          $match: {
+            operatorId: { $in: memberIds },
+            startTime: { $gte: since },
+          },
+        },
+        // One entry per member per hour they started drilling in
+        {
+          $group: {
+            _id: {
+              date: {
+                $dateToString: {
+                  format: '%Y-%m-%dT%H',
+                  date: '$startTime',
+                  timezone: 'UTC',
+                },
+              },
+              operatorId: '$operatorId',
+            },
+            startTime: { $first: '$startTime' },
+            earnedHASH: { $sum: '$earnedHASH' },
+          },
+        },
+        {
+          $group: {
+            _id: {
+              day: { $dayOfWeek: { date: '$startTime', timezone: 'UTC' } },
+              hour: { $hour: { date: '$startTime', timezone: 'UTC' } },
+            },
+            memberHours: { $sum: 1 },
+            earnedHASH: { $sum: '$earnedHASH' },
+          },
+        },
+        {
+          $project: {
+            _id: 0,
+            day: '$_id.day',
+            hour: '$_id.hour',
+            memberHours: 1,
+            earnedHASH: 1,
+          },
+        },
+      ]);
+
+      const heatmap = Array.from({ length: 7 }, () =>
+        Array.from({ length: 24 }, () => ({
+          avgActiveMembers: 0,
+          avgEarnedHASH: 0,
+        })),
+      );
+
+      for (const slot of slots) {
+        // `$dayOfWeek` goes from 1 (Sunday) to 7 (Saturday)
+        heatmap[slot.day - 1][slot.hour] = {
+          avgActiveMembers: slot.memberHours / weeks,
+          avgEarnedHASH: slot.earnedHASH / weeks,
+        };
+      }
+
+      return new ApiResponse(
+        200,
+        `(fetchPoolActivityHeatmap) Pool activity heatmap fetched successfully.`,
+        { weeks, heatmap },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchPoolActivityHeatmap) Error fetching pool activity heatmap: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Get the members of a pool ordered by join time, using the join timestamp and ID
+   * of the last member of the previous page (`<ISO timestamp>_<ID>`) as the cursor.
+   */
+  async getPoolMembers(
+    poolId: string,
+    cursor?: string,
+    limit: number = 50,
+  ): Promise<
+    ApiResponse<{ members: PoolMemberDto[]; nextCursor: string | null }>
+  > {
+    try {
+      if (limit < 1 || limit > 100) {
+        return new ApiResponse(400, `(getPoolMembers) Invalid limit: ${limit}`);
+      }
+
+      // Members that joined at the same time are ordered by ID, so the cursor needs both
+      const [cursorTimestamp, cursorId] = cursor?.split('_') ?? [];
+      const cursorDate = cursor ? new Date(cursorTimestamp) : null;
+      if (
+        cursor &&
+        (isNaN(cursorDate.getTime()) || !Types.ObjectId.isValid(cursorId))
+      ) {
+        return new ApiResponse(
+          400,
+          `(getPoolMembers) Invalid cursor: ${cursor}`,
+        );
+      }
+
+      const poolObjectId = new Types.ObjectId(poolId);
+
+      const poolExists = await this.poolModel.exists({ _id: poolObjectId });
+      if (!poolExists) {
+        return new ApiResponse(
+          404,
+          `(getPoolMembers) Pool with ID ${poolId} not found`,
+        );
+      }
+
+      // Fetch one extra member to know whether there is a next page
+      const poolOperators = await this.poolOperatorModel
+        .find(
+          {
+            pool: poolObjectId,
+            ...(cursor && {
+              $or: [
+                { createdAt: { $gt: cursorDate } },
+                {
+                  createdAt: cursorDate,
+                  _id: { $gt: new Types.ObjectId(cursorId) },
+                },
+              ],
+            }),
+          },
+          { operator: 1, createdAt: 1 },
+        )
+        .sort({ createdAt: 1, _id: 1 })
+        .limit(limit + 1)
+        .lean();
+
+      const hasMore = poolOperators.length > limit;
+      const page = poolOperators.slice(0, limit);
+
+      const operatorIds = page.map((po) => po.operator);
+      const [operators, drillEffs] = await Promise.all([
+        this.operatorModel
+          .find({ _id: { $in: operatorIds } }, { 'usernameData.username': 1 })
+          .lean(),
+        // A member's EFF is the sum of their drills' EFF, without operator-level boosts
+        this.drillModel.aggregate<{
+          _id: Types.ObjectId;
+          totalDrillEff: number;
+        }>([
+          { $match: { operatorId: { $in: operatorIds } } },
+          {
+            $group: {
+              _id: '$operatorId',
+              totalDrillEff: { $sum: '$actualEff' },
+            },
+          },
+        ]),
+      ]);
+      const operatorMap = new Map(
+        operators.map((operator) => [operator._id.toString(), operator]),
+      );
+      const drillEffMap = new Map(
+        drillEffs.map((drillEff) => [
+          drillEff._id.toString(),
+          drillEff.totalDrillEff,
+        ]),
+      );
+
+      const members: PoolMemberDto[] = page.map((po) => {
+        const operator = operatorMap.get(po.operator.toString());
+
+        return {
+          operatorId: po.operator.toString(),
+          username: operator?.usernameData?.username ?? null,
+          joinedTimestamp: po.createdAt,
+          cumulativeEff: drillEffMap.get(po.operator.toString()) ?? 0,
+        };
+      });
+
+      return new ApiResponse(
+        200,
+        `(getPoolMembers) Successfully fetched members for pool ${poolId}`,
+        {
+          members,
+          nextCursor: hasMore
+            ? `${page[page.length - 1].createdAt.toISOString()}_${page[page.length - 1]._id}`
+            : null,
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(getPoolMembers) Error fetching pool members: ${err.message}`,
+        ),
+      );
+    }
+  }
+
   /**
    * Get a specific operator in a pool by operator ID.
    * Used for retrieving the authenticated user's pool operator details.
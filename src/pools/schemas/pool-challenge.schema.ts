@@ -0,0 +1,163 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * Enum defining the status of a pool challenge
+ */
+export enum PoolChallengeStatus {
+  /**
+   * The challenge hasn't finished all of its cycles yet.
+   */
+  ACTIVE = 'active',
+  /**
+   * The challenge has ended and its reward (if any) has been transferred.
+   */
+  RESOLVED = 'resolved',
+}
+
+/**
+ * `PoolChallenge` represents a head-to-head contest between two pools over a fixed number of drilling cycles.
+ *
+ * The pool whose operators extract the most $HASH during the challenge wins `rewardHASH` from the other pool.
+ */
+@Schema({ timestamps: true, collection: 'PoolChallenges', versionKey: false })
+export class PoolChallenge extends Document {
+  /**
+   * The database ID of the challenge.
+   */
+  @ApiProperty({
+    description: 'The database ID of the challenge',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the pool that issued the challenge.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool that issued the challenge',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Pools' })
+  challengingPoolId: Types.ObjectId;
+
+  /**
+   * The database ID of the pool that was challenged.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool that was challenged',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Pools' })
+  challengedPoolId: Types.ObjectId;
+
+  /**
+   * The number of drilling cycles the challenge lasts.
+   */
+  @ApiProperty({
+    description: 'The number of drilling cycles the challenge lasts',
+    example: 100,
+  })
+  @Prop({ type: Number, required: true, min: 1 })
+  cycleCount: number;
+
+  /**
+   * The first drilling cycle included in the challenge.
+   */
+  @ApiProperty({
+    description: 'The first drilling cycle included in the challenge',
+    example: 12345,
+  })
+  @Prop({ type: Number, required: true, min: 1 })
+  startsAtCycle: number;
+
+  /**
+   * The amount of $HASH the losing pool transfers to the winning pool.
+   */
+  @ApiProperty({
+    description:
+      'The amount of $HASH the losing pool transfers to the winning pool',
+    example: 5000,
+  })
+  @Prop({ type: Number, required: true, min: 0 })
+  rewardHASH: number;
+
+  /**
+   * The current status of the challenge.
+   */
+  @ApiProperty({
+    description: 'The current status of the challenge',
+    enum: PoolChallengeStatus,
+    example: PoolChallengeStatus.ACTIVE,
+  })
+  @Prop({
+    type: String,
+    enum: PoolChallengeStatus,
+    required: true,
+    default: PoolChallengeStatus.ACTIVE,
+    index: true,
+  })
+  status: PoolChallengeStatus;
+
+  /**
+   * The database ID of the winning pool. Null while active or if the challenge ended in a tie.
+   */
+  @ApiProperty({
+    description:
+      'The database ID of the winning pool (null while active or on a tie)',
+    example: '507f1f77bcf86cd799439012',
+    required: false,
+  })
+  @Prop({ type: Types.ObjectId, ref: 'Pools', default: null })
+  winnerPoolId?: Types.ObjectId | null;
+
+  /**
+   * The total $HASH extracted by the challenging pool's operators during the challenge.
+   */
+  @ApiProperty({
+    description:
+      "The total $HASH extracted by the challenging pool's operators during the challenge",
+    example: 12000,
+  })
+  @Prop({ type: Number, default: 0 })
+  challengingPoolExtractedHASH: number;
+
+  /**
+   * The total $HASH extracted by the challenged pool's operators during the challenge.
+   */
+  @ApiProperty({
+    description:
+      "The total $HASH extracted by the challenged pool's operators during the challenge",
+    example: 9000,
+  })
+  @Prop({ type: Number, default: 0 })
+  challengedPoolExtractedHASH: number;
+
+  /**
+   * The amount of $HASH actually transferred to the winner (capped at the loser's treasury).
+   */
+  @ApiProperty({
+    description: 'The amount of $HASH actually transferred to the winner',
+    example: 5000,
+  })
+  @Prop({ type: Number, default: 0 })
+  transferredHASH: number;
+
+  /**
+   * When the challenge was resolved.
+   */
+  @ApiProperty({
+    description: 'When the challenge was resolved',
+    example: '2025-01-01T00:00:00.000Z',
+    required: false,
+  })
+  @Prop({ type: Date, default: null })
+  resolvedAt?: Date | null;
+}
+
+export const PoolChallengeSchema = SchemaFactory.createForClass(PoolChallenge);
+
+// Used at the end of every cycle to find challenges that are due
+PoolChallengeSchema.index({ status: 1, startsAtCycle: 1 });
@@ -0,0 +1,146 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The lifecycle of a pool efficiency goal.
+ */
+export enum PoolEfficiencyGoalStatus {
+  /** The pool is still working towards the goal. */
+  ACTIVE = 'active',
+  /** The pool reached the target EFF before the deadline, and every member was rewarded from the pool treasury. */
+  ACHIEVED = 'achieved',
+  /** The pool reached the target EFF, but the pool treasury couldn't cover the member rewards. */
+  UNFUNDED = 'unfunded',
+  /** The deadline passed before the pool reached the target EFF. */
+  EXPIRED = 'expired',
+}
+
+/**
+ * `PoolEfficiencyGoal` represents an EFF target a pool leader sets to motivate the pool's members.
+ *
+ * If the pool's `estimatedEff` reaches `targetEff` before `deadline`, every member of the pool receives
+ * `rewardHASHPerMember` $HASH from the pool treasury.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'PoolEfficiencyGoals',
+  versionKey: false,
+})
+export class PoolEfficiencyGoal extends Document {
+  /**
+   * The database ID of the goal.
+   */
+  @ApiProperty({
+    description: 'The database ID of the goal',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the pool the goal is for.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool the goal is for',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Pools' })
+  poolId: Types.ObjectId;
+
+  /**
+   * The database ID of the leader who set the goal.
+   */
+  @ApiProperty({
+    description: 'The database ID of the leader who set the goal',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  createdBy: Types.ObjectId;
+
+  /**
+   * The pool `estimatedEff` to reach.
+   */
+  @ApiProperty({
+    description: 'The pool estimated EFF to reach',
+    example: 250000,
+  })
+  @Prop({ type: Number, required: true })
+  targetEff: number;
+
+  /**
+   * When the pool has to reach `targetEff` by.
+   */
+  @ApiProperty({
+    description: 'When the pool has to reach the target EFF by',
+    example: '2025-01-08T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  deadline: Date;
+
+  /**
+   * The amount of $HASH every member receives from the pool treasury if the goal is achieved.
+   */
+  @ApiProperty({
+    description:
+      'The amount of $HASH every member receives from the pool treasury if the goal is achieved',
+    example: 50,
+  })
+  @Prop({ type: Number, required: true })
+  rewardHASHPerMember: number;
+
+  /**
+   * If the pool achieved the goal (and its members were rewarded).
+   */
+  @ApiProperty({
+    description: 'If the pool achieved the goal',
+    example: false,
+  })
+  @Prop({ type: Boolean, default: false })
+  achieved: boolean;
+
+  /**
+   * When the pool achieved the goal (NULL if it hasn't).
+   */
+  @ApiProperty({
+    description: 'When the pool achieved the goal',
+    example: '2025-01-05T00:00:00.000Z',
+    nullable: true,
+  })
+  @Prop({ type: Date, default: null })
+  achievedAt: Date | null;
+
+  /**
+   * The number of members that were rewarded when the goal was achieved.
+   */
+  @ApiProperty({
+    description: 'The number of members that were rewarded',
+    example: 12,
+  })
+  @Prop({ type: Number, default: 0 })
+  rewardedMemberCount: number;
+
+  /**
+   * The current status of the goal.
+   */
+  @ApiProperty({
+    description: 'The current status of the goal',
+    enum: PoolEfficiencyGoalStatus,
+    example: PoolEfficiencyGoalStatus.ACTIVE,
+  })
+  @Prop({
+    type: String,
+    enum: PoolEfficiencyGoalStatus,
+    default: PoolEfficiencyGoalStatus.ACTIVE,
+  })
+  status: PoolEfficiencyGoalStatus;
+}
+
+export const PoolEfficiencyGoalSchema =
+  SchemaFactory.createForClass(PoolEfficiencyGoal);
+
+// Index for fetching a pool's goals (and counting its active ones)
+PoolEfficiencyGoalSchema.index({ poolId: 1, createdAt: -1 });
+
+// Index for the background worker checking active goals
+PoolEfficiencyGoalSchema.index({ status: 1, deadline: 1 });
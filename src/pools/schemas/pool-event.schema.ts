@@ -0,0 +1,89 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The types of events that can be logged for a pool.
+ */
+export enum PoolEventType {
+  /**
+   * An inactive pool operator's reward share was forfeited to the pool treasury.
+   */
+  REWARD_FORFEIT = 'REWARD_FORFEIT',
+  /**
+   * An approved treasury withdrawal proposal paid out $HASH from the pool treasury to the pool leader.
+   */
+  TREASURY_WITHDRAWAL = 'TREASURY_WITHDRAWAL',
+}
+
+/**
+ * `PoolEvent` represents a notable event in a pool's history.
+ */
+@Schema({ timestamps: true, collection: 'PoolEvents', versionKey: false })
+export class PoolEvent extends Document {
+  /**
+   * The database ID of the pool event.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool event',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the pool the event belongs to.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool the event belongs to',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Pools' })
+  poolId: Types.ObjectId;
+
+  /**
+   * The type of the event.
+   */
+  @ApiProperty({
+    description: 'The type of the event',
+    enum: PoolEventType,
+    example: PoolEventType.REWARD_FORFEIT,
+  })
+  @Prop({ type: String, enum: PoolEventType, required: true })
+  type: PoolEventType;
+
+  /**
+   * The database ID of the operator the event concerns (if any).
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator the event concerns',
+    example: '507f1f77bcf86cd799439013',
+    nullable: true,
+  })
+  @Prop({ type: Types.ObjectId, ref: 'Operators', default: null })
+  operatorId: Types.ObjectId | null;
+
+  /**
+   * The amount of $HASH involved in the event (if any).
+   */
+  @ApiProperty({
+    description: 'The amount of $HASH involved in the event',
+    example: 1.25,
+  })
+  @Prop({ type: Number, default: 0 })
+  amount: number;
+
+  /**
+   * The timestamp when the event occurred.
+   */
+  @ApiProperty({
+    description: 'The timestamp when the event occurred',
+    example: '2025-03-01T00:00:00.000Z',
+  })
+  createdAt: Date;
+}
+
+export const PoolEventSchema = SchemaFactory.createForClass(PoolEvent);
+
+// Index for fetching a pool's events, newest first
+PoolEventSchema.index({ poolId: 1, createdAt: -1 });
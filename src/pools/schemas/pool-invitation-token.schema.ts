@@ -0,0 +1,113 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `PoolInvitationToken` represents a join link token generated for a pool's recruitment announcement.
+ *
+ * A token can be redeemed once to join its pool (see `PoolAnnouncementService.redeemInvitationToken`).
+ * Tokens expire after `INVITATION_TOKEN_EXPIRY_DAYS` days and are removed by MongoDB afterwards.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'PoolInvitationTokens',
+  versionKey: false,
+})
+export class PoolInvitationToken extends Document {
+  /**
+   * The database ID of the invitation token.
+   */
+  @ApiProperty({
+    description: 'The database ID of the invitation token',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the pool the token invites to.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool the token invites to',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Pools' })
+  poolId: Types.ObjectId;
+
+  /**
+   * The random token used in the join link.
+   */
+  @ApiProperty({
+    description: 'The random token used in the join link',
+    example: '9f86d081884c7d659a2feaa0c55ad015',
+  })
+  @Prop({ type: String, required: true, unique: true })
+  token: string;
+
+  /**
+   * The database ID of the operator (pool leader) who generated the token.
+   */
+  @ApiProperty({
+    description:
+      'The database ID of the operator (pool leader) who generated the token',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  createdBy: Types.ObjectId;
+
+  /**
+   * The ID of the Telegram message the token was announced in (null until the message is sent).
+   */
+  @ApiProperty({
+    description:
+      'The ID of the Telegram message the token was announced in (null until the message is sent)',
+    example: 42,
+    nullable: true,
+  })
+  @Prop({ type: Number, required: false, default: null })
+  tgMessageId: number | null;
+
+  /**
+   * When the token expires.
+   */
+  @ApiProperty({
+    description: 'When the token expires',
+    example: '2025-03-01T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  expiresAt: Date;
+
+  /**
+   * The database ID of the operator who joined the pool with the token (null until redeemed).
+   */
+  @ApiProperty({
+    description:
+      'The database ID of the operator who joined the pool with the token (null until redeemed)',
+    example: '507f1f77bcf86cd799439014',
+    nullable: true,
+  })
+  @Prop({
+    type: Types.ObjectId,
+    required: false,
+    default: null,
+    ref: 'Operators',
+  })
+  redeemedBy: Types.ObjectId | null;
+
+  /**
+   * When the token was redeemed (null until redeemed).
+   */
+  @ApiProperty({
+    description: 'When the token was redeemed (null until redeemed)',
+    example: '2025-02-25T00:00:00.000Z',
+    nullable: true,
+  })
+  @Prop({ type: Date, required: false, default: null })
+  redeemedAt: Date | null;
+}
+
+export const PoolInvitationTokenSchema =
+  SchemaFactory.createForClass(PoolInvitationToken);
+
+// Remove expired tokens automatically
+PoolInvitationTokenSchema.index({ expiresAt: 1 }, { expireAfterSeconds: 0 });
@@ -0,0 +1,72 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `PoolJoinRequestVote` represents an invited pool member's vote on a pool join request.
+ */
+@Schema({
+  timestamps: { createdAt: true, updatedAt: false },
+  collection: 'PoolJoinRequestVotes',
+  versionKey: false,
+})
+export class PoolJoinRequestVote extends Document {
+  /**
+   * The database ID of the vote.
+   */
+  @ApiProperty({
+    description: 'The database ID of the vote',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the join request voted on.
+   */
+  @ApiProperty({
+    description: 'The database ID of the join request voted on',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'PoolJoinRequests' })
+  requestId: Types.ObjectId;
+
+  /**
+   * The database ID of the pool member who voted.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool member who voted',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * Whether the member voted to approve (true) or reject (false) the request.
+   */
+  @ApiProperty({
+    description:
+      'Whether the member voted to approve (true) or reject (false) the request',
+    example: true,
+  })
+  @Prop({ type: Boolean, required: true })
+  approve: boolean;
+
+  /**
+   * The timestamp when the vote was cast.
+   */
+  @ApiProperty({
+    description: 'The timestamp when the vote was cast',
+    example: '2025-03-01T00:00:00.000Z',
+  })
+  createdAt: Date;
+}
+
+export const PoolJoinRequestVoteSchema =
+  SchemaFactory.createForClass(PoolJoinRequestVote);
+
+// Each member can only vote once per request
+PoolJoinRequestVoteSchema.index(
+  { requestId: 1, operatorId: 1 },
+  { unique: true },
+);
@@ -0,0 +1,143 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The lifecycle of a pool join request.
+ */
+export enum PoolJoinRequestStatus {
+  /** The request is waiting for the leader's decision or for enough approvals from invited members. */
+  PENDING = 'pending',
+  /** The request was approved and the operator joined the pool. */
+  APPROVED = 'approved',
+  /** The leader rejected the request, or the operator can never join the pool through it (e.g. they joined another pool). */
+  REJECTED = 'rejected',
+}
+
+/**
+ * `PoolJoinRequest` represents an operator's request to join a pool that requires approval.
+ *
+ * The request is approved either by the pool's leader or, without leader action, once `JOIN_REQUEST_APPROVAL_QUORUM`
+ * of the members invited to review it approved it.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'PoolJoinRequests',
+  versionKey: false,
+})
+export class PoolJoinRequest extends Document {
+  /**
+   * The database ID of the join request.
+   */
+  @ApiProperty({
+    description: 'The database ID of the join request',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the pool the operator wants to join.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool the operator wants to join',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Pools' })
+  poolId: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who wants to join the pool.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who wants to join the pool',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The database IDs of the pool members the leader invited to vote on the request.
+   */
+  @ApiProperty({
+    description:
+      'The database IDs of the pool members the leader invited to vote on the request',
+    type: [String],
+    example: ['507f1f77bcf86cd799439014'],
+  })
+  @Prop({ type: [Types.ObjectId], default: [], ref: 'Operators' })
+  invitedVoterIds: Types.ObjectId[];
+
+  /**
+   * The number of invited members who voted to approve the request.
+   */
+  @ApiProperty({
+    description:
+      'The number of invited members who voted to approve the request',
+    example: 2,
+  })
+  @Prop({ type: Number, required: true, default: 0 })
+  approvals: number;
+
+  /**
+   * The number of invited members who voted against the request.
+   */
+  @ApiProperty({
+    description: 'The number of invited members who voted against the request',
+    example: 1,
+  })
+  @Prop({ type: Number, required: true, default: 0 })
+  rejections: number;
+
+  /**
+   * The current status of the request.
+   */
+  @ApiProperty({
+    description: 'The current status of the request',
+    enum: PoolJoinRequestStatus,
+    example: PoolJoinRequestStatus.PENDING,
+  })
+  @Prop({
+    type: String,
+    enum: PoolJoinRequestStatus,
+    required: true,
+    default: PoolJoinRequestStatus.PENDING,
+  })
+  status: PoolJoinRequestStatus;
+
+  /**
+   * When the request was approved or rejected (`null` while pending).
+   */
+  @ApiProperty({
+    description:
+      'When the request was approved or rejected (null while pending)',
+    example: null,
+    nullable: true,
+  })
+  @Prop({ type: Date, default: null })
+  resolvedAt: Date | null;
+
+  /**
+   * The timestamp when the request was created.
+   */
+  @ApiProperty({
+    description: 'The timestamp when the request was created',
+    example: '2025-03-01T00:00:00.000Z',
+  })
+  createdAt: Date;
+}
+
+export const PoolJoinRequestSchema =
+  SchemaFactory.createForClass(PoolJoinRequest);
+
+// Index for fetching a pool's requests by status
+PoolJoinRequestSchema.index({ poolId: 1, status: 1 });
+
+// An operator can only have one pending request per pool
+PoolJoinRequestSchema.index(
+  { operatorId: 1, poolId: 1 },
+  {
+    unique: true,
+    partialFilterExpression: { status: PoolJoinRequestStatus.PENDING },
+  },
+);
@@ -0,0 +1,83 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `PoolLinks` represents the external links (website and socials) of a pool.
+ *
+ * Operators can check these links to learn more about a pool before joining it.
+ */
+@Schema({ timestamps: true, collection: 'PoolLinks', versionKey: false })
+export class PoolLinks extends Document {
+  /**
+   * The database ID of the pool links entry.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool links entry',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the pool these links belong to.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool these links belong to',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({
+    type: Types.ObjectId,
+    required: true,
+    unique: true,
+    index: true,
+    ref: 'Pools',
+  })
+  poolId: Types.ObjectId;
+
+  /**
+   * The pool's website URL.
+   */
+  @ApiProperty({
+    description: "The pool's website URL",
+    example: 'https://hashland-pool.com',
+    nullable: true,
+  })
+  @Prop({ type: String, required: false, default: null })
+  website: string | null;
+
+  /**
+   * The pool's Discord server invite URL.
+   */
+  @ApiProperty({
+    description: "The pool's Discord server invite URL",
+    example: 'https://discord.gg/hashland',
+    nullable: true,
+  })
+  @Prop({ type: String, required: false, default: null })
+  discord: string | null;
+
+  /**
+   * The pool's Twitter profile URL.
+   */
+  @ApiProperty({
+    description: "The pool's Twitter profile URL",
+    example: 'https://x.com/hashland',
+    nullable: true,
+  })
+  @Prop({ type: String, required: false, default: null })
+  twitter: string | null;
+
+  /**
+   * The pool's Telegram group or channel URL.
+   */
+  @ApiProperty({
+    description: "The pool's Telegram group or channel URL",
+    example: 'https://t.me/hashland',
+    nullable: true,
+  })
+  @Prop({ type: String, required: false, default: null })
+  telegram: string | null;
+}
+
+export const PoolLinksSchema = SchemaFactory.createForClass(PoolLinks);
@@ -0,0 +1,75 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `PoolMembershipHistory` represents a period during which an operator was a member of a pool.
+ *
+ * A new record is created whenever an operator joins (or is moved into) a pool, and is closed
+ * (by setting `memberTo`) when the operator leaves, is kicked from or is moved out of the pool.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'PoolMembershipHistories',
+  versionKey: false,
+})
+export class PoolMembershipHistory extends Document {
+  /**
+   * The database ID of the membership record.
+   */
+  @ApiProperty({
+    description: 'The database ID of the membership record',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the operator.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The database ID of the pool.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Pools' })
+  poolId: Types.ObjectId;
+
+  /**
+   * When the operator became a member of the pool.
+   */
+  @ApiProperty({
+    description: 'When the operator became a member of the pool',
+    example: '2025-03-01T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  memberFrom: Date;
+
+  /**
+   * When the operator stopped being a member of the pool (null if they're still a member).
+   */
+  @ApiProperty({
+    description:
+      "When the operator stopped being a member of the pool (null if they're still a member)",
+    example: null,
+    nullable: true,
+  })
+  @Prop({ type: Date, default: null })
+  memberTo: Date | null;
+}
+
+export const PoolMembershipHistorySchema = SchemaFactory.createForClass(
+  PoolMembershipHistory,
+);
+
+// Index for fetching an operator's memberships (and closing their open membership)
+PoolMembershipHistorySchema.index({ operatorId: 1, memberFrom: -1 });
@@ -0,0 +1,120 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The lifecycle of a pool merge request.
+ */
+export enum PoolMergeRequestStatus {
+  /** At least one of the two pool leaders hasn't approved the merge yet. */
+  PENDING = 'pending',
+  /** Both leaders approved, and the smaller pool was merged into the larger one. */
+  MERGED = 'merged',
+}
+
+/**
+ * `PoolMergeRequest` represents a request to merge two pools into one.
+ *
+ * The merge only goes through once the leaders of both pools approved it. The smaller pool's
+ * members and treasury then move to the larger pool, and the smaller pool is dissolved.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'PoolMergeRequests',
+  versionKey: false,
+})
+export class PoolMergeRequest extends Document {
+  /**
+   * The database ID of the merge request.
+   */
+  @ApiProperty({
+    description: 'The database ID of the merge request',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the pool whose leader requested the merge.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool whose leader requested the merge',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Pools' })
+  initiatingPoolId: Types.ObjectId;
+
+  /**
+   * The database ID of the pool the merge was requested with.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool the merge was requested with',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Pools' })
+  targetPoolId: Types.ObjectId;
+
+  /**
+   * Whether the initiating pool's leader approved the merge (always true, since they requested it).
+   */
+  @ApiProperty({
+    description: "Whether the initiating pool's leader approved the merge",
+    example: true,
+  })
+  @Prop({ type: Boolean, required: true, default: true })
+  initiatorApproved: boolean;
+
+  /**
+   * Whether the target pool's leader approved the merge.
+   */
+  @ApiProperty({
+    description: "Whether the target pool's leader approved the merge",
+    example: false,
+  })
+  @Prop({ type: Boolean, required: true, default: false })
+  targetApproved: boolean;
+
+  /**
+   * The database ID of the pool the two pools were merged into (the larger one), once merged.
+   */
+  @ApiProperty({
+    description:
+      'The database ID of the pool the two pools were merged into, once merged',
+    example: '507f1f77bcf86cd799439013',
+    nullable: true,
+  })
+  @Prop({ type: Types.ObjectId, default: null, ref: 'Pools' })
+  mergeToPoolId: Types.ObjectId | null;
+
+  /**
+   * The current status of the merge request.
+   */
+  @ApiProperty({
+    description: 'The current status of the merge request',
+    enum: PoolMergeRequestStatus,
+    example: PoolMergeRequestStatus.PENDING,
+  })
+  @Prop({
+    type: String,
+    enum: PoolMergeRequestStatus,
+    required: true,
+    default: PoolMergeRequestStatus.PENDING,
+  })
+  status: PoolMergeRequestStatus;
+
+  /**
+   * The timestamp when the merge request was created.
+   */
+  @ApiProperty({
+    description: 'The timestamp when the merge request was created',
+    example: '2025-03-01T00:00:00.000Z',
+  })
+  createdAt: Date;
+}
+
+export const PoolMergeRequestSchema =
+  SchemaFactory.createForClass(PoolMergeRequest);
+
+// Indexes for fetching a pool's merge requests by status
+PoolMergeRequestSchema.index({ initiatingPoolId: 1, status: 1 });
+PoolMergeRequestSchema.index({ targetPoolId: 1, status: 1 });
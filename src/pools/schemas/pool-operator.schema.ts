@@ -78,9 +78,26 @@ export class PoolOperator extends Document {
   })
   @Prop({ type: Number, default: 0 })
   totalRewards: number;
+
+  /**
+   * The number of consecutive cycles the operator has been in the pool without an active drilling session (capped at `FORFEIT_THRESHOLD`).
+   *
+   * Reset in every cycle the operator participates in. While this is at `FORFEIT_THRESHOLD`,
+   * the cycle rewards the operator would still receive without participating (e.g. the leader share) go to the pool treasury.
+   */
+  @ApiProperty({
+    description:
+      'The number of consecutive cycles the operator has missed without an active drilling session',
+    example: 0,
+  })
+  @Prop({ type: Number, default: 0 })
+  consecutiveMissedCycles: number;
 }
 
 /**
  * Generate the Mongoose schema for PoolOperator.
  */
 export const PoolOperatorSchema = SchemaFactory.createForClass(PoolOperator);
+
+// Index for cursor-paginating a pool's members by join time
+PoolOperatorSchema.index({ pool: 1, createdAt: 1 });
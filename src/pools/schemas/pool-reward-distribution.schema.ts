@@ -0,0 +1,136 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `PoolRewardDistribution` represents how a drilling cycle's issued $HASH was split according to a pool's reward system
+ * when the cycle's extractor was a member of the pool.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'PoolRewardDistributions',
+  versionKey: false,
+})
+export class PoolRewardDistribution extends Document {
+  /**
+   * The database ID of the reward distribution.
+   */
+  @ApiProperty({
+    description: 'The database ID of the reward distribution',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the pool the rewards were distributed in.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool the rewards were distributed in',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Pools' })
+  poolId: Types.ObjectId;
+
+  /**
+   * The drilling cycle number the rewards were issued in.
+   */
+  @ApiProperty({
+    description: 'The drilling cycle number the rewards were issued in',
+    example: 1234,
+    nullable: true,
+  })
+  @Prop({ type: Number, default: null })
+  cycleNumber: number | null;
+
+  /**
+   * The total amount of $HASH issued in the cycle.
+   */
+  @ApiProperty({
+    description: 'The total amount of $HASH issued in the cycle',
+    example: 100,
+  })
+  @Prop({ type: Number, required: true })
+  issuedHASH: number;
+
+  /**
+   * The database ID of the extractor operator.
+   */
+  @ApiProperty({
+    description: 'The database ID of the extractor operator',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  extractorOperatorId: Types.ObjectId;
+
+  /**
+   * The amount of $HASH the extractor operator received.
+   */
+  @ApiProperty({
+    description: 'The amount of $HASH the extractor operator received',
+    example: 48,
+  })
+  @Prop({ type: Number, required: true })
+  extractorReward: number;
+
+  /**
+   * The database ID of the pool leader at the time of the distribution (if any).
+   */
+  @ApiProperty({
+    description:
+      'The database ID of the pool leader at the time of the distribution',
+    example: '507f1f77bcf86cd799439014',
+    nullable: true,
+  })
+  @Prop({ type: Types.ObjectId, ref: 'Operators', default: null })
+  leaderId: Types.ObjectId | null;
+
+  /**
+   * The amount of $HASH the pool leader received (0 if the pool has no leader).
+   */
+  @ApiProperty({
+    description:
+      'The amount of $HASH the pool leader received (0 if the pool has no leader)',
+    example: 4,
+  })
+  @Prop({ type: Number, default: 0 })
+  leaderReward: number;
+
+  /**
+   * The amount of $HASH shared between the active pool operators (including forfeited shares).
+   */
+  @ApiProperty({
+    description:
+      'The amount of $HASH shared between the active pool operators (including forfeited shares)',
+    example: 48,
+  })
+  @Prop({ type: Number, default: 0 })
+  activePoolOperatorsReward: number;
+
+  /**
+   * The number of active pool operators the `activePoolOperatorsReward` was shared between.
+   */
+  @ApiProperty({
+    description:
+      'The number of active pool operators the active pool operators reward was shared between',
+    example: 12,
+  })
+  @Prop({ type: Number, default: 0 })
+  activePoolOperatorCount: number;
+
+  /**
+   * The timestamp when the rewards were distributed.
+   */
+  @ApiProperty({
+    description: 'The timestamp when the rewards were distributed',
+    example: '2025-03-01T00:00:00.000Z',
+  })
+  createdAt: Date;
+}
+
+export const PoolRewardDistributionSchema = SchemaFactory.createForClass(
+  PoolRewardDistribution,
+);
+
+// Index for fetching a pool's reward history, newest first
+PoolRewardDistributionSchema.index({ poolId: 1, createdAt: -1 });
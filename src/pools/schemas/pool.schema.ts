@@ -72,6 +72,29 @@ export class Pool extends Document {
   @Prop({ type: Number, default: null })
   maxOperators?: number | null;
 
+  /**
+   * The number of operators currently in the pool.
+   *
+   * Joins reserve a slot by incrementing this only while it's below `maxOperators`, so concurrent joins can't overfill the pool.
+   */
+  @ApiProperty({
+    description: 'The number of operators currently in the pool',
+    example: 7,
+  })
+  @Prop({ type: Number, default: 0 })
+  operatorCount: number;
+
+  /**
+   * Whether operators need to send a join request (approved by the leader or by a quorum of invited members) to join the pool.
+   */
+  @ApiProperty({
+    description:
+      'Whether operators need an approved join request to join the pool',
+    example: false,
+  })
+  @Prop({ type: Boolean, default: false })
+  requiresApproval: boolean;
+
   /**
    * The pool's reward system, which includes the reward distribution for the extractor operator, leader, and active pool operators.
    *
@@ -167,6 +190,27 @@ export class Pool extends Document {
   })
   @Prop({ type: Number, default: 0 })
   totalRewards: number;
+
+  /**
+   * The $HASH held in the pool's treasury, e.g. from reward shares forfeited by inactive pool operators.
+   */
+  @ApiProperty({
+    description: "The $HASH held in the pool's treasury",
+    example: 120.5,
+  })
+  @Prop({ type: Number, default: 0 })
+  treasuryHASH: number;
+
+  /**
+   * The database ID of the sibling pool, i.e. the pool this pool was split from or split into.
+   */
+  @ApiProperty({
+    description: 'The database ID of the sibling pool (if split)',
+    example: '507f1f77bcf86cd799439012',
+    required: false,
+  })
+  @Prop({ type: Types.ObjectId, ref: 'Pools', default: null })
+  siblingPoolId?: Types.ObjectId | null;
 }
 
 export const PoolSchema = SchemaFactory.createForClass(Pool);
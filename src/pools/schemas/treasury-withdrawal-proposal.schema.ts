@@ -0,0 +1,195 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The lifecycle of a treasury withdrawal proposal.
+ */
+export enum TreasuryWithdrawalProposalStatus {
+  /** Pool members can still vote on the proposal. */
+  PENDING = 'pending',
+  /** Enough members voted in favour, and the amount was paid out from the treasury to the pool leader. */
+  APPROVED = 'approved',
+  /** The proposal can no longer reach quorum, or the treasury couldn't cover the amount when it was approved. */
+  REJECTED = 'rejected',
+  /** The voting period ended before the proposal reached quorum. */
+  EXPIRED = 'expired',
+}
+
+/**
+ * `TreasuryWithdrawalProposal` represents a pool leader's request to withdraw $HASH from their pool's treasury.
+ *
+ * The withdrawal only goes through if enough of the pool's members (as of when the proposal was created) vote in favour before `expiresAt`.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'TreasuryWithdrawalProposals',
+  versionKey: false,
+})
+export class TreasuryWithdrawalProposal extends Document {
+  /**
+   * The database ID of the proposal.
+   */
+  @ApiProperty({
+    description: 'The database ID of the proposal',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the pool whose treasury the $HASH is withdrawn from.
+   */
+  @ApiProperty({
+    description:
+      'The database ID of the pool whose treasury the $HASH is withdrawn from',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Pools' })
+  poolId: Types.ObjectId;
+
+  /**
+   * The database ID of the operator who created the proposal.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who created the proposal',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  proposedBy: Types.ObjectId;
+
+  /**
+   * The amount of $HASH to withdraw.
+   */
+  @ApiProperty({
+    description: 'The amount of $HASH to withdraw',
+    example: 500,
+  })
+  @Prop({ type: Number, required: true, min: 0 })
+  amount: number;
+
+  /**
+   * Why the $HASH should be withdrawn.
+   */
+  @ApiProperty({
+    description: 'Why the $HASH should be withdrawn',
+    example: 'Funding the next pool tournament entry',
+  })
+  @Prop({ type: String, required: true })
+  reason: string;
+
+  /**
+   * The database IDs of the pool members when the proposal was created, i.e. the only operators who can vote on it.
+   *
+   * Quorum is measured against this set, so removing members after the proposal was created can't lower it.
+   */
+  @ApiProperty({
+    description:
+      'The database IDs of the pool members when the proposal was created (the only operators who can vote on it)',
+    type: [String],
+    example: ['507f1f77bcf86cd799439014'],
+  })
+  @Prop({ type: [Types.ObjectId], default: [], ref: 'Operators' })
+  eligibleVoterIds: Types.ObjectId[];
+
+  /**
+   * The number of pool members who voted in favour of the proposal.
+   */
+  @ApiProperty({
+    description:
+      'The number of pool members who voted in favour of the proposal',
+    example: 12,
+  })
+  @Prop({ type: Number, required: true, default: 0 })
+  votesFor: number;
+
+  /**
+   * The number of pool members who voted against the proposal.
+   */
+  @ApiProperty({
+    description: 'The number of pool members who voted against the proposal',
+    example: 3,
+  })
+  @Prop({ type: Number, required: true, default: 0 })
+  votesAgainst: number;
+
+  /**
+   * The database IDs of the operators who voted on the proposal (to prevent voting twice).
+   */
+  @ApiProperty({
+    description: 'The database IDs of the operators who voted on the proposal',
+    type: [String],
+    example: ['507f1f77bcf86cd799439014'],
+  })
+  @Prop({ type: [Types.ObjectId], default: [], ref: 'Operators' })
+  voterIds: Types.ObjectId[];
+
+  /**
+   * The database IDs of the operators who voted in favour of the proposal.
+   */
+  @ApiProperty({
+    description:
+      'The database IDs of the operators who voted in favour of the proposal',
+    type: [String],
+    example: ['507f1f77bcf86cd799439014'],
+  })
+  @Prop({ type: [Types.ObjectId], default: [], ref: 'Operators' })
+  forVoterIds: Types.ObjectId[];
+
+  /**
+   * The database IDs of the operators who voted against the proposal.
+   */
+  @ApiProperty({
+    description:
+      'The database IDs of the operators who voted against the proposal',
+    type: [String],
+    example: ['507f1f77bcf86cd799439015'],
+  })
+  @Prop({ type: [Types.ObjectId], default: [], ref: 'Operators' })
+  againstVoterIds: Types.ObjectId[];
+
+  /**
+   * The current status of the proposal.
+   */
+  @ApiProperty({
+    description: 'The current status of the proposal',
+    enum: TreasuryWithdrawalProposalStatus,
+    example: TreasuryWithdrawalProposalStatus.PENDING,
+  })
+  @Prop({
+    type: String,
+    enum: TreasuryWithdrawalProposalStatus,
+    required: true,
+    default: TreasuryWithdrawalProposalStatus.PENDING,
+  })
+  status: TreasuryWithdrawalProposalStatus;
+
+  /**
+   * When the voting period ends.
+   */
+  @ApiProperty({
+    description: 'When the voting period ends',
+    example: '2025-03-04T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  expiresAt: Date;
+
+  /**
+   * The timestamp when the proposal was created.
+   */
+  @ApiProperty({
+    description: 'The timestamp when the proposal was created',
+    example: '2025-03-01T00:00:00.000Z',
+  })
+  createdAt: Date;
+}
+
+export const TreasuryWithdrawalProposalSchema = SchemaFactory.createForClass(
+  TreasuryWithdrawalProposal,
+);
+
+// Index for fetching a pool's proposals by status
+TreasuryWithdrawalProposalSchema.index({ poolId: 1, status: 1 });
+
+// Index for the background worker resolving pending proposals
+TreasuryWithdrawalProposalSchema.index({ status: 1, expiresAt: 1 });
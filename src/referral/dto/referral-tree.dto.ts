@@ -0,0 +1,51 @@
+import { ApiProperty } from '@nestjs/swagger';
+import { Type } from 'class-transformer';
+import { IsInt, IsOptional, Max, Min } from 'class-validator';
+import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
+
+/**
+ * DTO representing an operator in a referral tree
+ */
+export class ReferralNodeDto {
+  @ApiProperty({
+    description: 'The ID of the operator',
+    example: '507f1f77bcf86cd799439011',
+  })
+  operatorId: string;
+
+  @ApiProperty({
+    description: 'The username of the operator',
+    example: 'crypto_miner42',
+  })
+  username: string;
+
+  @ApiProperty({
+    description: 'The current $HASH balance of the operator',
+    example: 1500,
+  })
+  currentHASH: number;
+
+  @ApiProperty({
+    description: 'The operators referred by this operator',
+    type: () => [ReferralNodeDto],
+  })
+  children: ReferralNodeDto[];
+}
+
+/**
+ * DTO for the referral tree query parameters
+ */
+export class ReferralTreeQueryDto {
+  @ApiProperty({
+    description: `How many levels of referrals to fetch (max ${GAME_CONSTANTS.REFERRAL.MAX_REFERRAL_TREE_DEPTH})`,
+    example: 3,
+    required: false,
+    default: 3,
+  })
+  @IsOptional()
+  @Type(() => Number)
+  @IsInt()
+  @Min(1)
+  @Max(GAME_CONSTANTS.REFERRAL.MAX_REFERRAL_TREE_DEPTH)
+  depth?: number = 3;
+}
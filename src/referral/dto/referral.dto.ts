@@ -96,3 +96,26 @@ export class ReferralStatsResponseDto {
     this.rewards = data.rewards;
   }
 }
+
+/**
+ * DTO for a referrer reward tier
+ */
+export class ReferralTierDto {
+  @ApiProperty({
+    description: 'The tier number (higher tiers give bigger bonuses)',
+    example: 2,
+  })
+  tier: number;
+
+  @ApiProperty({
+    description: 'The number of successful referrals needed to reach the tier',
+    example: 10,
+  })
+  minReferrals: number;
+
+  @ApiProperty({
+    description: 'The $HASH bonus the referrer gets per referral in the tier',
+    example: 75,
+  })
+  bonusHASHPerReferral: number;
+}
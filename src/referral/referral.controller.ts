@@ -21,7 +21,10 @@ import { JwtAuthGuard } from 'src/auth/jwt/jwt-auth.guard';
 import { ReferralService } from './referral.service';
 import { Types } from 'mongoose';
 import { ApiResponse } from 'src/common/dto/response.dto';
-import { ReferralStatsResponseDto } from './dto/referral.dto';
+import {
+  ReferralStatsResponseDto,
+  ReferralTierDto,
+} from './dto/referral.dto';
 import { ReferredUserDto } from './dto/referred-users.dto';
 import { PaginationQueryDto } from './dto/pagination.dto';
 import { PaginatedResponse } from 'src/common/dto/paginated-response.dto';
@@ -31,6 +34,10 @@ import {
   UseStarterCodeDto,
 } from './dto/starter-code.dto';
 import { StarterCode } from './schemas/starter-code.schema';
+import {
+  ReferralNodeDto,
+  ReferralTreeQueryDto,
+} from './dto/referral-tree.dto';
 
 /**
  * Controller for handling referral-related HTTP requests
@@ -66,6 +73,24 @@ export class ReferralController {
     return this.referralService.getReferralStats(operatorId);
   }
 
+  /**
+   * Get the referrer reward tiers
+   */
+  @Get('tiers')
+  @ApiOperation({
+    summary: 'Get referral tiers',
+    description:
+      'Retrieves the referrer reward tiers: the $HASH bonus per referral grows with the number of successful referrals',
+  })
+  @SwaggerResponse({
+    status: 200,
+    description: 'Successfully retrieved referral tiers',
+    type: [ReferralTierDto],
+  })
+  getReferralTiers(): ApiResponse<{ tiers: ReferralTierDto[] }> {
+    return this.referralService.getReferralTiers();
+  }
+
   /**
    * Get referral data for a specific user
    */
@@ -141,6 +166,44 @@ export class ReferralController {
     );
   }
 
+  /**
+   * Get the multi-level referral tree of a specific user
+   */
+  @ApiBearerAuth()
+  @UseGuards(JwtAuthGuard)
+  @Get(':id/referral-tree')
+  @ApiOperation({
+    summary: 'Get the referral tree of a specific user',
+    description:
+      'Retrieves the operators referred by the specified user ID, nested up to the requested depth',
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'Operator ID',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiQuery({
+    type: ReferralTreeQueryDto,
+  })
+  @SwaggerResponse({
+    status: 200,
+    description: 'Successfully retrieved referral tree',
+    type: ReferralNodeDto,
+  })
+  @SwaggerResponse({
+    status: 404,
+    description: 'User not found',
+  })
+  async getOperatorReferralTree(
+    @Param('id') id: string,
+    @Query() query: ReferralTreeQueryDto,
+  ): Promise<ApiResponse<{ tree: ReferralNodeDto } | null>> {
+    return this.referralService.getReferralTree(
+      new Types.ObjectId(id),
+      query.depth,
+    );
+  }
+
   /**
    * Create a new starter code
    */
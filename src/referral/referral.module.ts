@@ -10,6 +10,10 @@ import {
 } from 'src/operators/schemas/operator.schema';
 import { RedisModule } from 'src/common/redis.module';
 import { ReferralController } from './referral.controller';
+import {
+  HashTransaction,
+  HashTransactionSchema,
+} from 'src/operators/schemas/hash-transaction.schema';
 
 @Module({
   imports: [
@@ -19,6 +23,7 @@ import { ReferralController } from './referral.controller';
       { name: Referral.name, schema: ReferralSchema },
       { name: StarterCode.name, schema: StarterCodeSchema },
       { name: Operator.name, schema: OperatorSchema },
+      { name: HashTransaction.name, schema: HashTransactionSchema },
     ]),
   ],
   controllers: [ReferralController],
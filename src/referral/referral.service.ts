@@ -13,6 +13,7 @@ import { Operator } from 'src/operators/schemas/operator.schema';
 import {
   ReferralCodeResponseDto,
   ReferralStatsResponseDto,
+  ReferralTierDto,
 } from './dto/referral.dto';
 import { ApiResponse } from 'src/common/dto/response.dto';
 import { GAME_CONSTANTS } from 'src/common/constants/game.constants';
@@ -23,6 +24,26 @@ import {
   StarterCodeResponseDto,
   UseStarterCodeDto,
 } from './dto/starter-code.dto';
+import { ReferralNodeDto } from './dto/referral-tree.dto';
+import { RedisService } from 'src/common/redis.service';
+import {
+  HashTransaction,
+  HashTransactionCategory,
+  HashTransactionStatus,
+  HashTransactionType,
+} from 'src/operators/schemas/hash-transaction.schema';
+
+/**
+ * The operator fields needed to build a referral tree.
+ *
+ * IDs are `string`s when read back from the Redis cache, hence the union types.
+ */
+type ReferralTreeOperator = {
+  _id: Types.ObjectId | string;
+  usernameData: { username: string };
+  currentHASH: number;
+  referralData: { referredBy: Types.ObjectId | string };
+};
 
 /**
  * Service for managing referrals
@@ -35,8 +56,37 @@ export class ReferralService {
     @InjectModel(Referral.name) private referralModel: Model<Referral>,
     @InjectModel(StarterCode.name) private starterCodeModel: Model<StarterCode>,
     @InjectModel(Operator.name) private operatorModel: Model<Operator>,
+    @InjectModel(HashTransaction.name)
+    private hashTransactionModel: Model<HashTransaction>,
+    private readonly redisService: RedisService,
   ) {}
 
+  /**
+   * Fetches the referrer reward tiers, lowest tier first.
+   */
+  getReferralTiers(): ApiResponse<{ tiers: ReferralTierDto[] }> {
+    return new ApiResponse(200, `(getReferralTiers) Referral tiers fetched.`, {
+      tiers: GAME_CONSTANTS.REFERRAL.REFERRER_REWARD_TIERS,
+    });
+  }
+
+  /**
+   * Fetches the highest referrer reward tier reached with `successfulReferrals` (null if no tier is reached yet).
+   */
+  private getReferrerRewardTier(
+    successfulReferrals: number,
+  ): ReferralTierDto | null {
+    let currentTier: ReferralTierDto | null = null;
+
+    for (const tier of GAME_CONSTANTS.REFERRAL.REFERRER_REWARD_TIERS) {
+      if (successfulReferrals >= tier.minReferrals) {
+        currentTier = tier;
+      }
+    }
+
+    return currentTier;
+  }
+
   /**
    * Generates or retrieves a unique referral code for an operator
    * @param operatorId The operator's ID
@@ -309,20 +359,28 @@ export class ReferralService {
     referredId: Types.ObjectId,
   ): Promise<void> {
     try {
-      // Get the referral record
-      const referral = await this.referralModel.findOne({
-        referrerId,
-        referredId,
-      });
+      // Claim the referral record so its rewards can't be applied twice
+      const referral = await this.referralModel.findOneAndUpdate(
+        { referrerId, referredId, rewardsProcessed: { $ne: true } },
+        { $set: { rewardsProcessed: true } },
+        { projection: { _id: 1 } },
+      );
 
-      if (!referral || referral.rewardsProcessed) {
+      if (!referral) {
         return; // Skip if already processed or not found
       }
 
+      // The referrer's $HASH bonus depends on their tier (this referral now counts as successful)
+      const successfulReferrals = await this.referralModel.countDocuments({
+        referrerId,
+        rewardsProcessed: true,
+      });
+      const referrerTier = this.getReferrerRewardTier(successfulReferrals);
+
       // Get rewards from constants
       const referrerRewards = {
         effCredits: GAME_CONSTANTS.REFERRAL.REFERRER_REWARDS.EFF_CREDITS,
-        hashBonus: GAME_CONSTANTS.REFERRAL.REFERRER_REWARDS.HASH_BONUS,
+        hashBonus: referrerTier?.bonusHASHPerReferral ?? 0,
       };
 
       const referredRewards = {
@@ -343,6 +401,14 @@ export class ReferralService {
         },
       );
 
+      if (referrerRewards.hashBonus > 0) {
+        await this.creditReferralBonusHASH(
+          referrerId,
+          referrerRewards.hashBonus,
+          referral._id,
+        );
+      }
+
       // Apply referred user rewards
       await this.operatorModel.updateOne(
         { _id: referredId },
@@ -360,13 +426,12 @@ export class ReferralService {
           $set: {
             referrerRewards,
             referredRewards,
-            rewardsProcessed: true,
           },
         },
       );
 
       this.logger.log(
-        `Applied referral rewards: Referrer ${referrerId} (+${referrerRewards.effCredits} EFF), ` +
+        `Applied referral rewards: Referrer ${referrerId} (+${referrerRewards.effCredits} EFF, +${referrerRewards.hashBonus} $HASH at tier ${referrerTier?.tier ?? 0}), ` +
           `Referred ${referredId} (+${referredRewards.effCredits} EFF)`,
       );
     } catch (error) {
@@ -378,6 +443,38 @@ export class ReferralService {
     }
   }
 
+  /**
+   * Credits a referral's $HASH bonus to the referrer and records it as a `REFERRAL_BONUS` $HASH transaction.
+   */
+  private async creditReferralBonusHASH(
+    referrerId: Types.ObjectId,
+    amount: number,
+    referralId: Types.ObjectId,
+  ): Promise<void> {
+    const referrer = await this.operatorModel.findOneAndUpdate(
+      { _id: referrerId },
+      { $inc: { currentHASH: amount } },
+      { new: true, projection: { currentHASH: 1 } },
+    );
+
+    if (!referrer) {
+      return;
+    }
+
+    await this.hashTransactionModel.create({
+      operatorId: referrerId,
+      transactionType: HashTransactionType.CREDIT,
+      amount,
+      category: HashTransactionCategory.REFERRAL_BONUS,
+      description: `Referral bonus for referral ${referralId}`,
+      relatedEntityId: referralId,
+      relatedEntityType: 'referral',
+      balanceBefore: referrer.currentHASH - amount,
+      balanceAfter: referrer.currentHASH,
+      status: HashTransactionStatus.COMPLETED,
+    });
+  }
+
   /**
    * Get user ID from a referral code by finding the operator with that code
    * @param referralCode The referral code to look up
@@ -643,6 +740,153 @@ export class ReferralService {
     }
   }
 
+  /**
+   * Builds the referral tree of an operator up to `maxDepth` levels deep.
+   *
+   * The root's direct referrals are cached in Redis for
+   * `REFERRAL_TREE_CHILDREN_CACHE_TTL` seconds. Deeper levels are fetched in a single `$graphLookup` on `referralData.referredBy`.
+   * @param rootOperatorId The operator at the root of the tree
+   * @param maxDepth How many levels of referrals to include
+   * @returns The root node with its nested referrals
+   */
+  async getReferralTree(
+    rootOperatorId: Types.ObjectId,
+    maxDepth: number = 3,
+  ): Promise<ApiResponse<{ tree: ReferralNodeDto } | null>> {
+    try {
+      const depth = Math.min(
+        Math.max(1, maxDepth),
+        GAME_CONSTANTS.REFERRAL.MAX_REFERRAL_TREE_DEPTH,
+      );
+
+      const root = await this.operatorModel
+        .findById(rootOperatorId, {
+          'usernameData.username': 1,
+          currentHASH: 1,
+        })
+        .lean();
+
+      if (!root) {
+        return new ApiResponse(404, '(getReferralTree) Operator not found');
+      }
+
+      const children = await this.getDirectReferrals(rootOperatorId);
+
+      // Fetch all deeper levels at once, starting from the root's direct referrals
+      let descendants: ReferralTreeOperator[] = [];
+      if (depth > 1 && children.length > 0) {
+        const result = await this.operatorModel.aggregate<{
+          descendants: ReferralTreeOperator[];
+        }>([
+          {
+            $match: {
+              _id: {
+                $in: children.map((child) => new Types.ObjectId(child._id)),
+              },
+            },
+          },
+          {
+            $graphLookup: {
+              from: 'Operators',
+              startWith: '$_id',
+              connectFromField: '_id',
+              connectToField: 'referralData.referredBy',
+              maxDepth: depth - 2,
+              as: 'descendants',
+            },
+          },
+          {
+            $project: {
+              _id: 0,
+              'descendants._id': 1,
+              'descendants.usernameData.username': 1,
+              'descendants.currentHASH': 1,
+              'descendants.referralData.referredBy': 1,
+            },
+          },
+        ]);
+
+        descendants = result.flatMap((entry) => entry.descendants);
+      }
+
+      // Group all non-root operators by their referrer
+      const childrenByReferrer = new Map<string, ReferralTreeOperator[]>();
+      for (const operator of [...children, ...descendants]) {
+        const referrerId = operator.referralData.referredBy.toString();
+        const siblings = childrenByReferrer.get(referrerId) ?? [];
+        siblings.push(operator);
+        childrenByReferrer.set(referrerId, siblings);
+      }
+
+      const buildNode = (
+        operator: Pick<ReferralTreeOperator, '_id' | 'usernameData'> & {
+          currentHASH?: number;
+        },
+        level: number,
+      ): ReferralNodeDto => ({
+        operatorId: operator._id.toString(),
+        username: operator.usernameData?.username,
+        currentHASH: operator.currentHASH || 0,
+        children:
+          level < depth
+            ? (childrenByReferrer.get(operator._id.toString()) ?? []).map(
+                (child) => buildNode(child, level + 1),
+              )
+            : [],
+      });
+
+      return new ApiResponse(200, 'Referral tree retrieved successfully', {
+        tree: buildNode(root, 0),
+      });
+    } catch (error) {
+      this.logger.error(
+        `(getReferralTree) Error: ${error.message}`,
+        error.stack,
+      );
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(getReferralTree) Error retrieving referral tree: ${error.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches the operators directly referred by an operator, using a
+   * short-lived Redis cache.
+   * @param operatorId The referrer's operator ID
+   */
+  private async getDirectReferrals(
+    operatorId: Types.ObjectId,
+  ): Promise<ReferralTreeOperator[]> {
+    const cacheKey = `referral-tree:children:${operatorId.toString()}`;
+
+    const cached = await this.redisService.get(cacheKey);
+    if (cached) {
+      return JSON.parse(cached) as ReferralTreeOperator[];
+    }
+
+    const children = await this.operatorModel
+      .find(
+        { 'referralData.referredBy': operatorId },
+        {
+          'usernameData.username': 1,
+          currentHASH: 1,
+          'referralData.referredBy': 1,
+        },
+      )
+      .lean<ReferralTreeOperator[]>();
+
+    await this.redisService.set(
+      cacheKey,
+      JSON.stringify(children),
+      GAME_CONSTANTS.REFERRAL.REFERRAL_TREE_CHILDREN_CACHE_TTL,
+    );
+
+    return children;
+  }
+
   /**
    * Generates a unique starter code that can be used for referrals
    * @param createStarterCodeDto Data for creating a starter code
@@ -0,0 +1,103 @@
+import { NestFactory } from '@nestjs/core';
+import { getConnectionToken } from '@nestjs/mongoose';
+import { Connection } from 'mongoose';
+import { AppModule } from '../app.module';
+import { TONPaymentFeature } from 'src/ton/schemas/ton-payment-claim.schema';
+
+/**
+ * Where each TON-paid feature recorded its payments before `TONPaymentClaims` existed,
+ * and how to read the claimed target and amount from a record.
+ */
+const TON_PAYMENT_SOURCES: {
+  collection: string;
+  filter: Record<string, any>;
+  feature: (record: any) => TONPaymentFeature;
+  targetId: (record: any) => string;
+  amountTON: (record: any) => number;
+}[] = [
+  {
+    collection: 'ShopPurchases',
+    filter: { 'blockchainData.chain': 'TON' },
+    feature: () => TONPaymentFeature.SHOP_PURCHASE,
+    targetId: (record) => record.itemPurchased,
+    amountTON: (record) => record.totalCost,
+  },
+  {
+    collection: 'DrillInsurances',
+    filter: {},
+    feature: () => TONPaymentFeature.DRILL_INSURANCE,
+    targetId: (record) => String(record.drillId),
+    amountTON: (record) => record.premiumTON,
+  },
+  {
+    collection: 'DrillSkinPurchases',
+    filter: {},
+    feature: () => TONPaymentFeature.DRILL_SKIN,
+    targetId: (record) => String(record.drillId),
+    amountTON: (record) => record.paidTON,
+  },
+  {
+    collection: 'DrillUpgrades',
+    filter: {},
+    feature: (record) =>
+      record.type === 'CONFIG'
+        ? TONPaymentFeature.DRILL_CONFIG_UPGRADE
+        : TONPaymentFeature.DRILL_UPGRADE,
+    targetId: (record) => String(record.drillId),
+    amountTON: (record) => record.costTON,
+  },
+];
+
+/**
+ * Records every TON payment redeemed before `TONPaymentClaims` existed as claimed,
+ * so old payments can't be redeemed again for another feature.
+ *
+ * Safe to run more than once; payments that are already claimed are skipped.
+ */
+export async function runBackfillTONPaymentClaims() {
+  const app = await NestFactory.createApplicationContext(AppModule); // Create NestJS app context
+  const connection = app.get<Connection>(getConnectionToken());
+  const claims = connection.collection('TONPaymentClaims');
+
+  for (const source of TON_PAYMENT_SOURCES) {
+    const records = await connection
+      .collection(source.collection)
+      .find({ ...source.filter, 'blockchainData.txHash': { $ne: null } })
+      .toArray();
+
+    if (records.length === 0) {
+      continue;
+    }
+
+    const result = await claims.bulkWrite(
+      records.map((record) => ({
+        updateOne: {
+          filter: { txHash: record.blockchainData.txHash },
+          update: {
+            $setOnInsert: {
+              txHash: record.blockchainData.txHash,
+              operatorId: record.operatorId,
+              feature: source.feature(record),
+              targetId: source.targetId(record),
+              amountTON: source.amountTON(record) ?? 0,
+              createdAt: record.createdAt ?? new Date(),
+              updatedAt: new Date(),
+            },
+          },
+          upsert: true,
+        },
+      })),
+      { ordered: false },
+    );
+
+    console.log(
+      `✅ Backfilled ${result.upsertedCount} TON payment claims from ${source.collection}.`,
+    );
+  }
+
+  await app.close(); // Close the app to prevent memory leaks
+}
+
+runBackfillTONPaymentClaims().catch((err) => {
+  console.error('❌ Error running function:', err);
+});
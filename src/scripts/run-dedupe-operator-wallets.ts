@@ -0,0 +1,102 @@
+import { NestFactory } from '@nestjs/core';
+import { getConnectionToken } from '@nestjs/mongoose';
+import { Connection, Types } from 'mongoose';
+import { AppModule } from '../app.module';
+import { OperatorWalletService } from 'src/operators/operator-wallet.service';
+import { AllowedChain } from 'src/common/enums/chain.enum';
+import { canonicalTonAddress } from 'src/common/utils/wallets-data';
+
+/**
+ * Removes duplicate wallet links (the same `address` on the same `chain`), then creates the unique `{ address, chain }` index.
+ * TON addresses are converted to their raw form first, so bounceable, non-bounceable and raw forms of a wallet count as duplicates.
+ *
+ * The earliest link of each wallet is kept, since that operator linked it first. Operators that lose a wallet
+ * get their asset equity recalculated.
+ *
+ * Must be run before deploying the unique index, since MongoDB can't build it while duplicates exist.
+ * Safe to run more than once.
+ */
+export async function runDedupeOperatorWallets() {
+  const app = await NestFactory.createApplicationContext(AppModule); // Create NestJS app context
+  const connection = app.get<Connection>(getConnectionToken());
+  const operatorWalletService = app.get(OperatorWalletService);
+  const wallets = connection.collection('OperatorWallets');
+
+  // TON addresses are compared (and stored) in their raw form, so every form of the same wallet counts as one
+  const canonicalAddress = (address: string, chain: string): string =>
+    chain === AllowedChain.TON
+      ? (canonicalTonAddress(address) ?? address)
+      : address;
+
+  const allWallets = await wallets
+    .find<{
+      _id: Types.ObjectId;
+      operatorId: Types.ObjectId;
+      address: string;
+      chain: string;
+    }>({}, { projection: { operatorId: 1, address: 1, chain: 1 } })
+    .sort({ createdAt: 1, _id: 1 })
+    .toArray();
+
+  const linksByWallet = new Map<string, typeof allWallets>();
+  for (const wallet of allWallets) {
+    const key = `${wallet.chain}:${canonicalAddress(wallet.address, wallet.chain)}`;
+    linksByWallet.set(key, [...(linksByWallet.get(key) ?? []), wallet]);
+  }
+
+  const duplicates = [...linksByWallet.values()].filter(
+    (links) => links.length > 1,
+  );
+  const removedLinks = duplicates.flatMap((links) => links.slice(1));
+
+  if (removedLinks.length > 0) {
+    await wallets.deleteMany({
+      _id: { $in: removedLinks.map((link) => link._id) },
+    });
+  }
+
+  // Only the kept links remain, so rewriting their addresses can't collide with another link
+  const keptLinks = [...linksByWallet.values()].map((links) => links[0]);
+  const canonicalizedLinks = keptLinks.filter(
+    (link) => canonicalAddress(link.address, link.chain) !== link.address,
+  );
+
+  for (const link of canonicalizedLinks) {
+    await wallets.updateOne(
+      { _id: link._id },
+      { $set: { address: canonicalAddress(link.address, link.chain) } },
+    );
+  }
+
+  console.log(
+    `✅ Removed ${removedLinks.length} duplicate links of ${duplicates.length} wallets.`,
+  );
+
+  console.log(
+    `✅ Converted ${canonicalizedLinks.length} TON wallet addresses to their raw form.`,
+  );
+
+  const affectedOperatorIds = new Map(
+    removedLinks.map((link) => [link.operatorId.toString(), link.operatorId]),
+  );
+
+  for (const operatorId of affectedOperatorIds.values()) {
+    await operatorWalletService
+      .updateAssetEquityForOperator(operatorId)
+      .catch((err: any) => {
+        console.error(
+          `❌ Error updating asset equity for operator ${operatorId}: ${err.message}`,
+        );
+      });
+  }
+
+  await wallets.createIndex({ address: 1, chain: 1 }, { unique: true });
+
+  console.log(`✅ Created the unique { address, chain } wallet index.`);
+
+  await app.close(); // Close the app to prevent memory leaks
+}
+
+runDedupeOperatorWallets().catch((err) => {
+  console.error('❌ Error running function:', err);
+});
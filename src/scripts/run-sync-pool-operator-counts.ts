@@ -0,0 +1,47 @@
+import { NestFactory } from '@nestjs/core';
+import { getConnectionToken } from '@nestjs/mongoose';
+import { Connection } from 'mongoose';
+import { AppModule } from '../app.module';
+
+/**
+ * Sets every pool's `operatorCount` to its actual number of `PoolOperators`.
+ *
+ * Run once before deploying the counter-based join check, and again whenever the counters may have drifted.
+ * Should be run while joins are paused, since joins in the meantime would be overwritten.
+ */
+export async function runSyncPoolOperatorCounts() {
+  const app = await NestFactory.createApplicationContext(AppModule); // Create NestJS app context
+  const connection = app.get<Connection>(getConnectionToken());
+
+  const counts = await connection
+    .collection('PoolOperators')
+    .aggregate<{ _id: any; count: number }>([
+      { $group: { _id: '$pool', count: { $sum: 1 } } },
+    ])
+    .toArray();
+
+  const pools = connection.collection('Pools');
+
+  // Pools without any operators aren't in the aggregation, so reset everything first
+  await pools.updateMany({}, { $set: { operatorCount: 0 } });
+
+  if (counts.length > 0) {
+    await pools.bulkWrite(
+      counts.map(({ _id, count }) => ({
+        updateOne: {
+          filter: { _id },
+          update: { $set: { operatorCount: count } },
+        },
+      })),
+      { ordered: false },
+    );
+  }
+
+  console.log(`✅ Synced operator counts of ${counts.length} pools.`);
+
+  await app.close(); // Close the app to prevent memory leaks
+}
+
+runSyncPoolOperatorCounts().catch((err) => {
+  console.error('❌ Error running function:', err);
+});
@@ -0,0 +1,90 @@
+import {
+  Processor,
+  Process,
+  InjectQueue,
+  OnGlobalQueueFailed,
+} from '@nestjs/bull';
+import { Queue } from 'bull';
+import { Injectable, Logger, OnModuleInit } from '@nestjs/common';
+import { ShopPurchaseService } from './shop-purchase.service';
+
+@Injectable()
+@Processor('flash-sale-queue')
+export class FlashSaleQueue implements OnModuleInit {
+  private readonly logger = new Logger(FlashSaleQueue.name);
+  private readonly oneMinuteInMs = 60 * 1000; // 1 minute
+
+  // ⬇️ Total number of flash sales expired since the app started
+  private flashSalesExpiredTotal = 0;
+
+  constructor(
+    private readonly shopPurchaseService: ShopPurchaseService,
+    @InjectQueue('flash-sale-queue')
+    private readonly flashSaleQueue: Queue,
+  ) {}
+
+  /**
+   * Called when the module initializes.
+   */
+  async onModuleInit() {
+    // ✅ Schedule Flash Sale Expiry (Every Minute)
+    await this.ensureJobScheduled('expire-flash-sales', this.oneMinuteInMs);
+  }
+
+  /**
+   * Ensures a Bull job is scheduled, preventing duplicates.
+   */
+  private async ensureJobScheduled(jobName: string, intervalMs: number) {
+    const existingJobs = await this.flashSaleQueue.getRepeatableJobs();
+    if (!existingJobs.some((job) => job.name === jobName)) {
+      await this.flashSaleQueue.add(
+        jobName,
+        {},
+        {
+          repeat: { every: intervalMs },
+          removeOnComplete: true,
+          removeOnFail: false,
+        },
+      );
+      this.logger.log(
+        `✅ (flashSaleQueue) Scheduled job: ${jobName} every ${intervalMs / 1000 / 60} minutes.`,
+      );
+    } else {
+      this.logger.log(
+        `🔄 (flashSaleQueue) Job already scheduled: ${jobName}.`,
+      );
+    }
+  }
+
+  /**
+   * Expires the active flash sales that have ended and restores their original prices (runs **every minute**).
+   */
+  @Process({
+    name: 'expire-flash-sales',
+    concurrency: 1, // Limit to one concurrent job at a time
+  })
+  async handleExpireFlashSales() {
+    try {
+      const expiredCount = await this.shopPurchaseService.expireFlashSales();
+      this.flashSalesExpiredTotal += expiredCount;
+
+      this.logger.debug(
+        `(expire-flash-sales) Expired ${expiredCount} flash sales this run (${this.flashSalesExpiredTotal} total).`,
+      );
+    } catch (error) {
+      this.logger.error(
+        `❌ (expire-flash-sales) Error expiring flash sales: ${error.message}`,
+      );
+    }
+  }
+
+  /**
+   * Handle failed jobs in the queue.
+   */
+  @OnGlobalQueueFailed()
+  onFailed(jobId: number, err: Error) {
+    this.logger.error(
+      `❌ Flash Sale Queue job ${jobId} has failed: ${err.message}`,
+    );
+  }
+}
@@ -0,0 +1,125 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The status of a flash sale.
+ */
+export enum FlashSaleStatus {
+  /** The sale price is currently applied to the shop item. */
+  ACTIVE = 'active',
+  /** The sale has ended and the shop item's original price was restored. */
+  EXPIRED = 'expired',
+}
+
+/**
+ * `FlashSale` represents a temporary price reduction of a shop item.
+ *
+ * While the sale is active, the shop item's `purchaseCost` is the sale price. Once `endTime` has passed,
+ * the sale is expired and the shop item's `originalPurchaseCost` is restored.
+ */
+@Schema({ timestamps: true, collection: 'FlashSales', versionKey: false })
+export class FlashSale extends Document {
+  /**
+   * The database ID of the flash sale.
+   */
+  @ApiProperty({
+    description: 'The database ID of the flash sale',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the shop item on sale.
+   */
+  @ApiProperty({
+    description: 'The database ID of the shop item on sale',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'ShopItems' })
+  shopItemId: Types.ObjectId;
+
+  /**
+   * The shop item's price during the sale.
+   */
+  @ApiProperty({
+    description: "The shop item's price during the sale",
+    example: { ton: 0.5, bera: 0.5 },
+  })
+  @Prop({
+    type: {
+      ton: { type: Number, required: true },
+      bera: { type: Number, required: true },
+    },
+    required: true,
+    _id: false,
+  })
+  salePurchaseCost: {
+    ton: number;
+    bera: number;
+  };
+
+  /**
+   * The shop item's price before the sale (restored once the sale expires).
+   */
+  @ApiProperty({
+    description:
+      "The shop item's price before the sale (restored once the sale expires)",
+    example: { ton: 1, bera: 1 },
+  })
+  @Prop({
+    type: {
+      ton: { type: Number, required: true },
+      bera: { type: Number, required: true },
+    },
+    required: true,
+    _id: false,
+  })
+  originalPurchaseCost: {
+    ton: number;
+    bera: number;
+  };
+
+  /**
+   * When the sale started.
+   */
+  @ApiProperty({
+    description: 'When the sale started',
+    example: '2025-03-01T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  startTime: Date;
+
+  /**
+   * When the sale ends.
+   */
+  @ApiProperty({
+    description: 'When the sale ends',
+    example: '2025-03-02T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  endTime: Date;
+
+  /**
+   * The status of the sale.
+   */
+  @ApiProperty({
+    description: 'The status of the sale',
+    enum: FlashSaleStatus,
+    example: FlashSaleStatus.ACTIVE,
+  })
+  @Prop({
+    type: String,
+    enum: FlashSaleStatus,
+    default: FlashSaleStatus.ACTIVE,
+  })
+  status: FlashSaleStatus;
+}
+
+export const FlashSaleSchema = SchemaFactory.createForClass(FlashSale);
+
+// Index for finding active sales that have ended
+FlashSaleSchema.index({ status: 1, endTime: 1 });
+// Index for checking whether a shop item already has an active sale
+FlashSaleSchema.index({ shopItemId: 1, status: 1 });
@@ -81,6 +81,32 @@ export class ShopItem extends Document {
     ton: number;
     bera: number;
   };
+
+  /**
+   * The number of units of the shop item left for sale (null if the supply is unlimited).
+   *
+   * Used for limited-edition items. Once this reaches 0, the item is sold out until an admin restocks it.
+   */
+  @ApiProperty({
+    description:
+      'The number of units left for sale (null if the supply is unlimited)',
+    example: 50,
+    nullable: true,
+  })
+  @Prop({ type: Number, required: false, default: null, min: 0 })
+  remainingSupply: number | null;
+
+  /**
+   * Whether the shop item is currently listed in the shop.
+   *
+   * Removed items are deactivated rather than deleted so drills bought from them keep their lineage.
+   */
+  @ApiProperty({
+    description: 'Whether the shop item is currently listed in the shop',
+    example: true,
+  })
+  @Prop({ type: Boolean, required: true, default: true })
+  active: boolean;
 }
 
 export const ShopItemSchema = SchemaFactory.createForClass(ShopItem);
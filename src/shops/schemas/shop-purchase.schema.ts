@@ -3,6 +3,7 @@ import { BlockchainData } from 'src/common/schemas/blockchain-payment.schema';
 import { Document, Types } from 'mongoose';
 import { TGStarsData } from 'src/common/schemas/telegram-payment.schema';
 import { ApiProperty } from '@nestjs/swagger';
+import { ShopPurchaseCategory } from 'src/common/enums/shop.enum';
 
 /**
  * `ShopPurchase` represents a purchase made in the shop.
@@ -76,6 +77,32 @@ export class ShopPurchase extends Document {
   @Prop({ required: true })
   currency: string;
 
+  /**
+   * The cost of the purchase in TON (0 if it was paid in another currency or airdropped).
+   */
+  @ApiProperty({
+    description:
+      'The cost of the purchase in TON (0 if it was paid in another currency or airdropped)',
+    example: 0.95,
+  })
+  @Prop({ type: Number, default: 0 })
+  priceTON: number;
+
+  /**
+   * The revenue category of the item purchased.
+   */
+  @ApiProperty({
+    description: 'The revenue category of the item purchased',
+    enum: ShopPurchaseCategory,
+    example: ShopPurchaseCategory.FUEL,
+  })
+  @Prop({
+    type: String,
+    enum: ShopPurchaseCategory,
+    default: ShopPurchaseCategory.PURCHASE,
+  })
+  category: ShopPurchaseCategory;
+
   /**
    * If the operator purchased the item using Telegram Stars, this will contain the data received from the Telegram payment provider.
    */
@@ -99,6 +126,19 @@ export class ShopPurchase extends Document {
   @Prop({ type: BlockchainData, required: false, default: null })
   blockchainData?: BlockchainData;
 
+  /**
+   * If the item was airdropped by an admin instead of purchased, this will contain the reason for the airdrop (e.g. a promotional campaign).
+   */
+  @ApiProperty({
+    description:
+      'The reason for the airdrop (for items airdropped by an admin instead of purchased)',
+    example: 'launch',
+    required: false,
+    nullable: true,
+  })
+  @Prop({ type: String, required: false, default: null })
+  airdropReason?: string | null;
+
   /**
    * The timestamp when the purchase was created
    */
@@ -119,3 +159,15 @@ export class ShopPurchase extends Document {
 }
 
 export const ShopPurchaseSchema = SchemaFactory.createForClass(ShopPurchase);
+
+// Index for aggregating revenue within a time window
+ShopPurchaseSchema.index({ createdAt: 1, currency: 1 });
+
+// A transaction can only pay for one purchase
+ShopPurchaseSchema.index(
+  { 'blockchainData.txHash': 1 },
+  {
+    unique: true,
+    partialFilterExpression: { 'blockchainData.txHash': { $type: 'string' } },
+  },
+);
@@ -36,4 +36,18 @@ export class ShopItemController {
 
     return this.shopItemService.getShopItems(projectionObj);
   }
+
+  @ApiOperation({
+    summary: 'Get shop drills',
+    description: 'Fetches all drills currently listed in the shop',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved shop drills',
+    type: GetShopItemsResponseDto,
+  })
+  @Get('drills')
+  async getShopDrills(): Promise<AppApiResponse<{ shopItems: ShopItem[] }>> {
+    return this.shopItemService.getShopDrills();
+  }
 }
@@ -1,10 +1,11 @@
 import { Injectable, InternalServerErrorException } from '@nestjs/common';
 import { InjectModel } from '@nestjs/mongoose';
-import { Model } from 'mongoose';
+import { Model, Types } from 'mongoose';
 import { ApiResponse } from 'src/common/dto/response.dto';
 import { ShopItemEffects } from 'src/common/schemas/shop-item-effect.schema';
 import { ShopItem } from './schemas/shop-item.schema';
 import { ShopItemType } from 'src/common/enums/shop.enum';
+import { DrillConfig, DrillVersion } from 'src/common/enums/drill.enum';
 
 @Injectable()
 export class ShopItemService {
@@ -56,7 +57,7 @@ export class ShopItemService {
   ): Promise<ApiResponse<{ shopItems: ShopItem[] }>> {
     try {
       const shopItems = await this.shopItemModel
-        .find({})
+        .find({ active: { $ne: false } })
         .select(projection)
         .lean();
       return new ApiResponse<{ shopItems: ShopItem[] }>(
@@ -73,4 +74,161 @@ export class ShopItemService {
       );
     }
   }
+
+  /**
+   * Fetches all drills currently listed in the shop.
+   */
+  async getShopDrills(): Promise<ApiResponse<{ shopItems: ShopItem[] }>> {
+    try {
+      const shopItems = await this.shopItemModel
+        .find({
+          active: { $ne: false },
+          'itemEffects.drillData': { $exists: true, $ne: null },
+        })
+        .lean();
+      return new ApiResponse<{ shopItems: ShopItem[] }>(
+        200,
+        `(getShopDrills) Fetched ${shopItems.length} shop drills.`,
+        { shopItems },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(getShopDrills) Error fetching shop drills: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Lists a new drill in the shop.
+   *
+   * `upgradeCosts` must contain exactly `maxLevel - 1` entries for levels 2 to `maxLevel`, in order.
+   */
+  async addShopDrill(
+    config: DrillConfig,
+    version: DrillVersion,
+    purchaseCost: {
+      ton: number;
+      bera: number;
+    },
+    baseEff: number,
+    maxLevel: number,
+    upgradeCosts: { level: number; costTON: number }[],
+    description?: string,
+  ): Promise<ApiResponse<{ shopItemId: string } | null>> {
+    try {
+      // Each drill configuration is sold as `<config>_DRILL`
+      const item = `${config}_DRILL` as ShopItemType;
+
+      if (!Object.values(ShopItemType).includes(item)) {
+        return new ApiResponse<null>(
+          400,
+          `(addShopDrill) ${config} drills can't be sold in the shop.`,
+        );
+      }
+
+      if (upgradeCosts.length !== maxLevel - 1) {
+        return new ApiResponse<null>(
+          400,
+          `(addShopDrill) Expected ${maxLevel - 1} upgrade costs, got ${upgradeCosts.length}.`,
+        );
+      }
+
+      const outOfOrder = upgradeCosts.findIndex(
+        (cost, index) => cost.level !== index + 2,
+      );
+
+      if (outOfOrder !== -1) {
+        return new ApiResponse<null>(
+          400,
+          `(addShopDrill) Upgrade cost at index ${outOfOrder} should be for level ${outOfOrder + 2}, got level ${upgradeCosts[outOfOrder].level}.`,
+        );
+      }
+
+      const shopItem = await this.shopItemModel.create({
+        item,
+        itemEffects: {
+          drillData: {
+            version,
+            config,
+            baseEff,
+            maxLevel,
+            upgradeCostsTON: upgradeCosts.map((cost) => cost.costTON),
+          },
+        },
+        description,
+        purchaseCost,
+      });
+
+      return new ApiResponse<{ shopItemId: string }>(
+        200,
+        `(addShopDrill) Shop drill created.`,
+        {
+          shopItemId: String(shopItem._id),
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(addShopDrill) Error creating shop drill: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Removes a drill from the shop.
+   *
+   * The shop item is deactivated rather than deleted so drills bought from it keep their lineage.
+   */
+  async removeShopDrill(
+    shopItemId: Types.ObjectId,
+  ): Promise<ApiResponse<null>> {
+    try {
+      const shopItem = await this.shopItemModel
+        .findOne({ _id: shopItemId }, { itemEffects: 1, active: 1 })
+        .lean();
+
+      if (!shopItem) {
+        return new ApiResponse<null>(
+          404,
+          `(removeShopDrill) Shop item not found.`,
+        );
+      }
+
+      if (!shopItem.itemEffects?.drillData) {
+        return new ApiResponse<null>(
+          400,
+          `(removeShopDrill) Shop item is not a drill.`,
+        );
+      }
+
+      if (shopItem.active === false) {
+        return new ApiResponse<null>(
+          400,
+          `(removeShopDrill) Shop drill has already been removed.`,
+        );
+      }
+
+      await this.shopItemModel.updateOne(
+        { _id: shopItemId },
+        { $set: { active: false } },
+      );
+
+      return new ApiResponse<null>(
+        200,
+        `(removeShopDrill) Shop drill removed.`,
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse<null>(
+          500,
+          `(removeShopDrill) Error removing shop drill: ${err.message}`,
+        ),
+      );
+    }
+  }
 }
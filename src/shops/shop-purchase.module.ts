@@ -12,10 +12,19 @@ import {
   Operator,
   OperatorSchema,
 } from 'src/operators/schemas/operator.schema';
+import {
+  OperatorWallet,
+  OperatorWalletSchema,
+} from 'src/operators/schemas/operator-wallet.schema';
 import { ShopPurchaseController } from './shop-purchase.controller';
 import { AlchemyModule } from 'src/alchemy/alchemy.module';
 import { DrillingGatewayModule } from 'src/gateway/drilling.gateway.module';
 import { MixpanelModule } from 'src/mixpanel/mixpanel.module';
+import { DrillNFTSyncModule } from 'src/drills/drill-nft-sync.module';
+import { TelegramModule } from 'src/telegram/telegram.module';
+import { BullModule } from '@nestjs/bull';
+import { FlashSale, FlashSaleSchema } from './schemas/flash-sale.schema';
+import { FlashSaleQueue } from './flash-sale.queue';
 
 @Module({
   imports: [
@@ -24,14 +33,26 @@ import { MixpanelModule } from 'src/mixpanel/mixpanel.module';
       { name: ShopItem.name, schema: ShopItemSchema },
       { name: Drill.name, schema: DrillSchema },
       { name: Operator.name, schema: OperatorSchema },
+      { name: FlashSale.name, schema: FlashSaleSchema },
+      { name: OperatorWallet.name, schema: OperatorWalletSchema },
     ]), // Register ShopPurchase schema
+    BullModule.registerQueue({
+      name: 'flash-sale-queue',
+      defaultJobOptions: {
+        attempts: 3, // Retry failed jobs 3 times
+        removeOnComplete: true, // Remove completed jobs
+        removeOnFail: false, // Keep failed jobs for debugging
+      },
+    }),
     TonModule,
     AlchemyModule,
     DrillingGatewayModule,
     MixpanelModule,
+    DrillNFTSyncModule,
+    TelegramModule,
   ],
   controllers: [ShopPurchaseController], // Expose API endpoints
-  providers: [ShopPurchaseService], // Business logic for ShopService
+  providers: [ShopPurchaseService, FlashSaleQueue], // Business logic for ShopService
   exports: [MongooseModule, ShopPurchaseService], // Allow usage in other modules
 })
 export class ShopPurchaseModule {}
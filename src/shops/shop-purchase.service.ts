@@ -16,13 +16,26 @@ import { Drill } from 'src/drills/schemas/drill.schema';
 import { ShopItemEffects } from 'src/common/schemas/shop-item-effect.schema';
 import { Operator } from 'src/operators/schemas/operator.schema';
 import { TonService } from 'src/ton/ton.service';
+import { TONPaymentFeature } from 'src/ton/schemas/ton-payment-claim.schema';
 import { AllowedChain } from 'src/common/enums/chain.enum';
 import { BlockchainData } from 'src/common/schemas/blockchain-payment.schema';
 import { AlchemyService } from 'src/alchemy/alchemy.service';
 import { RedisService } from 'src/common/redis.service';
 import { DrillingGatewayService } from 'src/gateway/drilling.gateway.service';
 import { MixpanelService } from 'src/mixpanel/mixpanel.service';
+import { DrillNFTSyncService } from 'src/drills/drill-nft-sync.service';
+import { DrillNFTSyncOperation } from 'src/common/enums/drill.enum';
 import { EVENT_CONSTANTS } from 'src/common/constants/mixpanel.constants';
+import { TelegramService } from 'src/telegram/telegram.service';
+import { shopItemCategory } from 'src/common/utils/shop';
+import {
+  drillMinAssetEquity,
+  drillPurchaseCost,
+  meetsDrillMinAssetEquity,
+} from 'src/common/utils/drill';
+import { FlashSale, FlashSaleStatus } from './schemas/flash-sale.schema';
+import { OperatorWallet } from 'src/operators/schemas/operator-wallet.schema';
+import { canonicalTonAddress } from 'src/common/utils/wallets-data';
 
 @Injectable()
 export class ShopPurchaseService {
@@ -33,15 +46,21 @@ export class ShopPurchaseService {
     private readonly shopPurchaseModel: Model<ShopPurchase>,
     @InjectModel(ShopItem.name)
     private readonly shopItemModel: Model<ShopItem>,
+    @InjectModel(FlashSale.name)
+    private readonly flashSaleModel: Model<FlashSale>,
     @InjectModel(Drill.name)
     private readonly drillModel: Model<Drill>,
     @InjectModel(Operator.name)
     private readonly operatorModel: Model<Operator>,
+    @InjectModel(OperatorWallet.name)
+    private readonly operatorWalletModel: Model<OperatorWallet>,
     private readonly tonService: TonService,
     private readonly alchemyService: AlchemyService,
     private readonly redisService: RedisService,
     private readonly drillingGatewayService: DrillingGatewayService,
     private readonly mixpanelService: MixpanelService,
+    private readonly drillNFTSyncService: DrillNFTSyncService,
+    private readonly telegramService: TelegramService,
   ) {}
 
   /**
@@ -67,6 +86,9 @@ export class ShopPurchaseService {
       createdAt: Date;
     } | null>
   > {
+    // The shop item a unit of supply was reserved from, until the purchase is recorded
+    let reservedShopItemId: Types.ObjectId | null = null;
+
     try {
       // Check if the purchase is allowed
       const purchaseAllowedResponse = await this.checkPurchaseAllowed(
@@ -83,6 +105,41 @@ export class ShopPurchaseService {
         );
       }
 
+      // Reserve a unit of limited-edition items (`remainingSupply` not null) before verifying the payment, so concurrent purchases can't oversell them
+      const reservedShopItem = await this.shopItemModel
+        .findOneAndUpdate(
+          {
+            ...(shopItemId ? { _id: shopItemId } : { item: shopItemName }),
+            active: { $ne: false },
+            $or: [{ remainingSupply: null }, { remainingSupply: { $gt: 0 } }],
+          },
+          [
+            {
+              $set: {
+                remainingSupply: {
+                  $cond: [
+                    { $eq: ['$remainingSupply', null] },
+                    null,
+                    { $subtract: ['$remainingSupply', 1] },
+                  ],
+                },
+              },
+            },
+          ],
+          { projection: { _id: 1, remainingSupply: 1 } },
+        )
+        .lean();
+
+      if (!reservedShopItem) {
+        throw new ForbiddenException(
+          `(purchaseItem) Purchase not allowed: Shop item is sold out.`,
+        );
+      }
+
+      if (reservedShopItem.remainingSupply !== null) {
+        reservedShopItemId = reservedShopItem._id;
+      }
+
       // Check if this tx hash was already used for a purchase
       const existingPurchase = await this.shopPurchaseModel.exists({
         'blockchainData.txHash': txHash,
@@ -94,6 +151,22 @@ export class ShopPurchaseService {
         );
       }
 
+      // TON payments must come from a wallet the operator has linked
+      if (chain === AllowedChain.TON) {
+        // Linked TON wallets are stored in their raw form
+        const linkedWallet = await this.operatorWalletModel.exists({
+          operatorId,
+          address: canonicalTonAddress(address) ?? address,
+          chain: AllowedChain.TON,
+        });
+
+        if (!linkedWallet) {
+          throw new ForbiddenException(
+            `(purchaseItem) Address ${address} is not a TON wallet linked to this operator.`,
+          );
+        }
+      }
+
       // Check if the payment is valid
       let blockchainData: BlockchainData | null = null;
 
@@ -120,19 +193,86 @@ export class ShopPurchaseService {
         );
       }
 
+      if (chain === AllowedChain.TON) {
+        // The BOC is converted to the actual tx hash during verification, so check that one as well
+        const existingTONPurchase = await this.shopPurchaseModel.exists({
+          'blockchainData.txHash': blockchainData.txHash,
+        });
+
+        if (existingTONPurchase) {
+          throw new ForbiddenException(
+            `(purchaseItem) Transaction hash already used for a purchase.`,
+          );
+        }
+
+        const shopItemPriceTON =
+          purchaseAllowedResponse.data.shopItemPrice?.ton ?? 0;
+
+        if (blockchainData.txPayload.cost < shopItemPriceTON) {
+          throw new BadRequestException(
+            `(purchaseItem) Paid ${blockchainData.txPayload.cost} TON, but ${shopItemName} costs ${shopItemPriceTON} TON.`,
+          );
+        }
+      }
+
       this.logger.debug(
         `(purchaseItem) Blockchain data verified: ${JSON.stringify(blockchainData, null, 2)}`,
       );
 
+      // Backstop in case the operator's asset equity dropped after `checkPurchaseAllowed`
+      const drillConfig =
+        purchaseAllowedResponse.data.shopItemEffects?.drillData?.config;
+
+      if (drillConfig && drillMinAssetEquity(drillConfig) !== null) {
+        const operator = await this.operatorModel
+          .findOne({ _id: operatorId }, { assetEquity: 1 })
+          .lean();
+
+        if (
+          !meetsDrillMinAssetEquity(drillConfig, operator?.assetEquity ?? 0)
+        ) {
+          throw new ForbiddenException(
+            `(purchaseItem) Insufficient asset equity for the ${drillConfig} drill configuration. Required: ${drillMinAssetEquity(drillConfig)} USD.`,
+          );
+        }
+      }
+
+      // Claim TON payments before granting anything, so they can't also be redeemed for other features
+      if (chain === AllowedChain.TON) {
+        const claimError = await this.tonService.claimTONPayment(
+          operatorId,
+          blockchainData,
+          TONPaymentFeature.SHOP_PURCHASE,
+          shopItemName,
+        );
+
+        if (claimError) {
+          throw new ForbiddenException(`(purchaseItem) ${claimError}`);
+        }
+      }
+
       // Create a new shop purchase
-      const shopPurchase = await this.shopPurchaseModel.create({
-        operatorId,
-        itemPurchased: shopItemName,
-        amount: 1,
-        totalCost: blockchainData.txPayload.cost,
-        currency: blockchainData.txPayload.curr,
-        blockchainData,
-      });
+      const shopPurchase = await this.shopPurchaseModel
+        .create({
+          operatorId,
+          itemPurchased: shopItemName,
+          amount: 1,
+          totalCost: blockchainData.txPayload.cost,
+          currency: blockchainData.txPayload.curr,
+          priceTON:
+            chain === AllowedChain.TON ? blockchainData.txPayload.cost : 0,
+          category: shopItemCategory(shopItemName),
+          blockchainData,
+        })
+        .catch(async (err: any) => {
+          if (chain === AllowedChain.TON && err.code !== 11000) {
+            await this.tonService.releaseTONPayment(blockchainData.txHash);
+          }
+          throw err;
+        });
+
+      // The reserved unit now belongs to this purchase
+      reservedShopItemId = null;
 
       this.logger.debug(
         `(purchaseItem) Shop purchase created: ${JSON.stringify(
@@ -142,6 +282,14 @@ export class ShopPurchaseService {
         )}`,
       );
 
+      // Track the operator's total TON spent
+      if (chain === AllowedChain.TON) {
+        await this.operatorModel.updateOne(
+          { _id: operatorId },
+          { $inc: { totalTONSpent: blockchainData.txPayload.cost } },
+        );
+      }
+
       // Grant the effects of the shop item to the operator
       await this.grantShopItemEffects(
         operatorId,
@@ -182,6 +330,27 @@ export class ShopPurchaseService {
         },
       );
     } catch (err: any) {
+      // Give the reserved unit back, since the purchase didn't go through
+      if (reservedShopItemId) {
+        await this.shopItemModel
+          .updateOne(
+            { _id: reservedShopItemId },
+            { $inc: { remainingSupply: 1 } },
+          )
+          .catch((releaseErr: any) => {
+            this.logger.error(
+              `(purchaseItem) Error giving back the reserved unit of shop item ${reservedShopItemId}: ${releaseErr.message}`,
+            );
+          });
+      }
+
+      // Another request used the same transaction in the meantime
+      if (err.code === 11000) {
+        throw new ForbiddenException(
+          `(purchaseItem) Transaction hash already used for a purchase.`,
+        );
+      }
+
       throw new HttpException(
         `(purchaseItem) Error purchasing item: ${err.message}`,
         err.status || 500,
@@ -189,6 +358,343 @@ export class ShopPurchaseService {
     }
   }
 
+  /**
+   * (Admin only) Restocks a limited-edition drill shop item by adding `quantity` units to its remaining supply.
+   *
+   * Publishes a `events:shop:restock:{shopItemId}` Redis event, notifies connected operators
+   * and announces the restock in the Telegram broadcast channel.
+   */
+  async restockShopDrill(
+    shopItemId: Types.ObjectId,
+    quantity: number,
+  ): Promise<ApiResponse<{ remainingSupply: number } | null>> {
+    try {
+      const shopItem = await this.shopItemModel
+        .findById(shopItemId, { item: 1, itemEffects: 1, remainingSupply: 1 })
+        .lean();
+
+      if (!shopItem) {
+        return new ApiResponse(404, `(restockShopDrill) Shop item not found.`);
+      }
+
+      if (!shopItem.itemEffects?.drillData) {
+        return new ApiResponse(
+          400,
+          `(restockShopDrill) Shop item ${shopItem.item} is not a drill.`,
+        );
+      }
+
+      if (shopItem.remainingSupply === null) {
+        return new ApiResponse(
+          400,
+          `(restockShopDrill) Shop item ${shopItem.item} has an unlimited supply.`,
+        );
+      }
+
+      const { remainingSupply } = await this.shopItemModel
+        .findByIdAndUpdate(
+          shopItemId,
+          { $inc: { remainingSupply: quantity } },
+          { new: true, projection: { remainingSupply: 1 } },
+        )
+        .lean();
+
+      const restock = {
+        shopItemId: shopItemId.toString(),
+        item: shopItem.item,
+        quantity,
+        remainingSupply,
+      };
+
+      await this.redisService.publish(
+        `events:shop:restock:${shopItemId.toString()}`,
+        JSON.stringify(restock),
+      );
+      this.drillingGatewayService.notifyShopRestock(restock);
+
+      try {
+        await this.telegramService.sendBroadcastMessage(
+          `📦 ${shopItem.item} is back in stock! ${remainingSupply} left in the shop.`,
+        );
+      } catch (err: any) {
+        // The restock already went through, so a failed announcement isn't fatal
+        this.logger.warn(
+          `(restockShopDrill) Could not announce restock of ${shopItem.item}: ${err.message}`,
+        );
+      }
+
+      this.logger.log(
+        `📦 (restockShopDrill) Restocked ${quantity} units of ${shopItem.item} (${remainingSupply} left).`,
+      );
+
+      return new ApiResponse(200, `(restockShopDrill) Shop item restocked.`, {
+        remainingSupply,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(restockShopDrill) Error restocking shop item: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * (Admin only) Starts a flash sale, lowering a shop item's price to `salePurchaseCost` until `endTime`.
+   *
+   * The shop item's current price is stored on the sale and restored by `expireFlashSales` once the sale ends.
+   */
+  async startFlashSale(
+    shopItemId: Types.ObjectId,
+    salePurchaseCost: { ton: number; bera: number },
+    endTime: Date,
+  ): Promise<ApiResponse<{ flashSaleId: string } | null>> {
+    try {
+      if (endTime.getTime() <= Date.now()) {
+        return new ApiResponse(
+          400,
+          `(startFlashSale) The sale must end in the future.`,
+        );
+      }
+
+      const shopItem = await this.shopItemModel
+        .findById(shopItemId, { item: 1, purchaseCost: 1 })
+        .lean();
+
+      if (!shopItem) {
+        return new ApiResponse(404, `(startFlashSale) Shop item not found.`);
+      }
+
+      if (
+        salePurchaseCost.ton > shopItem.purchaseCost.ton ||
+        salePurchaseCost.bera > shopItem.purchaseCost.bera
+      ) {
+        return new ApiResponse(
+          400,
+          `(startFlashSale) The sale price can't be higher than the current price.`,
+        );
+      }
+
+      const activeSale = await this.flashSaleModel.exists({
+        shopItemId,
+        status: FlashSaleStatus.ACTIVE,
+      });
+
+      if (activeSale) {
+        return new ApiResponse(
+          400,
+          `(startFlashSale) Shop item ${shopItem.item} already has an active flash sale.`,
+        );
+      }
+
+      const flashSale = await this.flashSaleModel.create({
+        shopItemId,
+        salePurchaseCost,
+        originalPurchaseCost: {
+          ton: shopItem.purchaseCost.ton,
+          bera: shopItem.purchaseCost.bera,
+        },
+        startTime: new Date(),
+        endTime,
+      });
+
+      await this.shopItemModel.updateOne(
+        { _id: shopItemId },
+        { $set: { purchaseCost: salePurchaseCost } },
+      );
+
+      this.logger.log(
+        `🏷️ (startFlashSale) Started flash sale ${flashSale._id} for ${shopItem.item} until ${endTime.toISOString()}.`,
+      );
+
+      return new ApiResponse(200, `(startFlashSale) Flash sale started.`, {
+        flashSaleId: flashSale._id.toString(),
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(startFlashSale) Error starting flash sale: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Expires the active flash sales that have ended and restores their shop items' original prices.
+   *
+   * Publishes a `events:shop:sale-expired:{flashSaleId}` Redis event for each expired sale and announces it
+   * in the Telegram broadcast channel. Returns the number of expired sales.
+   */
+  async expireFlashSales(): Promise<number> {
+    const endedSales = await this.flashSaleModel
+      .find(
+        { status: FlashSaleStatus.ACTIVE, endTime: { $lt: new Date() } },
+        { _id: 1 },
+      )
+      .lean();
+
+    let expiredCount = 0;
+
+    for (const { _id } of endedSales) {
+      try {
+        // Claim the sale first so a concurrent run can't expire it twice
+        const sale = await this.flashSaleModel
+          .findOneAndUpdate(
+            { _id, status: FlashSaleStatus.ACTIVE },
+            { $set: { status: FlashSaleStatus.EXPIRED } },
+            { new: true },
+          )
+          .lean();
+
+        if (!sale) continue;
+
+        const shopItem = await this.shopItemModel
+          .findByIdAndUpdate(
+            sale.shopItemId,
+            { $set: { purchaseCost: sale.originalPurchaseCost } },
+            { projection: { item: 1 } },
+          )
+          .lean();
+
+        expiredCount++;
+
+        await this.redisService.publish(
+          `events:shop:sale-expired:${sale._id.toString()}`,
+          JSON.stringify({
+            flashSaleId: sale._id.toString(),
+            shopItemId: sale.shopItemId.toString(),
+            item: shopItem?.item ?? null,
+            purchaseCost: sale.originalPurchaseCost,
+          }),
+        );
+
+        if (shopItem) {
+          try {
+            await this.telegramService.sendBroadcastMessage(
+              `⌛ The flash sale on ${shopItem.item} has ended.`,
+            );
+          } catch (err: any) {
+            // The sale already expired, so a failed announcement isn't fatal
+            this.logger.warn(
+              `(expireFlashSales) Could not announce the end of flash sale ${sale._id}: ${err.message}`,
+            );
+          }
+        }
+      } catch (err: any) {
+        this.logger.error(
+          `❌ (expireFlashSales) Error expiring flash sale ${_id}: ${err.message}`,
+        );
+      }
+    }
+
+    if (expiredCount > 0) {
+      this.logger.log(
+        `⌛ (expireFlashSales) Expired ${expiredCount} flash sales and restored their original prices.`,
+      );
+    }
+
+    return expiredCount;
+  }
+
+  /**
+   * (Admin only) Airdrops a drill shop item to multiple operators for free, e.g. for promotional campaigns.
+   *
+   * For each operator, a zero-cost shop purchase is recorded (with the airdrop reason) and the drill is granted
+   * as if it was purchased. Only the drill is granted; other effects of the shop item are ignored.
+   */
+  async airdropShopDrill(
+    shopItemId: Types.ObjectId,
+    operatorIds: Types.ObjectId[],
+    reason: string,
+  ): Promise<ApiResponse<{ succeeded: number; failed: number } | null>> {
+    try {
+      const shopItem = await this.shopItemModel
+        .findById(shopItemId, { item: 1, itemEffects: 1 })
+        .lean();
+
+      if (!shopItem) {
+        return new ApiResponse(404, `(airdropShopDrill) Shop item not found.`);
+      }
+
+      if (!shopItem.itemEffects?.drillData) {
+        return new ApiResponse(
+          400,
+          `(airdropShopDrill) Shop item ${shopItem.item} is not a drill.`,
+        );
+      }
+
+      // Airdrop each operator at most once, and skip operators that don't exist
+      const uniqueOperatorIds = [
+        ...new Map(operatorIds.map((id) => [id.toString(), id])).values(),
+      ];
+      const existingOperatorIds = new Set(
+        (
+          await this.operatorModel
+            .find({ _id: { $in: uniqueOperatorIds } }, { _id: 1 })
+            .lean()
+        ).map((operator) => operator._id.toString()),
+      );
+
+      let succeeded = 0;
+      let failed = operatorIds.length - uniqueOperatorIds.length;
+
+      for (const operatorId of uniqueOperatorIds) {
+        if (!existingOperatorIds.has(operatorId.toString())) {
+          failed++;
+          continue;
+        }
+
+        try {
+          await this.shopPurchaseModel.create({
+            operatorId,
+            itemPurchased: shopItem.item,
+            amount: 1,
+            totalCost: 0,
+            currency: 'TON',
+            priceTON: 0,
+            category: shopItemCategory(shopItem.item),
+            airdropReason: reason,
+          });
+
+          await this.grantShopItemEffects(operatorId, {
+            drillData: shopItem.itemEffects.drillData,
+          });
+
+          await this.telegramService.notifyOperator(
+            operatorId,
+            'You received a drill!',
+            `A free ${shopItem.item} drill has been added to your account (${reason}).`,
+          );
+
+          succeeded++;
+        } catch (err: any) {
+          this.logger.error(
+            `❌ (airdropShopDrill) Error airdropping ${shopItem.item} to operator ${operatorId}: ${err.message}`,
+          );
+          failed++;
+        }
+      }
+
+      this.logger.log(
+        `🎁 (airdropShopDrill) Airdropped ${shopItem.item} to ${succeeded} operators (${failed} failed). Reason: ${reason}`,
+      );
+
+      return new ApiResponse(200, `(airdropShopDrill) Airdrop completed.`, {
+        succeeded,
+        failed,
+      });
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(airdropShopDrill) Error airdropping drills: ${err.message}`,
+        ),
+      );
+    }
+  }
+
   /**
    * Grants the operator the effects of a shop item. For example,
    * if the shop item is a drill, we would create a new drill for the operator and update the cumulative EFF of the operator.
@@ -236,6 +742,12 @@ export class ShopPurchaseService {
 
         await newDrill.save();
 
+        // Sync the new drill's NFT metadata on-chain in the background
+        await this.drillNFTSyncService.enqueueSync(
+          newDrill._id,
+          DrillNFTSyncOperation.CREATE,
+        );
+
         // ✅ Prepare cumulativeEff update
         bulkOperations.push({
           updateOne: {
@@ -419,15 +931,18 @@ export class ShopPurchaseService {
         );
       }
 
+      // Removed (inactive) items can no longer be bought
       const query: any = shopItemId
-        ? { _id: shopItemId }
-        : { item: shopItemName };
+        ? { _id: shopItemId, active: { $ne: false } }
+        : { item: shopItemName, active: { $ne: false } };
 
       // ✅ Fetch Shop Item
       const shopItem = await this.shopItemModel
         .findOne(query, {
           item: 1,
-          ...(showShopItemEffects && { itemEffects: 1 }),
+          remainingSupply: 1,
+          // Drill prices and asset equity requirements depend on the drill configuration
+          itemEffects: 1,
           ...(showShopItemPrice && { purchaseCost: 1 }),
         })
         .lean();
@@ -440,6 +955,15 @@ export class ShopPurchaseService {
         );
       }
 
+      // ✅ Limited-edition items can't be bought once sold out
+      if (shopItem.remainingSupply !== null && shopItem.remainingSupply <= 0) {
+        return new ApiResponse<{ purchaseAllowed: boolean; reason: string }>(
+          403,
+          `(checkPurchaseAllowed) Shop item is sold out.`,
+          { purchaseAllowed: false, reason: 'Shop item is sold out.' },
+        );
+      }
+
       const lowercaseItemName = shopItem.item.toLowerCase();
 
       ////////////////// NOTE: TEMPORARILY DISABLED DRILL PURCHASE PREREQUISITES CHECK!!!!! ///////////////////
@@ -586,6 +1110,36 @@ export class ShopPurchaseService {
         }
       }
 
+      const drillConfig = shopItem.itemEffects?.drillData?.config;
+
+      // ✅ Exclusive drill configurations require a minimum asset equity
+      if (drillConfig && drillMinAssetEquity(drillConfig) !== null) {
+        const operator = await this.operatorModel
+          .findById(operatorId, { assetEquity: 1 })
+          .lean();
+
+        if (
+          !meetsDrillMinAssetEquity(drillConfig, operator?.assetEquity ?? 0)
+        ) {
+          return new ApiResponse<{
+            purchaseAllowed: boolean;
+            reason: string;
+          }>(
+            403,
+            `(checkPurchaseAllowed) Insufficient asset equity for the ${drillConfig} drill configuration.`,
+            {
+              purchaseAllowed: false,
+              reason: `Requires at least ${drillMinAssetEquity(drillConfig)} USD in asset equity, but only ${operator?.assetEquity ?? 0} USD found.`,
+            },
+          );
+        }
+      }
+
+      const shopItemPrice =
+        showShopItemPrice && drillConfig
+          ? drillPurchaseCost(shopItem.purchaseCost, drillConfig)
+          : shopItem.purchaseCost;
+
       // ✅ Return success with optional item effect
       return new ApiResponse<{
         purchaseAllowed: boolean;
@@ -597,7 +1151,7 @@ export class ShopPurchaseService {
       }>(200, `(checkPurchaseAllowed) Purchase allowed.`, {
         purchaseAllowed: true,
         shopItemEffects: showShopItemEffects ? shopItem.itemEffects : undefined,
-        shopItemPrice: showShopItemPrice ? shopItem.purchaseCost : undefined,
+        shopItemPrice: showShopItemPrice ? shopItemPrice : undefined,
       });
     } catch (err: any) {
       throw new InternalServerErrorException(
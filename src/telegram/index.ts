@@ -1,5 +1,6 @@
 export * from './telegram.module';
 export * from './telegram.service';
+export * from './telegram.messages';
 export * from './telegram.controller';
 export * from './schemas/telegram-channel-member.schema';
 export * from './schemas/telegram-webhook.schema';
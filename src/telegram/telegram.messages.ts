@@ -0,0 +1,110 @@
+/**
+ * The language used when an operator has no preferred language or when a translation is missing.
+ */
+export const DEFAULT_TELEGRAM_LANGUAGE = 'en';
+
+/**
+ * All message keys that the Telegram bot can send.
+ */
+export type TelegramMessageKey =
+  | 'PLAY_HASHLAND'
+  | 'OPEN_GAME'
+  | 'HELP'
+  | 'UNKNOWN_COMMAND'
+  | 'COMMANDS_ONLY'
+  | 'REFERRAL_LINK_FAILED'
+  | 'REFERRAL_PLAY_FIRST'
+  | 'REFERRAL_LINK_ERROR'
+  | 'REFERRAL_INVITED_COUNT'
+  | 'REFERRAL_REWARDS_EARNED'
+  | 'REFERRAL_LINK';
+
+/**
+ * In-code translations for all Telegram bot messages, keyed by language code.
+ *
+ * Placeholders are written as `{0}`, `{1}`, ... and are replaced in order by the arguments passed to `getTelegramMessage`.
+ */
+export const TELEGRAM_MESSAGES: Record<
+  string,
+  Partial<Record<TelegramMessageKey, string>>
+> = {
+  en: {
+    PLAY_HASHLAND: 'Play Hashland!',
+    OPEN_GAME: 'Open Game',
+    HELP:
+      'Available commands:\n/start - Start the bot\n/referral - Get your referral link\n/help - Show this help message',
+    UNKNOWN_COMMAND: 'Unknown command: {0}',
+    COMMANDS_ONLY: 'I received your message, but I only respond to commands.',
+    REFERRAL_LINK_FAILED:
+      'Could not generate referral link. Please try again later.',
+    REFERRAL_PLAY_FIRST:
+      'You need to play Hashland first to get a referral link!',
+    REFERRAL_LINK_ERROR:
+      'Error generating referral link. Please try again later.',
+    REFERRAL_INVITED_COUNT: 'You have invited {0} friends so far!',
+    REFERRAL_REWARDS_EARNED:
+      'Total rewards earned: {0} EFF credits, {1} HASH bonus',
+    REFERRAL_LINK:
+      "Share your unique Hashland referral link with friends:{0}\n\n{1}\n\nWhen they join through your link, you'll both receive special bonuses!",
+  },
+  ru: {
+    PLAY_HASHLAND: 'Играйте в Hashland!',
+    OPEN_GAME: 'Открыть игру',
+    HELP:
+      'Доступные команды:\n/start - Запустить бота\n/referral - Получить реферальную ссылку\n/help - Показать это сообщение',
+    UNKNOWN_COMMAND: 'Неизвестная команда: {0}',
+    COMMANDS_ONLY: 'Я получил ваше сообщение, но отвечаю только на команды.',
+    REFERRAL_LINK_FAILED:
+      'Не удалось создать реферальную ссылку. Попробуйте позже.',
+    REFERRAL_PLAY_FIRST:
+      'Сначала сыграйте в Hashland, чтобы получить реферальную ссылку!',
+    REFERRAL_LINK_ERROR:
+      'Ошибка при создании реферальной ссылки. Попробуйте позже.',
+    REFERRAL_INVITED_COUNT: 'Вы уже пригласили друзей: {0}!',
+    REFERRAL_REWARDS_EARNED:
+      'Всего получено наград: {0} EFF кредитов, {1} HASH бонуса',
+    REFERRAL_LINK:
+      'Поделитесь своей реферальной ссылкой Hashland с друзьями:{0}\n\n{1}\n\nКогда они присоединятся по вашей ссылке, вы оба получите специальные бонусы!',
+  },
+  zh: {
+    PLAY_HASHLAND: '来玩 Hashland！',
+    OPEN_GAME: '打开游戏',
+    HELP:
+      '可用命令：\n/start - 启动机器人\n/referral - 获取你的邀请链接\n/help - 显示此帮助信息',
+    UNKNOWN_COMMAND: '未知命令：{0}',
+    COMMANDS_ONLY: '已收到你的消息，但我只响应命令。',
+    REFERRAL_LINK_FAILED: '无法生成邀请链接，请稍后再试。',
+    REFERRAL_PLAY_FIRST: '你需要先玩 Hashland 才能获取邀请链接！',
+    REFERRAL_LINK_ERROR: '生成邀请链接时出错，请稍后再试。',
+    REFERRAL_INVITED_COUNT: '你已经邀请了 {0} 位好友！',
+    REFERRAL_REWARDS_EARNED: '累计获得奖励：{0} EFF 积分，{1} HASH 奖励',
+    REFERRAL_LINK:
+      '与好友分享你的专属 Hashland 邀请链接：{0}\n\n{1}\n\n当他们通过你的链接加入时，你们都将获得特别奖励！',
+  },
+};
+
+/**
+ * Returns the translated message for `key` in the given language, falling back to English
+ * if the language or the specific translation is missing.
+ *
+ * @param lang - The language code (e.g. `en`, `ru`, `zh`). Region suffixes such as `en-US` are ignored.
+ * @param key - The message key
+ * @param args - Values that replace the `{0}`, `{1}`, ... placeholders in order
+ */
+export function getTelegramMessage(
+  lang: string | null | undefined,
+  key: TelegramMessageKey,
+  ...args: Array<string | number>
+): string {
+  const normalizedLang = (lang || DEFAULT_TELEGRAM_LANGUAGE)
+    .split('-')[0]
+    .toLowerCase();
+
+  const template =
+    TELEGRAM_MESSAGES[normalizedLang]?.[key] ??
+    TELEGRAM_MESSAGES[DEFAULT_TELEGRAM_LANGUAGE][key];
+
+  return template.replace(/\{(\d+)\}/g, (match, index) =>
+    args[index] !== undefined ? String(args[index]) : match,
+  );
+}
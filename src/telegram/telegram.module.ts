@@ -14,6 +14,7 @@ import {
 import { HttpModule } from '@nestjs/axios';
 import { OperatorModule } from 'src/operators/operator.module';
 import { ReferralModule } from 'src/referral/referral.module';
+import { OperatorNotificationModule } from 'src/notifications/operator-notification.module';
 
 @Module({
   imports: [
@@ -21,6 +22,7 @@ import { ReferralModule } from 'src/referral/referral.module';
     HttpModule,
     OperatorModule,
     ReferralModule,
+    OperatorNotificationModule,
     MongooseModule.forFeature([
       { name: TelegramChannelMember.name, schema: TelegramChannelMemberSchema },
       { name: TelegramWebhook.name, schema: TelegramWebhookSchema },
@@ -18,6 +18,9 @@ import { ApiResponse } from 'src/common/dto/response.dto';
 import { OperatorService } from 'src/operators/operator.service';
 import { Operator } from 'src/operators/schemas/operator.schema';
 import { ReferralService } from 'src/referral/referral.service';
+import { getTelegramMessage } from './telegram.messages';
+import { isTelegramChatMember } from 'src/common/utils/telegram';
+import { OperatorNotificationService } from 'src/notifications/operator-notification.service';
 
 /**
  * Service for managing Telegram-related functionality
@@ -28,6 +31,7 @@ export class TelegramService {
   private readonly botToken: string;
   private readonly apiBaseUrl: string;
   private readonly hashlandUrl: string;
+  private readonly broadcastChannelId: string;
 
   constructor(
     private configService: ConfigService,
@@ -37,6 +41,7 @@ export class TelegramService {
     @InjectModel(TelegramWebhook.name)
     private webhookModel: Model<TelegramWebhook>,
     private referralService: ReferralService,
+    private operatorNotificationService: OperatorNotificationService,
   ) {
     this.botToken = this.configService.get<string>('TELEGRAM_BOT_TOKEN');
     if (!this.botToken) {
@@ -50,6 +55,15 @@ export class TelegramService {
     if (!this.hashlandUrl) {
       this.logger.warn('HASHLAND_URL is not defined in environment variables');
     }
+
+    this.broadcastChannelId = this.configService.get<string>(
+      'TELEGRAM_BROADCAST_CHANNEL_ID',
+    );
+    if (!this.broadcastChannelId) {
+      this.logger.warn(
+        'TELEGRAM_BROADCAST_CHANNEL_ID is not defined in environment variables',
+      );
+    }
   }
 
   /**
@@ -204,6 +218,12 @@ export class TelegramService {
       return 'Received message without text';
     }
 
+    // Use the operator's preferred language, falling back to the Telegram client's language
+    const lang = await this.resolveLanguage(
+      userId,
+      message.from?.language_code,
+    );
+
     // Handle commands
     if (text.startsWith('/')) {
       // Extract command and parameters
@@ -250,44 +270,51 @@ export class TelegramService {
           }
 
           // Send the regular start message with game link
-          await this.sendTelegramMessage(chatId, 'Play Hashland!', {
-            reply_markup: {
-              inline_keyboard: [
-                [
-                  {
-                    text: `Open Game`,
-                    web_app: {
-                      url:
-                        this.hashlandUrl +
-                        (referralCode ? `?ref=${referralCode}` : ''),
+          await this.sendTelegramMessage(
+            chatId,
+            getTelegramMessage(lang, 'PLAY_HASHLAND'),
+            {
+              reply_markup: {
+                inline_keyboard: [
+                  [
+                    {
+                      text: getTelegramMessage(lang, 'OPEN_GAME'),
+                      web_app: {
+                        url:
+                          this.hashlandUrl +
+                          (referralCode ? `?ref=${referralCode}` : ''),
+                      },
                     },
-                  },
+                  ],
                 ],
-              ],
+              },
             },
-          });
+          );
           return 'Processed /start command';
 
         case 'referral':
           if (!userId) {
             await this.sendTelegramMessage(
               chatId,
-              'Could not generate referral link. Please try again later.',
+              getTelegramMessage(lang, 'REFERRAL_LINK_FAILED'),
             );
             return 'Failed to generate referral: missing user ID';
           }
 
-          return await this.sendReferralLink(chatId, userId);
+          return await this.sendReferralLink(chatId, userId, lang);
 
         case 'help':
           await this.sendTelegramMessage(
             chatId,
-            'Available commands:\n/start - Start the bot\n/referral - Get your referral link\n/help - Show this help message',
+            getTelegramMessage(lang, 'HELP'),
           );
           return 'Processed /help command';
 
         default:
-          await this.sendTelegramMessage(chatId, `Unknown command: ${command}`);
+          await this.sendTelegramMessage(
+            chatId,
+            getTelegramMessage(lang, 'UNKNOWN_COMMAND', command),
+          );
           return `Received unknown command: ${command}`;
       }
     }
@@ -295,7 +322,7 @@ export class TelegramService {
     // Default response for non-command messages
     await this.sendTelegramMessage(
       chatId,
-      'I received your message, but I only respond to commands.',
+      getTelegramMessage(lang, 'COMMANDS_ONLY'),
     );
     return 'Processed regular message';
   }
@@ -304,11 +331,13 @@ export class TelegramService {
    * Send a referral link to a user based on their Telegram ID
    * @param chatId - The chat ID to send to
    * @param telegramId - The user's Telegram ID
+   * @param lang - The language to send the messages in
    * @returns Status message
    */
   private async sendReferralLink(
     chatId: string | number,
     telegramId: string,
+    lang?: string,
   ): Promise<string> {
     try {
       // Get the operator's ID from the Telegram ID
@@ -318,7 +347,7 @@ export class TelegramService {
       if (!operatorId) {
         await this.sendTelegramMessage(
           chatId,
-          'You need to play Hashland first to get a referral link!',
+          getTelegramMessage(lang, 'REFERRAL_PLAY_FIRST'),
         );
         return 'Failed to generate referral: operator not found';
       }
@@ -330,7 +359,7 @@ export class TelegramService {
       if (!referralStatsResponse.data) {
         await this.sendTelegramMessage(
           chatId,
-          'Error generating referral link. Please try again later.',
+          getTelegramMessage(lang, 'REFERRAL_LINK_ERROR'),
         );
         return 'Failed to generate referral: could not get stats';
       }
@@ -344,7 +373,7 @@ export class TelegramService {
       if (!botUsername) {
         await this.sendTelegramMessage(
           chatId,
-          'Error generating referral link. Please try again later.',
+          getTelegramMessage(lang, 'REFERRAL_LINK_ERROR'),
         );
         return 'Failed to generate referral link: could not get bot username';
       }
@@ -352,16 +381,21 @@ export class TelegramService {
       // Create the referral link and additional statistics message
       const referralLink = `https://t.me/${botUsername}?start=${referralCode}`;
 
-      let additionalStats = `\n\nYou have invited ${totalReferrals} friends so far!`;
+      let additionalStats = `\n\n${getTelegramMessage(lang, 'REFERRAL_INVITED_COUNT', totalReferrals)}`;
 
       if (rewards.effCredits > 0 || rewards.hashBonus > 0) {
-        additionalStats += `\nTotal rewards earned: ${rewards.effCredits} EFF credits, ${rewards.hashBonus} HASH bonus`;
+        additionalStats += `\n${getTelegramMessage(lang, 'REFERRAL_REWARDS_EARNED', rewards.effCredits, rewards.hashBonus)}`;
       }
 
       // Send the message with the referral link
       await this.sendTelegramMessage(
         chatId,
-        `Share your unique Hashland referral link with friends:${additionalStats}\n\n${referralLink}\n\nWhen they join through your link, you'll both receive special bonuses!`,
+        getTelegramMessage(
+          lang,
+          'REFERRAL_LINK',
+          additionalStats,
+          referralLink,
+        ),
       );
 
       return 'Sent referral link';
@@ -372,7 +406,7 @@ export class TelegramService {
       );
       await this.sendTelegramMessage(
         chatId,
-        'Error generating referral link. Please try again later.',
+        getTelegramMessage(lang, 'REFERRAL_LINK_ERROR'),
       );
       return `Error sending referral link: ${error.message}`;
     }
@@ -458,6 +492,24 @@ export class TelegramService {
     }
   }
 
+  /**
+   * Resolve the language to use when messaging a Telegram user
+   * @param telegramId - The Telegram user ID
+   * @param fallbackLanguage - The language reported by the Telegram client, used if the operator has no preference
+   * @returns The language code to use for messages
+   */
+  private async resolveLanguage(
+    telegramId?: string,
+    fallbackLanguage?: string,
+  ): Promise<string | undefined> {
+    if (!telegramId) {
+      return fallbackLanguage;
+    }
+
+    const operator = await this.findOperatorByTelegramId(telegramId);
+    return operator?.tgProfile?.language || fallbackLanguage;
+  }
+
   /**
    * Check if a Telegram user ID is associated with a valid operator
    * @param userId - The Telegram user ID to check
@@ -512,20 +564,7 @@ export class TelegramService {
     channelId: string,
   ): Promise<boolean> {
     try {
-      const response = await axios.get(`${this.apiBaseUrl}/getChatMember`, {
-        params: {
-          chat_id: channelId,
-          user_id: userId,
-        },
-      });
-
-      if (response.data.ok) {
-        const status = response.data.result.status;
-        // User is a member if they are a member, administrator, or creator
-        return ['member', 'administrator', 'creator'].includes(status);
-      }
-
-      return false;
+      return await isTelegramChatMember(this.botToken, channelId, userId);
     } catch (error) {
       this.logger.error(
         `Error checking channel membership with API: ${error.message}`,
@@ -707,6 +746,62 @@ export class TelegramService {
     }
   }
 
+  /**
+   * Send a message to the configured Telegram broadcast channel (e.g. for platform-wide announcements).
+   * @param text - The message text
+   * @param options - Additional options for the message
+   * @returns The sent message, or null if no broadcast channel is configured
+   */
+  async sendBroadcastMessage(
+    text: string,
+    options: Record<string, any> = {},
+  ): Promise<any> {
+    if (!this.broadcastChannelId) {
+      return null;
+    }
+
+    return this.sendTelegramMessage(this.broadcastChannelId, text, options);
+  }
+
+  /**
+   * Notify an operator. The notification is always stored in the operator's in-app inbox,
+   * and is also sent via Telegram if the operator has a linked Telegram account.
+   * @param operatorId - The operator to notify
+   * @param title - The notification title
+   * @param body - The notification body
+   */
+  async notifyOperator(
+    operatorId: Types.ObjectId,
+    title: string,
+    body: string,
+  ): Promise<void> {
+    await this.operatorNotificationService.createNotification(
+      operatorId,
+      title,
+      body,
+    );
+
+    const operator = await this.operatorService.findById(operatorId, {
+      tgProfile: 1,
+    });
+
+    if (!operator.tgProfile?.tgId) {
+      return;
+    }
+
+    try {
+      await this.sendTelegramMessage(
+        operator.tgProfile.tgId,
+        `${title}\n\n${body}`,
+      );
+    } catch (error) {
+      // The in-app notification was already stored, so a failed Telegram message isn't fatal
+      this.logger.warn(
+        `Could not send Telegram notification to operator ${operatorId}: ${error.message}`,
+      );
+    }
+  }
+
   /**
    * Get the bot's username
    * @returns The bot's username
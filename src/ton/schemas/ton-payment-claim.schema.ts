@@ -0,0 +1,95 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * The features that are paid for with TON.
+ */
+export enum TONPaymentFeature {
+  SHOP_PURCHASE = 'SHOP_PURCHASE',
+  DRILL_INSURANCE = 'DRILL_INSURANCE',
+  DRILL_SKIN = 'DRILL_SKIN',
+  DRILL_UPGRADE = 'DRILL_UPGRADE',
+  DRILL_CONFIG_UPGRADE = 'DRILL_CONFIG_UPGRADE',
+}
+
+/**
+ * `TONPaymentClaim` records that a TON payment was redeemed for a feature.
+ *
+ * Every TON-paid feature claims its payment here before granting anything. Since `txHash` is unique
+ * across all features, a single payment can only ever be redeemed once.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'TONPaymentClaims',
+  versionKey: false,
+})
+export class TONPaymentClaim extends Document {
+  /**
+   * The database ID of the claim.
+   */
+  @ApiProperty({
+    description: 'The database ID of the claim',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The hash of the TON transaction that was claimed.
+   */
+  @ApiProperty({
+    description: 'The hash of the TON transaction that was claimed',
+    example: 'Xq3Lk2kF1mP0n6w9Jb7yRtV4sA8cE5uH2dG0fZ1iO3=',
+  })
+  @Prop({ type: String, required: true })
+  txHash: string;
+
+  /**
+   * The database ID of the operator who redeemed the payment.
+   */
+  @ApiProperty({
+    description: 'The database ID of the operator who redeemed the payment',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Operators' })
+  operatorId: Types.ObjectId;
+
+  /**
+   * The feature the payment was redeemed for.
+   */
+  @ApiProperty({
+    description: 'The feature the payment was redeemed for',
+    enum: TONPaymentFeature,
+    example: TONPaymentFeature.DRILL_UPGRADE,
+  })
+  @Prop({ type: String, enum: TONPaymentFeature, required: true })
+  feature: TONPaymentFeature;
+
+  /**
+   * What the payment was redeemed for (e.g. the upgraded drill's ID or the purchased shop item's name).
+   */
+  @ApiProperty({
+    description:
+      "What the payment was redeemed for (e.g. the upgraded drill's ID)",
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: String, required: true })
+  targetId: string;
+
+  /**
+   * The amount of TON paid.
+   */
+  @ApiProperty({
+    description: 'The amount of TON paid',
+    example: 1.5,
+  })
+  @Prop({ type: Number, required: true })
+  amountTON: number;
+}
+
+export const TONPaymentClaimSchema =
+  SchemaFactory.createForClass(TONPaymentClaim);
+
+// A payment can only be claimed once, across all features
+TONPaymentClaimSchema.index({ txHash: 1 }, { unique: true });
@@ -1,8 +1,17 @@
 import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
 import { TonService } from './ton.service';
+import {
+  TONPaymentClaim,
+  TONPaymentClaimSchema,
+} from './schemas/ton-payment-claim.schema';
 
 @Module({
-  imports: [],
+  imports: [
+    MongooseModule.forFeature([
+      { name: TONPaymentClaim.name, schema: TONPaymentClaimSchema },
+    ]),
+  ],
   controllers: [], // Expose API endpoints
   providers: [TonService], // Business logic for TONService
   exports: [TonService], // Allow usage in other modules
@@ -1,18 +1,41 @@
 import { Injectable } from '@nestjs/common';
 import { ConfigService } from '@nestjs/config';
-import { Types } from 'mongoose';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
 import {
   BlockchainData,
   TxParsedMessage,
 } from 'src/common/schemas/blockchain-payment.schema';
 import TonWeb, { AddressType } from 'tonweb';
+import {
+  TONPaymentClaim,
+  TONPaymentFeature,
+} from './schemas/ton-payment-claim.schema';
+
+/**
+ * Fetches the `item` a TON payment's message must contain to pay for `feature` on `targetId`,
+ * e.g. `DRILL_UPGRADE:507f1f77bcf86cd799439011`.
+ *
+ * Shop purchases keep using the shop item's name, which is what clients already send.
+ */
+export const tonPaymentItem = (
+  feature: TONPaymentFeature,
+  targetId: string,
+): string =>
+  feature === TONPaymentFeature.SHOP_PURCHASE
+    ? targetId
+    : `${feature}:${targetId}`;
 
 @Injectable()
 export class TonService {
   private tonWeb: TonWeb | null = null; // ✅ Lazy Initialization
   private readonly receiverAddress: string;
 
-  constructor(private configService: ConfigService) {
+  constructor(
+    private configService: ConfigService,
+    @InjectModel(TONPaymentClaim.name)
+    private tonPaymentClaimModel: Model<TONPaymentClaim>,
+  ) {
     const apiEndpoint = this.configService.get<string>('TON_API_ENDPOINT');
     const apiKey = this.configService.get<string>('TON_API_KEY');
     this.receiverAddress = this.configService.get<string>(
@@ -38,6 +61,53 @@ export class TonService {
     );
   }
 
+  /**
+   * Claims a verified TON payment for `feature` on `targetId` (e.g. a drill upgrade for a drill ID).
+   *
+   * Every TON-paid feature must claim its payment before granting anything. Payments are claimed in a single
+   * collection with a unique `txHash`, so one payment can't be redeemed by several features (or twice by the same one).
+   * The payment's message must also name the feature and target (see `tonPaymentItem`), so a payment made for
+   * one thing can't be redeemed for another.
+   *
+   * Returns the reason if the payment can't be claimed, otherwise null.
+   */
+  async claimTONPayment(
+    operatorId: Types.ObjectId,
+    blockchainData: BlockchainData,
+    feature: TONPaymentFeature,
+    targetId: string,
+  ): Promise<string | null> {
+    const expectedItem = tonPaymentItem(feature, targetId);
+
+    if (blockchainData.txPayload?.item !== expectedItem) {
+      return `Transaction was not made for ${expectedItem}.`;
+    }
+
+    try {
+      await this.tonPaymentClaimModel.create({
+        txHash: blockchainData.txHash,
+        operatorId,
+        feature,
+        targetId,
+        amountTON: blockchainData.txPayload.cost,
+      });
+    } catch (err: any) {
+      if (err.code === 11000) {
+        return `Transaction hash already used for a payment.`;
+      }
+      throw err;
+    }
+
+    return null;
+  }
+
+  /**
+   * Releases a claimed TON payment whose feature couldn't be granted, so the operator can retry with it.
+   */
+  async releaseTONPayment(txHash: string): Promise<void> {
+    await this.tonPaymentClaimModel.deleteOne({ txHash });
+  }
+
   /**
    * Verifies if a TON transaction that was made for a purchase is valid.
    *
@@ -0,0 +1,63 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * `TournamentEntry` represents a pool's score in a tournament.
+ *
+ * An entry is created the first time one of the pool's operators extracts a cycle during the tournament.
+ */
+@Schema({
+  timestamps: true,
+  collection: 'TournamentEntries',
+  versionKey: false,
+})
+export class TournamentEntry extends Document {
+  /**
+   * The database ID of the tournament entry.
+   */
+  @ApiProperty({
+    description: 'The database ID of the tournament entry',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The database ID of the tournament.
+   */
+  @ApiProperty({
+    description: 'The database ID of the tournament',
+    example: '507f1f77bcf86cd799439012',
+  })
+  @Prop({ type: Types.ObjectId, required: true, ref: 'Tournaments' })
+  tournamentId: Types.ObjectId;
+
+  /**
+   * The database ID of the pool.
+   */
+  @ApiProperty({
+    description: 'The database ID of the pool',
+    example: '507f1f77bcf86cd799439013',
+  })
+  @Prop({ type: Types.ObjectId, required: true, index: true, ref: 'Pools' })
+  poolId: Types.ObjectId;
+
+  /**
+   * The pool's score, i.e. the number of cycles extracted by the pool's operators during the tournament.
+   */
+  @ApiProperty({
+    description:
+      "The pool's score (cycles extracted by the pool's operators during the tournament)",
+    example: 42,
+  })
+  @Prop({ type: Number, required: true, default: 0 })
+  score: number;
+}
+
+export const TournamentEntrySchema =
+  SchemaFactory.createForClass(TournamentEntry);
+
+// One entry per pool per tournament, ranked by score
+TournamentEntrySchema.index({ tournamentId: 1, poolId: 1 }, { unique: true });
+TournamentEntrySchema.index({ tournamentId: 1, score: -1 });
@@ -0,0 +1,122 @@
+import { Prop, Schema, SchemaFactory } from '@nestjs/mongoose';
+import { Document, Types } from 'mongoose';
+import { ApiProperty } from '@nestjs/swagger';
+
+/**
+ * Enum defining the status of a tournament
+ */
+export enum TournamentStatus {
+  /**
+   * The tournament hasn't ended yet (or its prize hasn't been distributed yet).
+   */
+  ACTIVE = 'active',
+  /**
+   * The tournament has ended and its prize (if any) has been distributed.
+   */
+  ENDED = 'ended',
+}
+
+/**
+ * `Tournament` represents a time-boxed competition between all pools.
+ *
+ * Pools score a point for every drilling cycle extracted by one of their operators between `startsAt` and `endsAt`.
+ * Once the tournament ends, `prizeHASH` is paid out from the HASH Reserve to the members of the highest scoring pool.
+ */
+@Schema({ timestamps: true, collection: 'Tournaments', versionKey: false })
+export class Tournament extends Document {
+  /**
+   * The database ID of the tournament.
+   */
+  @ApiProperty({
+    description: 'The database ID of the tournament',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @Prop({ type: Types.ObjectId, default: () => new Types.ObjectId() })
+  _id: Types.ObjectId;
+
+  /**
+   * The name of the tournament.
+   */
+  @ApiProperty({
+    description: 'The name of the tournament',
+    example: 'January Extraction Cup',
+  })
+  @Prop({ type: String, required: true })
+  name: string;
+
+  /**
+   * When the tournament starts.
+   */
+  @ApiProperty({
+    description: 'When the tournament starts',
+    example: '2025-01-01T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  startsAt: Date;
+
+  /**
+   * When the tournament ends.
+   */
+  @ApiProperty({
+    description: 'When the tournament ends',
+    example: '2025-02-01T00:00:00.000Z',
+  })
+  @Prop({ type: Date, required: true })
+  endsAt: Date;
+
+  /**
+   * The amount of $HASH split between the winning pool's members.
+   */
+  @ApiProperty({
+    description: "The amount of $HASH split between the winning pool's members",
+    example: 100000,
+  })
+  @Prop({ type: Number, required: true, default: 0 })
+  prizeHASH: number;
+
+  /**
+   * The current status of the tournament.
+   */
+  @ApiProperty({
+    description: 'The current status of the tournament',
+    enum: TournamentStatus,
+    example: TournamentStatus.ACTIVE,
+  })
+  @Prop({
+    type: String,
+    enum: TournamentStatus,
+    required: true,
+    default: TournamentStatus.ACTIVE,
+  })
+  status: TournamentStatus;
+
+  /**
+   * The database ID of the winning pool (NULL until the tournament ends, or if no pool scored).
+   */
+  @ApiProperty({
+    description:
+      'The database ID of the winning pool (null until the tournament ends, or if no pool scored)',
+    example: '507f1f77bcf86cd799439012',
+    nullable: true,
+  })
+  @Prop({ type: Types.ObjectId, required: false, default: null, ref: 'Pools' })
+  winnerPoolId: Types.ObjectId | null;
+
+  /**
+   * The amount of $HASH actually distributed to the winning pool's members.
+   *
+   * This can be lower than `prizeHASH` if the HASH Reserve didn't hold enough $HASH.
+   */
+  @ApiProperty({
+    description:
+      "The amount of $HASH actually distributed to the winning pool's members",
+    example: 100000,
+  })
+  @Prop({ type: Number, required: true, default: 0 })
+  distributedHASH: number;
+}
+
+export const TournamentSchema = SchemaFactory.createForClass(Tournament);
+
+// The worker looks up active tournaments that have ended
+TournamentSchema.index({ status: 1, endsAt: 1 });
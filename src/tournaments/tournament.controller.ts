@@ -0,0 +1,65 @@
+import { Controller, Get, Param, Query } from '@nestjs/common';
+import {
+  ApiOperation,
+  ApiParam,
+  ApiQuery,
+  ApiResponse,
+  ApiTags,
+} from '@nestjs/swagger';
+import { Types } from 'mongoose';
+import { GetLeaderboardQueryDto } from 'src/common/dto/leaderboard.dto';
+import { TournamentService } from './tournament.service';
+import { TournamentStatus } from './schemas/tournament.schema';
+
+@ApiTags('Tournaments')
+@Controller('tournaments') // Base route: `/tournaments`
+export class TournamentController {
+  constructor(private readonly tournamentService: TournamentService) {}
+
+  @ApiOperation({
+    summary: 'Get tournaments',
+    description: 'Fetches all tournaments, optionally filtered by status',
+  })
+  @ApiQuery({
+    name: 'status',
+    enum: TournamentStatus,
+    required: false,
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved tournaments',
+  })
+  @Get()
+  async fetchTournaments(@Query('status') status?: TournamentStatus) {
+    return this.tournamentService.fetchTournaments(status);
+  }
+
+  @ApiOperation({
+    summary: 'Get tournament leaderboard',
+    description: "Fetches a tournament's pool rankings, highest score first",
+  })
+  @ApiParam({
+    name: 'id',
+    description: 'The ID of the tournament',
+    example: '507f1f77bcf86cd799439011',
+  })
+  @ApiResponse({
+    status: 200,
+    description: 'Successfully retrieved tournament leaderboard',
+  })
+  @ApiResponse({
+    status: 404,
+    description: 'Tournament not found',
+  })
+  @Get(':id/leaderboard')
+  async fetchTournamentLeaderboard(
+    @Param('id') id: string,
+    @Query() query: GetLeaderboardQueryDto,
+  ) {
+    return this.tournamentService.fetchTournamentLeaderboard(
+      new Types.ObjectId(id),
+      query.page,
+      query.limit,
+    );
+  }
+}
@@ -0,0 +1,41 @@
+import { Module } from '@nestjs/common';
+import { MongooseModule } from '@nestjs/mongoose';
+import { BullModule } from '@nestjs/bull';
+import { Tournament, TournamentSchema } from './schemas/tournament.schema';
+import {
+  TournamentEntry,
+  TournamentEntrySchema,
+} from './schemas/tournament-entry.schema';
+import {
+  PoolOperator,
+  PoolOperatorSchema,
+} from 'src/pools/schemas/pool-operator.schema';
+import { OperatorModule } from 'src/operators/operator.module';
+import { HashReserveModule } from 'src/hash-reserve/hash-reserve.module';
+import { TournamentService } from './tournament.service';
+import { TournamentController } from './tournament.controller';
+import { TournamentQueue } from './tournament.queue';
+
+@Module({
+  imports: [
+    MongooseModule.forFeature([
+      { name: Tournament.name, schema: TournamentSchema },
+      { name: TournamentEntry.name, schema: TournamentEntrySchema },
+      { name: PoolOperator.name, schema: PoolOperatorSchema },
+    ]),
+    BullModule.registerQueue({
+      name: 'tournament-queue',
+      defaultJobOptions: {
+        attempts: 3, // Retry failed jobs 3 times
+        removeOnComplete: true, // Remove completed jobs
+        removeOnFail: false, // Keep failed jobs for debugging
+      },
+    }),
+    OperatorModule,
+    HashReserveModule, // Tournament prizes are paid out from the HASH Reserve
+  ],
+  controllers: [TournamentController], // Expose API endpoints
+  providers: [TournamentService, TournamentQueue],
+  exports: [TournamentService],
+})
+export class TournamentModule {}
@@ -0,0 +1,84 @@
+import {
+  Processor,
+  Process,
+  InjectQueue,
+  OnGlobalQueueFailed,
+} from '@nestjs/bull';
+import { Queue } from 'bull';
+import { Injectable, Logger, OnModuleInit } from '@nestjs/common';
+import { TournamentService } from './tournament.service';
+
+@Injectable()
+@Processor('tournament-queue')
+export class TournamentQueue implements OnModuleInit {
+  private readonly logger = new Logger(TournamentQueue.name);
+  private readonly fiveMinutesInMs = 5 * 60 * 1000; // 5 minutes
+
+  constructor(
+    private readonly tournamentService: TournamentService,
+    @InjectQueue('tournament-queue') private readonly tournamentQueue: Queue,
+  ) {}
+
+  /**
+   * Called when the module initializes.
+   */
+  async onModuleInit() {
+    // ✅ Schedule Tournament Prize Distribution (Every 5 Minutes)
+    await this.ensureJobScheduled(
+      'distribute-tournament-prizes',
+      this.fiveMinutesInMs,
+    );
+  }
+
+  /**
+   * Ensures a Bull job is scheduled, preventing duplicates.
+   */
+  private async ensureJobScheduled(jobName: string, intervalMs: number) {
+    const existingJobs = await this.tournamentQueue.getRepeatableJobs();
+    if (!existingJobs.some((job) => job.name === jobName)) {
+      await this.tournamentQueue.add(
+        jobName,
+        {},
+        {
+          repeat: { every: intervalMs },
+          removeOnComplete: true,
+          removeOnFail: false,
+        },
+      );
+      this.logger.log(
+        `✅ (tournamentQueue) Scheduled job: ${jobName} every ${intervalMs / 1000 / 60} minutes.`,
+      );
+    } else {
+      this.logger.log(
+        `🔄 (tournamentQueue) Job already scheduled: ${jobName}.`,
+      );
+    }
+  }
+
+  /**
+   * Distributes the prizes of tournaments that have ended (runs **every 5 minutes**).
+   */
+  @Process({
+    name: 'distribute-tournament-prizes',
+    concurrency: 1, // Limit to one concurrent job at a time
+  })
+  async handleDistributeTournamentPrizes() {
+    try {
+      await this.tournamentService.distributeEndedTournaments();
+    } catch (error) {
+      this.logger.error(
+        `❌ (distribute-tournament-prizes) Error distributing tournament prizes: ${error.message}`,
+      );
+    }
+  }
+
+  /**
+   * Handle failed jobs in the queue.
+   */
+  @OnGlobalQueueFailed()
+  onFailed(jobId: number, err: Error) {
+    this.logger.error(
+      `❌ Tournament Queue job ${jobId} has failed: ${err.message}`,
+    );
+  }
+}
@@ -0,0 +1,292 @@
+import {
+  Injectable,
+  InternalServerErrorException,
+  Logger,
+} from '@nestjs/common';
+import { InjectModel } from '@nestjs/mongoose';
+import { Model, Types } from 'mongoose';
+import { performance } from 'perf_hooks';
+import { Tournament, TournamentStatus } from './schemas/tournament.schema';
+import { TournamentEntry } from './schemas/tournament-entry.schema';
+import { PoolOperator } from 'src/pools/schemas/pool-operator.schema';
+import { HashTransactionCategory } from 'src/operators/schemas/hash-transaction.schema';
+import { OperatorService } from 'src/operators/operator.service';
+import { HashReserveService } from 'src/hash-reserve/hash-reserve.service';
+import { ApiResponse } from 'src/common/dto/response.dto';
+
+@Injectable()
+export class TournamentService {
+  private readonly logger = new Logger(TournamentService.name);
+
+  constructor(
+    @InjectModel(Tournament.name) private tournamentModel: Model<Tournament>,
+    @InjectModel(TournamentEntry.name)
+    private tournamentEntryModel: Model<TournamentEntry>,
+    @InjectModel(PoolOperator.name)
+    private poolOperatorModel: Model<PoolOperator>,
+    private readonly operatorService: OperatorService,
+    private readonly hashReserveService: HashReserveService,
+  ) {}
+
+  /**
+   * (Admin only) Creates a tournament between all pools.
+   */
+  async createTournament(
+    name: string,
+    startsAt: Date,
+    endsAt: Date,
+    prizeHASH: number,
+  ): Promise<ApiResponse<{ tournament: Tournament } | null>> {
+    try {
+      if (endsAt <= startsAt) {
+        return new ApiResponse(
+          400,
+          `(createTournament) Tournament must end after it starts.`,
+        );
+      }
+
+      if (endsAt <= new Date()) {
+        return new ApiResponse(
+          400,
+          `(createTournament) Tournament must end in the future.`,
+        );
+      }
+
+      const tournament = await this.tournamentModel.create({
+        name,
+        startsAt,
+        endsAt,
+        prizeHASH,
+      });
+
+      this.logger.log(
+        `🏁 (createTournament) Tournament "${name}" created, running from ${startsAt.toISOString()} to ${endsAt.toISOString()} with a prize of ${prizeHASH} $HASH.`,
+      );
+
+      return new ApiResponse(
+        200,
+        `(createTournament) Tournament created successfully.`,
+        { tournament },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(createTournament) Error creating tournament: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches all tournaments, most recent first.
+   */
+  async fetchTournaments(
+    status?: TournamentStatus,
+  ): Promise<ApiResponse<{ tournaments: Tournament[] }>> {
+    try {
+      const tournaments = await this.tournamentModel
+        .find(status ? { status } : {})
+        .sort({ startsAt: -1 })
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(fetchTournaments) Tournaments fetched successfully.`,
+        { tournaments },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchTournaments) Error fetching tournaments: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Fetches a tournament's pool rankings, highest score first.
+   */
+  async fetchTournamentLeaderboard(
+    tournamentId: Types.ObjectId,
+    page: number = 1,
+    limit: number = 50,
+  ): Promise<
+    ApiResponse<{
+      tournament: Tournament;
+      entries: { rank: number; poolId: Types.ObjectId; score: number }[];
+    }>
+  > {
+    try {
+      const tournament = await this.tournamentModel
+        .findById(tournamentId)
+        .lean();
+
+      if (!tournament) {
+        return new ApiResponse(
+          404,
+          `(fetchTournamentLeaderboard) Tournament not found.`,
+        );
+      }
+
+      const entries = await this.tournamentEntryModel
+        .find({ tournamentId }, { poolId: 1, score: 1 })
+        .sort({ score: -1, updatedAt: 1 })
+        .skip((page - 1) * limit)
+        .limit(limit)
+        .lean();
+
+      return new ApiResponse(
+        200,
+        `(fetchTournamentLeaderboard) Tournament leaderboard fetched.`,
+        {
+          tournament,
+          entries: entries.map((entry, index) => ({
+            rank: (page - 1) * limit + index + 1,
+            poolId: entry.poolId,
+            score: entry.score,
+          })),
+        },
+      );
+    } catch (err: any) {
+      throw new InternalServerErrorException(
+        new ApiResponse(
+          500,
+          `(fetchTournamentLeaderboard) Error fetching tournament leaderboard: ${err.message}`,
+        ),
+      );
+    }
+  }
+
+  /**
+   * Adds a point to the extractor's pool in every ongoing tournament.
+   *
+   * Called at the end of every drilling cycle that has an extractor.
+   */
+  async recordExtractionWin(
+    extractorOperatorId: Types.ObjectId,
+  ): Promise<void> {
+    const poolOperator = await this.poolOperatorModel
+      .findOne({ operator: extractorOperatorId }, { pool: 1 })
+      .lean();
+
+    // Solo operators don't compete in tournaments
+    if (!poolOperator) return;
+
+    const now = new Date();
+    const ongoingTournaments = await this.tournamentModel
+      .find(
+        {
+          status: TournamentStatus.ACTIVE,
+          startsAt: { $lte: now },
+          endsAt: { $gt: now },
+        },
+        { _id: 1 },
+      )
+      .lean();
+
+    if (ongoingTournaments.length === 0) return;
+
+    await this.tournamentEntryModel.bulkWrite(
+      ongoingTournaments.map((tournament) => ({
+        updateOne: {
+          filter: { tournamentId: tournament._id, poolId: poolOperator.pool },
+          update: { $inc: { score: 1 } },
+          upsert: true,
+        },
+      })),
+    );
+  }
+
+  /**
+   * Distributes the prizes of all active tournaments that have ended.
+   *
+   * Called periodically by the tournament queue.
+   */
+  async distributeEndedTournaments(): Promise<void> {
+    const startTime = performance.now();
+
+    const endedTournaments = await this.tournamentModel
+      .find({ status: TournamentStatus.ACTIVE, endsAt: { $lte: new Date() } })
+      .lean();
+
+    for (const tournament of endedTournaments) {
+      try {
+        await this.distributeTournamentPrize(tournament);
+      } catch (err: any) {
+        this.logger.error(
+          `❌ (distributeEndedTournaments) Error distributing prize for tournament ${tournament._id}: ${err.message}`,
+        );
+      }
+    }
+
+    this.logger.log(
+      `✅ (distributeEndedTournaments) Processed ${endedTournaments.length} ended tournaments in ${(performance.now() - startTime).toFixed(2)}ms.`,
+    );
+  }
+
+  /**
+   * Ranks a tournament's entries and splits `prizeHASH` equally between the members of the highest scoring pool.
+   *
+   * Ties go to the pool that reached the score first. The prize is withdrawn from the HASH Reserve,
+   * capped at what the reserve holds.
+   */
+  private async distributeTournamentPrize(
+    tournament: Pick<Tournament, '_id' | 'name' | 'prizeHASH'>,
+  ): Promise<void> {
+    // Claim the tournament first so that its prize can never be distributed twice
+    const claim = await this.tournamentModel.updateOne(
+      { _id: tournament._id, status: TournamentStatus.ACTIVE },
+      { $set: { status: TournamentStatus.ENDED } },
+    );
+
+    if (claim.modifiedCount === 0) return;
+
+    const winner = await this.tournamentEntryModel
+      .findOne({ tournamentId: tournament._id, score: { $gt: 0 } })
+      .sort({ score: -1, updatedAt: 1 })
+      .lean();
+
+    if (!winner) {
+      this.logger.log(
+        `🏁 (distributeTournamentPrize) Tournament ${tournament._id} ended without any scoring pool.`,
+      );
+      return;
+    }
+
+    const members = await this.poolOperatorModel
+      .find({ pool: winner.poolId }, { operator: 1 })
+      .lean();
+
+    let distributedHASH = 0;
+    if (members.length > 0 && tournament.prizeHASH > 0) {
+      distributedHASH = await this.hashReserveService.withdrawFromHASHReserve(
+        tournament.prizeHASH,
+      );
+
+      const share = distributedHASH / members.length;
+      if (share > 0) {
+        for (const member of members) {
+          await this.operatorService.addHASH(
+            member.operator as Types.ObjectId,
+            share,
+            HashTransactionCategory.TOURNAMENT_PRIZE,
+            `Prize for winning tournament "${tournament.name}"`,
+            tournament._id,
+            'tournament',
+          );
+        }
+      }
+    }
+
+    await this.tournamentModel.updateOne(
+      { _id: tournament._id },
+      { $set: { winnerPoolId: winner.poolId, distributedHASH } },
+    );
+
+    this.logger.log(
+      `🏆 (distributeTournamentPrize) Tournament ${tournament._id} won by pool ${winner.poolId} with a score of ${winner.score}. Distributed ${distributedHASH} $HASH to ${members.length} members.`,
+    );
+  }
+}
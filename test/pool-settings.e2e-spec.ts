@@ -0,0 +1,95 @@
+import { Test, TestingModule } from '@nestjs/testing';
+import { INestApplication } from '@nestjs/common';
+import { MongooseModule, getModelToken } from '@nestjs/mongoose';
+import { ConfigModule } from '@nestjs/config';
+import { Model, Types } from 'mongoose';
+import { AppModule } from '../src/app.module';
+import { PoolService } from '../src/pools/pool.service';
+import { Pool } from '../src/pools/schemas/pool.schema';
+import { PoolOperator } from '../src/pools/schemas/pool-operator.schema';
+
+/**
+ * End-to-end tests for updating a pool's settings
+ */
+describe('Pool Settings (e2e)', () => {
+  let app: INestApplication;
+  let poolService: PoolService;
+  let poolModel: Model<Pool>;
+  let poolOperatorModel: Model<PoolOperator>;
+
+  const leaderId = new Types.ObjectId();
+  const poolId = new Types.ObjectId();
+
+  beforeAll(async () => {
+    const moduleFixture: TestingModule = await Test.createTestingModule({
+      imports: [
+        ConfigModule.forRoot({
+          isGlobal: true,
+          envFilePath: '.env.test',
+        }),
+        MongooseModule.forRoot('mongodb://localhost:27017/test-pool-settings'),
+        AppModule,
+      ],
+    }).compile();
+
+    app = moduleFixture.createNestApplication();
+    await app.init();
+
+    poolService = app.get(PoolService);
+    poolModel = app.get(getModelToken(Pool.name));
+    poolOperatorModel = app.get(getModelToken(PoolOperator.name));
+
+    // A pool with room for 5 operators and 3 members (including the leader)
+    await poolModel.create({
+      _id: poolId,
+      leaderId,
+      name: 'settings-test',
+      maxOperators: 5,
+    });
+    await poolOperatorModel.insertMany(
+      [leaderId, new Types.ObjectId(), new Types.ObjectId()].map(
+        (operatorId) => ({ operator: operatorId, pool: poolId }),
+      ),
+    );
+  });
+
+  afterAll(async () => {
+    await poolOperatorModel.deleteMany({ pool: poolId });
+    await poolModel.deleteOne({ _id: poolId });
+    await app.close();
+  });
+
+  describe('Capacity shrink', () => {
+    it('should reject shrinking max operators below the member count', async () => {
+      const response = await poolService.updatePoolSettings(leaderId, poolId, {
+        maxOperators: 2,
+      });
+
+      expect(response.status).toBe(422);
+
+      const pool = await poolModel.findById(poolId).lean();
+      expect(pool.maxOperators).toBe(5);
+    });
+
+    it('should allow shrinking max operators down to the member count', async () => {
+      const response = await poolService.updatePoolSettings(leaderId, poolId, {
+        maxOperators: 3,
+      });
+
+      expect(response.status).toBe(200);
+
+      const pool = await poolModel.findById(poolId).lean();
+      expect(pool.maxOperators).toBe(3);
+    });
+
+    it('should reject settings updates from operators other than the leader', async () => {
+      const response = await poolService.updatePoolSettings(
+        new Types.ObjectId(),
+        poolId,
+        { maxOperators: 10 },
+      );
+
+      expect(response.status).toBe(403);
+    });
+  });
+});
@@ -0,0 +1,6 @@
+/**
+ * Wraps `value` so it can be returned from a mocked `find*().lean()` query
+ */
+export const leanResult = (value: unknown) => ({
+  lean: jest.fn().mockResolvedValue(value),
+});